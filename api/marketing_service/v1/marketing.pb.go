@@ -26,22 +26,39 @@ const (
 
 // Coupon 优惠券
 type Coupon struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`        // 优惠码
-	AppId         string                 `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`                  // 应用ID
-	DiscountType  string                 `protobuf:"bytes,3,opt,name=discountType,proto3" json:"discountType,omitempty"`    // 折扣类型: percent/fixed
-	DiscountValue int64                  `protobuf:"varint,4,opt,name=discountValue,proto3" json:"discountValue,omitempty"` // 折扣值(百分比或分)
-	Currency      string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`           // 货币单位: CNY/USD/EUR 等，仅固定金额类型需要，默认 CNY
-	ValidFrom     int64                  `protobuf:"varint,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`         // 生效时间(timestamp)
-	ValidUntil    int64                  `protobuf:"varint,6,opt,name=validUntil,proto3" json:"validUntil,omitempty"`       // 过期时间(timestamp)
-	MaxUses       int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`             // 最大使用次数
-	UsedCount     int32                  `protobuf:"varint,8,opt,name=usedCount,proto3" json:"usedCount,omitempty"`         // 已使用次数
-	MinAmount     int64                  `protobuf:"varint,9,opt,name=minAmount,proto3" json:"minAmount,omitempty"`         // 最低消费金额(分)
-	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`               // 状态: active/inactive/expired
-	CreatedAt     int64                  `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`        // 创建时间(timestamp)
-	UpdatedAt     int64                  `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`        // 更新时间(timestamp)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode       string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`               // 优惠码
+	AppId            string                 `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"`                         // 应用ID
+	DiscountType     string                 `protobuf:"bytes,3,opt,name=discountType,proto3" json:"discountType,omitempty"`           // 折扣类型: percent/fixed
+	DiscountValue    int64                  `protobuf:"varint,4,opt,name=discountValue,proto3" json:"discountValue,omitempty"`        // 折扣值(百分比或分)
+	Currency         string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`                  // 货币单位: CNY/USD/EUR 等，仅固定金额类型需要，默认 CNY
+	ValidFrom        int64                  `protobuf:"varint,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`                // 生效时间(timestamp)
+	ValidUntil       int64                  `protobuf:"varint,6,opt,name=validUntil,proto3" json:"validUntil,omitempty"`              // 过期时间(timestamp)
+	MaxUses          int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`                    // 最大使用次数
+	UsedCount        int32                  `protobuf:"varint,8,opt,name=usedCount,proto3" json:"usedCount,omitempty"`                // 已使用次数
+	MinAmount        int64                  `protobuf:"varint,9,opt,name=minAmount,proto3" json:"minAmount,omitempty"`                // 最低消费金额(分)
+	Status           string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                      // 状态: active/inactive/expired
+	CreatedAt        int64                  `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`               // 创建时间(timestamp)
+	UpdatedAt        int64                  `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`               // 更新时间(timestamp)
+	ValidDays        int32                  `protobuf:"varint,14,opt,name=validDays,proto3" json:"validDays,omitempty"`               // 相对有效期天数：>0 时需先发放给用户，实例有效期从发放时间起算，validFrom/validUntil 为发放期
+	ClaimLimit       int32                  `protobuf:"varint,15,opt,name=claimLimit,proto3" json:"claimLimit,omitempty"`             // 每个用户可领取次数（0 表示不限）
+	ClaimStock       int32                  `protobuf:"varint,16,opt,name=claimStock,proto3" json:"claimStock,omitempty"`             // 可领取总量（0 表示不限）
+	ClaimedCount     int32                  `protobuf:"varint,17,opt,name=claimedCount,proto3" json:"claimedCount,omitempty"`         // 已领取/发放数量
+	BoundUserId      string                 `protobuf:"bytes,18,opt,name=boundUserId,proto3" json:"boundUserId,omitempty"`            // 绑定用户ID：非空时只有该用户可以领取和使用
+	AudienceId       string                 `protobuf:"bytes,19,opt,name=audienceId,proto3" json:"audienceId,omitempty"`              // 受众ID：非空时只有属于该受众的用户可以验证通过
+	Eligibility      string                 `protobuf:"bytes,20,opt,name=eligibility,proto3" json:"eligibility,omitempty"`            // 新客限制: FIRST_COUPON_USE(首次使用优惠券)/FIRST_ORDER(首单)，为空表示不限
+	Timezone         string                 `protobuf:"bytes,21,opt,name=timezone,proto3" json:"timezone,omitempty"`                  // IANA 时区，如 Asia/Shanghai，用于求值 schedule，为空表示 UTC
+	Schedule         *CouponSchedule        `protobuf:"bytes,22,opt,name=schedule,proto3" json:"schedule,omitempty"`                  // 周期性可用时段和不可用日期（未设置表示不限）
+	UserDailyLimit   int32                  `protobuf:"varint,23,opt,name=userDailyLimit,proto3" json:"userDailyLimit,omitempty"`     // 每个用户每天可使用次数（0 表示不限，下同；按 timezone 的自然日/周/月计算）
+	UserWeeklyLimit  int32                  `protobuf:"varint,24,opt,name=userWeeklyLimit,proto3" json:"userWeeklyLimit,omitempty"`   // 每个用户每周可使用次数（周一开始）
+	UserMonthlyLimit int32                  `protobuf:"varint,25,opt,name=userMonthlyLimit,proto3" json:"userMonthlyLimit,omitempty"` // 每个用户每月可使用次数
+	DailyLimit       int32                  `protobuf:"varint,26,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`             // 所有用户每天合计可使用次数
+	CampaignId       string                 `protobuf:"bytes,27,opt,name=campaignId,proto3" json:"campaignId,omitempty"`              // 所属活动ID：非空时只有活动进行中才可用
+	Budget           int64                  `protobuf:"varint,28,opt,name=budget,proto3" json:"budget,omitempty"`                     // 折扣预算(分)：折扣金额合计上限（0 表示不限），用尽后自动停用
+	BudgetUsed       int64                  `protobuf:"varint,29,opt,name=budgetUsed,proto3" json:"budgetUsed,omitempty"`             // 已使用的折扣金额(分)
+	Pacing           *CouponPacing          `protobuf:"bytes,30,opt,name=pacing,proto3" json:"pacing,omitempty"`                      // 投放节奏（未设置表示不限）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Coupon) Reset() {
@@ -165,130 +182,152 @@ func (x *Coupon) GetUpdatedAt() int64 {
 	return 0
 }
 
-// CreateCouponRequest 创建优惠券请求
-type CreateCouponRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	DiscountType  string                 `protobuf:"bytes,2,opt,name=discountType,proto3" json:"discountType,omitempty"`
-	DiscountValue int64                  `protobuf:"varint,3,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // 货币单位: CNY/USD/EUR 等，仅固定金额类型需要，默认 CNY
-	ValidFrom     int64                  `protobuf:"varint,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
-	ValidUntil    int64                  `protobuf:"varint,6,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
-	MaxUses       int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
-	MinAmount     int64                  `protobuf:"varint,8,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Coupon) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
 }
 
-func (x *CreateCouponRequest) Reset() {
-	*x = CreateCouponRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Coupon) GetClaimLimit() int32 {
+	if x != nil {
+		return x.ClaimLimit
+	}
+	return 0
 }
 
-func (x *CreateCouponRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Coupon) GetClaimStock() int32 {
+	if x != nil {
+		return x.ClaimStock
+	}
+	return 0
 }
 
-func (*CreateCouponRequest) ProtoMessage() {}
+func (x *Coupon) GetClaimedCount() int32 {
+	if x != nil {
+		return x.ClaimedCount
+	}
+	return 0
+}
 
-func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[1]
+func (x *Coupon) GetBoundUserId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BoundUserId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
-func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{1}
+func (x *Coupon) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
 }
 
-func (x *CreateCouponRequest) GetCouponCode() string {
+func (x *Coupon) GetEligibility() string {
 	if x != nil {
-		return x.CouponCode
+		return x.Eligibility
 	}
 	return ""
 }
 
-func (x *CreateCouponRequest) GetDiscountType() string {
+func (x *Coupon) GetTimezone() string {
 	if x != nil {
-		return x.DiscountType
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *CreateCouponRequest) GetDiscountValue() int64 {
+func (x *Coupon) GetSchedule() *CouponSchedule {
 	if x != nil {
-		return x.DiscountValue
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Coupon) GetUserDailyLimit() int32 {
+	if x != nil {
+		return x.UserDailyLimit
 	}
 	return 0
 }
 
-func (x *CreateCouponRequest) GetCurrency() string {
+func (x *Coupon) GetUserWeeklyLimit() int32 {
 	if x != nil {
-		return x.Currency
+		return x.UserWeeklyLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCouponRequest) GetValidFrom() int64 {
+func (x *Coupon) GetUserMonthlyLimit() int32 {
 	if x != nil {
-		return x.ValidFrom
+		return x.UserMonthlyLimit
 	}
 	return 0
 }
 
-func (x *CreateCouponRequest) GetValidUntil() int64 {
+func (x *Coupon) GetDailyLimit() int32 {
 	if x != nil {
-		return x.ValidUntil
+		return x.DailyLimit
 	}
 	return 0
 }
 
-func (x *CreateCouponRequest) GetMaxUses() int32 {
+func (x *Coupon) GetCampaignId() string {
 	if x != nil {
-		return x.MaxUses
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *Coupon) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
 	}
 	return 0
 }
 
-func (x *CreateCouponRequest) GetMinAmount() int64 {
+func (x *Coupon) GetBudgetUsed() int64 {
 	if x != nil {
-		return x.MinAmount
+		return x.BudgetUsed
 	}
 	return 0
 }
 
-// CreateCouponReply 创建优惠券响应
-type CreateCouponReply struct {
+func (x *Coupon) GetPacing() *CouponPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+// CouponQuota 频次限制的剩余额度
+type CouponQuota struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`          // 限制范围: USER_DAY/USER_WEEK/USER_MONTH/DAY/TOTAL(总使用次数 maxUses)
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`         // 限制次数
+	Used          int32                  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`           // 已使用次数
+	Remaining     int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"` // 剩余次数
+	ResetAt       int64                  `protobuf:"varint,5,opt,name=resetAt,proto3" json:"resetAt,omitempty"`     // 额度重置时间(timestamp)，TOTAL 为 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCouponReply) Reset() {
-	*x = CreateCouponReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[2]
+func (x *CouponQuota) Reset() {
+	*x = CouponQuota{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCouponReply) String() string {
+func (x *CouponQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCouponReply) ProtoMessage() {}
+func (*CouponQuota) ProtoMessage() {}
 
-func (x *CreateCouponReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[2]
+func (x *CouponQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,41 +338,71 @@ func (x *CreateCouponReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCouponReply.ProtoReflect.Descriptor instead.
-func (*CreateCouponReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CouponQuota.ProtoReflect.Descriptor instead.
+func (*CouponQuota) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateCouponReply) GetCoupon() *Coupon {
+func (x *CouponQuota) GetScope() string {
 	if x != nil {
-		return x.Coupon
+		return x.Scope
 	}
-	return nil
+	return ""
 }
 
-// GetCouponRequest 获取优惠券请求
-type GetCouponRequest struct {
+func (x *CouponQuota) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CouponQuota) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *CouponQuota) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *CouponQuota) GetResetAt() int64 {
+	if x != nil {
+		return x.ResetAt
+	}
+	return 0
+}
+
+// CouponTimeWindow 每周可用时段：endTime 不晚于 startTime 时跨过午夜，结束于次日
+type CouponTimeWindow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Weekdays      []int32                `protobuf:"varint,1,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"` // 时段开始的星期几（0=周日 ... 6=周六），为空表示每天
+	StartTime     string                 `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`       // 开始时间 HH:MM
+	EndTime       string                 `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`           // 结束时间 HH:MM（不含），24:00 表示当天结束
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCouponRequest) Reset() {
-	*x = GetCouponRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[3]
+func (x *CouponTimeWindow) Reset() {
+	*x = CouponTimeWindow{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCouponRequest) String() string {
+func (x *CouponTimeWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCouponRequest) ProtoMessage() {}
+func (*CouponTimeWindow) ProtoMessage() {}
 
-func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[3]
+func (x *CouponTimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,41 +413,56 @@ func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCouponRequest.ProtoReflect.Descriptor instead.
-func (*GetCouponRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CouponTimeWindow.ProtoReflect.Descriptor instead.
+func (*CouponTimeWindow) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetCouponRequest) GetCouponCode() string {
+func (x *CouponTimeWindow) GetWeekdays() []int32 {
 	if x != nil {
-		return x.CouponCode
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *CouponTimeWindow) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
 	}
 	return ""
 }
 
-// GetCouponReply 获取优惠券响应
-type GetCouponReply struct {
+func (x *CouponTimeWindow) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// CouponSchedule 优惠券周期性可用时段，在优惠券时区内求值
+type CouponSchedule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Windows       []*CouponTimeWindow    `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`             // 可用时段，为空表示全天可用
+	BlackoutDates []string               `protobuf:"bytes,2,rep,name=blackoutDates,proto3" json:"blackoutDates,omitempty"` // 不可用日期 YYYY-MM-DD
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCouponReply) Reset() {
-	*x = GetCouponReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[4]
+func (x *CouponSchedule) Reset() {
+	*x = CouponSchedule{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCouponReply) String() string {
+func (x *CouponSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCouponReply) ProtoMessage() {}
+func (*CouponSchedule) ProtoMessage() {}
 
-func (x *GetCouponReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[4]
+func (x *CouponSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,44 +473,49 @@ func (x *GetCouponReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCouponReply.ProtoReflect.Descriptor instead.
-func (*GetCouponReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CouponSchedule.ProtoReflect.Descriptor instead.
+func (*CouponSchedule) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetCouponReply) GetCoupon() *Coupon {
+func (x *CouponSchedule) GetWindows() []*CouponTimeWindow {
 	if x != nil {
-		return x.Coupon
+		return x.Windows
 	}
 	return nil
 }
 
-// ListCouponsRequest 列出优惠券请求
-type ListCouponsRequest struct {
+func (x *CouponSchedule) GetBlackoutDates() []string {
+	if x != nil {
+		return x.BlackoutDates
+	}
+	return nil
+}
+
+// CouponPacing 投放节奏：把 maxUses 和 budget 分摊到生效期内，从 validFrom 起每个时间片开始时释放一批额度
+type CouponPacing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID（查询参数，必填）
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Interval      string                 `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`   // 时间片长度: HOUR/DAY（更新时传空字符串表示取消节奏）
+	Curve         []int32                `protobuf:"varint,2,rep,packed,name=curve,proto3" json:"curve,omitempty"` // 节奏曲线：把生效期等分为 N 段，每段额度与权重成正比，为空表示全程均匀
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCouponsRequest) Reset() {
-	*x = ListCouponsRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[5]
+func (x *CouponPacing) Reset() {
+	*x = CouponPacing{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCouponsRequest) String() string {
+func (x *CouponPacing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCouponsRequest) ProtoMessage() {}
+func (*CouponPacing) ProtoMessage() {}
 
-func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[5]
+func (x *CouponPacing) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,65 +526,70 @@ func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
-func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListCouponsRequest) GetAppId() string {
-	if x != nil {
-		return x.AppId
-	}
-	return ""
+// Deprecated: Use CouponPacing.ProtoReflect.Descriptor instead.
+func (*CouponPacing) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListCouponsRequest) GetStatus() string {
+func (x *CouponPacing) GetInterval() string {
 	if x != nil {
-		return x.Status
+		return x.Interval
 	}
 	return ""
 }
 
-func (x *ListCouponsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListCouponsRequest) GetPageSize() int32 {
+func (x *CouponPacing) GetCurve() []int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Curve
 	}
-	return 0
+	return nil
 }
 
-// ListCouponsReply 列出优惠券响应
-type ListCouponsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Coupons       []*Coupon              `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CreateCouponRequest 创建优惠券请求
+type CreateCouponRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode       string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	DiscountType     string                 `protobuf:"bytes,2,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue    int64                  `protobuf:"varint,3,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // 货币单位: CNY/USD/EUR 等，仅固定金额类型需要，默认 CNY
+	ValidFrom        int64                  `protobuf:"varint,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil       int64                  `protobuf:"varint,6,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	MaxUses          int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	MinAmount        int64                  `protobuf:"varint,8,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	ValidDays        int32                  `protobuf:"varint,9,opt,name=validDays,proto3" json:"validDays,omitempty"`                // 相对有效期天数（可选，>0 时需先发放给用户）
+	ClaimLimit       int32                  `protobuf:"varint,10,opt,name=claimLimit,proto3" json:"claimLimit,omitempty"`             // 每个用户可领取次数（可选，0 表示不限）
+	ClaimStock       int32                  `protobuf:"varint,11,opt,name=claimStock,proto3" json:"claimStock,omitempty"`             // 可领取总量（可选，0 表示不限）
+	BoundUserId      string                 `protobuf:"bytes,12,opt,name=boundUserId,proto3" json:"boundUserId,omitempty"`            // 绑定用户ID（可选，如补偿券只允许该用户使用）
+	AudienceId       string                 `protobuf:"bytes,13,opt,name=audienceId,proto3" json:"audienceId,omitempty"`              // 受众ID（可选）
+	Eligibility      string                 `protobuf:"bytes,14,opt,name=eligibility,proto3" json:"eligibility,omitempty"`            // 新客限制（可选）
+	Timezone         string                 `protobuf:"bytes,15,opt,name=timezone,proto3" json:"timezone,omitempty"`                  // IANA 时区（可选，默认 UTC）
+	Schedule         *CouponSchedule        `protobuf:"bytes,16,opt,name=schedule,proto3" json:"schedule,omitempty"`                  // 周期性可用时段（可选）
+	UserDailyLimit   int32                  `protobuf:"varint,17,opt,name=userDailyLimit,proto3" json:"userDailyLimit,omitempty"`     // 每个用户每天可使用次数（0 表示不限）
+	UserWeeklyLimit  int32                  `protobuf:"varint,18,opt,name=userWeeklyLimit,proto3" json:"userWeeklyLimit,omitempty"`   // 每个用户每周可使用次数（0 表示不限）
+	UserMonthlyLimit int32                  `protobuf:"varint,19,opt,name=userMonthlyLimit,proto3" json:"userMonthlyLimit,omitempty"` // 每个用户每月可使用次数（0 表示不限）
+	DailyLimit       int32                  `protobuf:"varint,20,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`             // 所有用户每天合计可使用次数（0 表示不限）
+	CampaignId       string                 `protobuf:"bytes,21,opt,name=campaignId,proto3" json:"campaignId,omitempty"`              // 所属活动ID（可选）
+	Budget           int64                  `protobuf:"varint,22,opt,name=budget,proto3" json:"budget,omitempty"`                     // 折扣预算(分)（0 表示不限）
+	Pacing           *CouponPacing          `protobuf:"bytes,23,opt,name=pacing,proto3" json:"pacing,omitempty"`                      // 投放节奏（可选，需要设置 maxUses 或 budget）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListCouponsReply) Reset() {
-	*x = ListCouponsReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[6]
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCouponsReply) String() string {
+func (x *CreateCouponRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCouponsReply) ProtoMessage() {}
+func (*CreateCouponRequest) ProtoMessage() {}
 
-func (x *ListCouponsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[6]
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,171 +600,195 @@ func (x *ListCouponsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCouponsReply.ProtoReflect.Descriptor instead.
-func (*ListCouponsReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListCouponsReply) GetCoupons() []*Coupon {
+func (x *CreateCouponRequest) GetCouponCode() string {
 	if x != nil {
-		return x.Coupons
+		return x.CouponCode
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCouponsReply) GetTotal() int32 {
+func (x *CreateCouponRequest) GetDiscountType() string {
 	if x != nil {
-		return x.Total
+		return x.DiscountType
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCouponsReply) GetPage() int32 {
+func (x *CreateCouponRequest) GetDiscountValue() int64 {
 	if x != nil {
-		return x.Page
+		return x.DiscountValue
 	}
 	return 0
 }
 
-func (x *ListCouponsReply) GetPageSize() int32 {
+func (x *CreateCouponRequest) GetCurrency() string {
 	if x != nil {
-		return x.PageSize
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateCouponRequest) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
 	}
 	return 0
 }
 
-// UpdateCouponRequest 更新优惠券请求
-type UpdateCouponRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	DiscountType  string                 `protobuf:"bytes,2,opt,name=discountType,proto3" json:"discountType,omitempty"`
-	DiscountValue int64                  `protobuf:"varint,3,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
-	Currency      string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"` // 货币单位: CNY/USD/EUR 等，仅固定金额类型需要
-	ValidFrom     int64                  `protobuf:"varint,4,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
-	ValidUntil    int64                  `protobuf:"varint,5,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
-	MaxUses       int32                  `protobuf:"varint,6,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
-	MinAmount     int64                  `protobuf:"varint,7,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
-	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateCouponRequest) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
 }
 
-func (x *UpdateCouponRequest) Reset() {
-	*x = UpdateCouponRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateCouponRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
 }
 
-func (x *UpdateCouponRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateCouponRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
 }
 
-func (*UpdateCouponRequest) ProtoMessage() {}
+func (x *CreateCouponRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
 
-func (x *UpdateCouponRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[7]
+func (x *CreateCouponRequest) GetClaimLimit() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ClaimLimit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateCouponRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCouponRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{7}
+func (x *CreateCouponRequest) GetClaimStock() int32 {
+	if x != nil {
+		return x.ClaimStock
+	}
+	return 0
 }
 
-func (x *UpdateCouponRequest) GetCouponCode() string {
+func (x *CreateCouponRequest) GetBoundUserId() string {
 	if x != nil {
-		return x.CouponCode
+		return x.BoundUserId
 	}
 	return ""
 }
 
-func (x *UpdateCouponRequest) GetDiscountType() string {
+func (x *CreateCouponRequest) GetAudienceId() string {
 	if x != nil {
-		return x.DiscountType
+		return x.AudienceId
 	}
 	return ""
 }
 
-func (x *UpdateCouponRequest) GetDiscountValue() int64 {
+func (x *CreateCouponRequest) GetEligibility() string {
 	if x != nil {
-		return x.DiscountValue
+		return x.Eligibility
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCouponRequest) GetCurrency() string {
+func (x *CreateCouponRequest) GetTimezone() string {
 	if x != nil {
-		return x.Currency
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *UpdateCouponRequest) GetValidFrom() int64 {
+func (x *CreateCouponRequest) GetSchedule() *CouponSchedule {
 	if x != nil {
-		return x.ValidFrom
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *CreateCouponRequest) GetUserDailyLimit() int32 {
+	if x != nil {
+		return x.UserDailyLimit
 	}
 	return 0
 }
 
-func (x *UpdateCouponRequest) GetValidUntil() int64 {
+func (x *CreateCouponRequest) GetUserWeeklyLimit() int32 {
 	if x != nil {
-		return x.ValidUntil
+		return x.UserWeeklyLimit
 	}
 	return 0
 }
 
-func (x *UpdateCouponRequest) GetMaxUses() int32 {
+func (x *CreateCouponRequest) GetUserMonthlyLimit() int32 {
 	if x != nil {
-		return x.MaxUses
+		return x.UserMonthlyLimit
 	}
 	return 0
 }
 
-func (x *UpdateCouponRequest) GetMinAmount() int64 {
+func (x *CreateCouponRequest) GetDailyLimit() int32 {
 	if x != nil {
-		return x.MinAmount
+		return x.DailyLimit
 	}
 	return 0
 }
 
-func (x *UpdateCouponRequest) GetStatus() string {
+func (x *CreateCouponRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Status
+		return x.CampaignId
 	}
 	return ""
 }
 
-// UpdateCouponReply 更新优惠券响应
-type UpdateCouponReply struct {
+func (x *CreateCouponRequest) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *CreateCouponRequest) GetPacing() *CouponPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+// CreateCouponReply 创建优惠券响应
+type CreateCouponReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCouponReply) Reset() {
-	*x = UpdateCouponReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[8]
+func (x *CreateCouponReply) Reset() {
+	*x = CreateCouponReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCouponReply) String() string {
+func (x *CreateCouponReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCouponReply) ProtoMessage() {}
+func (*CreateCouponReply) ProtoMessage() {}
 
-func (x *UpdateCouponReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[8]
+func (x *CreateCouponReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,41 +799,41 @@ func (x *UpdateCouponReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCouponReply.ProtoReflect.Descriptor instead.
-func (*UpdateCouponReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateCouponReply.ProtoReflect.Descriptor instead.
+func (*CreateCouponReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdateCouponReply) GetCoupon() *Coupon {
+func (x *CreateCouponReply) GetCoupon() *Coupon {
 	if x != nil {
 		return x.Coupon
 	}
 	return nil
 }
 
-// DeleteCouponRequest 删除优惠券请求
-type DeleteCouponRequest struct {
+// GetCouponRequest 获取优惠券请求
+type GetCouponRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCouponRequest) Reset() {
-	*x = DeleteCouponRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[9]
+func (x *GetCouponRequest) Reset() {
+	*x = GetCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCouponRequest) String() string {
+func (x *GetCouponRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCouponRequest) ProtoMessage() {}
+func (*GetCouponRequest) ProtoMessage() {}
 
-func (x *DeleteCouponRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[9]
+func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,42 +844,41 @@ func (x *DeleteCouponRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCouponRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCouponRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetCouponRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteCouponRequest) GetCouponCode() string {
+func (x *GetCouponRequest) GetCouponCode() string {
 	if x != nil {
 		return x.CouponCode
 	}
 	return ""
 }
 
-// ValidateCouponRequest 验证优惠券请求 (供 Payment Service 调用)
-type ValidateCouponRequest struct {
+// GetCouponReply 获取优惠券响应
+type GetCouponReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // 订单金额(分)
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateCouponRequest) Reset() {
-	*x = ValidateCouponRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[10]
+func (x *GetCouponReply) Reset() {
+	*x = GetCouponReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateCouponRequest) String() string {
+func (x *GetCouponReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateCouponRequest) ProtoMessage() {}
+func (*GetCouponReply) ProtoMessage() {}
 
-func (x *ValidateCouponRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[10]
+func (x *GetCouponReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,52 +889,57 @@ func (x *ValidateCouponRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateCouponRequest.ProtoReflect.Descriptor instead.
-func (*ValidateCouponRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *ValidateCouponRequest) GetCouponCode() string {
-	if x != nil {
-		return x.CouponCode
-	}
-	return ""
+// Deprecated: Use GetCouponReply.ProtoReflect.Descriptor instead.
+func (*GetCouponReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ValidateCouponRequest) GetAmount() int64 {
+func (x *GetCouponReply) GetCoupon() *Coupon {
 	if x != nil {
-		return x.Amount
+		return x.Coupon
 	}
-	return 0
+	return nil
 }
 
-// ValidateCouponReply 验证优惠券响应
-type ValidateCouponReply struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Valid          bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	DiscountAmount int64                  `protobuf:"varint,3,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"` // 折扣金额(分)
-	FinalAmount    int64                  `protobuf:"varint,4,opt,name=finalAmount,proto3" json:"finalAmount,omitempty"`       // 最终金额(分)
-	Coupon         *Coupon                `protobuf:"bytes,5,opt,name=coupon,proto3" json:"coupon,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ListCouponsRequest 列出优惠券请求
+type ListCouponsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AppId              string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID（查询参数，必填）
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page               int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize           int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	CodePrefix         string                 `protobuf:"bytes,5,opt,name=codePrefix,proto3" json:"codePrefix,omitempty"`                   // 优惠码前缀匹配
+	CodeContains       string                 `protobuf:"bytes,6,opt,name=codeContains,proto3" json:"codeContains,omitempty"`               // 优惠码子串匹配
+	DiscountType       string                 `protobuf:"bytes,7,opt,name=discountType,proto3" json:"discountType,omitempty"`               // 折扣类型
+	Currency           string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                       // 货币单位: CNY/USD/EUR
+	ActiveFrom         int64                  `protobuf:"varint,9,opt,name=activeFrom,proto3" json:"activeFrom,omitempty"`                  // 有效期窗口起始(timestamp)，与优惠券有效期有交集即命中
+	ActiveUntil        int64                  `protobuf:"varint,10,opt,name=activeUntil,proto3" json:"activeUntil,omitempty"`               // 有效期窗口结束(timestamp)
+	ExpiringWithinDays int32                  `protobuf:"varint,11,opt,name=expiringWithinDays,proto3" json:"expiringWithinDays,omitempty"` // N 天内过期（仅未过期的优惠券）
+	Exhausted          *bool                  `protobuf:"varint,12,opt,name=exhausted,proto3,oneof" json:"exhausted,omitempty"`             // 是否已用尽: true 仅已用尽 / false 仅未用尽 / 不传不筛选
+	CreatedFrom        int64                  `protobuf:"varint,13,opt,name=createdFrom,proto3" json:"createdFrom,omitempty"`               // 创建时间起始(timestamp)
+	CreatedTo          int64                  `protobuf:"varint,14,opt,name=createdTo,proto3" json:"createdTo,omitempty"`                   // 创建时间结束(timestamp)
+	SortBy             string                 `protobuf:"bytes,15,opt,name=sortBy,proto3" json:"sortBy,omitempty"`                          // 排序字段，默认 created_at
+	SortOrder          string                 `protobuf:"bytes,16,opt,name=sortOrder,proto3" json:"sortOrder,omitempty"`                    // 排序方向，默认 desc
+	CampaignId         string                 `protobuf:"bytes,17,opt,name=campaignId,proto3" json:"campaignId,omitempty"`                  // 所属活动ID
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ValidateCouponReply) Reset() {
-	*x = ValidateCouponReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[11]
+func (x *ListCouponsRequest) Reset() {
+	*x = ListCouponsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateCouponReply) String() string {
+func (x *ListCouponsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateCouponReply) ProtoMessage() {}
+func (*ListCouponsRequest) ProtoMessage() {}
 
-func (x *ValidateCouponReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[11]
+func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,76 +950,8931 @@ func (x *ValidateCouponReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateCouponReply.ProtoReflect.Descriptor instead.
-func (*ValidateCouponReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ValidateCouponReply) GetValid() bool {
+func (x *ListCouponsRequest) GetAppId() string {
 	if x != nil {
-		return x.Valid
+		return x.AppId
 	}
-	return false
+	return ""
 }
 
-func (x *ValidateCouponReply) GetMessage() string {
+func (x *ListCouponsRequest) GetStatus() string {
 	if x != nil {
-		return x.Message
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ValidateCouponReply) GetDiscountAmount() int64 {
+func (x *ListCouponsRequest) GetPage() int32 {
 	if x != nil {
-		return x.DiscountAmount
+		return x.Page
 	}
 	return 0
 }
 
-func (x *ValidateCouponReply) GetFinalAmount() int64 {
+func (x *ListCouponsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.FinalAmount
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ValidateCouponReply) GetCoupon() *Coupon {
+func (x *ListCouponsRequest) GetCodePrefix() string {
 	if x != nil {
-		return x.Coupon
+		return x.CodePrefix
 	}
-	return nil
-}
-
-// UseCouponRequest 使用优惠券请求 (供 Payment Service 调用)
-type UseCouponRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode     string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	AppId          string                 `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID
-	UserId         string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
-	PaymentOrderId string                 `protobuf:"bytes,4,opt,name=paymentOrderId,proto3" json:"paymentOrderId,omitempty"` // 支付订单ID（payment-service的业务订单号orderId）
-	PaymentId      string                 `protobuf:"bytes,5,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	OriginalAmount int64                  `protobuf:"varint,6,opt,name=originalAmount,proto3" json:"originalAmount,omitempty"`
-	DiscountAmount int64                  `protobuf:"varint,7,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"`
-	FinalAmount    int64                  `protobuf:"varint,8,opt,name=finalAmount,proto3" json:"finalAmount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return ""
 }
 
-func (x *UseCouponRequest) Reset() {
-	*x = UseCouponRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListCouponsRequest) GetCodeContains() string {
+	if x != nil {
+		return x.CodeContains
+	}
+	return ""
+}
+
+func (x *ListCouponsRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *ListCouponsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListCouponsRequest) GetActiveFrom() int64 {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetActiveUntil() int64 {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetExpiringWithinDays() int32 {
+	if x != nil {
+		return x.ExpiringWithinDays
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetExhausted() bool {
+	if x != nil && x.Exhausted != nil {
+		return *x.Exhausted
+	}
+	return false
+}
+
+func (x *ListCouponsRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListCouponsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListCouponsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// ListCouponsReply 列出优惠券响应
+type ListCouponsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupons       []*Coupon              `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponsReply) Reset() {
+	*x = ListCouponsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsReply) ProtoMessage() {}
+
+func (x *ListCouponsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsReply.ProtoReflect.Descriptor instead.
+func (*ListCouponsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCouponsReply) GetCoupons() []*Coupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *ListCouponsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCouponsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateCouponRequest 更新优惠券请求
+type UpdateCouponRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode       string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	DiscountType     string                 `protobuf:"bytes,2,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue    int64                  `protobuf:"varint,3,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	Currency         string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"` // 货币单位: CNY/USD/EUR 等，仅固定金额类型需要
+	ValidFrom        int64                  `protobuf:"varint,4,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil       int64                  `protobuf:"varint,5,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	MaxUses          int32                  `protobuf:"varint,6,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	MinAmount        int64                  `protobuf:"varint,7,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ValidDays        *int32                 `protobuf:"varint,11,opt,name=validDays,proto3,oneof" json:"validDays,omitempty"`               // 相对有效期天数（0 表示改回使用优惠券本身的有效期）
+	ClaimLimit       *int32                 `protobuf:"varint,12,opt,name=claimLimit,proto3,oneof" json:"claimLimit,omitempty"`             // 每个用户可领取次数（0 表示不限）
+	ClaimStock       *int32                 `protobuf:"varint,13,opt,name=claimStock,proto3,oneof" json:"claimStock,omitempty"`             // 可领取总量（0 表示不限，小于已领取数量时停止领取）
+	BoundUserId      *string                `protobuf:"bytes,14,opt,name=boundUserId,proto3,oneof" json:"boundUserId,omitempty"`            // 绑定用户ID（空字符串表示解除绑定）
+	AudienceId       *string                `protobuf:"bytes,15,opt,name=audienceId,proto3,oneof" json:"audienceId,omitempty"`              // 受众ID（空字符串表示不限受众）
+	Eligibility      *string                `protobuf:"bytes,16,opt,name=eligibility,proto3,oneof" json:"eligibility,omitempty"`            // 新客限制（空字符串表示不限）
+	Timezone         *string                `protobuf:"bytes,17,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`                  // IANA 时区（空字符串表示 UTC）
+	Schedule         *CouponSchedule        `protobuf:"bytes,18,opt,name=schedule,proto3" json:"schedule,omitempty"`                        // 周期性可用时段（未传表示不修改，传空对象表示不限）
+	UserDailyLimit   *int32                 `protobuf:"varint,19,opt,name=userDailyLimit,proto3,oneof" json:"userDailyLimit,omitempty"`     // 每个用户每天可使用次数（0 表示不限）
+	UserWeeklyLimit  *int32                 `protobuf:"varint,20,opt,name=userWeeklyLimit,proto3,oneof" json:"userWeeklyLimit,omitempty"`   // 每个用户每周可使用次数（0 表示不限）
+	UserMonthlyLimit *int32                 `protobuf:"varint,21,opt,name=userMonthlyLimit,proto3,oneof" json:"userMonthlyLimit,omitempty"` // 每个用户每月可使用次数（0 表示不限）
+	DailyLimit       *int32                 `protobuf:"varint,22,opt,name=dailyLimit,proto3,oneof" json:"dailyLimit,omitempty"`             // 所有用户每天合计可使用次数（0 表示不限）
+	CampaignId       *string                `protobuf:"bytes,23,opt,name=campaignId,proto3,oneof" json:"campaignId,omitempty"`              // 所属活动ID（空字符串表示移出活动）
+	Budget           *int64                 `protobuf:"varint,24,opt,name=budget,proto3,oneof" json:"budget,omitempty"`                     // 折扣预算(分)（0 表示不限；预算用尽停用后，提高预算并重新激活即可恢复）
+	Pacing           *CouponPacing          `protobuf:"bytes,25,opt,name=pacing,proto3" json:"pacing,omitempty"`                            // 投放节奏（未传表示不修改，interval 为空表示取消节奏）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateCouponRequest) Reset() {
+	*x = UpdateCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCouponRequest) ProtoMessage() {}
+
+func (x *UpdateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCouponRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateCouponRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetDiscountValue() int64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetValidDays() int32 {
+	if x != nil && x.ValidDays != nil {
+		return *x.ValidDays
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetClaimLimit() int32 {
+	if x != nil && x.ClaimLimit != nil {
+		return *x.ClaimLimit
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetClaimStock() int32 {
+	if x != nil && x.ClaimStock != nil {
+		return *x.ClaimStock
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetBoundUserId() string {
+	if x != nil && x.BoundUserId != nil {
+		return *x.BoundUserId
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetAudienceId() string {
+	if x != nil && x.AudienceId != nil {
+		return *x.AudienceId
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetEligibility() string {
+	if x != nil && x.Eligibility != nil {
+		return *x.Eligibility
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetSchedule() *CouponSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *UpdateCouponRequest) GetUserDailyLimit() int32 {
+	if x != nil && x.UserDailyLimit != nil {
+		return *x.UserDailyLimit
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetUserWeeklyLimit() int32 {
+	if x != nil && x.UserWeeklyLimit != nil {
+		return *x.UserWeeklyLimit
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetUserMonthlyLimit() int32 {
+	if x != nil && x.UserMonthlyLimit != nil {
+		return *x.UserMonthlyLimit
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetDailyLimit() int32 {
+	if x != nil && x.DailyLimit != nil {
+		return *x.DailyLimit
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetCampaignId() string {
+	if x != nil && x.CampaignId != nil {
+		return *x.CampaignId
+	}
+	return ""
+}
+
+func (x *UpdateCouponRequest) GetBudget() int64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetPacing() *CouponPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+// UpdateCouponReply 更新优惠券响应
+type UpdateCouponReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCouponReply) Reset() {
+	*x = UpdateCouponReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCouponReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCouponReply) ProtoMessage() {}
+
+func (x *UpdateCouponReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCouponReply.ProtoReflect.Descriptor instead.
+func (*UpdateCouponReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateCouponReply) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// DeleteCouponRequest 删除优惠券请求
+type DeleteCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCouponRequest) Reset() {
+	*x = DeleteCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouponRequest) ProtoMessage() {}
+
+func (x *DeleteCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouponRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteCouponRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+// ImportCouponsRequest 批量导入优惠券请求
+// CSV 首行为标题行，必填列: couponCode, discountType, discountValue, validFrom, validUntil, maxUses；
+// 可选列: currency, minAmount, status。也接受优惠券导出文件的中英文列标题
+type ImportCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`     // 应用ID（由中间件从 Header 提取）
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // CSV 内容（UTF-8）
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`       // 导入模式，默认 all_or_nothing
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`  // 仅预览校验结果，不写入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCouponsRequest) Reset() {
+	*x = ImportCouponsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCouponsRequest) ProtoMessage() {}
+
+func (x *ImportCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ImportCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportCouponsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ImportCouponsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportCouponsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportCouponsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportCouponRowResult 批量导入单行结果
+type ImportCouponRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // 文件中的行号（标题行为第 1 行）
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`   // OK/CREATED/INVALID/DUPLICATE/ALREADY_EXISTS/FAILED/NOT_IMPORTED
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // 失败原因
+	Coupon        *Coupon                `protobuf:"bytes,5,opt,name=coupon,proto3" json:"coupon,omitempty"`   // 解析出的优惠券（校验失败时为空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCouponRowResult) Reset() {
+	*x = ImportCouponRowResult{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCouponRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCouponRowResult) ProtoMessage() {}
+
+func (x *ImportCouponRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCouponRowResult.ProtoReflect.Descriptor instead.
+func (*ImportCouponRowResult) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportCouponRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportCouponRowResult) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *ImportCouponRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportCouponRowResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportCouponRowResult) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// ImportCouponsReply 批量导入优惠券响应
+type ImportCouponsReply struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Total         int32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`         // 数据行数
+	Valid         int32                    `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`         // 校验通过行数
+	Created       int32                    `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`     // 实际创建行数
+	Failed        int32                    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`       // 失败行数
+	Committed     bool                     `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"` // 是否有数据写入
+	DryRun        bool                     `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Rows          []*ImportCouponRowResult `protobuf:"bytes,7,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCouponsReply) Reset() {
+	*x = ImportCouponsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCouponsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCouponsReply) ProtoMessage() {}
+
+func (x *ImportCouponsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCouponsReply.ProtoReflect.Descriptor instead.
+func (*ImportCouponsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportCouponsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportCouponsReply) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ImportCouponsReply) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportCouponsReply) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportCouponsReply) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *ImportCouponsReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportCouponsReply) GetRows() []*ImportCouponRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// BatchUpdateCouponStatusRequest 批量修改优惠券状态请求（couponCodes 与 filter 二选一）
+type BatchUpdateCouponStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`             // 应用ID（由中间件从 Header 提取）
+	CouponCodes   []string               `protobuf:"bytes,2,rep,name=couponCodes,proto3" json:"couponCodes,omitempty"` // 指定优惠码
+	Filter        *ListCouponsRequest    `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`           // 按列表筛选条件选择优惠券（忽略分页参数）
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`           // 目标状态
+	Operator      string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`       // 操作人（写入审计日志）
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`           // 操作原因（写入审计日志）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateCouponStatusRequest) Reset() {
+	*x = BatchUpdateCouponStatusRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateCouponStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCouponStatusRequest) ProtoMessage() {}
+
+func (x *BatchUpdateCouponStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCouponStatusRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCouponStatusRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchUpdateCouponStatusRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *BatchUpdateCouponStatusRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *BatchUpdateCouponStatusRequest) GetFilter() *ListCouponsRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BatchUpdateCouponStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchUpdateCouponStatusRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BatchUpdateCouponStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BatchDeleteCouponsRequest 批量删除优惠券请求（couponCodes 与 filter 二选一）
+type BatchDeleteCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`             // 应用ID（由中间件从 Header 提取）
+	CouponCodes   []string               `protobuf:"bytes,2,rep,name=couponCodes,proto3" json:"couponCodes,omitempty"` // 指定优惠码
+	Filter        *ListCouponsRequest    `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`           // 按列表筛选条件选择优惠券（忽略分页参数）
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`       // 操作人（写入审计日志）
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`           // 操作原因（写入审计日志）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteCouponsRequest) Reset() {
+	*x = BatchDeleteCouponsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteCouponsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteCouponsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchDeleteCouponsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *BatchDeleteCouponsRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *BatchDeleteCouponsRequest) GetFilter() *ListCouponsRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BatchDeleteCouponsRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BatchDeleteCouponsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BatchCouponItemResult 批量操作单个优惠券的结果
+type BatchCouponItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`       // UPDATED/DELETED/UNCHANGED/NOT_FOUND/FAILED
+	OldStatus     string                 `protobuf:"bytes,3,opt,name=oldStatus,proto3" json:"oldStatus,omitempty"` // 操作前状态
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`     // 失败原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCouponItemResult) Reset() {
+	*x = BatchCouponItemResult{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCouponItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCouponItemResult) ProtoMessage() {}
+
+func (x *BatchCouponItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCouponItemResult.ProtoReflect.Descriptor instead.
+func (*BatchCouponItemResult) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchCouponItemResult) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *BatchCouponItemResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *BatchCouponItemResult) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *BatchCouponItemResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BatchCouponsReply 批量操作响应
+type BatchCouponsReply struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	BatchId       string                   `protobuf:"bytes,1,opt,name=batchId,proto3" json:"batchId,omitempty"`    // 批量操作ID（对应审计日志）
+	Matched       int32                    `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`   // 选中的优惠券数量
+	Affected      int32                    `protobuf:"varint,3,opt,name=affected,proto3" json:"affected,omitempty"` // 实际修改或删除的数量
+	Failed        int32                    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`     // 失败数量
+	Items         []*BatchCouponItemResult `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCouponsReply) Reset() {
+	*x = BatchCouponsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCouponsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCouponsReply) ProtoMessage() {}
+
+func (x *BatchCouponsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCouponsReply.ProtoReflect.Descriptor instead.
+func (*BatchCouponsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchCouponsReply) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchCouponsReply) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *BatchCouponsReply) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *BatchCouponsReply) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchCouponsReply) GetItems() []*BatchCouponItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// UserCoupon 用户优惠券（发放给用户的优惠券实例）
+type UserCoupon struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserCouponId   string                 `protobuf:"bytes,1,opt,name=userCouponId,proto3" json:"userCouponId,omitempty"`
+	CouponCode     string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                 // 状态: available/used/expired（expired 为未使用且已过有效期）
+	ValidFrom      int64                  `protobuf:"varint,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`          // 实例生效时间(timestamp)
+	ValidUntil     int64                  `protobuf:"varint,6,opt,name=validUntil,proto3" json:"validUntil,omitempty"`        // 实例过期时间(timestamp)
+	IssuedAt       int64                  `protobuf:"varint,7,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`            // 发放时间(timestamp)
+	UsedAt         int64                  `protobuf:"varint,8,opt,name=usedAt,proto3" json:"usedAt,omitempty"`                // 使用时间(timestamp)
+	PaymentOrderId string                 `protobuf:"bytes,9,opt,name=paymentOrderId,proto3" json:"paymentOrderId,omitempty"` // 使用时的支付订单ID
+	Coupon         *Coupon                `protobuf:"bytes,10,opt,name=coupon,proto3" json:"coupon,omitempty"`                // 优惠券详情
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserCoupon) Reset() {
+	*x = UserCoupon{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCoupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCoupon) ProtoMessage() {}
+
+func (x *UserCoupon) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCoupon.ProtoReflect.Descriptor instead.
+func (*UserCoupon) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UserCoupon) GetUserCouponId() string {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *UserCoupon) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *UserCoupon) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *UserCoupon) GetUsedAt() int64 {
+	if x != nil {
+		return x.UsedAt
+	}
+	return 0
+}
+
+func (x *UserCoupon) GetPaymentOrderId() string {
+	if x != nil {
+		return x.PaymentOrderId
+	}
+	return ""
+}
+
+func (x *UserCoupon) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// IssueCouponRequest 发放优惠券请求
+type IssueCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCouponRequest) Reset() {
+	*x = IssueCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCouponRequest) ProtoMessage() {}
+
+func (x *IssueCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCouponRequest.ProtoReflect.Descriptor instead.
+func (*IssueCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IssueCouponRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *IssueCouponRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// UserCouponReply 用户优惠券响应（发放、领取、查询共用）
+type UserCouponReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCoupon    *UserCoupon            `protobuf:"bytes,1,opt,name=userCoupon,proto3" json:"userCoupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCouponReply) Reset() {
+	*x = UserCouponReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCouponReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponReply) ProtoMessage() {}
+
+func (x *UserCouponReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponReply.ProtoReflect.Descriptor instead.
+func (*UserCouponReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserCouponReply) GetUserCoupon() *UserCoupon {
+	if x != nil {
+		return x.UserCoupon
+	}
+	return nil
+}
+
+// ClaimCouponRequest 领取优惠券请求
+type ClaimCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCouponRequest) Reset() {
+	*x = ClaimCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCouponRequest) ProtoMessage() {}
+
+func (x *ClaimCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCouponRequest.ProtoReflect.Descriptor instead.
+func (*ClaimCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ClaimCouponRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *ClaimCouponRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ListUserCouponsRequest 用户券包列表请求
+type ListUserCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 分栏，空表示全部
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserCouponsRequest) Reset() {
+	*x = ListUserCouponsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserCouponsRequest) ProtoMessage() {}
+
+func (x *ListUserCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserCouponsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserCouponsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListUserCouponsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserCouponsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListUserCouponsReply 用户券包列表响应
+type ListUserCouponsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCoupons   []*UserCoupon          `protobuf:"bytes,1,rep,name=userCoupons,proto3" json:"userCoupons,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserCouponsReply) Reset() {
+	*x = ListUserCouponsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserCouponsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserCouponsReply) ProtoMessage() {}
+
+func (x *ListUserCouponsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserCouponsReply.ProtoReflect.Descriptor instead.
+func (*ListUserCouponsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListUserCouponsReply) GetUserCoupons() []*UserCoupon {
+	if x != nil {
+		return x.UserCoupons
+	}
+	return nil
+}
+
+func (x *ListUserCouponsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUserCouponsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserCouponsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// GetUserCouponRequest 获取用户优惠券请求
+type GetUserCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCouponId  string                 `protobuf:"bytes,1,opt,name=userCouponId,proto3" json:"userCouponId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCouponRequest) Reset() {
+	*x = GetUserCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCouponRequest) ProtoMessage() {}
+
+func (x *GetUserCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCouponRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetUserCouponRequest) GetUserCouponId() string {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return ""
+}
+
+// CloneCouponRequest 复制优惠券请求
+type CloneCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`        // 源优惠码
+	NewCouponCode string                 `protobuf:"bytes,2,opt,name=newCouponCode,proto3" json:"newCouponCode,omitempty"`  // 新优惠码
+	ValidFrom     int64                  `protobuf:"varint,3,opt,name=validFrom,proto3" json:"validFrom,omitempty"`         // 新的生效时间(timestamp)，不传为当前时间；过期时间按源优惠券的有效时长平移，不可用日期按相同天数平移
+	KeepBoundUser bool                   `protobuf:"varint,4,opt,name=keepBoundUser,proto3" json:"keepBoundUser,omitempty"` // 是否保留源优惠券的绑定用户（默认不保留，复制出的优惠券不绑定用户）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneCouponRequest) Reset() {
+	*x = CloneCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneCouponRequest) ProtoMessage() {}
+
+func (x *CloneCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneCouponRequest.ProtoReflect.Descriptor instead.
+func (*CloneCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CloneCouponRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CloneCouponRequest) GetNewCouponCode() string {
+	if x != nil {
+		return x.NewCouponCode
+	}
+	return ""
+}
+
+func (x *CloneCouponRequest) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *CloneCouponRequest) GetKeepBoundUser() bool {
+	if x != nil {
+		return x.KeepBoundUser
+	}
+	return false
+}
+
+// CouponTemplate 优惠券模板
+type CouponTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,4,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue int64                  `protobuf:"varint,5,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	MinAmount     int64                  `protobuf:"varint,8,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	ValidDays     int32                  `protobuf:"varint,9,opt,name=validDays,proto3" json:"validDays,omitempty"` // 有效天数（从优惠券生效时间起算）
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponTemplate) Reset() {
+	*x = CouponTemplate{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplate) ProtoMessage() {}
+
+func (x *CouponTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplate.ProtoReflect.Descriptor instead.
+func (*CouponTemplate) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CouponTemplate) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetDiscountValue() int64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// CreateCouponTemplateRequest 创建优惠券模板请求
+type CreateCouponTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID（由中间件从 Header 提取）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,4,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue int64                  `protobuf:"varint,5,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"` // 货币单位，默认 CNY
+	MaxUses       int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	MinAmount     int64                  `protobuf:"varint,8,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	ValidDays     int32                  `protobuf:"varint,9,opt,name=validDays,proto3" json:"validDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponTemplateRequest) Reset() {
+	*x = CreateCouponTemplateRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCouponTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateCouponTemplateRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateRequest) GetDiscountValue() int64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *CreateCouponTemplateRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateCouponTemplateRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *CreateCouponTemplateRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+// GetCouponTemplateRequest 获取优惠券模板请求
+type GetCouponTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponTemplateRequest) Reset() {
+	*x = GetCouponTemplateRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponTemplateRequest) ProtoMessage() {}
+
+func (x *GetCouponTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetCouponTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// CouponTemplateReply 优惠券模板响应
+type CouponTemplateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CouponTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponTemplateReply) Reset() {
+	*x = CouponTemplateReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateReply) ProtoMessage() {}
+
+func (x *CouponTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateReply.ProtoReflect.Descriptor instead.
+func (*CouponTemplateReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CouponTemplateReply) GetTemplate() *CouponTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// ListCouponTemplatesRequest 列出优惠券模板请求
+type ListCouponTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponTemplatesRequest) Reset() {
+	*x = ListCouponTemplatesRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponTemplatesRequest) ProtoMessage() {}
+
+func (x *ListCouponTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCouponTemplatesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListCouponTemplatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListCouponTemplatesReply 列出优惠券模板响应
+type ListCouponTemplatesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*CouponTemplate      `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponTemplatesReply) Reset() {
+	*x = ListCouponTemplatesReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponTemplatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponTemplatesReply) ProtoMessage() {}
+
+func (x *ListCouponTemplatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponTemplatesReply.ProtoReflect.Descriptor instead.
+func (*ListCouponTemplatesReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListCouponTemplatesReply) GetTemplates() []*CouponTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListCouponTemplatesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCouponTemplatesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponTemplatesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateCouponTemplateRequest 更新优惠券模板请求（未传的字段保持不变）
+type UpdateCouponTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,4,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountValue int64                  `protobuf:"varint,5,opt,name=discountValue,proto3" json:"discountValue,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	MinAmount     *int64                 `protobuf:"varint,8,opt,name=minAmount,proto3,oneof" json:"minAmount,omitempty"`
+	ValidDays     int32                  `protobuf:"varint,9,opt,name=validDays,proto3" json:"validDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCouponTemplateRequest) Reset() {
+	*x = UpdateCouponTemplateRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCouponTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCouponTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateCouponTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCouponTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateCouponTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateCouponTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCouponTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCouponTemplateRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *UpdateCouponTemplateRequest) GetDiscountValue() int64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *UpdateCouponTemplateRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateCouponTemplateRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *UpdateCouponTemplateRequest) GetMinAmount() int64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *UpdateCouponTemplateRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+// DeleteCouponTemplateRequest 删除优惠券模板请求
+type DeleteCouponTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCouponTemplateRequest) Reset() {
+	*x = DeleteCouponTemplateRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCouponTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouponTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteCouponTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouponTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteCouponTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// CreateCouponFromTemplateRequest 按模板创建优惠券请求
+type CreateCouponFromTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	ValidFrom     int64                  `protobuf:"varint,3,opt,name=validFrom,proto3" json:"validFrom,omitempty"` // 生效时间(timestamp)，不传为当前时间；过期时间 = 生效时间 + 模板有效天数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponFromTemplateRequest) Reset() {
+	*x = CreateCouponFromTemplateRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCouponFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateCouponFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateCouponFromTemplateRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CreateCouponFromTemplateRequest) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+// AudienceCondition 受众规则条件
+type AudienceCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     string                 `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"` // 用户属性名
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`   // 操作符，GT/GTE/LT/LTE 按数值比较
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`       // 比较值：IN/NOT_IN 为多个，EXISTS 不需要，其他为一个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceCondition) Reset() {
+	*x = AudienceCondition{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceCondition) ProtoMessage() {}
+
+func (x *AudienceCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceCondition.ProtoReflect.Descriptor instead.
+func (*AudienceCondition) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AudienceCondition) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *AudienceCondition) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AudienceCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// AudienceRule 受众圈选规则（TAG/SEGMENT 类型），按调用方传入的用户属性求值
+type AudienceRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         string                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"` // 条件组合方式，默认 ALL
+	Conditions    []*AudienceCondition   `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceRule) Reset() {
+	*x = AudienceRule{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceRule) ProtoMessage() {}
+
+func (x *AudienceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceRule.ProtoReflect.Descriptor instead.
+func (*AudienceRule) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AudienceRule) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *AudienceRule) GetConditions() []*AudienceCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// Audience 受众
+type Audience struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AudienceId    string                 `protobuf:"bytes,1,opt,name=audienceId,proto3" json:"audienceId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                // 类型: TAG/SEGMENT/LIST/ALL
+	Rule          *AudienceRule          `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`                // 圈选规则（TAG/SEGMENT 类型）
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`            // 状态: ACTIVE/PAUSED/ENDED，非 ACTIVE 时任何用户都不命中
+	MemberCount   int64                  `protobuf:"varint,7,opt,name=memberCount,proto3" json:"memberCount,omitempty"` // 名单人数（LIST 类型）
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Audience) Reset() {
+	*x = Audience{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Audience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Audience) ProtoMessage() {}
+
+func (x *Audience) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Audience.ProtoReflect.Descriptor instead.
+func (*Audience) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Audience) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
+}
+
+func (x *Audience) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Audience) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Audience) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Audience) GetRule() *AudienceRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *Audience) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Audience) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Audience) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Audience) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// CreateAudienceRequest 创建受众请求
+type CreateAudienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Rule          *AudienceRule          `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`     // TAG/SEGMENT 类型必填
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // 默认 ACTIVE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAudienceRequest) Reset() {
+	*x = CreateAudienceRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAudienceRequest) ProtoMessage() {}
+
+func (x *CreateAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAudienceRequest.ProtoReflect.Descriptor instead.
+func (*CreateAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateAudienceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAudienceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAudienceRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAudienceRequest) GetRule() *AudienceRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *CreateAudienceRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// AudienceReply 受众响应
+type AudienceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audience      *Audience              `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceReply) Reset() {
+	*x = AudienceReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceReply) ProtoMessage() {}
+
+func (x *AudienceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceReply.ProtoReflect.Descriptor instead.
+func (*AudienceReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AudienceReply) GetAudience() *Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+// GetAudienceRequest 获取受众请求
+type GetAudienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AudienceId    string                 `protobuf:"bytes,1,opt,name=audienceId,proto3" json:"audienceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAudienceRequest) Reset() {
+	*x = GetAudienceRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudienceRequest) ProtoMessage() {}
+
+func (x *GetAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudienceRequest.ProtoReflect.Descriptor instead.
+func (*GetAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetAudienceRequest) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
+}
+
+// ListAudiencesRequest 列出受众请求
+type ListAudiencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // 按类型筛选
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAudiencesRequest) Reset() {
+	*x = ListAudiencesRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAudiencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAudiencesRequest) ProtoMessage() {}
+
+func (x *ListAudiencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAudiencesRequest.ProtoReflect.Descriptor instead.
+func (*ListAudiencesRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListAudiencesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListAudiencesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAudiencesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListAudiencesReply 列出受众响应
+type ListAudiencesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audiences     []*Audience            `protobuf:"bytes,1,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAudiencesReply) Reset() {
+	*x = ListAudiencesReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAudiencesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAudiencesReply) ProtoMessage() {}
+
+func (x *ListAudiencesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAudiencesReply.ProtoReflect.Descriptor instead.
+func (*ListAudiencesReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAudiencesReply) GetAudiences() []*Audience {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (x *ListAudiencesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAudiencesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAudiencesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateAudienceRequest 更新受众请求（类型不可修改）
+type UpdateAudienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AudienceId    string                 `protobuf:"bytes,1,opt,name=audienceId,proto3" json:"audienceId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Rule          *AudienceRule          `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"` // 传入时整体替换规则
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAudienceRequest) Reset() {
+	*x = UpdateAudienceRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAudienceRequest) ProtoMessage() {}
+
+func (x *UpdateAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAudienceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateAudienceRequest) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
+}
+
+func (x *UpdateAudienceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAudienceRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateAudienceRequest) GetRule() *AudienceRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *UpdateAudienceRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// DeleteAudienceRequest 删除受众请求
+type DeleteAudienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AudienceId    string                 `protobuf:"bytes,1,opt,name=audienceId,proto3" json:"audienceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAudienceRequest) Reset() {
+	*x = DeleteAudienceRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAudienceRequest) ProtoMessage() {}
+
+func (x *DeleteAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAudienceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteAudienceRequest) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
+}
+
+// UploadAudienceMembersRequest 上传受众名单请求
+type UploadAudienceMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AudienceId    string                 `protobuf:"bytes,1,opt,name=audienceId,proto3" json:"audienceId,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // 名单文件内容：每行一个用户ID（CSV 取第一列，可带标题行）
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`       // 上传模式，默认 REPLACE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAudienceMembersRequest) Reset() {
+	*x = UploadAudienceMembersRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAudienceMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAudienceMembersRequest) ProtoMessage() {}
+
+func (x *UploadAudienceMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAudienceMembersRequest.ProtoReflect.Descriptor instead.
+func (*UploadAudienceMembersRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UploadAudienceMembersRequest) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
+}
+
+func (x *UploadAudienceMembersRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UploadAudienceMembersRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// UploadAudienceMembersReply 上传受众名单响应
+type UploadAudienceMembersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                      // 有效的去重用户数
+	Invalid       int32                  `protobuf:"varint,2,opt,name=invalid,proto3" json:"invalid,omitempty"`                  // 无效行数（用户ID超过 36 个字符）
+	InvalidLines  []int32                `protobuf:"varint,3,rep,packed,name=invalidLines,proto3" json:"invalidLines,omitempty"` // 无效行号（最多前 100 个）
+	MemberCount   int64                  `protobuf:"varint,4,opt,name=memberCount,proto3" json:"memberCount,omitempty"`          // 上传后的名单人数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAudienceMembersReply) Reset() {
+	*x = UploadAudienceMembersReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAudienceMembersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAudienceMembersReply) ProtoMessage() {}
+
+func (x *UploadAudienceMembersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAudienceMembersReply.ProtoReflect.Descriptor instead.
+func (*UploadAudienceMembersReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UploadAudienceMembersReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UploadAudienceMembersReply) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *UploadAudienceMembersReply) GetInvalidLines() []int32 {
+	if x != nil {
+		return x.InvalidLines
+	}
+	return nil
+}
+
+func (x *UploadAudienceMembersReply) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+// CheckAudienceRequest 检查受众请求
+type CheckAudienceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AudienceId     string                 `protobuf:"bytes,1,opt,name=audienceId,proto3" json:"audienceId,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserAttributes map[string]string      `protobuf:"bytes,3,rep,name=userAttributes,proto3" json:"userAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户属性（TAG/SEGMENT 受众求值）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckAudienceRequest) Reset() {
+	*x = CheckAudienceRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAudienceRequest) ProtoMessage() {}
+
+func (x *CheckAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAudienceRequest.ProtoReflect.Descriptor instead.
+func (*CheckAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CheckAudienceRequest) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
+}
+
+func (x *CheckAudienceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckAudienceRequest) GetUserAttributes() map[string]string {
+	if x != nil {
+		return x.UserAttributes
+	}
+	return nil
+}
+
+// CheckAudienceReply 检查受众响应
+type CheckAudienceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAudienceReply) Reset() {
+	*x = CheckAudienceReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAudienceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAudienceReply) ProtoMessage() {}
+
+func (x *CheckAudienceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAudienceReply.ProtoReflect.Descriptor instead.
+func (*CheckAudienceReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CheckAudienceReply) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+// Campaign 营销活动
+type Campaign struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // 类型: REDEEM_CODE/TASK_REWARD/DIRECT_SEND
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime     int64                  `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"` // 开始时间(timestamp)
+	EndTime       int64                  `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 结束时间(timestamp)
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`        // 状态: DRAFT/ACTIVE/PAUSED/ENDED，只有 ACTIVE 且在起止时间内其下优惠券才可用
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Budget        int64                  `protobuf:"varint,10,opt,name=budget,proto3" json:"budget,omitempty"`         // 折扣预算(分)：活动下所有优惠券的折扣金额合计上限（0 表示不限），用尽后自动暂停
+	BudgetUsed    int64                  `protobuf:"varint,11,opt,name=budgetUsed,proto3" json:"budgetUsed,omitempty"` // 已使用的折扣金额(分)
+	Currency      string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`      // 折扣预算的货币单位: CNY/USD/EUR
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Campaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campaign) ProtoMessage() {}
+
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Campaign) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *Campaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Campaign) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Campaign) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Campaign) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Campaign) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *Campaign) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Campaign) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Campaign) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Campaign) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *Campaign) GetBudgetUsed() int64 {
+	if x != nil {
+		return x.BudgetUsed
+	}
+	return 0
+}
+
+func (x *Campaign) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// CreateCampaignRequest 创建活动请求
+type CreateCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime     int64                  `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"` // 开始时间(timestamp)
+	EndTime       int64                  `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 结束时间(timestamp)
+	Budget        int64                  `protobuf:"varint,6,opt,name=budget,proto3" json:"budget,omitempty"`       // 折扣预算(分)（0 表示不限）
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`    // 折扣预算的货币单位（默认 CNY，创建后不可修改）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignRequest) ProtoMessage() {}
+
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CreateCampaignRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CreateCampaignRequest) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *CreateCampaignRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// CampaignReply 活动响应
+type CampaignReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignReply) Reset() {
+	*x = CampaignReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignReply) ProtoMessage() {}
+
+func (x *CampaignReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignReply.ProtoReflect.Descriptor instead.
+func (*CampaignReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CampaignReply) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// GetCampaignRequest 获取活动请求
+type GetCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignRequest) Reset() {
+	*x = GetCampaignRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignRequest) ProtoMessage() {}
+
+func (x *GetCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// ListCampaignsRequest 列出活动请求
+type ListCampaignsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // 按状态筛选
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`     // 按类型筛选
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsRequest) Reset() {
+	*x = ListCampaignsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsRequest) ProtoMessage() {}
+
+func (x *ListCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListCampaignsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListCampaignsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListCampaignsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCampaignsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListCampaignsReply 列出活动响应
+type ListCampaignsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaigns     []*Campaign            `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsReply) Reset() {
+	*x = ListCampaignsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsReply) ProtoMessage() {}
+
+func (x *ListCampaignsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsReply.ProtoReflect.Descriptor instead.
+func (*ListCampaignsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCampaignsReply) GetCampaigns() []*Campaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *ListCampaignsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCampaignsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCampaignsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateCampaignRequest 更新活动请求（已结束的活动不可修改，状态通过发布/暂停/结束修改）
+type UpdateCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	StartTime     int64                  `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"` // 开始时间(timestamp)，0 表示不修改
+	EndTime       int64                  `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 结束时间(timestamp)，0 表示不修改
+	Budget        *int64                 `protobuf:"varint,7,opt,name=budget,proto3,oneof" json:"budget,omitempty"` // 折扣预算(分)（0 表示不限）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCampaignRequest) Reset() {
+	*x = UpdateCampaignRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCampaignRequest) ProtoMessage() {}
+
+func (x *UpdateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *UpdateCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCampaignRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateCampaignRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCampaignRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *UpdateCampaignRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *UpdateCampaignRequest) GetBudget() int64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+// DeleteCampaignRequest 删除活动请求
+type DeleteCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCampaignRequest) Reset() {
+	*x = DeleteCampaignRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCampaignRequest) ProtoMessage() {}
+
+func (x *DeleteCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCampaignRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// PublishCampaignRequest 发布活动请求
+type PublishCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishCampaignRequest) Reset() {
+	*x = PublishCampaignRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishCampaignRequest) ProtoMessage() {}
+
+func (x *PublishCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishCampaignRequest.ProtoReflect.Descriptor instead.
+func (*PublishCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PublishCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// PauseCampaignRequest 暂停活动请求
+type PauseCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseCampaignRequest) Reset() {
+	*x = PauseCampaignRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCampaignRequest) ProtoMessage() {}
+
+func (x *PauseCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCampaignRequest.ProtoReflect.Descriptor instead.
+func (*PauseCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PauseCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// EndCampaignRequest 结束活动请求
+type EndCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndCampaignRequest) Reset() {
+	*x = EndCampaignRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndCampaignRequest) ProtoMessage() {}
+
+func (x *EndCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndCampaignRequest.ProtoReflect.Descriptor instead.
+func (*EndCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EndCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// RedeemCode 兑换码（兑换后发放优惠券、积分或订阅时长，不用于订单抵扣）
+type RedeemCode struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Code             string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	BatchId          string                 `protobuf:"bytes,2,opt,name=batchId,proto3" json:"batchId,omitempty"`                    // 批次ID
+	CampaignId       string                 `protobuf:"bytes,3,opt,name=campaignId,proto3" json:"campaignId,omitempty"`              // 所属活动ID（REDEEM_CODE 类型），非空时只有活动进行中才可兑换
+	RewardType       string                 `protobuf:"bytes,4,opt,name=rewardType,proto3" json:"rewardType,omitempty"`              // 兑换内容: COUPON/POINTS/SUBSCRIPTION
+	CouponCode       string                 `protobuf:"bytes,5,opt,name=couponCode,proto3" json:"couponCode,omitempty"`              // COUPON：发放到用户券包的优惠码
+	Points           int64                  `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`                     // POINTS：积分数
+	SubscriptionPlan string                 `protobuf:"bytes,7,opt,name=subscriptionPlan,proto3" json:"subscriptionPlan,omitempty"`  // SUBSCRIPTION：订阅套餐
+	SubscriptionDays int32                  `protobuf:"varint,8,opt,name=subscriptionDays,proto3" json:"subscriptionDays,omitempty"` // SUBSCRIPTION：订阅天数
+	Status           string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                      // 状态: ACTIVE/REDEEMED/EXPIRED/REVOKED（EXPIRED 含未兑换且已过过期时间）
+	ExpiresAt        int64                  `protobuf:"varint,10,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`              // 过期时间(timestamp)
+	RedeemedBy       string                 `protobuf:"bytes,11,opt,name=redeemedBy,proto3" json:"redeemedBy,omitempty"`             // 兑换用户ID
+	RedeemedAt       int64                  `protobuf:"varint,12,opt,name=redeemedAt,proto3" json:"redeemedAt,omitempty"`            // 兑换时间(timestamp)
+	CreatedAt        int64                  `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RedeemCode) Reset() {
+	*x = RedeemCode{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCode) ProtoMessage() {}
+
+func (x *RedeemCode) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCode.ProtoReflect.Descriptor instead.
+func (*RedeemCode) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RedeemCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RedeemCode) GetSubscriptionPlan() string {
+	if x != nil {
+		return x.SubscriptionPlan
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetSubscriptionDays() int32 {
+	if x != nil {
+		return x.SubscriptionDays
+	}
+	return 0
+}
+
+func (x *RedeemCode) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RedeemCode) GetRedeemedBy() string {
+	if x != nil {
+		return x.RedeemedBy
+	}
+	return ""
+}
+
+func (x *RedeemCode) GetRedeemedAt() int64 {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return 0
+}
+
+func (x *RedeemCode) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RedeemCode) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// RedeemCodeRedemption 兑换记录
+type RedeemCodeRedemption struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RedemptionId     string                 `protobuf:"bytes,1,opt,name=redemptionId,proto3" json:"redemptionId,omitempty"`
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	RewardType       string                 `protobuf:"bytes,4,opt,name=rewardType,proto3" json:"rewardType,omitempty"`              // 兑换内容: COUPON/POINTS/SUBSCRIPTION
+	CouponCode       string                 `protobuf:"bytes,5,opt,name=couponCode,proto3" json:"couponCode,omitempty"`              // COUPON：发放的优惠码
+	UserCouponId     string                 `protobuf:"bytes,6,opt,name=userCouponId,proto3" json:"userCouponId,omitempty"`          // COUPON：发放到券包的用户优惠券ID
+	Points           int64                  `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`                     // POINTS：发放的积分数
+	SubscriptionPlan string                 `protobuf:"bytes,8,opt,name=subscriptionPlan,proto3" json:"subscriptionPlan,omitempty"`  // SUBSCRIPTION：订阅套餐
+	SubscriptionDays int32                  `protobuf:"varint,9,opt,name=subscriptionDays,proto3" json:"subscriptionDays,omitempty"` // SUBSCRIPTION：订阅天数
+	RedeemedAt       int64                  `protobuf:"varint,10,opt,name=redeemedAt,proto3" json:"redeemedAt,omitempty"`            // 兑换时间(timestamp)
+	GrantStatus      string                 `protobuf:"bytes,11,opt,name=grantStatus,proto3" json:"grantStatus,omitempty"`           // 权益发放状态: PENDING/GRANTED/FAILED（FAILED 可调用 RetryRedeemCodeGrant 重试）
+	GrantError       string                 `protobuf:"bytes,12,opt,name=grantError,proto3" json:"grantError,omitempty"`             // 最近一次发放失败的原因
+	GrantAttempts    int32                  `protobuf:"varint,13,opt,name=grantAttempts,proto3" json:"grantAttempts,omitempty"`      // 积分、订阅时长的发放尝试次数
+	GrantedAt        int64                  `protobuf:"varint,14,opt,name=grantedAt,proto3" json:"grantedAt,omitempty"`              // 权益发放时间(timestamp)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RedeemCodeRedemption) Reset() {
+	*x = RedeemCodeRedemption{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCodeRedemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCodeRedemption) ProtoMessage() {}
+
+func (x *RedeemCodeRedemption) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCodeRedemption.ProtoReflect.Descriptor instead.
+func (*RedeemCodeRedemption) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RedeemCodeRedemption) GetRedemptionId() string {
+	if x != nil {
+		return x.RedemptionId
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetUserCouponId() string {
+	if x != nil {
+		return x.UserCouponId
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RedeemCodeRedemption) GetSubscriptionPlan() string {
+	if x != nil {
+		return x.SubscriptionPlan
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetSubscriptionDays() int32 {
+	if x != nil {
+		return x.SubscriptionDays
+	}
+	return 0
+}
+
+func (x *RedeemCodeRedemption) GetRedeemedAt() int64 {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return 0
+}
+
+func (x *RedeemCodeRedemption) GetGrantStatus() string {
+	if x != nil {
+		return x.GrantStatus
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetGrantError() string {
+	if x != nil {
+		return x.GrantError
+	}
+	return ""
+}
+
+func (x *RedeemCodeRedemption) GetGrantAttempts() int32 {
+	if x != nil {
+		return x.GrantAttempts
+	}
+	return 0
+}
+
+func (x *RedeemCodeRedemption) GetGrantedAt() int64 {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return 0
+}
+
+// CreateRedeemCodesRequest 批量生成兑换码请求（同一批次的兑换内容、过期时间和所属活动相同）
+type CreateRedeemCodesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Count            int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`   // 生成数量
+	Prefix           string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`  // 兑换码前缀（转为大写）
+	Length           int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"` // 随机部分长度（默认 12，最少 8）
+	RewardType       string                 `protobuf:"bytes,4,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	CouponCode       string                 `protobuf:"bytes,5,opt,name=couponCode,proto3" json:"couponCode,omitempty"`              // COUPON：发放到用户券包的优惠码
+	Points           int64                  `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`                     // POINTS：积分数
+	SubscriptionPlan string                 `protobuf:"bytes,7,opt,name=subscriptionPlan,proto3" json:"subscriptionPlan,omitempty"`  // SUBSCRIPTION：订阅套餐
+	SubscriptionDays int32                  `protobuf:"varint,8,opt,name=subscriptionDays,proto3" json:"subscriptionDays,omitempty"` // SUBSCRIPTION：订阅天数
+	ExpiresAt        int64                  `protobuf:"varint,9,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`               // 过期时间(timestamp)
+	CampaignId       string                 `protobuf:"bytes,10,opt,name=campaignId,proto3" json:"campaignId,omitempty"`             // 所属活动ID（可选，须为 REDEEM_CODE 类型）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateRedeemCodesRequest) Reset() {
+	*x = CreateRedeemCodesRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRedeemCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedeemCodesRequest) ProtoMessage() {}
+
+func (x *CreateRedeemCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedeemCodesRequest.ProtoReflect.Descriptor instead.
+func (*CreateRedeemCodesRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateRedeemCodesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CreateRedeemCodesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *CreateRedeemCodesRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *CreateRedeemCodesRequest) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *CreateRedeemCodesRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CreateRedeemCodesRequest) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CreateRedeemCodesRequest) GetSubscriptionPlan() string {
+	if x != nil {
+		return x.SubscriptionPlan
+	}
+	return ""
+}
+
+func (x *CreateRedeemCodesRequest) GetSubscriptionDays() int32 {
+	if x != nil {
+		return x.SubscriptionDays
+	}
+	return 0
+}
+
+func (x *CreateRedeemCodesRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateRedeemCodesRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// CreateRedeemCodesReply 批量生成兑换码响应
+type CreateRedeemCodesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batchId,proto3" json:"batchId,omitempty"`
+	Codes         []string               `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRedeemCodesReply) Reset() {
+	*x = CreateRedeemCodesReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRedeemCodesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedeemCodesReply) ProtoMessage() {}
+
+func (x *CreateRedeemCodesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedeemCodesReply.ProtoReflect.Descriptor instead.
+func (*CreateRedeemCodesReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateRedeemCodesReply) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *CreateRedeemCodesReply) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+// GetRedeemCodeRequest 获取兑换码请求
+type GetRedeemCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRedeemCodeRequest) Reset() {
+	*x = GetRedeemCodeRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRedeemCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedeemCodeRequest) ProtoMessage() {}
+
+func (x *GetRedeemCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedeemCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetRedeemCodeRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetRedeemCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// RedeemCodeReply 兑换码响应
+type RedeemCodeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RedeemCode    *RedeemCode            `protobuf:"bytes,1,opt,name=redeemCode,proto3" json:"redeemCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCodeReply) Reset() {
+	*x = RedeemCodeReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCodeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCodeReply) ProtoMessage() {}
+
+func (x *RedeemCodeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCodeReply.ProtoReflect.Descriptor instead.
+func (*RedeemCodeReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RedeemCodeReply) GetRedeemCode() *RedeemCode {
+	if x != nil {
+		return x.RedeemCode
+	}
+	return nil
+}
+
+// ListRedeemCodesRequest 列出兑换码请求
+type ListRedeemCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batchId,proto3" json:"batchId,omitempty"`       // 按批次筛选
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`         // 按状态筛选
+	RedeemedBy    string                 `protobuf:"bytes,3,opt,name=redeemedBy,proto3" json:"redeemedBy,omitempty"` // 按兑换用户筛选
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedeemCodesRequest) Reset() {
+	*x = ListRedeemCodesRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedeemCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedeemCodesRequest) ProtoMessage() {}
+
+func (x *ListRedeemCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedeemCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListRedeemCodesRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListRedeemCodesRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ListRedeemCodesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRedeemCodesRequest) GetRedeemedBy() string {
+	if x != nil {
+		return x.RedeemedBy
+	}
+	return ""
+}
+
+func (x *ListRedeemCodesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRedeemCodesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListRedeemCodesReply 列出兑换码响应
+type ListRedeemCodesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RedeemCodes   []*RedeemCode          `protobuf:"bytes,1,rep,name=redeemCodes,proto3" json:"redeemCodes,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedeemCodesReply) Reset() {
+	*x = ListRedeemCodesReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedeemCodesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedeemCodesReply) ProtoMessage() {}
+
+func (x *ListRedeemCodesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedeemCodesReply.ProtoReflect.Descriptor instead.
+func (*ListRedeemCodesReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListRedeemCodesReply) GetRedeemCodes() []*RedeemCode {
+	if x != nil {
+		return x.RedeemCodes
+	}
+	return nil
+}
+
+func (x *ListRedeemCodesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListRedeemCodesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRedeemCodesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// RedeemCodeRequest 兑换请求
+type RedeemCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCodeRequest) Reset() {
+	*x = RedeemCodeRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCodeRequest) ProtoMessage() {}
+
+func (x *RedeemCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCodeRequest.ProtoReflect.Descriptor instead.
+func (*RedeemCodeRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RedeemCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RedeemCodeRedemptionReply 兑换响应
+type RedeemCodeRedemptionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redemption    *RedeemCodeRedemption  `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCodeRedemptionReply) Reset() {
+	*x = RedeemCodeRedemptionReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCodeRedemptionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCodeRedemptionReply) ProtoMessage() {}
+
+func (x *RedeemCodeRedemptionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCodeRedemptionReply.ProtoReflect.Descriptor instead.
+func (*RedeemCodeRedemptionReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RedeemCodeRedemptionReply) GetRedemption() *RedeemCodeRedemption {
+	if x != nil {
+		return x.Redemption
+	}
+	return nil
+}
+
+// RevokeRedeemCodeRequest 作废兑换码请求
+type RevokeRedeemCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRedeemCodeRequest) Reset() {
+	*x = RevokeRedeemCodeRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRedeemCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRedeemCodeRequest) ProtoMessage() {}
+
+func (x *RevokeRedeemCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRedeemCodeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRedeemCodeRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RevokeRedeemCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// ListRedeemCodeRedemptionsRequest 列出兑换记录请求
+type ListRedeemCodeRedemptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`           // 按兑换用户筛选
+	GrantStatus   string                 `protobuf:"bytes,2,opt,name=grantStatus,proto3" json:"grantStatus,omitempty"` // 按发放状态筛选
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedeemCodeRedemptionsRequest) Reset() {
+	*x = ListRedeemCodeRedemptionsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedeemCodeRedemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedeemCodeRedemptionsRequest) ProtoMessage() {}
+
+func (x *ListRedeemCodeRedemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedeemCodeRedemptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRedeemCodeRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListRedeemCodeRedemptionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRedeemCodeRedemptionsRequest) GetGrantStatus() string {
+	if x != nil {
+		return x.GrantStatus
+	}
+	return ""
+}
+
+func (x *ListRedeemCodeRedemptionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRedeemCodeRedemptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListRedeemCodeRedemptionsReply 列出兑换记录响应
+type ListRedeemCodeRedemptionsReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Redemptions   []*RedeemCodeRedemption `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                   `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedeemCodeRedemptionsReply) Reset() {
+	*x = ListRedeemCodeRedemptionsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedeemCodeRedemptionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedeemCodeRedemptionsReply) ProtoMessage() {}
+
+func (x *ListRedeemCodeRedemptionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedeemCodeRedemptionsReply.ProtoReflect.Descriptor instead.
+func (*ListRedeemCodeRedemptionsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListRedeemCodeRedemptionsReply) GetRedemptions() []*RedeemCodeRedemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+func (x *ListRedeemCodeRedemptionsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListRedeemCodeRedemptionsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRedeemCodeRedemptionsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// RetryRedeemCodeGrantRequest 重试兑换权益发放请求
+type RetryRedeemCodeGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryRedeemCodeGrantRequest) Reset() {
+	*x = RetryRedeemCodeGrantRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryRedeemCodeGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryRedeemCodeGrantRequest) ProtoMessage() {}
+
+func (x *RetryRedeemCodeGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryRedeemCodeGrantRequest.ProtoReflect.Descriptor instead.
+func (*RetryRedeemCodeGrantRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RetryRedeemCodeGrantRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// RewardContent 奖励内容（按奖励类型填写对应字段）
+type RewardContent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DiscountType     string                 `protobuf:"bytes,1,opt,name=discountType,proto3" json:"discountType,omitempty"`          // COUPON：折扣类型 percent/fixed
+	DiscountValue    int64                  `protobuf:"varint,2,opt,name=discountValue,proto3" json:"discountValue,omitempty"`       // COUPON：折扣值
+	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                  // COUPON：货币单位（默认 CNY）
+	MinAmount        int64                  `protobuf:"varint,4,opt,name=minAmount,proto3" json:"minAmount,omitempty"`               // COUPON：最低消费金额
+	Points           int64                  `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`                     // POINTS：积分数
+	SubscriptionPlan string                 `protobuf:"bytes,6,opt,name=subscriptionPlan,proto3" json:"subscriptionPlan,omitempty"`  // SUBSCRIPTION：订阅套餐
+	SubscriptionDays int32                  `protobuf:"varint,7,opt,name=subscriptionDays,proto3" json:"subscriptionDays,omitempty"` // SUBSCRIPTION：订阅天数
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RewardContent) Reset() {
+	*x = RewardContent{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardContent) ProtoMessage() {}
+
+func (x *RewardContent) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardContent.ProtoReflect.Descriptor instead.
+func (*RewardContent) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RewardContent) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *RewardContent) GetDiscountValue() int64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *RewardContent) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RewardContent) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *RewardContent) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RewardContent) GetSubscriptionPlan() string {
+	if x != nil {
+		return x.SubscriptionPlan
+	}
+	return ""
+}
+
+func (x *RewardContent) GetSubscriptionDays() int32 {
+	if x != nil {
+		return x.SubscriptionDays
+	}
+	return 0
+}
+
+// RewardGeneratorConfig 生成配置
+type RewardGeneratorConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CodePrefix    string                 `protobuf:"bytes,1,opt,name=codePrefix,proto3" json:"codePrefix,omitempty"`  // COUPON：生成的优惠码前缀（最多 16 位大写字母或数字）
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"` // 发放总量（0 表示不限）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardGeneratorConfig) Reset() {
+	*x = RewardGeneratorConfig{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardGeneratorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardGeneratorConfig) ProtoMessage() {}
+
+func (x *RewardGeneratorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardGeneratorConfig.ProtoReflect.Descriptor instead.
+func (*RewardGeneratorConfig) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RewardGeneratorConfig) GetCodePrefix() string {
+	if x != nil {
+		return x.CodePrefix
+	}
+	return ""
+}
+
+func (x *RewardGeneratorConfig) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// RewardValidatorConfig 校验配置
+type RewardValidatorConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     int64                  `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"`       // 可发放开始时间(timestamp)，0 表示不限
+	EndTime       int64                  `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime,omitempty"`           // 可发放结束时间(timestamp)，0 表示不限
+	AudienceId    string                 `protobuf:"bytes,3,opt,name=audienceId,proto3" json:"audienceId,omitempty"`      // 受众ID：非空时只发放给属于该受众的用户
+	PerUserLimit  int32                  `protobuf:"varint,4,opt,name=perUserLimit,proto3" json:"perUserLimit,omitempty"` // 每个用户可获得次数（0 表示不限）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardValidatorConfig) Reset() {
+	*x = RewardValidatorConfig{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardValidatorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardValidatorConfig) ProtoMessage() {}
+
+func (x *RewardValidatorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardValidatorConfig.ProtoReflect.Descriptor instead.
+func (*RewardValidatorConfig) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RewardValidatorConfig) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *RewardValidatorConfig) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *RewardValidatorConfig) GetAudienceId() string {
+	if x != nil {
+		return x.AudienceId
+	}
+	return ""
+}
+
+func (x *RewardValidatorConfig) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+// RewardDistributorConfig 发放配置
+type RewardDistributorConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // AUTO(生成后立即发放，默认)/MANUAL(等待确认发放)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardDistributorConfig) Reset() {
+	*x = RewardDistributorConfig{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardDistributorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardDistributorConfig) ProtoMessage() {}
+
+func (x *RewardDistributorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardDistributorConfig.ProtoReflect.Descriptor instead.
+func (*RewardDistributorConfig) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RewardDistributorConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// Reward 奖励模板
+type Reward struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	RewardId          string                   `protobuf:"bytes,1,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	Name              string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RewardType        string                   `protobuf:"bytes,4,opt,name=rewardType,proto3" json:"rewardType,omitempty"` // 奖励类型: COUPON/POINTS/SUBSCRIPTION
+	Content           *RewardContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	GeneratorConfig   *RewardGeneratorConfig   `protobuf:"bytes,6,opt,name=generatorConfig,proto3" json:"generatorConfig,omitempty"`
+	ValidatorConfig   *RewardValidatorConfig   `protobuf:"bytes,7,opt,name=validatorConfig,proto3" json:"validatorConfig,omitempty"`
+	DistributorConfig *RewardDistributorConfig `protobuf:"bytes,8,opt,name=distributorConfig,proto3" json:"distributorConfig,omitempty"`
+	ValidDays         int32                    `protobuf:"varint,9,opt,name=validDays,proto3" json:"validDays,omitempty"` // 发放后有效天数：COUPON 为优惠券有效期（必填），其他类型为发放记录的过期时间（0 表示不过期）
+	Version           int32                    `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`    // 版本号（每次修改加一）
+	Status            string                   `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`       // 状态: ACTIVE/PAUSED/ENDED，只有 ACTIVE 的奖励可以发放
+	CreatedAt         int64                    `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt         int64                    `protobuf:"varint,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Reward) Reset() {
+	*x = Reward{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reward) ProtoMessage() {}
+
+func (x *Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
+func (*Reward) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Reward) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *Reward) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reward) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Reward) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *Reward) GetContent() *RewardContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *Reward) GetGeneratorConfig() *RewardGeneratorConfig {
+	if x != nil {
+		return x.GeneratorConfig
+	}
+	return nil
+}
+
+func (x *Reward) GetValidatorConfig() *RewardValidatorConfig {
+	if x != nil {
+		return x.ValidatorConfig
+	}
+	return nil
+}
+
+func (x *Reward) GetDistributorConfig() *RewardDistributorConfig {
+	if x != nil {
+		return x.DistributorConfig
+	}
+	return nil
+}
+
+func (x *Reward) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *Reward) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Reward) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reward) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Reward) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// CreateRewardRequest 创建奖励模板请求
+type CreateRewardRequest struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Name              string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RewardType        string                   `protobuf:"bytes,3,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	Content           *RewardContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	GeneratorConfig   *RewardGeneratorConfig   `protobuf:"bytes,5,opt,name=generatorConfig,proto3" json:"generatorConfig,omitempty"`
+	ValidatorConfig   *RewardValidatorConfig   `protobuf:"bytes,6,opt,name=validatorConfig,proto3" json:"validatorConfig,omitempty"`
+	DistributorConfig *RewardDistributorConfig `protobuf:"bytes,7,opt,name=distributorConfig,proto3" json:"distributorConfig,omitempty"`
+	ValidDays         int32                    `protobuf:"varint,8,opt,name=validDays,proto3" json:"validDays,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateRewardRequest) Reset() {
+	*x = CreateRewardRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRewardRequest) ProtoMessage() {}
+
+func (x *CreateRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRewardRequest.ProtoReflect.Descriptor instead.
+func (*CreateRewardRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateRewardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *CreateRewardRequest) GetContent() *RewardContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreateRewardRequest) GetGeneratorConfig() *RewardGeneratorConfig {
+	if x != nil {
+		return x.GeneratorConfig
+	}
+	return nil
+}
+
+func (x *CreateRewardRequest) GetValidatorConfig() *RewardValidatorConfig {
+	if x != nil {
+		return x.ValidatorConfig
+	}
+	return nil
+}
+
+func (x *CreateRewardRequest) GetDistributorConfig() *RewardDistributorConfig {
+	if x != nil {
+		return x.DistributorConfig
+	}
+	return nil
+}
+
+func (x *CreateRewardRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+// RewardReply 奖励模板响应
+type RewardReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reward        *Reward                `protobuf:"bytes,1,opt,name=reward,proto3" json:"reward,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardReply) Reset() {
+	*x = RewardReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardReply) ProtoMessage() {}
+
+func (x *RewardReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardReply.ProtoReflect.Descriptor instead.
+func (*RewardReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RewardReply) GetReward() *Reward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+// GetRewardRequest 获取奖励模板请求
+type GetRewardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RewardId      string                 `protobuf:"bytes,1,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRewardRequest) Reset() {
+	*x = GetRewardRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRewardRequest) ProtoMessage() {}
+
+func (x *GetRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRewardRequest.ProtoReflect.Descriptor instead.
+func (*GetRewardRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetRewardRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+// ListRewardsRequest 列出奖励模板请求
+type ListRewardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RewardType    string                 `protobuf:"bytes,1,opt,name=rewardType,proto3" json:"rewardType,omitempty"` // 按类型筛选
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`         // 按状态筛选
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRewardsRequest) Reset() {
+	*x = ListRewardsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRewardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRewardsRequest) ProtoMessage() {}
+
+func (x *ListRewardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRewardsRequest.ProtoReflect.Descriptor instead.
+func (*ListRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListRewardsRequest) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *ListRewardsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRewardsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRewardsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListRewardsReply 列出奖励模板响应
+type ListRewardsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rewards       []*Reward              `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRewardsReply) Reset() {
+	*x = ListRewardsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRewardsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRewardsReply) ProtoMessage() {}
+
+func (x *ListRewardsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRewardsReply.ProtoReflect.Descriptor instead.
+func (*ListRewardsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListRewardsReply) GetRewards() []*Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *ListRewardsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListRewardsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRewardsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateRewardRequest 更新奖励模板请求（未传的字段不修改，配置和内容整体替换）
+type UpdateRewardRequest struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	RewardId          string                   `protobuf:"bytes,1,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	Version           int32                    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 期望的当前版本（乐观锁），0 表示不检查
+	Name              string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description       *string                  `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RewardType        string                   `protobuf:"bytes,5,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	Content           *RewardContent           `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	GeneratorConfig   *RewardGeneratorConfig   `protobuf:"bytes,7,opt,name=generatorConfig,proto3" json:"generatorConfig,omitempty"`
+	ValidatorConfig   *RewardValidatorConfig   `protobuf:"bytes,8,opt,name=validatorConfig,proto3" json:"validatorConfig,omitempty"`
+	DistributorConfig *RewardDistributorConfig `protobuf:"bytes,9,opt,name=distributorConfig,proto3" json:"distributorConfig,omitempty"`
+	ValidDays         *int32                   `protobuf:"varint,10,opt,name=validDays,proto3,oneof" json:"validDays,omitempty"`
+	Status            string                   `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateRewardRequest) Reset() {
+	*x = UpdateRewardRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRewardRequest) ProtoMessage() {}
+
+func (x *UpdateRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRewardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRewardRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateRewardRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *UpdateRewardRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateRewardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRewardRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRewardRequest) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *UpdateRewardRequest) GetContent() *RewardContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UpdateRewardRequest) GetGeneratorConfig() *RewardGeneratorConfig {
+	if x != nil {
+		return x.GeneratorConfig
+	}
+	return nil
+}
+
+func (x *UpdateRewardRequest) GetValidatorConfig() *RewardValidatorConfig {
+	if x != nil {
+		return x.ValidatorConfig
+	}
+	return nil
+}
+
+func (x *UpdateRewardRequest) GetDistributorConfig() *RewardDistributorConfig {
+	if x != nil {
+		return x.DistributorConfig
+	}
+	return nil
+}
+
+func (x *UpdateRewardRequest) GetValidDays() int32 {
+	if x != nil && x.ValidDays != nil {
+		return *x.ValidDays
+	}
+	return 0
+}
+
+func (x *UpdateRewardRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// DeleteRewardRequest 删除奖励模板请求
+type DeleteRewardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RewardId      string                 `protobuf:"bytes,1,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRewardRequest) Reset() {
+	*x = DeleteRewardRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRewardRequest) ProtoMessage() {}
+
+func (x *DeleteRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRewardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRewardRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeleteRewardRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+// IssueRewardRequest 发放奖励请求
+type IssueRewardRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RewardId       string                 `protobuf:"bytes,1,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	CampaignId     string                 `protobuf:"bytes,3,opt,name=campaignId,proto3" json:"campaignId,omitempty"`                                                                                   // 所属活动ID（可选），活动须在进行中
+	RequestId      string                 `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`                                                                                     // 幂等键（可选）：同一奖励下相同的 requestId 只发放一次
+	UserAttributes map[string]string      `protobuf:"bytes,5,rep,name=userAttributes,proto3" json:"userAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户属性（校验配置引用 TAG/SEGMENT 受众时用于求值）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IssueRewardRequest) Reset() {
+	*x = IssueRewardRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueRewardRequest) ProtoMessage() {}
+
+func (x *IssueRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueRewardRequest.ProtoReflect.Descriptor instead.
+func (*IssueRewardRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *IssueRewardRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *IssueRewardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IssueRewardRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *IssueRewardRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *IssueRewardRequest) GetUserAttributes() map[string]string {
+	if x != nil {
+		return x.UserAttributes
+	}
+	return nil
+}
+
+// RewardGrant 奖励发放记录（名称和内容为发放时的快照）
+type RewardGrant struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GrantId         string                 `protobuf:"bytes,1,opt,name=grantId,proto3" json:"grantId,omitempty"`
+	RewardId        string                 `protobuf:"bytes,2,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	RewardName      string                 `protobuf:"bytes,3,opt,name=rewardName,proto3" json:"rewardName,omitempty"`
+	RewardType      string                 `protobuf:"bytes,4,opt,name=rewardType,proto3" json:"rewardType,omitempty"`
+	RewardVersion   int32                  `protobuf:"varint,5,opt,name=rewardVersion,proto3" json:"rewardVersion,omitempty"`    // 发放时的奖励版本
+	ContentSnapshot *RewardContent         `protobuf:"bytes,6,opt,name=contentSnapshot,proto3" json:"contentSnapshot,omitempty"` // 发放时的奖励内容
+	CampaignId      string                 `protobuf:"bytes,7,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CampaignName    string                 `protobuf:"bytes,8,opt,name=campaignName,proto3" json:"campaignName,omitempty"`
+	TaskId          string                 `protobuf:"bytes,9,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	TaskName        string                 `protobuf:"bytes,10,opt,name=taskName,proto3" json:"taskName,omitempty"`
+	UserId          string                 `protobuf:"bytes,11,opt,name=userId,proto3" json:"userId,omitempty"`
+	RequestId       string                 `protobuf:"bytes,12,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Status          string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`          // 状态: PENDING/GENERATED/DISTRIBUTED/USED/EXPIRED（EXPIRED 含未使用且已过过期时间）
+	CouponCode      string                 `protobuf:"bytes,14,opt,name=couponCode,proto3" json:"couponCode,omitempty"`  // COUPON：生成的用户专属优惠码（发放后激活）
+	ExpireTime      int64                  `protobuf:"varint,15,opt,name=expireTime,proto3" json:"expireTime,omitempty"` // 过期时间(timestamp)，0 表示不过期
+	GeneratedAt     int64                  `protobuf:"varint,16,opt,name=generatedAt,proto3" json:"generatedAt,omitempty"`
+	DistributedAt   int64                  `protobuf:"varint,17,opt,name=distributedAt,proto3" json:"distributedAt,omitempty"`
+	UsedAt          int64                  `protobuf:"varint,18,opt,name=usedAt,proto3" json:"usedAt,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,19,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,20,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RewardGrant) Reset() {
+	*x = RewardGrant{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardGrant) ProtoMessage() {}
+
+func (x *RewardGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardGrant.ProtoReflect.Descriptor instead.
+func (*RewardGrant) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RewardGrant) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetRewardName() string {
+	if x != nil {
+		return x.RewardName
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetRewardVersion() int32 {
+	if x != nil {
+		return x.RewardVersion
+	}
+	return 0
+}
+
+func (x *RewardGrant) GetContentSnapshot() *RewardContent {
+	if x != nil {
+		return x.ContentSnapshot
+	}
+	return nil
+}
+
+func (x *RewardGrant) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetCampaignName() string {
+	if x != nil {
+		return x.CampaignName
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *RewardGrant) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *RewardGrant) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+func (x *RewardGrant) GetDistributedAt() int64 {
+	if x != nil {
+		return x.DistributedAt
+	}
+	return 0
+}
+
+func (x *RewardGrant) GetUsedAt() int64 {
+	if x != nil {
+		return x.UsedAt
+	}
+	return 0
+}
+
+func (x *RewardGrant) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RewardGrant) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// RewardGrantReply 奖励发放记录响应
+type RewardGrantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *RewardGrant           `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardGrantReply) Reset() {
+	*x = RewardGrantReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardGrantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardGrantReply) ProtoMessage() {}
+
+func (x *RewardGrantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardGrantReply.ProtoReflect.Descriptor instead.
+func (*RewardGrantReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RewardGrantReply) GetGrant() *RewardGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+// GetRewardGrantRequest 获取奖励发放记录请求
+type GetRewardGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantId       string                 `protobuf:"bytes,1,opt,name=grantId,proto3" json:"grantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRewardGrantRequest) Reset() {
+	*x = GetRewardGrantRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRewardGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRewardGrantRequest) ProtoMessage() {}
+
+func (x *GetRewardGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRewardGrantRequest.ProtoReflect.Descriptor instead.
+func (*GetRewardGrantRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetRewardGrantRequest) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+// ListRewardGrantsRequest 列出奖励发放记录请求
+type ListRewardGrantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RewardId      string                 `protobuf:"bytes,1,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,3,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	TaskId        string                 `protobuf:"bytes,4,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRewardGrantsRequest) Reset() {
+	*x = ListRewardGrantsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRewardGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRewardGrantsRequest) ProtoMessage() {}
+
+func (x *ListRewardGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRewardGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListRewardGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListRewardGrantsRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *ListRewardGrantsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRewardGrantsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListRewardGrantsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListRewardGrantsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRewardGrantsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRewardGrantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListRewardGrantsReply 列出奖励发放记录响应
+type ListRewardGrantsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*RewardGrant         `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRewardGrantsReply) Reset() {
+	*x = ListRewardGrantsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRewardGrantsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRewardGrantsReply) ProtoMessage() {}
+
+func (x *ListRewardGrantsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRewardGrantsReply.ProtoReflect.Descriptor instead.
+func (*ListRewardGrantsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListRewardGrantsReply) GetGrants() []*RewardGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *ListRewardGrantsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListRewardGrantsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRewardGrantsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// DistributeRewardGrantRequest 确认发放请求
+type DistributeRewardGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantId       string                 `protobuf:"bytes,1,opt,name=grantId,proto3" json:"grantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistributeRewardGrantRequest) Reset() {
+	*x = DistributeRewardGrantRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistributeRewardGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributeRewardGrantRequest) ProtoMessage() {}
+
+func (x *DistributeRewardGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributeRewardGrantRequest.ProtoReflect.Descriptor instead.
+func (*DistributeRewardGrantRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DistributeRewardGrantRequest) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+// UseRewardGrantRequest 标记奖励已使用请求
+type UseRewardGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantId       string                 `protobuf:"bytes,1,opt,name=grantId,proto3" json:"grantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UseRewardGrantRequest) Reset() {
+	*x = UseRewardGrantRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseRewardGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseRewardGrantRequest) ProtoMessage() {}
+
+func (x *UseRewardGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseRewardGrantRequest.ProtoReflect.Descriptor instead.
+func (*UseRewardGrantRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UseRewardGrantRequest) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+// TaskTriggerConfig 触发配置
+type TaskTriggerConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=eventType,proto3" json:"eventType,omitempty"`         // 触发事件: USER_REGISTER/ORDER_PAID/USER_SIGN_IN（INVITE/PURCHASE/SIGN_IN 任务固定为对应事件，SHARE 任务必填）
+	UserAttribute string                 `protobuf:"bytes,2,opt,name=userAttribute,proto3" json:"userAttribute,omitempty"` // 非空时事件属性中该字段的值为获得进度的用户（INVITE 任务默认 inviterId），否则为事件用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTriggerConfig) Reset() {
+	*x = TaskTriggerConfig{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTriggerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTriggerConfig) ProtoMessage() {}
+
+func (x *TaskTriggerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTriggerConfig.ProtoReflect.Descriptor instead.
+func (*TaskTriggerConfig) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *TaskTriggerConfig) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *TaskTriggerConfig) GetUserAttribute() string {
+	if x != nil {
+		return x.UserAttribute
+	}
+	return ""
+}
+
+// TaskConditionConfig 完成条件
+type TaskConditionConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinAmount     int64                  `protobuf:"varint,1,opt,name=minAmount,proto3" json:"minAmount,omitempty"`                                                                            // ORDER_PAID：订单金额下限(分)，0 表示不限
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`                                                                               // ORDER_PAID：订单币种，为空表示不限
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 事件属性须与这些键值全部相等
+	TargetCount   int32                  `protobuf:"varint,4,opt,name=targetCount,proto3" json:"targetCount,omitempty"`                                                                        // 完成所需的事件次数（默认 1）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskConditionConfig) Reset() {
+	*x = TaskConditionConfig{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskConditionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskConditionConfig) ProtoMessage() {}
+
+func (x *TaskConditionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskConditionConfig.ProtoReflect.Descriptor instead.
+func (*TaskConditionConfig) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TaskConditionConfig) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *TaskConditionConfig) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TaskConditionConfig) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *TaskConditionConfig) GetTargetCount() int32 {
+	if x != nil {
+		return x.TargetCount
+	}
+	return 0
+}
+
+// Task 任务
+type Task struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TaskId          string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	CampaignId      string                 `protobuf:"bytes,2,opt,name=campaignId,proto3" json:"campaignId,omitempty"` // 所属活动（TASK_REWARD 类型），活动不在进行中时不处理事件
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TaskType        string                 `protobuf:"bytes,5,opt,name=taskType,proto3" json:"taskType,omitempty"` // 任务类型: INVITE/PURCHASE/SHARE/SIGN_IN
+	TriggerConfig   *TaskTriggerConfig     `protobuf:"bytes,6,opt,name=triggerConfig,proto3" json:"triggerConfig,omitempty"`
+	ConditionConfig *TaskConditionConfig   `protobuf:"bytes,7,opt,name=conditionConfig,proto3" json:"conditionConfig,omitempty"`
+	RewardId        string                 `protobuf:"bytes,8,opt,name=rewardId,proto3" json:"rewardId,omitempty"`      // 完成后发放的奖励
+	Repeatable      bool                   `protobuf:"varint,9,opt,name=repeatable,proto3" json:"repeatable,omitempty"` // 是否可重复完成（是则每完成一次进度归零）
+	Status          string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`         // 状态: ACTIVE/PAUSED/ENDED，只有 ACTIVE 的任务处理事件
+	CreatedAt       int64                  `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Task) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Task) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *Task) GetTriggerConfig() *TaskTriggerConfig {
+	if x != nil {
+		return x.TriggerConfig
+	}
+	return nil
+}
+
+func (x *Task) GetConditionConfig() *TaskConditionConfig {
+	if x != nil {
+		return x.ConditionConfig
+	}
+	return nil
+}
+
+func (x *Task) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *Task) GetRepeatable() bool {
+	if x != nil {
+		return x.Repeatable
+	}
+	return false
+}
+
+func (x *Task) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Task) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// CreateTaskRequest 创建任务请求
+type CreateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId      string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TaskType        string                 `protobuf:"bytes,4,opt,name=taskType,proto3" json:"taskType,omitempty"`
+	TriggerConfig   *TaskTriggerConfig     `protobuf:"bytes,5,opt,name=triggerConfig,proto3" json:"triggerConfig,omitempty"`
+	ConditionConfig *TaskConditionConfig   `protobuf:"bytes,6,opt,name=conditionConfig,proto3" json:"conditionConfig,omitempty"`
+	RewardId        string                 `protobuf:"bytes,7,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	Repeatable      bool                   `protobuf:"varint,8,opt,name=repeatable,proto3" json:"repeatable,omitempty"`
+	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // 默认 ACTIVE
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateTaskRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetTriggerConfig() *TaskTriggerConfig {
+	if x != nil {
+		return x.TriggerConfig
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetConditionConfig() *TaskConditionConfig {
+	if x != nil {
+		return x.ConditionConfig
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetRepeatable() bool {
+	if x != nil {
+		return x.Repeatable
+	}
+	return false
+}
+
+func (x *CreateTaskRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// TaskReply 任务响应
+type TaskReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskReply) Reset() {
+	*x = TaskReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskReply) ProtoMessage() {}
+
+func (x *TaskReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskReply.ProtoReflect.Descriptor instead.
+func (*TaskReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *TaskReply) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// GetTaskRequest 获取任务请求
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// ListTasksRequest 列出任务请求
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	TaskType      string                 `protobuf:"bytes,2,opt,name=taskType,proto3" json:"taskType,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListTasksRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListTasksReply 列出任务响应
+type ListTasksReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksReply) Reset() {
+	*x = ListTasksReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksReply) ProtoMessage() {}
+
+func (x *ListTasksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksReply.ProtoReflect.Descriptor instead.
+func (*ListTasksReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListTasksReply) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTasksReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTasksReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateTaskRequest 更新任务请求（未传的字段不修改，配置整体替换）
+type UpdateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TaskId          string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	TaskType        string                 `protobuf:"bytes,4,opt,name=taskType,proto3" json:"taskType,omitempty"`
+	TriggerConfig   *TaskTriggerConfig     `protobuf:"bytes,5,opt,name=triggerConfig,proto3" json:"triggerConfig,omitempty"`
+	ConditionConfig *TaskConditionConfig   `protobuf:"bytes,6,opt,name=conditionConfig,proto3" json:"conditionConfig,omitempty"`
+	RewardId        string                 `protobuf:"bytes,7,opt,name=rewardId,proto3" json:"rewardId,omitempty"`
+	Repeatable      *bool                  `protobuf:"varint,8,opt,name=repeatable,proto3,oneof" json:"repeatable,omitempty"`
+	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UpdateTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTriggerConfig() *TaskTriggerConfig {
+	if x != nil {
+		return x.TriggerConfig
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetConditionConfig() *TaskConditionConfig {
+	if x != nil {
+		return x.ConditionConfig
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetRepeatable() bool {
+	if x != nil && x.Repeatable != nil {
+		return *x.Repeatable
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// DeleteTaskRequest 删除任务请求
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeleteTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// TaskProgress 用户任务进度
+type TaskProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Progress      int32                  `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`       // 当前已计入的事件次数
+	Target        int32                  `protobuf:"varint,4,opt,name=target,proto3" json:"target,omitempty"`           // 完成所需次数
+	Completions   int32                  `protobuf:"varint,5,opt,name=completions,proto3" json:"completions,omitempty"` // 已完成次数
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`            // 状态: IN_PROGRESS/COMPLETED
+	LastEventId   string                 `protobuf:"bytes,7,opt,name=lastEventId,proto3" json:"lastEventId,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,8,opt,name=completedAt,proto3" json:"completedAt,omitempty"` // 最近完成时间(timestamp)，0 表示未完成过
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskProgress) Reset() {
+	*x = TaskProgress{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskProgress) ProtoMessage() {}
+
+func (x *TaskProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskProgress.ProtoReflect.Descriptor instead.
+func (*TaskProgress) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TaskProgress) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskProgress) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskProgress) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *TaskProgress) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *TaskProgress) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *TaskProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TaskProgress) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+func (x *TaskProgress) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *TaskProgress) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TaskProgress) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// ListTaskProgressRequest 列出用户任务进度请求
+type ListTaskProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskProgressRequest) Reset() {
+	*x = ListTaskProgressRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskProgressRequest) ProtoMessage() {}
+
+func (x *ListTaskProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskProgressRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskProgressRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListTaskProgressRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListTaskProgressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTaskProgressRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTaskProgressRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTaskProgressRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListTaskProgressReply 列出用户任务进度响应
+type ListTaskProgressReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Progress      []*TaskProgress        `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskProgressReply) Reset() {
+	*x = ListTaskProgressReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskProgressReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskProgressReply) ProtoMessage() {}
+
+func (x *ListTaskProgressReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskProgressReply.ProtoReflect.Descriptor instead.
+func (*ListTaskProgressReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListTaskProgressReply) GetProgress() []*TaskProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *ListTaskProgressReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTaskProgressReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTaskProgressReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// TriggerEventRequest 上报业务事件请求
+type TriggerEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"` // 事件ID（幂等键）：同一事件重复上报时不重复计入进度
+	EventType     string                 `protobuf:"bytes,2,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                                  // ORDER_PAID：订单金额(分)
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                                                                               // ORDER_PAID：订单币种
+	Attributes    map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 事件属性（如 inviterId），用于完成条件和奖励受众求值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerEventRequest) Reset() {
+	*x = TriggerEventRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventRequest) ProtoMessage() {}
+
+func (x *TriggerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventRequest.ProtoReflect.Descriptor instead.
+func (*TriggerEventRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *TriggerEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TriggerEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *TriggerEventRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TriggerEventRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TriggerEventRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TriggerEventRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// TaskTriggerResult 事件对单个任务的处理结果
+type TaskTriggerResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	TaskName      string                 `protobuf:"bytes,2,opt,name=taskName,proto3" json:"taskName,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"` // 获得进度的用户
+	Progress      int32                  `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Target        int32                  `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`
+	Completions   int32                  `protobuf:"varint,6,opt,name=completions,proto3" json:"completions,omitempty"`
+	Completed     bool                   `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`     // 该事件完成了任务
+	Duplicate     bool                   `protobuf:"varint,8,opt,name=duplicate,proto3" json:"duplicate,omitempty"`     // 该事件已处理过
+	Ignored       bool                   `protobuf:"varint,9,opt,name=ignored,proto3" json:"ignored,omitempty"`         // 任务已完成且不可重复完成，事件不再计入
+	GrantId       string                 `protobuf:"bytes,10,opt,name=grantId,proto3" json:"grantId,omitempty"`         // 发放的奖励记录
+	RewardError   string                 `protobuf:"bytes,11,opt,name=rewardError,proto3" json:"rewardError,omitempty"` // 奖励发放失败的原因（重复上报该事件时重试发放）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTriggerResult) Reset() {
+	*x = TaskTriggerResult{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTriggerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTriggerResult) ProtoMessage() {}
+
+func (x *TaskTriggerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTriggerResult.ProtoReflect.Descriptor instead.
+func (*TaskTriggerResult) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *TaskTriggerResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskTriggerResult) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *TaskTriggerResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskTriggerResult) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *TaskTriggerResult) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *TaskTriggerResult) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *TaskTriggerResult) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *TaskTriggerResult) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *TaskTriggerResult) GetIgnored() bool {
+	if x != nil {
+		return x.Ignored
+	}
+	return false
+}
+
+func (x *TaskTriggerResult) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+func (x *TaskTriggerResult) GetRewardError() string {
+	if x != nil {
+		return x.RewardError
+	}
+	return ""
+}
+
+// TriggerEventReply 上报业务事件响应
+type TriggerEventReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*TaskTriggerResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 匹配到的任务（不匹配的任务不返回）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerEventReply) Reset() {
+	*x = TriggerEventReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerEventReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventReply) ProtoMessage() {}
+
+func (x *TriggerEventReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventReply.ProtoReflect.Descriptor instead.
+func (*TriggerEventReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *TriggerEventReply) GetResults() []*TaskTriggerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ValidateCouponRequest 验证优惠券请求 (供 Payment Service 调用)
+type ValidateCouponRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode     string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                                                                          // 订单金额(分)
+	UserId         string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`                                                                                           // 用户ID（用于转化漏斗统计；绑定用户、相对有效期和名单受众的优惠券必须传入）
+	UserAttributes map[string]string      `protobuf:"bytes,4,rep,name=userAttributes,proto3" json:"userAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户属性（如 level、tags、注册天数），优惠券引用 TAG/SEGMENT 受众时用于求值
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ValidateCouponRequest) Reset() {
+	*x = ValidateCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCouponRequest) ProtoMessage() {}
+
+func (x *ValidateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCouponRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ValidateCouponRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *ValidateCouponRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ValidateCouponRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateCouponRequest) GetUserAttributes() map[string]string {
+	if x != nil {
+		return x.UserAttributes
+	}
+	return nil
+}
+
+// ValidateCouponReply 验证优惠券响应
+type ValidateCouponReply struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Valid           bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	DiscountAmount  int64                  `protobuf:"varint,3,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"` // 折扣金额(分)
+	FinalAmount     int64                  `protobuf:"varint,4,opt,name=finalAmount,proto3" json:"finalAmount,omitempty"`       // 最终金额(分)
+	Coupon          *Coupon                `protobuf:"bytes,5,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Reason          string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                          // 验证结果原因: OK/NOT_FOUND/APP_MISMATCH/USER_MISMATCH/INACTIVE/NOT_STARTED/EXPIRED/EXHAUSTED/BELOW_MIN_AMOUNT/NOT_ISSUED/NOT_IN_AUDIENCE/NOT_NEW_CUSTOMER/OUTSIDE_SCHEDULE/LIMIT_EXCEEDED/CAMPAIGN_INACTIVE/BUDGET_EXHAUSTED/PACE_LIMITED
+	NextAvailableAt int64                  `protobuf:"varint,7,opt,name=nextAvailableAt,proto3" json:"nextAvailableAt,omitempty"`       // reason 为 OUTSIDE_SCHEDULE 或 PACE_LIMITED 时下一个可用时间(timestamp)，0 表示生效期内不再可用
+	Quotas          []*CouponQuota         `protobuf:"bytes,8,rep,name=quotas,proto3" json:"quotas,omitempty"`                          // 频次限制的剩余额度（未传 userId 时只包含 DAY/TOTAL）
+	BudgetRemaining *int64                 `protobuf:"varint,9,opt,name=budgetRemaining,proto3,oneof" json:"budgetRemaining,omitempty"` // 当前可用的剩余折扣预算(分)：优惠券、所属活动和投放节奏中最小的，未设置预算时不返回
+	BudgetClamped   bool                   `protobuf:"varint,10,opt,name=budgetClamped,proto3" json:"budgetClamped,omitempty"`          // 折扣金额是否因剩余预算不足被削减（discountAmount 已是削减后的金额）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidateCouponReply) Reset() {
+	*x = ValidateCouponReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCouponReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCouponReply) ProtoMessage() {}
+
+func (x *ValidateCouponReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCouponReply.ProtoReflect.Descriptor instead.
+func (*ValidateCouponReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ValidateCouponReply) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateCouponReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateCouponReply) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *ValidateCouponReply) GetFinalAmount() int64 {
+	if x != nil {
+		return x.FinalAmount
+	}
+	return 0
+}
+
+func (x *ValidateCouponReply) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *ValidateCouponReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ValidateCouponReply) GetNextAvailableAt() int64 {
+	if x != nil {
+		return x.NextAvailableAt
+	}
+	return 0
+}
+
+func (x *ValidateCouponReply) GetQuotas() []*CouponQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *ValidateCouponReply) GetBudgetRemaining() int64 {
+	if x != nil && x.BudgetRemaining != nil {
+		return *x.BudgetRemaining
+	}
+	return 0
+}
+
+func (x *ValidateCouponReply) GetBudgetClamped() bool {
+	if x != nil {
+		return x.BudgetClamped
+	}
+	return false
+}
+
+// UseCouponRequest 使用优惠券请求 (供 Payment Service 调用)
+type UseCouponRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode     string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	AppId          string                 `protobuf:"bytes,2,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID
+	UserId         string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	PaymentOrderId string                 `protobuf:"bytes,4,opt,name=paymentOrderId,proto3" json:"paymentOrderId,omitempty"` // 支付订单ID（payment-service的业务订单号orderId）
+	PaymentId      string                 `protobuf:"bytes,5,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	OriginalAmount int64                  `protobuf:"varint,6,opt,name=originalAmount,proto3" json:"originalAmount,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,7,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"`
+	FinalAmount    int64                  `protobuf:"varint,8,opt,name=finalAmount,proto3" json:"finalAmount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UseCouponRequest) Reset() {
+	*x = UseCouponRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UseCouponRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseCouponRequest) ProtoMessage() {}
+func (*UseCouponRequest) ProtoMessage() {}
+
+func (x *UseCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseCouponRequest.ProtoReflect.Descriptor instead.
+func (*UseCouponRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *UseCouponRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *UseCouponRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *UseCouponRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UseCouponRequest) GetPaymentOrderId() string {
+	if x != nil {
+		return x.PaymentOrderId
+	}
+	return ""
+}
+
+func (x *UseCouponRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *UseCouponRequest) GetOriginalAmount() int64 {
+	if x != nil {
+		return x.OriginalAmount
+	}
+	return 0
+}
+
+func (x *UseCouponRequest) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *UseCouponRequest) GetFinalAmount() int64 {
+	if x != nil {
+		return x.FinalAmount
+	}
+	return 0
+}
+
+// UseCouponReply 使用优惠券响应
+type UseCouponReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UseCouponReply) Reset() {
+	*x = UseCouponReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseCouponReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseCouponReply) ProtoMessage() {}
+
+func (x *UseCouponReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseCouponReply.ProtoReflect.Descriptor instead.
+func (*UseCouponReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *UseCouponReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UseCouponReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetCouponStatsRequest 获取优惠券统计请求
+type GetCouponStatsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode       string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	ExactUniqueUsers bool                   `protobuf:"varint,2,opt,name=exactUniqueUsers,proto3" json:"exactUniqueUsers,omitempty"` // 去重用户数使用精确查询（默认 HyperLogLog 近似值）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCouponStatsRequest) Reset() {
+	*x = GetCouponStatsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponStatsRequest) ProtoMessage() {}
+
+func (x *GetCouponStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponStatsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetCouponStatsRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *GetCouponStatsRequest) GetExactUniqueUsers() bool {
+	if x != nil {
+		return x.ExactUniqueUsers
+	}
+	return false
+}
+
+// GetCouponStatsReply 获取优惠券统计响应
+type GetCouponStatsReply struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode         string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	TotalUses          int32                  `protobuf:"varint,2,opt,name=totalUses,proto3" json:"totalUses,omitempty"`                   // 使用次数
+	TotalOrders        int32                  `protobuf:"varint,3,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`               // 订单数
+	TotalRevenue       int64                  `protobuf:"varint,4,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`             // 产生收入(分)（已废弃：跨币种相加，请使用 amountsByCurrency）
+	TotalDiscount      int64                  `protobuf:"varint,5,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"`           // 折扣金额(分)（已废弃：跨币种相加，请使用 amountsByCurrency）
+	ConversionRate     float32                `protobuf:"fixed32,6,opt,name=conversionRate,proto3" json:"conversionRate,omitempty"`        // 转化率(%)：使用次数 / 验证次数
+	ValidationAttempts int32                  `protobuf:"varint,7,opt,name=validationAttempts,proto3" json:"validationAttempts,omitempty"` // 验证次数
+	ValidAttempts      int32                  `protobuf:"varint,8,opt,name=validAttempts,proto3" json:"validAttempts,omitempty"`           // 验证通过次数
+	ValidRate          float32                `protobuf:"fixed32,9,opt,name=validRate,proto3" json:"validRate,omitempty"`                  // 验证通过率(%)：验证通过次数 / 验证次数
+	RedemptionRate     float32                `protobuf:"fixed32,10,opt,name=redemptionRate,proto3" json:"redemptionRate,omitempty"`       // 核销率(%)：使用次数 / 验证通过次数
+	QuotaUtilization   float32                `protobuf:"fixed32,11,opt,name=quotaUtilization,proto3" json:"quotaUtilization,omitempty"`   // 配额使用率(%)：使用次数 / 最大使用次数
+	AmountsByCurrency  []*CurrencyAmount      `protobuf:"bytes,12,rep,name=amountsByCurrency,proto3" json:"amountsByCurrency,omitempty"`   // 按币种拆分的收入与折扣
+	UniqueUsers        int64                  `protobuf:"varint,13,opt,name=uniqueUsers,proto3" json:"uniqueUsers,omitempty"`              // 去重用户数
+	UniqueUsersExact   bool                   `protobuf:"varint,14,opt,name=uniqueUsersExact,proto3" json:"uniqueUsersExact,omitempty"`    // uniqueUsers 是否为精确值（false 表示 HyperLogLog 近似值，误差约 0.81%）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCouponStatsReply) Reset() {
+	*x = GetCouponStatsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponStatsReply) ProtoMessage() {}
+
+func (x *GetCouponStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponStatsReply.ProtoReflect.Descriptor instead.
+func (*GetCouponStatsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetCouponStatsReply) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *GetCouponStatsReply) GetTotalUses() int32 {
+	if x != nil {
+		return x.TotalUses
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetTotalOrders() int32 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetTotalRevenue() int64 {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetTotalDiscount() int64 {
+	if x != nil {
+		return x.TotalDiscount
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetConversionRate() float32 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetValidationAttempts() int32 {
+	if x != nil {
+		return x.ValidationAttempts
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetValidAttempts() int32 {
+	if x != nil {
+		return x.ValidAttempts
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetValidRate() float32 {
+	if x != nil {
+		return x.ValidRate
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetRedemptionRate() float32 {
+	if x != nil {
+		return x.RedemptionRate
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetQuotaUtilization() float32 {
+	if x != nil {
+		return x.QuotaUtilization
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetAmountsByCurrency() []*CurrencyAmount {
+	if x != nil {
+		return x.AmountsByCurrency
+	}
+	return nil
+}
+
+func (x *GetCouponStatsReply) GetUniqueUsers() int64 {
+	if x != nil {
+		return x.UniqueUsers
+	}
+	return 0
+}
+
+func (x *GetCouponStatsReply) GetUniqueUsersExact() bool {
+	if x != nil {
+		return x.UniqueUsersExact
+	}
+	return false
+}
+
+// CurrencyAmount 按币种汇总的金额（不同币种的金额不能直接相加）
+type CurrencyAmount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`            // 货币单位: CNY/USD/EUR
+	TotalUses     int32                  `protobuf:"varint,2,opt,name=totalUses,proto3" json:"totalUses,omitempty"`         // 使用次数
+	TotalRevenue  int64                  `protobuf:"varint,3,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`   // 产生收入(最小货币单位)
+	TotalDiscount int64                  `protobuf:"varint,4,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"` // 折扣金额(最小货币单位)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyAmount) Reset() {
+	*x = CurrencyAmount{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyAmount) ProtoMessage() {}
+
+func (x *CurrencyAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyAmount.ProtoReflect.Descriptor instead.
+func (*CurrencyAmount) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CurrencyAmount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyAmount) GetTotalUses() int32 {
+	if x != nil {
+		return x.TotalUses
+	}
+	return 0
+}
+
+func (x *CurrencyAmount) GetTotalRevenue() int64 {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return 0
+}
+
+func (x *CurrencyAmount) GetTotalDiscount() int64 {
+	if x != nil {
+		return x.TotalDiscount
+	}
+	return 0
+}
+
+// CouponUsage 优惠券使用记录
+type CouponUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CouponUsageId  string                 `protobuf:"bytes,1,opt,name=couponUsageId,proto3" json:"couponUsageId,omitempty"`
+	CouponCode     string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	AppId          string                 `protobuf:"bytes,3,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID
+	UserId         string                 `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+	PaymentOrderId string                 `protobuf:"bytes,5,opt,name=paymentOrderId,proto3" json:"paymentOrderId,omitempty"` // 支付订单ID（payment-service的业务订单号orderId）
+	PaymentId      string                 `protobuf:"bytes,6,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	OriginalAmount int64                  `protobuf:"varint,7,opt,name=originalAmount,proto3" json:"originalAmount,omitempty"` // 原价(分)
+	DiscountAmount int64                  `protobuf:"varint,8,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"` // 折扣金额(分)
+	FinalAmount    int64                  `protobuf:"varint,9,opt,name=finalAmount,proto3" json:"finalAmount,omitempty"`       // 实付金额(分)
+	UsedAt         int64                  `protobuf:"varint,10,opt,name=usedAt,proto3" json:"usedAt,omitempty"`                // 使用时间(timestamp)
+	Currency       string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`             // 货币单位（使用时的快照）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CouponUsage) Reset() {
+	*x = CouponUsage{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponUsage) ProtoMessage() {}
+
+func (x *CouponUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponUsage.ProtoReflect.Descriptor instead.
+func (*CouponUsage) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CouponUsage) GetCouponUsageId() string {
+	if x != nil {
+		return x.CouponUsageId
+	}
+	return ""
+}
+
+func (x *CouponUsage) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CouponUsage) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *CouponUsage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CouponUsage) GetPaymentOrderId() string {
+	if x != nil {
+		return x.PaymentOrderId
+	}
+	return ""
+}
+
+func (x *CouponUsage) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CouponUsage) GetOriginalAmount() int64 {
+	if x != nil {
+		return x.OriginalAmount
+	}
+	return 0
+}
+
+func (x *CouponUsage) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *CouponUsage) GetFinalAmount() int64 {
+	if x != nil {
+		return x.FinalAmount
+	}
+	return 0
+}
+
+func (x *CouponUsage) GetUsedAt() int64 {
+	if x != nil {
+		return x.UsedAt
+	}
+	return 0
+}
+
+func (x *CouponUsage) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// ListCouponUsagesRequest 列出优惠券使用记录请求
+type ListCouponUsagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponUsagesRequest) Reset() {
+	*x = ListCouponUsagesRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponUsagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponUsagesRequest) ProtoMessage() {}
+
+func (x *ListCouponUsagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponUsagesRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponUsagesRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListCouponUsagesRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *ListCouponUsagesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponUsagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListCouponUsagesReply 列出优惠券使用记录响应
+type ListCouponUsagesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usages        []*CouponUsage         `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCouponUsagesReply) Reset() {
+	*x = ListCouponUsagesReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCouponUsagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponUsagesReply) ProtoMessage() {}
+
+func (x *ListCouponUsagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponUsagesReply.ProtoReflect.Descriptor instead.
+func (*ListCouponUsagesReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListCouponUsagesReply) GetUsages() []*CouponUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+func (x *ListCouponUsagesReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCouponUsagesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponUsagesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// GetCouponUsageTimeSeriesRequest 获取优惠券使用时间序列统计请求
+type GetCouponUsageTimeSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`              // 起始时间(timestamp，含)
+	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`                  // 结束时间(timestamp，不含)
+	Granularity   string                 `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"` // 时间粒度
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`       // IANA 时区，如 Asia/Shanghai，默认 UTC
+	CouponCode    string                 `protobuf:"bytes,5,opt,name=couponCode,proto3" json:"couponCode,omitempty"`   // 优惠码（可选，不传则统计整个应用）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponUsageTimeSeriesRequest) Reset() {
+	*x = GetCouponUsageTimeSeriesRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponUsageTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponUsageTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetCouponUsageTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponUsageTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponUsageTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetCouponUsageTimeSeriesRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetCouponUsageTimeSeriesRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetCouponUsageTimeSeriesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetCouponUsageTimeSeriesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetCouponUsageTimeSeriesRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+// CouponUsageTimeSeriesPoint 使用时间序列数据点
+type CouponUsageTimeSeriesPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart    int64                  `protobuf:"varint,1,opt,name=bucketStart,proto3" json:"bucketStart,omitempty"`       // 时间桶起始时间(timestamp)
+	Uses           int32                  `protobuf:"varint,2,opt,name=uses,proto3" json:"uses,omitempty"`                     // 使用次数
+	DistinctOrders int32                  `protobuf:"varint,3,opt,name=distinctOrders,proto3" json:"distinctOrders,omitempty"` // 去重订单数
+	DistinctUsers  int32                  `protobuf:"varint,4,opt,name=distinctUsers,proto3" json:"distinctUsers,omitempty"`   // 去重用户数
+	Revenue        int64                  `protobuf:"varint,5,opt,name=revenue,proto3" json:"revenue,omitempty"`               // 产生收入(分)
+	Discount       int64                  `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"`             // 折扣金额(分)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CouponUsageTimeSeriesPoint) Reset() {
+	*x = CouponUsageTimeSeriesPoint{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponUsageTimeSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponUsageTimeSeriesPoint) ProtoMessage() {}
+
+func (x *CouponUsageTimeSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponUsageTimeSeriesPoint.ProtoReflect.Descriptor instead.
+func (*CouponUsageTimeSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *CouponUsageTimeSeriesPoint) GetBucketStart() int64 {
+	if x != nil {
+		return x.BucketStart
+	}
+	return 0
+}
+
+func (x *CouponUsageTimeSeriesPoint) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *CouponUsageTimeSeriesPoint) GetDistinctOrders() int32 {
+	if x != nil {
+		return x.DistinctOrders
+	}
+	return 0
+}
+
+func (x *CouponUsageTimeSeriesPoint) GetDistinctUsers() int32 {
+	if x != nil {
+		return x.DistinctUsers
+	}
+	return 0
+}
+
+func (x *CouponUsageTimeSeriesPoint) GetRevenue() int64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *CouponUsageTimeSeriesPoint) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// GetCouponUsageTimeSeriesReply 获取优惠券使用时间序列统计响应
+type GetCouponUsageTimeSeriesReply struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Granularity   string                        `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Timezone      string                        `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Points        []*CouponUsageTimeSeriesPoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"` // 按时间升序，无数据的时间桶补零
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponUsageTimeSeriesReply) Reset() {
+	*x = GetCouponUsageTimeSeriesReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponUsageTimeSeriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponUsageTimeSeriesReply) ProtoMessage() {}
+
+func (x *GetCouponUsageTimeSeriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponUsageTimeSeriesReply.ProtoReflect.Descriptor instead.
+func (*GetCouponUsageTimeSeriesReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetCouponUsageTimeSeriesReply) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetCouponUsageTimeSeriesReply) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetCouponUsageTimeSeriesReply) GetPoints() []*CouponUsageTimeSeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// ListUsagesByUserRequest 按用户列出优惠券使用记录请求
+type ListUsagesByUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UsedFrom      int64                  `protobuf:"varint,2,opt,name=usedFrom,proto3" json:"usedFrom,omitempty"` // 使用时间起始(timestamp)
+	UsedTo        int64                  `protobuf:"varint,3,opt,name=usedTo,proto3" json:"usedTo,omitempty"`     // 使用时间结束(timestamp)
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsagesByUserRequest) Reset() {
+	*x = ListUsagesByUserRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsagesByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsagesByUserRequest) ProtoMessage() {}
+
+func (x *ListUsagesByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsagesByUserRequest.ProtoReflect.Descriptor instead.
+func (*ListUsagesByUserRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListUsagesByUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUsagesByUserRequest) GetUsedFrom() int64 {
+	if x != nil {
+		return x.UsedFrom
+	}
+	return 0
+}
+
+func (x *ListUsagesByUserRequest) GetUsedTo() int64 {
+	if x != nil {
+		return x.UsedTo
+	}
+	return 0
+}
+
+func (x *ListUsagesByUserRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsagesByUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// GetUsageByPaymentOrderRequest 按支付订单查询优惠券使用记录请求
+type GetUsageByPaymentOrderRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentOrderId string                 `protobuf:"bytes,1,opt,name=paymentOrderId,proto3" json:"paymentOrderId,omitempty"` // 支付订单ID（payment-service的业务订单号orderId）
+	UsedFrom       int64                  `protobuf:"varint,2,opt,name=usedFrom,proto3" json:"usedFrom,omitempty"`            // 使用时间起始(timestamp)
+	UsedTo         int64                  `protobuf:"varint,3,opt,name=usedTo,proto3" json:"usedTo,omitempty"`                // 使用时间结束(timestamp)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsageByPaymentOrderRequest) Reset() {
+	*x = GetUsageByPaymentOrderRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageByPaymentOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageByPaymentOrderRequest) ProtoMessage() {}
+
+func (x *GetUsageByPaymentOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageByPaymentOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageByPaymentOrderRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetUsageByPaymentOrderRequest) GetPaymentOrderId() string {
+	if x != nil {
+		return x.PaymentOrderId
+	}
+	return ""
+}
+
+func (x *GetUsageByPaymentOrderRequest) GetUsedFrom() int64 {
+	if x != nil {
+		return x.UsedFrom
+	}
+	return 0
+}
+
+func (x *GetUsageByPaymentOrderRequest) GetUsedTo() int64 {
+	if x != nil {
+		return x.UsedTo
+	}
+	return 0
+}
+
+// GetUsageByPaymentIdRequest 按支付流水号查询优惠券使用记录请求
+type GetUsageByPaymentIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	UsedFrom      int64                  `protobuf:"varint,2,opt,name=usedFrom,proto3" json:"usedFrom,omitempty"` // 使用时间起始(timestamp)
+	UsedTo        int64                  `protobuf:"varint,3,opt,name=usedTo,proto3" json:"usedTo,omitempty"`     // 使用时间结束(timestamp)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageByPaymentIdRequest) Reset() {
+	*x = GetUsageByPaymentIdRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageByPaymentIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageByPaymentIdRequest) ProtoMessage() {}
 
-func (x *UseCouponRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[12]
+func (x *GetUsageByPaymentIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,91 +9885,169 @@ func (x *UseCouponRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseCouponRequest.ProtoReflect.Descriptor instead.
-func (*UseCouponRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetUsageByPaymentIdRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageByPaymentIdRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *UseCouponRequest) GetCouponCode() string {
+func (x *GetUsageByPaymentIdRequest) GetPaymentId() string {
 	if x != nil {
-		return x.CouponCode
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *UseCouponRequest) GetAppId() string {
+func (x *GetUsageByPaymentIdRequest) GetUsedFrom() int64 {
 	if x != nil {
-		return x.AppId
+		return x.UsedFrom
 	}
-	return ""
+	return 0
 }
 
-func (x *UseCouponRequest) GetUserId() string {
+func (x *GetUsageByPaymentIdRequest) GetUsedTo() int64 {
 	if x != nil {
-		return x.UserId
+		return x.UsedTo
 	}
-	return ""
+	return 0
 }
 
-func (x *UseCouponRequest) GetPaymentOrderId() string {
+// GetCouponUsageReply 获取优惠券使用记录响应
+type GetCouponUsageReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usage         *CouponUsage           `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponUsageReply) Reset() {
+	*x = GetCouponUsageReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponUsageReply) ProtoMessage() {}
+
+func (x *GetCouponUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[129]
 	if x != nil {
-		return x.PaymentOrderId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UseCouponRequest) GetPaymentId() string {
+// Deprecated: Use GetCouponUsageReply.ProtoReflect.Descriptor instead.
+func (*GetCouponUsageReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetCouponUsageReply) GetUsage() *CouponUsage {
 	if x != nil {
-		return x.PaymentId
+		return x.Usage
+	}
+	return nil
+}
+
+// RebuildCouponStatsRequest 重算每日统计汇总请求
+type RebuildCouponStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`           // 应用ID（由中间件从 Header 提取）
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"` // 优惠码（可选，不传则重算整个应用）
+	From          int64                  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`            // 起始时间(timestamp，可选)，按数据库连接时区的自然日对齐
+	To            int64                  `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`                // 结束时间(timestamp，可选)，按数据库连接时区的自然日对齐
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildCouponStatsRequest) Reset() {
+	*x = RebuildCouponStatsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildCouponStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildCouponStatsRequest) ProtoMessage() {}
+
+func (x *RebuildCouponStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildCouponStatsRequest.ProtoReflect.Descriptor instead.
+func (*RebuildCouponStatsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *RebuildCouponStatsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *UseCouponRequest) GetOriginalAmount() int64 {
+func (x *RebuildCouponStatsRequest) GetCouponCode() string {
 	if x != nil {
-		return x.OriginalAmount
+		return x.CouponCode
 	}
-	return 0
+	return ""
 }
 
-func (x *UseCouponRequest) GetDiscountAmount() int64 {
+func (x *RebuildCouponStatsRequest) GetFrom() int64 {
 	if x != nil {
-		return x.DiscountAmount
+		return x.From
 	}
 	return 0
 }
 
-func (x *UseCouponRequest) GetFinalAmount() int64 {
+func (x *RebuildCouponStatsRequest) GetTo() int64 {
 	if x != nil {
-		return x.FinalAmount
+		return x.To
 	}
 	return 0
 }
 
-// UseCouponReply 使用优惠券响应
-type UseCouponReply struct {
+// RebuildCouponStatsReply 重算每日统计汇总响应
+type RebuildCouponStatsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rows          int32                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"` // 写入的每日汇总行数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UseCouponReply) Reset() {
-	*x = UseCouponReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[13]
+func (x *RebuildCouponStatsReply) Reset() {
+	*x = RebuildCouponStatsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UseCouponReply) String() string {
+func (x *RebuildCouponStatsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseCouponReply) ProtoMessage() {}
+func (*RebuildCouponStatsReply) ProtoMessage() {}
 
-func (x *UseCouponReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[13]
+func (x *RebuildCouponStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,48 +10058,46 @@ func (x *UseCouponReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseCouponReply.ProtoReflect.Descriptor instead.
-func (*UseCouponReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *UseCouponReply) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use RebuildCouponStatsReply.ProtoReflect.Descriptor instead.
+func (*RebuildCouponStatsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *UseCouponReply) GetMessage() string {
+func (x *RebuildCouponStatsReply) GetRows() int32 {
 	if x != nil {
-		return x.Message
+		return x.Rows
 	}
-	return ""
+	return 0
 }
 
-// GetCouponStatsRequest 获取优惠券统计请求
-type GetCouponStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetCouponsSummaryStatsRequest 获取所有优惠券汇总统计请求
+type GetCouponsSummaryStatsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AppId            string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`                        // 应用ID（查询参数，必填）
+	TopN             int32                  `protobuf:"varint,2,opt,name=topN,proto3" json:"topN,omitempty"`                         // 返回前N个优惠券，默认 10
+	RankBy           string                 `protobuf:"bytes,3,opt,name=rankBy,proto3" json:"rankBy,omitempty"`                      // 排序依据，默认 uses
+	From             int64                  `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`                         // 统计窗口起始(timestamp)，作用于使用时间和验证时间
+	To               int64                  `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`                             // 统计窗口结束(timestamp)
+	ExactUniqueUsers bool                   `protobuf:"varint,6,opt,name=exactUniqueUsers,proto3" json:"exactUniqueUsers,omitempty"` // 去重用户数使用精确查询（默认 HyperLogLog 近似值）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetCouponStatsRequest) Reset() {
-	*x = GetCouponStatsRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[14]
+func (x *GetCouponsSummaryStatsRequest) Reset() {
+	*x = GetCouponsSummaryStatsRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCouponStatsRequest) String() string {
+func (x *GetCouponsSummaryStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCouponStatsRequest) ProtoMessage() {}
+func (*GetCouponsSummaryStatsRequest) ProtoMessage() {}
 
-func (x *GetCouponStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[14]
+func (x *GetCouponsSummaryStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,46 +10108,91 @@ func (x *GetCouponStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCouponStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetCouponStatsRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetCouponsSummaryStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponsSummaryStatsRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *GetCouponStatsRequest) GetCouponCode() string {
+func (x *GetCouponsSummaryStatsRequest) GetAppId() string {
 	if x != nil {
-		return x.CouponCode
+		return x.AppId
 	}
 	return ""
 }
 
-// GetCouponStatsReply 获取优惠券统计响应
-type GetCouponStatsReply struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode     string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	TotalUses      int32                  `protobuf:"varint,2,opt,name=totalUses,proto3" json:"totalUses,omitempty"`            // 使用次数
-	TotalOrders    int32                  `protobuf:"varint,3,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`        // 订单数
-	TotalRevenue   int64                  `protobuf:"varint,4,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`      // 产生收入(分)
-	TotalDiscount  int64                  `protobuf:"varint,5,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"`    // 折扣金额(分)
-	ConversionRate float32                `protobuf:"fixed32,6,opt,name=conversionRate,proto3" json:"conversionRate,omitempty"` // 转化率
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCouponsSummaryStatsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
 }
 
-func (x *GetCouponStatsReply) Reset() {
-	*x = GetCouponStatsReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[15]
+func (x *GetCouponsSummaryStatsRequest) GetRankBy() string {
+	if x != nil {
+		return x.RankBy
+	}
+	return ""
+}
+
+func (x *GetCouponsSummaryStatsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsRequest) GetExactUniqueUsers() bool {
+	if x != nil {
+		return x.ExactUniqueUsers
+	}
+	return false
+}
+
+// GetCouponsSummaryStatsReply 获取所有优惠券汇总统计响应
+type GetCouponsSummaryStatsReply struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	TotalCoupons            int32                  `protobuf:"varint,1,opt,name=totalCoupons,proto3" json:"totalCoupons,omitempty"`                       // 优惠券总数
+	ActiveCoupons           int32                  `protobuf:"varint,2,opt,name=activeCoupons,proto3" json:"activeCoupons,omitempty"`                     // 激活的优惠券数
+	TotalUses               int32                  `protobuf:"varint,3,opt,name=totalUses,proto3" json:"totalUses,omitempty"`                             // 总使用次数
+	TotalOrders             int32                  `protobuf:"varint,4,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`                         // 总订单数
+	TotalRevenue            int64                  `protobuf:"varint,5,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`                       // 总收入(分)（已废弃：跨币种相加，请使用 amountsByCurrency）
+	TotalDiscount           int64                  `protobuf:"varint,6,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"`                     // 总折扣金额(分)（已废弃：跨币种相加，请使用 amountsByCurrency）
+	AverageConversionRate   float32                `protobuf:"fixed32,7,opt,name=averageConversionRate,proto3" json:"averageConversionRate,omitempty"`    // 平均转化率(%)：有验证记录的前N个优惠券转化率的平均值
+	TopCoupons              []*CouponStats         `protobuf:"bytes,8,rep,name=topCoupons,proto3" json:"topCoupons,omitempty"`                            // 前N个优惠券的详细统计（按 rankBy 排序）
+	TotalValidationAttempts int32                  `protobuf:"varint,9,opt,name=totalValidationAttempts,proto3" json:"totalValidationAttempts,omitempty"` // 总验证次数
+	TotalValidAttempts      int32                  `protobuf:"varint,10,opt,name=totalValidAttempts,proto3" json:"totalValidAttempts,omitempty"`          // 总验证通过次数
+	ConversionRate          float32                `protobuf:"fixed32,11,opt,name=conversionRate,proto3" json:"conversionRate,omitempty"`                 // 应用整体转化率(%)：总使用次数 / 总验证次数
+	ValidRate               float32                `protobuf:"fixed32,12,opt,name=validRate,proto3" json:"validRate,omitempty"`                           // 应用整体验证通过率(%)
+	RedemptionRate          float32                `protobuf:"fixed32,13,opt,name=redemptionRate,proto3" json:"redemptionRate,omitempty"`                 // 应用整体核销率(%)：总使用次数 / 总验证通过次数
+	AmountsByCurrency       []*CurrencyAmount      `protobuf:"bytes,14,rep,name=amountsByCurrency,proto3" json:"amountsByCurrency,omitempty"`             // 按币种拆分的总收入与总折扣
+	UniqueUsers             int64                  `protobuf:"varint,15,opt,name=uniqueUsers,proto3" json:"uniqueUsers,omitempty"`                        // 去重用户数（统计窗口内）
+	UniqueUsersExact        bool                   `protobuf:"varint,16,opt,name=uniqueUsersExact,proto3" json:"uniqueUsersExact,omitempty"`              // uniqueUsers 是否为精确值（false 表示 HyperLogLog 近似值，误差约 0.81%）
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetCouponsSummaryStatsReply) Reset() {
+	*x = GetCouponsSummaryStatsReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCouponStatsReply) String() string {
+func (x *GetCouponsSummaryStatsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCouponStatsReply) ProtoMessage() {}
+func (*GetCouponsSummaryStatsReply) ProtoMessage() {}
 
-func (x *GetCouponStatsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[15]
+func (x *GetCouponsSummaryStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,88 +10200,161 @@ func (x *GetCouponStatsReply) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponsSummaryStatsReply.ProtoReflect.Descriptor instead.
+func (*GetCouponsSummaryStatsReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetCouponsSummaryStatsReply) GetTotalCoupons() int32 {
+	if x != nil {
+		return x.TotalCoupons
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsReply) GetActiveCoupons() int32 {
+	if x != nil {
+		return x.ActiveCoupons
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsReply) GetTotalUses() int32 {
+	if x != nil {
+		return x.TotalUses
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsReply) GetTotalOrders() int32 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsReply) GetTotalRevenue() int64 {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsReply) GetTotalDiscount() int64 {
+	if x != nil {
+		return x.TotalDiscount
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsReply) GetAverageConversionRate() float32 {
+	if x != nil {
+		return x.AverageConversionRate
+	}
+	return 0
+}
+
+func (x *GetCouponsSummaryStatsReply) GetTopCoupons() []*CouponStats {
+	if x != nil {
+		return x.TopCoupons
+	}
+	return nil
 }
 
-// Deprecated: Use GetCouponStatsReply.ProtoReflect.Descriptor instead.
-func (*GetCouponStatsReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{15}
+func (x *GetCouponsSummaryStatsReply) GetTotalValidationAttempts() int32 {
+	if x != nil {
+		return x.TotalValidationAttempts
+	}
+	return 0
 }
 
-func (x *GetCouponStatsReply) GetCouponCode() string {
+func (x *GetCouponsSummaryStatsReply) GetTotalValidAttempts() int32 {
 	if x != nil {
-		return x.CouponCode
+		return x.TotalValidAttempts
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCouponStatsReply) GetTotalUses() int32 {
+func (x *GetCouponsSummaryStatsReply) GetConversionRate() float32 {
 	if x != nil {
-		return x.TotalUses
+		return x.ConversionRate
 	}
 	return 0
 }
 
-func (x *GetCouponStatsReply) GetTotalOrders() int32 {
+func (x *GetCouponsSummaryStatsReply) GetValidRate() float32 {
 	if x != nil {
-		return x.TotalOrders
+		return x.ValidRate
 	}
 	return 0
 }
 
-func (x *GetCouponStatsReply) GetTotalRevenue() int64 {
+func (x *GetCouponsSummaryStatsReply) GetRedemptionRate() float32 {
 	if x != nil {
-		return x.TotalRevenue
+		return x.RedemptionRate
 	}
 	return 0
 }
 
-func (x *GetCouponStatsReply) GetTotalDiscount() int64 {
+func (x *GetCouponsSummaryStatsReply) GetAmountsByCurrency() []*CurrencyAmount {
 	if x != nil {
-		return x.TotalDiscount
+		return x.AmountsByCurrency
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCouponStatsReply) GetConversionRate() float32 {
+func (x *GetCouponsSummaryStatsReply) GetUniqueUsers() int64 {
 	if x != nil {
-		return x.ConversionRate
+		return x.UniqueUsers
 	}
 	return 0
 }
 
-// CouponUsage 优惠券使用记录
-type CouponUsage struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CouponUsageId  string                 `protobuf:"bytes,1,opt,name=couponUsageId,proto3" json:"couponUsageId,omitempty"`
-	CouponCode     string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	AppId          string                 `protobuf:"bytes,3,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID
-	UserId         string                 `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
-	PaymentOrderId string                 `protobuf:"bytes,5,opt,name=paymentOrderId,proto3" json:"paymentOrderId,omitempty"` // 支付订单ID（payment-service的业务订单号orderId）
-	PaymentId      string                 `protobuf:"bytes,6,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	OriginalAmount int64                  `protobuf:"varint,7,opt,name=originalAmount,proto3" json:"originalAmount,omitempty"` // 原价(分)
-	DiscountAmount int64                  `protobuf:"varint,8,opt,name=discountAmount,proto3" json:"discountAmount,omitempty"` // 折扣金额(分)
-	FinalAmount    int64                  `protobuf:"varint,9,opt,name=finalAmount,proto3" json:"finalAmount,omitempty"`       // 实付金额(分)
-	UsedAt         int64                  `protobuf:"varint,10,opt,name=usedAt,proto3" json:"usedAt,omitempty"`                // 使用时间(timestamp)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCouponsSummaryStatsReply) GetUniqueUsersExact() bool {
+	if x != nil {
+		return x.UniqueUsersExact
+	}
+	return false
 }
 
-func (x *CouponUsage) Reset() {
-	*x = CouponUsage{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[16]
+// CouponStats 优惠券统计（用于汇总统计响应）
+type CouponStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode         string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	TotalUses          int32                  `protobuf:"varint,2,opt,name=totalUses,proto3" json:"totalUses,omitempty"`
+	TotalOrders        int32                  `protobuf:"varint,3,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`
+	TotalRevenue       int64                  `protobuf:"varint,4,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`
+	TotalDiscount      int64                  `protobuf:"varint,5,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"`
+	ConversionRate     float32                `protobuf:"fixed32,6,opt,name=conversionRate,proto3" json:"conversionRate,omitempty"` // 转化率(%)：使用次数 / 验证次数
+	ValidationAttempts int32                  `protobuf:"varint,7,opt,name=validationAttempts,proto3" json:"validationAttempts,omitempty"`
+	ValidAttempts      int32                  `protobuf:"varint,8,opt,name=validAttempts,proto3" json:"validAttempts,omitempty"`
+	ValidRate          float32                `protobuf:"fixed32,9,opt,name=validRate,proto3" json:"validRate,omitempty"`
+	RedemptionRate     float32                `protobuf:"fixed32,10,opt,name=redemptionRate,proto3" json:"redemptionRate,omitempty"`
+	QuotaUtilization   float32                `protobuf:"fixed32,11,opt,name=quotaUtilization,proto3" json:"quotaUtilization,omitempty"` // 配额使用率(%)：使用次数 / 最大使用次数
+	AmountsByCurrency  []*CurrencyAmount      `protobuf:"bytes,12,rep,name=amountsByCurrency,proto3" json:"amountsByCurrency,omitempty"` // 按币种拆分的收入与折扣
+	DistinctUsers      int32                  `protobuf:"varint,13,opt,name=distinctUsers,proto3" json:"distinctUsers,omitempty"`        // 去重用户数
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CouponStats) Reset() {
+	*x = CouponStats{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CouponUsage) String() string {
+func (x *CouponStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CouponUsage) ProtoMessage() {}
+func (*CouponStats) ProtoMessage() {}
 
-func (x *CouponUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[16]
+func (x *CouponStats) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,106 +10365,130 @@ func (x *CouponUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CouponUsage.ProtoReflect.Descriptor instead.
-func (*CouponUsage) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CouponStats.ProtoReflect.Descriptor instead.
+func (*CouponStats) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *CouponUsage) GetCouponUsageId() string {
+func (x *CouponStats) GetCouponCode() string {
 	if x != nil {
-		return x.CouponUsageId
+		return x.CouponCode
 	}
 	return ""
 }
 
-func (x *CouponUsage) GetCouponCode() string {
+func (x *CouponStats) GetTotalUses() int32 {
 	if x != nil {
-		return x.CouponCode
+		return x.TotalUses
 	}
-	return ""
+	return 0
 }
 
-func (x *CouponUsage) GetAppId() string {
+func (x *CouponStats) GetTotalOrders() int32 {
 	if x != nil {
-		return x.AppId
+		return x.TotalOrders
 	}
-	return ""
+	return 0
 }
 
-func (x *CouponUsage) GetUserId() string {
+func (x *CouponStats) GetTotalRevenue() int64 {
 	if x != nil {
-		return x.UserId
+		return x.TotalRevenue
 	}
-	return ""
+	return 0
 }
 
-func (x *CouponUsage) GetPaymentOrderId() string {
+func (x *CouponStats) GetTotalDiscount() int64 {
 	if x != nil {
-		return x.PaymentOrderId
+		return x.TotalDiscount
 	}
-	return ""
+	return 0
 }
 
-func (x *CouponUsage) GetPaymentId() string {
+func (x *CouponStats) GetConversionRate() float32 {
 	if x != nil {
-		return x.PaymentId
+		return x.ConversionRate
 	}
-	return ""
+	return 0
 }
 
-func (x *CouponUsage) GetOriginalAmount() int64 {
+func (x *CouponStats) GetValidationAttempts() int32 {
 	if x != nil {
-		return x.OriginalAmount
+		return x.ValidationAttempts
 	}
 	return 0
 }
 
-func (x *CouponUsage) GetDiscountAmount() int64 {
+func (x *CouponStats) GetValidAttempts() int32 {
 	if x != nil {
-		return x.DiscountAmount
+		return x.ValidAttempts
 	}
 	return 0
 }
 
-func (x *CouponUsage) GetFinalAmount() int64 {
+func (x *CouponStats) GetValidRate() float32 {
 	if x != nil {
-		return x.FinalAmount
+		return x.ValidRate
 	}
 	return 0
 }
 
-func (x *CouponUsage) GetUsedAt() int64 {
+func (x *CouponStats) GetRedemptionRate() float32 {
 	if x != nil {
-		return x.UsedAt
+		return x.RedemptionRate
 	}
 	return 0
 }
 
-// ListCouponUsagesRequest 列出优惠券使用记录请求
-type ListCouponUsagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode    string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CouponStats) GetQuotaUtilization() float32 {
+	if x != nil {
+		return x.QuotaUtilization
+	}
+	return 0
 }
 
-func (x *ListCouponUsagesRequest) Reset() {
-	*x = ListCouponUsagesRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[17]
+func (x *CouponStats) GetAmountsByCurrency() []*CurrencyAmount {
+	if x != nil {
+		return x.AmountsByCurrency
+	}
+	return nil
+}
+
+func (x *CouponStats) GetDistinctUsers() int32 {
+	if x != nil {
+		return x.DistinctUsers
+	}
+	return 0
+}
+
+// ExportCouponUsagesFilter 使用记录导出筛选条件（流式导出时作为查询参数）
+type ExportCouponUsagesFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode     string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`         // 优惠码
+	UserId         string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`                 // 用户ID
+	PaymentOrderId string                 `protobuf:"bytes,3,opt,name=paymentOrderId,proto3" json:"paymentOrderId,omitempty"` // 支付订单ID
+	PaymentId      string                 `protobuf:"bytes,4,opt,name=paymentId,proto3" json:"paymentId,omitempty"`           // 支付流水号
+	UsedFrom       int64                  `protobuf:"varint,5,opt,name=usedFrom,proto3" json:"usedFrom,omitempty"`            // 使用时间起始(timestamp)
+	UsedTo         int64                  `protobuf:"varint,6,opt,name=usedTo,proto3" json:"usedTo,omitempty"`                // 使用时间结束(timestamp)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportCouponUsagesFilter) Reset() {
+	*x = ExportCouponUsagesFilter{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCouponUsagesRequest) String() string {
+func (x *ExportCouponUsagesFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCouponUsagesRequest) ProtoMessage() {}
+func (*ExportCouponUsagesFilter) ProtoMessage() {}
 
-func (x *ListCouponUsagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[17]
+func (x *ExportCouponUsagesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,58 +10499,85 @@ func (x *ListCouponUsagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCouponUsagesRequest.ProtoReflect.Descriptor instead.
-func (*ListCouponUsagesRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExportCouponUsagesFilter.ProtoReflect.Descriptor instead.
+func (*ExportCouponUsagesFilter) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *ListCouponUsagesRequest) GetCouponCode() string {
+func (x *ExportCouponUsagesFilter) GetCouponCode() string {
 	if x != nil {
 		return x.CouponCode
 	}
 	return ""
 }
 
-func (x *ListCouponUsagesRequest) GetPage() int32 {
+func (x *ExportCouponUsagesFilter) GetUserId() string {
 	if x != nil {
-		return x.Page
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportCouponUsagesFilter) GetPaymentOrderId() string {
+	if x != nil {
+		return x.PaymentOrderId
+	}
+	return ""
+}
+
+func (x *ExportCouponUsagesFilter) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ExportCouponUsagesFilter) GetUsedFrom() int64 {
+	if x != nil {
+		return x.UsedFrom
 	}
 	return 0
 }
 
-func (x *ListCouponUsagesRequest) GetPageSize() int32 {
+func (x *ExportCouponUsagesFilter) GetUsedTo() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.UsedTo
 	}
 	return 0
 }
 
-// ListCouponUsagesReply 列出优惠券使用记录响应
-type ListCouponUsagesReply struct {
+// ExportJob 后台导出任务
+type ExportJob struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Usages        []*CouponUsage         `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Resource      string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`        // 导出数据类型: coupons/coupon_usages
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`            // 导出格式: csv/xlsx
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`            // 任务状态: pending/running/succeeded/failed
+	Rows          int64                  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`               // 导出行数
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`              // 失败原因
+	FileName      string                 `protobuf:"bytes,7,opt,name=fileName,proto3" json:"fileName,omitempty"`        // 下载文件名
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`     // 创建时间(timestamp)
+	FinishedAt    int64                  `protobuf:"varint,9,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`   // 完成时间(timestamp)
+	DownloadUrl   string                 `protobuf:"bytes,10,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"` // 下载地址（仅 succeeded 时返回）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCouponUsagesReply) Reset() {
-	*x = ListCouponUsagesReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[18]
+func (x *ExportJob) Reset() {
+	*x = ExportJob{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCouponUsagesReply) String() string {
+func (x *ExportJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCouponUsagesReply) ProtoMessage() {}
+func (*ExportJob) ProtoMessage() {}
 
-func (x *ListCouponUsagesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[18]
+func (x *ExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,114 +10588,108 @@ func (x *ListCouponUsagesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCouponUsagesReply.ProtoReflect.Descriptor instead.
-func (*ListCouponUsagesReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ExportJob.ProtoReflect.Descriptor instead.
+func (*ExportJob) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *ListCouponUsagesReply) GetUsages() []*CouponUsage {
+func (x *ExportJob) GetJobId() string {
 	if x != nil {
-		return x.Usages
+		return x.JobId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCouponUsagesReply) GetTotal() int32 {
+func (x *ExportJob) GetResource() string {
 	if x != nil {
-		return x.Total
+		return x.Resource
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCouponUsagesReply) GetPage() int32 {
+func (x *ExportJob) GetFormat() string {
 	if x != nil {
-		return x.Page
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCouponUsagesReply) GetPageSize() int32 {
+func (x *ExportJob) GetStatus() string {
 	if x != nil {
-		return x.PageSize
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-// GetCouponsSummaryStatsRequest 获取所有优惠券汇总统计请求
-type GetCouponsSummaryStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"` // 应用ID（查询参数，必填）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ExportJob) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
 }
 
-func (x *GetCouponsSummaryStatsRequest) Reset() {
-	*x = GetCouponsSummaryStatsRequest{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ExportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *GetCouponsSummaryStatsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExportJob) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
 }
 
-func (*GetCouponsSummaryStatsRequest) ProtoMessage() {}
-
-func (x *GetCouponsSummaryStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[19]
+func (x *ExportJob) GetCreatedAt() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCouponsSummaryStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetCouponsSummaryStatsRequest) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{19}
+func (x *ExportJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
 }
 
-func (x *GetCouponsSummaryStatsRequest) GetAppId() string {
+func (x *ExportJob) GetDownloadUrl() string {
 	if x != nil {
-		return x.AppId
+		return x.DownloadUrl
 	}
 	return ""
 }
 
-// GetCouponsSummaryStatsReply 获取所有优惠券汇总统计响应
-type GetCouponsSummaryStatsReply struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	TotalCoupons          int32                  `protobuf:"varint,1,opt,name=totalCoupons,proto3" json:"totalCoupons,omitempty"`                    // 优惠券总数
-	ActiveCoupons         int32                  `protobuf:"varint,2,opt,name=activeCoupons,proto3" json:"activeCoupons,omitempty"`                  // 激活的优惠券数
-	TotalUses             int32                  `protobuf:"varint,3,opt,name=totalUses,proto3" json:"totalUses,omitempty"`                          // 总使用次数
-	TotalOrders           int32                  `protobuf:"varint,4,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`                      // 总订单数
-	TotalRevenue          int64                  `protobuf:"varint,5,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`                    // 总收入(分)
-	TotalDiscount         int64                  `protobuf:"varint,6,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"`                  // 总折扣金额(分)
-	AverageConversionRate float32                `protobuf:"fixed32,7,opt,name=averageConversionRate,proto3" json:"averageConversionRate,omitempty"` // 平均转化率
-	TopCoupons            []*CouponStats         `protobuf:"bytes,8,rep,name=topCoupons,proto3" json:"topCoupons,omitempty"`                         // 前N个优惠券的详细统计（按使用次数排序）
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// CreateExportJobRequest 创建后台导出任务请求
+type CreateExportJobRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	AppId         string                    `protobuf:"bytes,1,opt,name=appId,proto3" json:"appId,omitempty"`               // 应用ID（由中间件从 Header 提取）
+	Resource      string                    `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`         // 导出数据类型
+	Format        string                    `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`             // 导出格式
+	CouponFilter  *ListCouponsRequest       `protobuf:"bytes,4,opt,name=couponFilter,proto3" json:"couponFilter,omitempty"` // resource=coupons 时的筛选条件（忽略分页参数）
+	UsageFilter   *ExportCouponUsagesFilter `protobuf:"bytes,5,opt,name=usageFilter,proto3" json:"usageFilter,omitempty"`   // resource=coupon_usages 时的筛选条件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCouponsSummaryStatsReply) Reset() {
-	*x = GetCouponsSummaryStatsReply{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[20]
+func (x *CreateExportJobRequest) Reset() {
+	*x = CreateExportJobRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCouponsSummaryStatsReply) String() string {
+func (x *CreateExportJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCouponsSummaryStatsReply) ProtoMessage() {}
+func (*CreateExportJobRequest) ProtoMessage() {}
 
-func (x *GetCouponsSummaryStatsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[20]
+func (x *CreateExportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,95 +10700,114 @@ func (x *GetCouponsSummaryStatsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCouponsSummaryStatsReply.ProtoReflect.Descriptor instead.
-func (*GetCouponsSummaryStatsReply) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GetCouponsSummaryStatsReply) GetTotalCoupons() int32 {
-	if x != nil {
-		return x.TotalCoupons
-	}
-	return 0
+// Deprecated: Use CreateExportJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateExportJobRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *GetCouponsSummaryStatsReply) GetActiveCoupons() int32 {
+func (x *CreateExportJobRequest) GetAppId() string {
 	if x != nil {
-		return x.ActiveCoupons
+		return x.AppId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCouponsSummaryStatsReply) GetTotalUses() int32 {
+func (x *CreateExportJobRequest) GetResource() string {
 	if x != nil {
-		return x.TotalUses
+		return x.Resource
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCouponsSummaryStatsReply) GetTotalOrders() int32 {
+func (x *CreateExportJobRequest) GetFormat() string {
 	if x != nil {
-		return x.TotalOrders
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCouponsSummaryStatsReply) GetTotalRevenue() int64 {
+func (x *CreateExportJobRequest) GetCouponFilter() *ListCouponsRequest {
 	if x != nil {
-		return x.TotalRevenue
+		return x.CouponFilter
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCouponsSummaryStatsReply) GetTotalDiscount() int64 {
+func (x *CreateExportJobRequest) GetUsageFilter() *ExportCouponUsagesFilter {
 	if x != nil {
-		return x.TotalDiscount
+		return x.UsageFilter
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCouponsSummaryStatsReply) GetAverageConversionRate() float32 {
+// CreateExportJobReply 创建后台导出任务响应
+type CreateExportJobReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ExportJob             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExportJobReply) Reset() {
+	*x = CreateExportJobReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExportJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportJobReply) ProtoMessage() {}
+
+func (x *CreateExportJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[138]
 	if x != nil {
-		return x.AverageConversionRate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetCouponsSummaryStatsReply) GetTopCoupons() []*CouponStats {
+// Deprecated: Use CreateExportJobReply.ProtoReflect.Descriptor instead.
+func (*CreateExportJobReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *CreateExportJobReply) GetJob() *ExportJob {
 	if x != nil {
-		return x.TopCoupons
+		return x.Job
 	}
 	return nil
 }
 
-// CouponStats 优惠券统计（用于汇总统计响应）
-type CouponStats struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CouponCode     string                 `protobuf:"bytes,1,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
-	TotalUses      int32                  `protobuf:"varint,2,opt,name=totalUses,proto3" json:"totalUses,omitempty"`
-	TotalOrders    int32                  `protobuf:"varint,3,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`
-	TotalRevenue   int64                  `protobuf:"varint,4,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`
-	TotalDiscount  int64                  `protobuf:"varint,5,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"`
-	ConversionRate float32                `protobuf:"fixed32,6,opt,name=conversionRate,proto3" json:"conversionRate,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GetExportJobRequest 获取后台导出任务请求
+type GetExportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CouponStats) Reset() {
-	*x = CouponStats{}
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[21]
+func (x *GetExportJobRequest) Reset() {
+	*x = GetExportJobRequest{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CouponStats) String() string {
+func (x *GetExportJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CouponStats) ProtoMessage() {}
+func (*GetExportJobRequest) ProtoMessage() {}
 
-func (x *CouponStats) ProtoReflect() protoreflect.Message {
-	mi := &file_marketing_service_v1_marketing_proto_msgTypes[21]
+func (x *GetExportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,58 +10818,68 @@ func (x *CouponStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CouponStats.ProtoReflect.Descriptor instead.
-func (*CouponStats) Descriptor() ([]byte, []int) {
-	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetExportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetExportJobRequest) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *CouponStats) GetCouponCode() string {
+func (x *GetExportJobRequest) GetJobId() string {
 	if x != nil {
-		return x.CouponCode
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *CouponStats) GetTotalUses() int32 {
-	if x != nil {
-		return x.TotalUses
-	}
-	return 0
+// GetExportJobReply 获取后台导出任务响应
+type GetExportJobReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ExportJob             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CouponStats) GetTotalOrders() int32 {
-	if x != nil {
-		return x.TotalOrders
-	}
-	return 0
+func (x *GetExportJobReply) Reset() {
+	*x = GetExportJobReply{}
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CouponStats) GetTotalRevenue() int64 {
-	if x != nil {
-		return x.TotalRevenue
-	}
-	return 0
+func (x *GetExportJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CouponStats) GetTotalDiscount() int64 {
+func (*GetExportJobReply) ProtoMessage() {}
+
+func (x *GetExportJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marketing_service_v1_marketing_proto_msgTypes[140]
 	if x != nil {
-		return x.TotalDiscount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CouponStats) GetConversionRate() float32 {
+// Deprecated: Use GetExportJobReply.ProtoReflect.Descriptor instead.
+func (*GetExportJobReply) Descriptor() ([]byte, []int) {
+	return file_marketing_service_v1_marketing_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetExportJobReply) GetJob() *ExportJob {
 	if x != nil {
-		return x.ConversionRate
+		return x.Job
 	}
-	return 0
+	return nil
 }
 
 var File_marketing_service_v1_marketing_proto protoreflect.FileDescriptor
 
 const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\n" +
-	"$marketing_service/v1/marketing.proto\x12\x1dplatform.marketing_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x8c\x03\n" +
+	"$marketing_service/v1/marketing.proto\x12\x1dplatform.marketing_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x94\b\n" +
 	"\x06Coupon\x12\x1e\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tR\n" +
@@ -1653,7 +10898,53 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\x06status\x18\n" +
 	" \x01(\tR\x06status\x12\x1c\n" +
 	"\tcreatedAt\x18\v \x01(\x03R\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\f \x01(\x03R\tupdatedAt\"\xc5\x02\n" +
+	"\tupdatedAt\x18\f \x01(\x03R\tupdatedAt\x12\x1c\n" +
+	"\tvalidDays\x18\x0e \x01(\x05R\tvalidDays\x12\x1e\n" +
+	"\n" +
+	"claimLimit\x18\x0f \x01(\x05R\n" +
+	"claimLimit\x12\x1e\n" +
+	"\n" +
+	"claimStock\x18\x10 \x01(\x05R\n" +
+	"claimStock\x12\"\n" +
+	"\fclaimedCount\x18\x11 \x01(\x05R\fclaimedCount\x12 \n" +
+	"\vboundUserId\x18\x12 \x01(\tR\vboundUserId\x12\x1e\n" +
+	"\n" +
+	"audienceId\x18\x13 \x01(\tR\n" +
+	"audienceId\x12 \n" +
+	"\veligibility\x18\x14 \x01(\tR\veligibility\x12\x1a\n" +
+	"\btimezone\x18\x15 \x01(\tR\btimezone\x12I\n" +
+	"\bschedule\x18\x16 \x01(\v2-.platform.marketing_service.v1.CouponScheduleR\bschedule\x12&\n" +
+	"\x0euserDailyLimit\x18\x17 \x01(\x05R\x0euserDailyLimit\x12(\n" +
+	"\x0fuserWeeklyLimit\x18\x18 \x01(\x05R\x0fuserWeeklyLimit\x12*\n" +
+	"\x10userMonthlyLimit\x18\x19 \x01(\x05R\x10userMonthlyLimit\x12\x1e\n" +
+	"\n" +
+	"dailyLimit\x18\x1a \x01(\x05R\n" +
+	"dailyLimit\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x1b \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06budget\x18\x1c \x01(\x03R\x06budget\x12\x1e\n" +
+	"\n" +
+	"budgetUsed\x18\x1d \x01(\x03R\n" +
+	"budgetUsed\x12C\n" +
+	"\x06pacing\x18\x1e \x01(\v2+.platform.marketing_service.v1.CouponPacingR\x06pacing\"\x85\x01\n" +
+	"\vCouponQuota\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04used\x18\x03 \x01(\x05R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x05R\tremaining\x12\x18\n" +
+	"\aresetAt\x18\x05 \x01(\x03R\aresetAt\"\xd0\x01\n" +
+	"\x10CouponTimeWindow\x12,\n" +
+	"\bweekdays\x18\x01 \x03(\x05B\x10\xfaB\r\x92\x01\n" +
+	"\x10\a\"\x06\x1a\x04\x18\x06(\x00R\bweekdays\x12D\n" +
+	"\tstartTime\x18\x02 \x01(\tB&\xfaB#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\tstartTime\x12H\n" +
+	"\aendTime\x18\x03 \x01(\tB.\xfaB+r)2'^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$R\aendTime\"\xb8\x01\n" +
+	"\x0eCouponSchedule\x12S\n" +
+	"\awindows\x18\x01 \x03(\v2/.platform.marketing_service.v1.CouponTimeWindowB\b\xfaB\x05\x92\x01\x02\x10\x14R\awindows\x12Q\n" +
+	"\rblackoutDates\x18\x02 \x03(\tB+\xfaB(\x92\x01%\x10\xee\x02\" r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\rblackoutDates\"g\n" +
+	"\fCouponPacing\x12.\n" +
+	"\binterval\x18\x01 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x04HOURR\x03DAYR\binterval\x12'\n" +
+	"\x05curve\x18\x02 \x03(\x05B\x11\xfaB\x0e\x92\x01\v\x10d\"\a\x1a\x05\x18\x90N(\x00R\x05curve\"\xa0\b\n" +
 	"\x13CreateCouponRequest\x12)\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\n" +
@@ -1666,7 +10957,34 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"validUntil\x18\x06 \x01(\x03R\n" +
 	"validUntil\x12!\n" +
 	"\amaxUses\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\amaxUses\x12\x1c\n" +
-	"\tminAmount\x18\b \x01(\x03R\tminAmount\"R\n" +
+	"\tminAmount\x18\b \x01(\x03R\tminAmount\x12(\n" +
+	"\tvalidDays\x18\t \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c(\x00R\tvalidDays\x12'\n" +
+	"\n" +
+	"claimLimit\x18\n" +
+	" \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"claimLimit\x12'\n" +
+	"\n" +
+	"claimStock\x18\v \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"claimStock\x12)\n" +
+	"\vboundUserId\x18\f \x01(\tB\a\xfaB\x04r\x02\x18$R\vboundUserId\x12'\n" +
+	"\n" +
+	"audienceId\x18\r \x01(\tB\a\xfaB\x04r\x02\x18 R\n" +
+	"audienceId\x12H\n" +
+	"\veligibility\x18\x0e \x01(\tB&\xfaB#r!R\x00R\x10FIRST_COUPON_USER\vFIRST_ORDERR\veligibility\x12#\n" +
+	"\btimezone\x18\x0f \x01(\tB\a\xfaB\x04r\x02\x18@R\btimezone\x12I\n" +
+	"\bschedule\x18\x10 \x01(\v2-.platform.marketing_service.v1.CouponScheduleR\bschedule\x12/\n" +
+	"\x0euserDailyLimit\x18\x11 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x0euserDailyLimit\x121\n" +
+	"\x0fuserWeeklyLimit\x18\x12 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x0fuserWeeklyLimit\x123\n" +
+	"\x10userMonthlyLimit\x18\x13 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x10userMonthlyLimit\x12'\n" +
+	"\n" +
+	"dailyLimit\x18\x14 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"dailyLimit\x12'\n" +
+	"\n" +
+	"campaignId\x18\x15 \x01(\tB\a\xfaB\x04r\x02\x18 R\n" +
+	"campaignId\x12\x1f\n" +
+	"\x06budget\x18\x16 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06budget\x12C\n" +
+	"\x06pacing\x18\x17 \x01(\v2+.platform.marketing_service.v1.CouponPacingR\x06pacing\"R\n" +
 	"\x11CreateCouponReply\x12=\n" +
 	"\x06coupon\x18\x01 \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\";\n" +
 	"\x10GetCouponRequest\x12'\n" +
@@ -1674,17 +10992,42 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"couponCode\"O\n" +
 	"\x0eGetCouponReply\x12=\n" +
-	"\x06coupon\x18\x01 \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\"r\n" +
+	"\x06coupon\x18\x01 \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\"\xbe\x05\n" +
 	"\x12ListCouponsRequest\x12\x14\n" +
 	"\x05appId\x18\x01 \x01(\tR\x05appId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x99\x01\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\x12'\n" +
+	"\n" +
+	"codePrefix\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x182R\n" +
+	"codePrefix\x12+\n" +
+	"\fcodeContains\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x182R\fcodeContains\x12;\n" +
+	"\fdiscountType\x18\a \x01(\tB\x17\xfaB\x14r\x12R\x00R\apercentR\x05fixedR\fdiscountType\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1e\n" +
+	"\n" +
+	"activeFrom\x18\t \x01(\x03R\n" +
+	"activeFrom\x12 \n" +
+	"\vactiveUntil\x18\n" +
+	" \x01(\x03R\vactiveUntil\x127\n" +
+	"\x12expiringWithinDays\x18\v \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x12expiringWithinDays\x12!\n" +
+	"\texhausted\x18\f \x01(\bH\x00R\texhausted\x88\x01\x01\x12 \n" +
+	"\vcreatedFrom\x18\r \x01(\x03R\vcreatedFrom\x12\x1c\n" +
+	"\tcreatedTo\x18\x0e \x01(\x03R\tcreatedTo\x12T\n" +
+	"\x06sortBy\x18\x0f \x01(\tB<\xfaB9r7R\x00R\n" +
+	"created_atR\vvalid_untilR\n" +
+	"used_countR\x0eremaining_usesR\x06sortBy\x120\n" +
+	"\tsortOrder\x18\x10 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x03ascR\x04descR\tsortOrder\x12'\n" +
+	"\n" +
+	"campaignId\x18\x11 \x01(\tB\a\xfaB\x04r\x02\x18 R\n" +
+	"campaignIdB\f\n" +
+	"\n" +
+	"_exhausted\"\x99\x01\n" +
 	"\x10ListCouponsReply\x12?\n" +
 	"\acoupons\x18\x01 \x03(\v2%.platform.marketing_service.v1.CouponR\acoupons\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xb2\x02\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x9b\n" +
+	"\n" +
 	"\x13UpdateCouponRequest\x12'\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -1699,24 +11042,788 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"validUntil\x12\x18\n" +
 	"\amaxUses\x18\x06 \x01(\x05R\amaxUses\x12\x1c\n" +
 	"\tminAmount\x18\a \x01(\x03R\tminAmount\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06status\"R\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12-\n" +
+	"\tvalidDays\x18\v \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c(\x00H\x00R\tvalidDays\x88\x01\x01\x12,\n" +
+	"\n" +
+	"claimLimit\x18\f \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x01R\n" +
+	"claimLimit\x88\x01\x01\x12,\n" +
+	"\n" +
+	"claimStock\x18\r \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x02R\n" +
+	"claimStock\x88\x01\x01\x12.\n" +
+	"\vboundUserId\x18\x0e \x01(\tB\a\xfaB\x04r\x02\x18$H\x03R\vboundUserId\x88\x01\x01\x12,\n" +
+	"\n" +
+	"audienceId\x18\x0f \x01(\tB\a\xfaB\x04r\x02\x18 H\x04R\n" +
+	"audienceId\x88\x01\x01\x12M\n" +
+	"\veligibility\x18\x10 \x01(\tB&\xfaB#r!R\x00R\x10FIRST_COUPON_USER\vFIRST_ORDERH\x05R\veligibility\x88\x01\x01\x12(\n" +
+	"\btimezone\x18\x11 \x01(\tB\a\xfaB\x04r\x02\x18@H\x06R\btimezone\x88\x01\x01\x12I\n" +
+	"\bschedule\x18\x12 \x01(\v2-.platform.marketing_service.v1.CouponScheduleR\bschedule\x124\n" +
+	"\x0euserDailyLimit\x18\x13 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\aR\x0euserDailyLimit\x88\x01\x01\x126\n" +
+	"\x0fuserWeeklyLimit\x18\x14 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\bR\x0fuserWeeklyLimit\x88\x01\x01\x128\n" +
+	"\x10userMonthlyLimit\x18\x15 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\tR\x10userMonthlyLimit\x88\x01\x01\x12,\n" +
+	"\n" +
+	"dailyLimit\x18\x16 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\n" +
+	"R\n" +
+	"dailyLimit\x88\x01\x01\x12,\n" +
+	"\n" +
+	"campaignId\x18\x17 \x01(\tB\a\xfaB\x04r\x02\x18 H\vR\n" +
+	"campaignId\x88\x01\x01\x12$\n" +
+	"\x06budget\x18\x18 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\fR\x06budget\x88\x01\x01\x12C\n" +
+	"\x06pacing\x18\x19 \x01(\v2+.platform.marketing_service.v1.CouponPacingR\x06pacingB\f\n" +
+	"\n" +
+	"_validDaysB\r\n" +
+	"\v_claimLimitB\r\n" +
+	"\v_claimStockB\x0e\n" +
+	"\f_boundUserIdB\r\n" +
+	"\v_audienceIdB\x0e\n" +
+	"\f_eligibilityB\v\n" +
+	"\t_timezoneB\x11\n" +
+	"\x0f_userDailyLimitB\x12\n" +
+	"\x10_userWeeklyLimitB\x13\n" +
+	"\x11_userMonthlyLimitB\r\n" +
+	"\v_dailyLimitB\r\n" +
+	"\v_campaignIdB\t\n" +
+	"\a_budget\"R\n" +
 	"\x11UpdateCouponReply\x12=\n" +
 	"\x06coupon\x18\x01 \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\">\n" +
 	"\x13DeleteCouponRequest\x12'\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
-	"couponCode\"a\n" +
+	"couponCode\"\xa6\x01\n" +
+	"\x14ImportCouponsRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12&\n" +
+	"\acontent\x18\x02 \x01(\tB\f\xfaB\tr\a\x10\x01(\x80\x80\x80\x01R\acontent\x128\n" +
+	"\x04mode\x18\x03 \x01(\tB$\xfaB!r\x1fR\x00R\x0eall_or_nothingR\vbest_effortR\x04mode\x12\x16\n" +
+	"\x06dryRun\x18\x04 \x01(\bR\x06dryRun\"\xbc\x01\n" +
+	"\x15ImportCouponRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12=\n" +
+	"\x06coupon\x18\x05 \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\"\xf2\x01\n" +
+	"\x12ImportCouponsReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\x05R\x05valid\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x1c\n" +
+	"\tcommitted\x18\x05 \x01(\bR\tcommitted\x12\x16\n" +
+	"\x06dryRun\x18\x06 \x01(\bR\x06dryRun\x12H\n" +
+	"\x04rows\x18\a \x03(\v24.platform.marketing_service.v1.ImportCouponRowResultR\x04rows\"\xa6\x02\n" +
+	"\x1eBatchUpdateCouponStatusRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12+\n" +
+	"\vcouponCodes\x18\x02 \x03(\tB\t\xfaB\x06\x92\x01\x03\x10\x88'R\vcouponCodes\x12I\n" +
+	"\x06filter\x18\x03 \x01(\v21.platform.marketing_service.v1.ListCouponsRequestR\x06filter\x12/\n" +
+	"\x06status\x18\x04 \x01(\tB\x17\xfaB\x14r\x12R\x06activeR\binactiveR\x06status\x12#\n" +
+	"\boperator\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\boperator\x12 \n" +
+	"\x06reason\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06reason\"\xf0\x01\n" +
+	"\x19BatchDeleteCouponsRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12+\n" +
+	"\vcouponCodes\x18\x02 \x03(\tB\t\xfaB\x06\x92\x01\x03\x10\x88'R\vcouponCodes\x12I\n" +
+	"\x06filter\x18\x03 \x01(\v21.platform.marketing_service.v1.ListCouponsRequestR\x06filter\x12#\n" +
+	"\boperator\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\boperator\x12 \n" +
+	"\x06reason\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06reason\"\x87\x01\n" +
+	"\x15BatchCouponItemResult\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x01 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06result\x18\x02 \x01(\tR\x06result\x12\x1c\n" +
+	"\toldStatus\x18\x03 \x01(\tR\toldStatus\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xc7\x01\n" +
+	"\x11BatchCouponsReply\x12\x18\n" +
+	"\abatchId\x18\x01 \x01(\tR\abatchId\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\x05R\amatched\x12\x1a\n" +
+	"\baffected\x18\x03 \x01(\x05R\baffected\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12J\n" +
+	"\x05items\x18\x05 \x03(\v24.platform.marketing_service.v1.BatchCouponItemResultR\x05items\"\xd9\x02\n" +
+	"\n" +
+	"UserCoupon\x12\"\n" +
+	"\fuserCouponId\x18\x01 \x01(\tR\fuserCouponId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1c\n" +
+	"\tvalidFrom\x18\x05 \x01(\x03R\tvalidFrom\x12\x1e\n" +
+	"\n" +
+	"validUntil\x18\x06 \x01(\x03R\n" +
+	"validUntil\x12\x1a\n" +
+	"\bissuedAt\x18\a \x01(\x03R\bissuedAt\x12\x16\n" +
+	"\x06usedAt\x18\b \x01(\x03R\x06usedAt\x12&\n" +
+	"\x0epaymentOrderId\x18\t \x01(\tR\x0epaymentOrderId\x12=\n" +
+	"\x06coupon\x18\n" +
+	" \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\"`\n" +
+	"\x12IssueCouponRequest\x12'\n" +
+	"\n" +
+	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"couponCode\x12!\n" +
+	"\x06userId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x06userId\"\\\n" +
+	"\x0fUserCouponReply\x12I\n" +
+	"\n" +
+	"userCoupon\x18\x01 \x01(\v2).platform.marketing_service.v1.UserCouponR\n" +
+	"userCoupon\"`\n" +
+	"\x12ClaimCouponRequest\x12'\n" +
+	"\n" +
+	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"couponCode\x12!\n" +
+	"\x06userId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x06userId\"\xa6\x01\n" +
+	"\x16ListUserCouponsRequest\x12!\n" +
+	"\x06userId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x06userId\x129\n" +
+	"\x06status\x18\x02 \x01(\tB!\xfaB\x1er\x1cR\x00R\tavailableR\x04usedR\aexpiredR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xa9\x01\n" +
+	"\x14ListUserCouponsReply\x12K\n" +
+	"\vuserCoupons\x18\x01 \x03(\v2).platform.marketing_service.v1.UserCouponR\vuserCoupons\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"C\n" +
+	"\x14GetUserCouponRequest\x12+\n" +
+	"\fuserCouponId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\fuserCouponId\"\xb2\x01\n" +
+	"\x12CloneCouponRequest\x12'\n" +
+	"\n" +
+	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"couponCode\x12/\n" +
+	"\rnewCouponCode\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\rnewCouponCode\x12\x1c\n" +
+	"\tvalidFrom\x18\x03 \x01(\x03R\tvalidFrom\x12$\n" +
+	"\rkeepBoundUser\x18\x04 \x01(\bR\rkeepBoundUser\"\xde\x02\n" +
+	"\x0eCouponTemplate\x12\x1e\n" +
+	"\n" +
+	"templateId\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
+	"\fdiscountType\x18\x04 \x01(\tR\fdiscountType\x12$\n" +
+	"\rdiscountValue\x18\x05 \x01(\x03R\rdiscountValue\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x18\n" +
+	"\amaxUses\x18\a \x01(\x05R\amaxUses\x12\x1c\n" +
+	"\tminAmount\x18\b \x01(\x03R\tminAmount\x12\x1c\n" +
+	"\tvalidDays\x18\t \x01(\x05R\tvalidDays\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\v \x01(\x03R\tupdatedAt\"\xf8\x02\n" +
+	"\x1bCreateCouponTemplateRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vdescription\x129\n" +
+	"\fdiscountType\x18\x04 \x01(\tB\x15\xfaB\x12r\x10R\apercentR\x05fixedR\fdiscountType\x12-\n" +
+	"\rdiscountValue\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\rdiscountValue\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12!\n" +
+	"\amaxUses\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\amaxUses\x12%\n" +
+	"\tminAmount\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tminAmount\x12(\n" +
+	"\tvalidDays\x18\t \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c \x00R\tvalidDays\"C\n" +
+	"\x18GetCouponTemplateRequest\x12'\n" +
+	"\n" +
+	"templateId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"templateId\"`\n" +
+	"\x13CouponTemplateReply\x12I\n" +
+	"\btemplate\x18\x01 \x01(\v2-.platform.marketing_service.v1.CouponTemplateR\btemplate\"b\n" +
+	"\x1aListCouponTemplatesRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"\xad\x01\n" +
+	"\x18ListCouponTemplatesReply\x12K\n" +
+	"\ttemplates\x18\x01 \x03(\v2-.platform.marketing_service.v1.CouponTemplateR\ttemplates\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x8c\x03\n" +
+	"\x1bUpdateCouponTemplateRequest\x12'\n" +
+	"\n" +
+	"templateId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"templateId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18@R\x04name\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x00R\vdescription\x88\x01\x01\x12;\n" +
+	"\fdiscountType\x18\x04 \x01(\tB\x17\xfaB\x14r\x12R\x00R\apercentR\x05fixedR\fdiscountType\x12$\n" +
+	"\rdiscountValue\x18\x05 \x01(\x03R\rdiscountValue\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x18\n" +
+	"\amaxUses\x18\a \x01(\x05R\amaxUses\x12!\n" +
+	"\tminAmount\x18\b \x01(\x03H\x01R\tminAmount\x88\x01\x01\x12\x1c\n" +
+	"\tvalidDays\x18\t \x01(\x05R\tvalidDaysB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_minAmount\"F\n" +
+	"\x1bDeleteCouponTemplateRequest\x12'\n" +
+	"\n" +
+	"templateId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"templateId\"\x93\x01\n" +
+	"\x1fCreateCouponFromTemplateRequest\x12'\n" +
+	"\n" +
+	"templateId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"templateId\x12)\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\n" +
+	"couponCode\x12\x1c\n" +
+	"\tvalidFrom\x18\x03 \x01(\x03R\tvalidFrom\"\xa5\x01\n" +
+	"\x11AudienceCondition\x12'\n" +
+	"\tattribute\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\tattribute\x12O\n" +
+	"\boperator\x18\x02 \x01(\tB3\xfaB0r.R\x02EQR\x02NER\x02INR\x06NOT_INR\x02GTR\x03GTER\x02LTR\x03LTER\x06EXISTSR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\x95\x01\n" +
+	"\fAudienceRule\x12'\n" +
+	"\x05match\x18\x01 \x01(\tB\x11\xfaB\x0er\fR\x00R\x03ALLR\x03ANYR\x05match\x12\\\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v20.platform.marketing_service.v1.AudienceConditionB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10\x14R\n" +
+	"conditions\"\xab\x02\n" +
+	"\bAudience\x12\x1e\n" +
+	"\n" +
+	"audienceId\x18\x01 \x01(\tR\n" +
+	"audienceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12?\n" +
+	"\x04rule\x18\x05 \x01(\v2+.platform.marketing_service.v1.AudienceRuleR\x04rule\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12 \n" +
+	"\vmemberCount\x18\a \x01(\x03R\vmemberCount\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\t \x01(\x03R\tupdatedAt\"\x8f\x02\n" +
+	"\x15CreateAudienceRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vdescription\x122\n" +
+	"\x04type\x18\x03 \x01(\tB\x1e\xfaB\x1br\x19R\x03TAGR\aSEGMENTR\x04LISTR\x03ALLR\x04type\x12?\n" +
+	"\x04rule\x18\x04 \x01(\v2+.platform.marketing_service.v1.AudienceRuleR\x04rule\x126\n" +
+	"\x06status\x18\x05 \x01(\tB\x1e\xfaB\x1br\x19R\x00R\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06status\"T\n" +
+	"\rAudienceReply\x12C\n" +
+	"\baudience\x18\x01 \x01(\v2'.platform.marketing_service.v1.AudienceR\baudience\"=\n" +
+	"\x12GetAudienceRequest\x12'\n" +
+	"\n" +
+	"audienceId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"audienceId\"|\n" +
+	"\x14ListAudiencesRequest\x124\n" +
+	"\x04type\x18\x01 \x01(\tB \xfaB\x1dr\x1bR\x00R\x03TAGR\aSEGMENTR\x04LISTR\x03ALLR\x04type\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"\xa1\x01\n" +
+	"\x12ListAudiencesReply\x12E\n" +
+	"\taudiences\x18\x01 \x03(\v2'.platform.marketing_service.v1.AudienceR\taudiences\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x97\x02\n" +
+	"\x15UpdateAudienceRequest\x12'\n" +
+	"\n" +
+	"audienceId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"audienceId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18@R\x04name\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x00R\vdescription\x88\x01\x01\x12?\n" +
+	"\x04rule\x18\x04 \x01(\v2+.platform.marketing_service.v1.AudienceRuleR\x04rule\x126\n" +
+	"\x06status\x18\x05 \x01(\tB\x1e\xfaB\x1br\x19R\x00R\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06statusB\x0e\n" +
+	"\f_description\"@\n" +
+	"\x15DeleteAudienceRequest\x12'\n" +
+	"\n" +
+	"audienceId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"audienceId\"\x9d\x01\n" +
+	"\x1cUploadAudienceMembersRequest\x12'\n" +
+	"\n" +
+	"audienceId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"audienceId\x12&\n" +
+	"\acontent\x18\x02 \x01(\tB\f\xfaB\tr\a\x10\x01\x18\x80\x80\x80\x02R\acontent\x12,\n" +
+	"\x04mode\x18\x03 \x01(\tB\x18\xfaB\x15r\x13R\x00R\aREPLACER\x06APPENDR\x04mode\"\x92\x01\n" +
+	"\x1aUploadAudienceMembersReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\ainvalid\x18\x02 \x01(\x05R\ainvalid\x12\"\n" +
+	"\finvalidLines\x18\x03 \x03(\x05R\finvalidLines\x12 \n" +
+	"\vmemberCount\x18\x04 \x01(\x03R\vmemberCount\"\x94\x02\n" +
+	"\x14CheckAudienceRequest\x12'\n" +
+	"\n" +
+	"audienceId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"audienceId\x12\x1f\n" +
+	"\x06userId\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18$R\x06userId\x12o\n" +
+	"\x0euserAttributes\x18\x03 \x03(\v2G.platform.marketing_service.v1.CheckAudienceRequest.UserAttributesEntryR\x0euserAttributes\x1aA\n" +
+	"\x13UserAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\".\n" +
+	"\x12CheckAudienceReply\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\bR\amatched\"\xd4\x02\n" +
+	"\bCampaign\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tstartTime\x18\x05 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x06 \x01(\x03R\aendTime\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\t \x01(\x03R\tupdatedAt\x12\x16\n" +
+	"\x06budget\x18\n" +
+	" \x01(\x03R\x06budget\x12\x1e\n" +
+	"\n" +
+	"budgetUsed\x18\v \x01(\x03R\n" +
+	"budgetUsed\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\"\xc3\x02\n" +
+	"\x15CreateCampaignRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12@\n" +
+	"\x04type\x18\x02 \x01(\tB,\xfaB)r'R\vREDEEM_CODER\vTASK_REWARDR\vDIRECT_SENDR\x04type\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vdescription\x12%\n" +
+	"\tstartTime\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tstartTime\x12!\n" +
+	"\aendTime\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aendTime\x12\x1f\n" +
+	"\x06budget\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06budget\x122\n" +
+	"\bcurrency\x18\a \x01(\tB\x16\xfaB\x13r\x11R\x00R\x03CNYR\x03USDR\x03EURR\bcurrency\"T\n" +
+	"\rCampaignReply\x12C\n" +
+	"\bcampaign\x18\x01 \x01(\v2'.platform.marketing_service.v1.CampaignR\bcampaign\"=\n" +
+	"\x12GetCampaignRequest\x12'\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"campaignId\"\xc9\x01\n" +
+	"\x14ListCampaignsRequest\x12=\n" +
+	"\x06status\x18\x01 \x01(\tB%\xfaB\"r R\x00R\x05DRAFTR\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06status\x12B\n" +
+	"\x04type\x18\x02 \x01(\tB.\xfaB+r)R\x00R\vREDEEM_CODER\vTASK_REWARDR\vDIRECT_SENDR\x04type\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xa1\x01\n" +
+	"\x12ListCampaignsReply\x12E\n" +
+	"\tcampaigns\x18\x01 \x03(\v2'.platform.marketing_service.v1.CampaignR\tcampaigns\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xcb\x02\n" +
+	"\x15UpdateCampaignRequest\x12'\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"campaignId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18@R\x04name\x12B\n" +
+	"\x04type\x18\x03 \x01(\tB.\xfaB+r)R\x00R\vREDEEM_CODER\vTASK_REWARDR\vDIRECT_SENDR\x04type\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x00R\vdescription\x88\x01\x01\x12\x1c\n" +
+	"\tstartTime\x18\x05 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x06 \x01(\x03R\aendTime\x12$\n" +
+	"\x06budget\x18\a \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x01R\x06budget\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_budget\"@\n" +
+	"\x15DeleteCampaignRequest\x12'\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"campaignId\"A\n" +
+	"\x16PublishCampaignRequest\x12'\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"campaignId\"?\n" +
+	"\x14PauseCampaignRequest\x12'\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"campaignId\"=\n" +
+	"\x12EndCampaignRequest\x12'\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"campaignId\"\xbc\x03\n" +
+	"\n" +
+	"RedeemCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\abatchId\x18\x02 \x01(\tR\abatchId\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x03 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"rewardType\x18\x04 \x01(\tR\n" +
+	"rewardType\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x05 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06points\x18\x06 \x01(\x03R\x06points\x12*\n" +
+	"\x10subscriptionPlan\x18\a \x01(\tR\x10subscriptionPlan\x12*\n" +
+	"\x10subscriptionDays\x18\b \x01(\x05R\x10subscriptionDays\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\texpiresAt\x18\n" +
+	" \x01(\x03R\texpiresAt\x12\x1e\n" +
+	"\n" +
+	"redeemedBy\x18\v \x01(\tR\n" +
+	"redeemedBy\x12\x1e\n" +
+	"\n" +
+	"redeemedAt\x18\f \x01(\x03R\n" +
+	"redeemedAt\x12\x1c\n" +
+	"\tcreatedAt\x18\r \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\x0e \x01(\x03R\tupdatedAt\"\xe0\x03\n" +
+	"\x14RedeemCodeRedemption\x12\"\n" +
+	"\fredemptionId\x18\x01 \x01(\tR\fredemptionId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\tR\x06userId\x12\x1e\n" +
+	"\n" +
+	"rewardType\x18\x04 \x01(\tR\n" +
+	"rewardType\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x05 \x01(\tR\n" +
+	"couponCode\x12\"\n" +
+	"\fuserCouponId\x18\x06 \x01(\tR\fuserCouponId\x12\x16\n" +
+	"\x06points\x18\a \x01(\x03R\x06points\x12*\n" +
+	"\x10subscriptionPlan\x18\b \x01(\tR\x10subscriptionPlan\x12*\n" +
+	"\x10subscriptionDays\x18\t \x01(\x05R\x10subscriptionDays\x12\x1e\n" +
+	"\n" +
+	"redeemedAt\x18\n" +
+	" \x01(\x03R\n" +
+	"redeemedAt\x12 \n" +
+	"\vgrantStatus\x18\v \x01(\tR\vgrantStatus\x12\x1e\n" +
+	"\n" +
+	"grantError\x18\f \x01(\tR\n" +
+	"grantError\x12$\n" +
+	"\rgrantAttempts\x18\r \x01(\x05R\rgrantAttempts\x12\x1c\n" +
+	"\tgrantedAt\x18\x0e \x01(\x03R\tgrantedAt\"\xb5\x03\n" +
+	"\x18CreateRedeemCodesRequest\x12 \n" +
+	"\x05count\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x01R\x05count\x12/\n" +
+	"\x06prefix\x18\x02 \x01(\tB\x17\xfaB\x14r\x12\x18\x102\x0e^[A-Za-z0-9]*$R\x06prefix\x12!\n" +
+	"\x06length\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18 (\x00R\x06length\x12C\n" +
+	"\n" +
+	"rewardType\x18\x04 \x01(\tB#\xfaB r\x1eR\x06COUPONR\x06POINTSR\fSUBSCRIPTIONR\n" +
+	"rewardType\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x05 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06points\x18\x06 \x01(\x03R\x06points\x123\n" +
+	"\x10subscriptionPlan\x18\a \x01(\tB\a\xfaB\x04r\x02\x18@R\x10subscriptionPlan\x12*\n" +
+	"\x10subscriptionDays\x18\b \x01(\x05R\x10subscriptionDays\x12%\n" +
+	"\texpiresAt\x18\t \x01(\x03B\a\xfaB\x04\"\x02 \x00R\texpiresAt\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\n" +
+	" \x01(\tR\n" +
+	"campaignId\"H\n" +
+	"\x16CreateRedeemCodesReply\x12\x18\n" +
+	"\abatchId\x18\x01 \x01(\tR\abatchId\x12\x14\n" +
+	"\x05codes\x18\x02 \x03(\tR\x05codes\"3\n" +
+	"\x14GetRedeemCodeRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"\\\n" +
+	"\x0fRedeemCodeReply\x12I\n" +
+	"\n" +
+	"redeemCode\x18\x01 \x01(\v2).platform.marketing_service.v1.RedeemCodeR\n" +
+	"redeemCode\"\xc7\x01\n" +
+	"\x16ListRedeemCodesRequest\x12\x18\n" +
+	"\abatchId\x18\x01 \x01(\tR\abatchId\x12C\n" +
+	"\x06status\x18\x02 \x01(\tB+\xfaB(r&R\x00R\x06ACTIVER\bREDEEMEDR\aEXPIREDR\aREVOKEDR\x06status\x12\x1e\n" +
+	"\n" +
+	"redeemedBy\x18\x03 \x01(\tR\n" +
+	"redeemedBy\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x05 \x01(\x05R\bpageSize\"\xa9\x01\n" +
+	"\x14ListRedeemCodesReply\x12K\n" +
+	"\vredeemCodes\x18\x01 \x03(\v2).platform.marketing_service.v1.RedeemCodeR\vredeemCodes\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"S\n" +
+	"\x11RedeemCodeRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\x12!\n" +
+	"\x06userId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x06userId\"p\n" +
+	"\x19RedeemCodeRedemptionReply\x12S\n" +
+	"\n" +
+	"redemption\x18\x01 \x01(\v23.platform.marketing_service.v1.RedeemCodeRedemptionR\n" +
+	"redemption\"6\n" +
+	"\x17RevokeRedeemCodeRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"\xaf\x01\n" +
+	" ListRedeemCodeRedemptionsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12C\n" +
+	"\vgrantStatus\x18\x02 \x01(\tB!\xfaB\x1er\x1cR\x00R\aPENDINGR\aGRANTEDR\x06FAILEDR\vgrantStatus\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xbd\x01\n" +
+	"\x1eListRedeemCodeRedemptionsReply\x12U\n" +
+	"\vredemptions\x18\x01 \x03(\v23.platform.marketing_service.v1.RedeemCodeRedemptionR\vredemptions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\":\n" +
+	"\x1bRetryRedeemCodeGrantRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"\x83\x02\n" +
+	"\rRewardContent\x12\"\n" +
+	"\fdiscountType\x18\x01 \x01(\tR\fdiscountType\x12$\n" +
+	"\rdiscountValue\x18\x02 \x01(\x03R\rdiscountValue\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tminAmount\x18\x04 \x01(\x03R\tminAmount\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x03R\x06points\x12*\n" +
+	"\x10subscriptionPlan\x18\x06 \x01(\tR\x10subscriptionPlan\x12*\n" +
+	"\x10subscriptionDays\x18\a \x01(\x05R\x10subscriptionDays\"W\n" +
+	"\x15RewardGeneratorConfig\x12\x1e\n" +
+	"\n" +
+	"codePrefix\x18\x01 \x01(\tR\n" +
+	"codePrefix\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x03R\n" +
+	"totalCount\"\x93\x01\n" +
+	"\x15RewardValidatorConfig\x12\x1c\n" +
+	"\tstartTime\x18\x01 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x02 \x01(\x03R\aendTime\x12\x1e\n" +
+	"\n" +
+	"audienceId\x18\x03 \x01(\tR\n" +
+	"audienceId\x12\"\n" +
+	"\fperUserLimit\x18\x04 \x01(\x05R\fperUserLimit\"-\n" +
+	"\x17RewardDistributorConfig\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\"\xf4\x04\n" +
+	"\x06Reward\x12\x1a\n" +
+	"\brewardId\x18\x01 \x01(\tR\brewardId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"rewardType\x18\x04 \x01(\tR\n" +
+	"rewardType\x12F\n" +
+	"\acontent\x18\x05 \x01(\v2,.platform.marketing_service.v1.RewardContentR\acontent\x12^\n" +
+	"\x0fgeneratorConfig\x18\x06 \x01(\v24.platform.marketing_service.v1.RewardGeneratorConfigR\x0fgeneratorConfig\x12^\n" +
+	"\x0fvalidatorConfig\x18\a \x01(\v24.platform.marketing_service.v1.RewardValidatorConfigR\x0fvalidatorConfig\x12d\n" +
+	"\x11distributorConfig\x18\b \x01(\v26.platform.marketing_service.v1.RewardDistributorConfigR\x11distributorConfig\x12\x1c\n" +
+	"\tvalidDays\x18\t \x01(\x05R\tvalidDays\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\r \x01(\x03R\tupdatedAt\"\xc7\x04\n" +
+	"\x13CreateRewardRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vdescription\x12C\n" +
+	"\n" +
+	"rewardType\x18\x03 \x01(\tB#\xfaB r\x1eR\x06COUPONR\x06POINTSR\fSUBSCRIPTIONR\n" +
+	"rewardType\x12P\n" +
+	"\acontent\x18\x04 \x01(\v2,.platform.marketing_service.v1.RewardContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\acontent\x12^\n" +
+	"\x0fgeneratorConfig\x18\x05 \x01(\v24.platform.marketing_service.v1.RewardGeneratorConfigR\x0fgeneratorConfig\x12^\n" +
+	"\x0fvalidatorConfig\x18\x06 \x01(\v24.platform.marketing_service.v1.RewardValidatorConfigR\x0fvalidatorConfig\x12d\n" +
+	"\x11distributorConfig\x18\a \x01(\v26.platform.marketing_service.v1.RewardDistributorConfigR\x11distributorConfig\x12(\n" +
+	"\tvalidDays\x18\b \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c(\x00R\tvalidDays\"L\n" +
+	"\vRewardReply\x12=\n" +
+	"\x06reward\x18\x01 \x01(\v2%.platform.marketing_service.v1.RewardR\x06reward\"7\n" +
+	"\x10GetRewardRequest\x12#\n" +
+	"\brewardId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\brewardId\"\xc3\x01\n" +
+	"\x12ListRewardsRequest\x12E\n" +
+	"\n" +
+	"rewardType\x18\x01 \x01(\tB%\xfaB\"r R\x00R\x06COUPONR\x06POINTSR\fSUBSCRIPTIONR\n" +
+	"rewardType\x126\n" +
+	"\x06status\x18\x02 \x01(\tB\x1e\xfaB\x1br\x19R\x00R\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x99\x01\n" +
+	"\x10ListRewardsReply\x12?\n" +
+	"\arewards\x18\x01 \x03(\v2%.platform.marketing_service.v1.RewardR\arewards\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xdc\x05\n" +
+	"\x13UpdateRewardRequest\x12#\n" +
+	"\brewardId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\brewardId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1b\n" +
+	"\x04name\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\x04name\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x00R\vdescription\x88\x01\x01\x12E\n" +
+	"\n" +
+	"rewardType\x18\x05 \x01(\tB%\xfaB\"r R\x00R\x06COUPONR\x06POINTSR\fSUBSCRIPTIONR\n" +
+	"rewardType\x12F\n" +
+	"\acontent\x18\x06 \x01(\v2,.platform.marketing_service.v1.RewardContentR\acontent\x12^\n" +
+	"\x0fgeneratorConfig\x18\a \x01(\v24.platform.marketing_service.v1.RewardGeneratorConfigR\x0fgeneratorConfig\x12^\n" +
+	"\x0fvalidatorConfig\x18\b \x01(\v24.platform.marketing_service.v1.RewardValidatorConfigR\x0fvalidatorConfig\x12d\n" +
+	"\x11distributorConfig\x18\t \x01(\v26.platform.marketing_service.v1.RewardDistributorConfigR\x11distributorConfig\x12-\n" +
+	"\tvalidDays\x18\n" +
+	" \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc2\x1c(\x00H\x01R\tvalidDays\x88\x01\x01\x126\n" +
+	"\x06status\x18\v \x01(\tB\x1e\xfaB\x1br\x19R\x00R\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06statusB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_validDays\":\n" +
+	"\x13DeleteRewardRequest\x12#\n" +
+	"\brewardId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\brewardId\"\xd6\x02\n" +
+	"\x12IssueRewardRequest\x12#\n" +
+	"\brewardId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\brewardId\x12!\n" +
+	"\x06userId\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x06userId\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x03 \x01(\tR\n" +
+	"campaignId\x12&\n" +
+	"\trequestId\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\trequestId\x12m\n" +
+	"\x0euserAttributes\x18\x05 \x03(\v2E.platform.marketing_service.v1.IssueRewardRequest.UserAttributesEntryR\x0euserAttributes\x1aA\n" +
+	"\x13UserAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa3\x05\n" +
+	"\vRewardGrant\x12\x18\n" +
+	"\agrantId\x18\x01 \x01(\tR\agrantId\x12\x1a\n" +
+	"\brewardId\x18\x02 \x01(\tR\brewardId\x12\x1e\n" +
+	"\n" +
+	"rewardName\x18\x03 \x01(\tR\n" +
+	"rewardName\x12\x1e\n" +
+	"\n" +
+	"rewardType\x18\x04 \x01(\tR\n" +
+	"rewardType\x12$\n" +
+	"\rrewardVersion\x18\x05 \x01(\x05R\rrewardVersion\x12V\n" +
+	"\x0fcontentSnapshot\x18\x06 \x01(\v2,.platform.marketing_service.v1.RewardContentR\x0fcontentSnapshot\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\a \x01(\tR\n" +
+	"campaignId\x12\"\n" +
+	"\fcampaignName\x18\b \x01(\tR\fcampaignName\x12\x16\n" +
+	"\x06taskId\x18\t \x01(\tR\x06taskId\x12\x1a\n" +
+	"\btaskName\x18\n" +
+	" \x01(\tR\btaskName\x12\x16\n" +
+	"\x06userId\x18\v \x01(\tR\x06userId\x12\x1c\n" +
+	"\trequestId\x18\f \x01(\tR\trequestId\x12\x16\n" +
+	"\x06status\x18\r \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x0e \x01(\tR\n" +
+	"couponCode\x12\x1e\n" +
+	"\n" +
+	"expireTime\x18\x0f \x01(\x03R\n" +
+	"expireTime\x12 \n" +
+	"\vgeneratedAt\x18\x10 \x01(\x03R\vgeneratedAt\x12$\n" +
+	"\rdistributedAt\x18\x11 \x01(\x03R\rdistributedAt\x12\x16\n" +
+	"\x06usedAt\x18\x12 \x01(\x03R\x06usedAt\x12\x1c\n" +
+	"\tcreatedAt\x18\x13 \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\x14 \x01(\x03R\tupdatedAt\"T\n" +
+	"\x10RewardGrantReply\x12@\n" +
+	"\x05grant\x18\x01 \x01(\v2*.platform.marketing_service.v1.RewardGrantR\x05grant\":\n" +
+	"\x15GetRewardGrantRequest\x12!\n" +
+	"\agrantId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\agrantId\"\x86\x02\n" +
+	"\x17ListRewardGrantsRequest\x12\x1a\n" +
+	"\brewardId\x18\x01 \x01(\tR\brewardId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x03 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06taskId\x18\x04 \x01(\tR\x06taskId\x12O\n" +
+	"\x06status\x18\x05 \x01(\tB7\xfaB4r2R\x00R\aPENDINGR\tGENERATEDR\vDISTRIBUTEDR\x04USEDR\aEXPIREDR\x06status\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\a \x01(\x05R\bpageSize\"\xa1\x01\n" +
+	"\x15ListRewardGrantsReply\x12B\n" +
+	"\x06grants\x18\x01 \x03(\v2*.platform.marketing_service.v1.RewardGrantR\x06grants\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"A\n" +
+	"\x1cDistributeRewardGrantRequest\x12!\n" +
+	"\agrantId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\agrantId\":\n" +
+	"\x15UseRewardGrantRequest\x12!\n" +
+	"\agrantId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\agrantId\"W\n" +
+	"\x11TaskTriggerConfig\x12\x1c\n" +
+	"\teventType\x18\x01 \x01(\tR\teventType\x12$\n" +
+	"\ruserAttribute\x18\x02 \x01(\tR\ruserAttribute\"\x94\x02\n" +
+	"\x13TaskConditionConfig\x12\x1c\n" +
+	"\tminAmount\x18\x01 \x01(\x03R\tminAmount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12b\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2B.platform.marketing_service.v1.TaskConditionConfig.AttributesEntryR\n" +
+	"attributes\x12 \n" +
+	"\vtargetCount\x18\x04 \x01(\x05R\vtargetCount\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd6\x03\n" +
+	"\x04Task\x12\x16\n" +
+	"\x06taskId\x18\x01 \x01(\tR\x06taskId\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x02 \x01(\tR\n" +
+	"campaignId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\btaskType\x18\x05 \x01(\tR\btaskType\x12V\n" +
+	"\rtriggerConfig\x18\x06 \x01(\v20.platform.marketing_service.v1.TaskTriggerConfigR\rtriggerConfig\x12\\\n" +
+	"\x0fconditionConfig\x18\a \x01(\v22.platform.marketing_service.v1.TaskConditionConfigR\x0fconditionConfig\x12\x1a\n" +
+	"\brewardId\x18\b \x01(\tR\brewardId\x12\x1e\n" +
+	"\n" +
+	"repeatable\x18\t \x01(\bR\n" +
+	"repeatable\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\v \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\f \x01(\x03R\tupdatedAt\"\xf6\x03\n" +
+	"\x11CreateTaskRequest\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\vdescription\x12C\n" +
+	"\btaskType\x18\x04 \x01(\tB'\xfaB$r\"R\x06INVITER\bPURCHASER\x05SHARER\aSIGN_INR\btaskType\x12V\n" +
+	"\rtriggerConfig\x18\x05 \x01(\v20.platform.marketing_service.v1.TaskTriggerConfigR\rtriggerConfig\x12\\\n" +
+	"\x0fconditionConfig\x18\x06 \x01(\v22.platform.marketing_service.v1.TaskConditionConfigR\x0fconditionConfig\x12#\n" +
+	"\brewardId\x18\a \x01(\tB\a\xfaB\x04r\x02\x10\x01R\brewardId\x12\x1e\n" +
+	"\n" +
+	"repeatable\x18\b \x01(\bR\n" +
+	"repeatable\x126\n" +
+	"\x06status\x18\t \x01(\tB\x1e\xfaB\x1br\x19R\x00R\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06status\"D\n" +
+	"\tTaskReply\x127\n" +
+	"\x04task\x18\x01 \x01(\v2#.platform.marketing_service.v1.TaskR\x04task\"1\n" +
+	"\x0eGetTaskRequest\x12\x1f\n" +
+	"\x06taskId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\"\xe1\x01\n" +
+	"\x10ListTasksRequest\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12E\n" +
+	"\btaskType\x18\x02 \x01(\tB)\xfaB&r$R\x00R\x06INVITER\bPURCHASER\x05SHARER\aSIGN_INR\btaskType\x126\n" +
+	"\x06status\x18\x03 \x01(\tB\x1e\xfaB\x1br\x19R\x00R\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x05 \x01(\x05R\bpageSize\"\x91\x01\n" +
+	"\x0eListTasksReply\x129\n" +
+	"\x05tasks\x18\x01 \x03(\v2#.platform.marketing_service.v1.TaskR\x05tasks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x97\x04\n" +
+	"\x11UpdateTaskRequest\x12\x1f\n" +
+	"\x06taskId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18@R\x04name\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01H\x00R\vdescription\x88\x01\x01\x12E\n" +
+	"\btaskType\x18\x04 \x01(\tB)\xfaB&r$R\x00R\x06INVITER\bPURCHASER\x05SHARER\aSIGN_INR\btaskType\x12V\n" +
+	"\rtriggerConfig\x18\x05 \x01(\v20.platform.marketing_service.v1.TaskTriggerConfigR\rtriggerConfig\x12\\\n" +
+	"\x0fconditionConfig\x18\x06 \x01(\v22.platform.marketing_service.v1.TaskConditionConfigR\x0fconditionConfig\x12\x1a\n" +
+	"\brewardId\x18\a \x01(\tR\brewardId\x12#\n" +
+	"\n" +
+	"repeatable\x18\b \x01(\bH\x01R\n" +
+	"repeatable\x88\x01\x01\x126\n" +
+	"\x06status\x18\t \x01(\tB\x1e\xfaB\x1br\x19R\x00R\x06ACTIVER\x06PAUSEDR\x05ENDEDR\x06statusB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_repeatable\"4\n" +
+	"\x11DeleteTaskRequest\x12\x1f\n" +
+	"\x06taskId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\"\xac\x02\n" +
+	"\fTaskProgress\x12\x16\n" +
+	"\x06taskId\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprogress\x18\x03 \x01(\x05R\bprogress\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\x05R\x06target\x12 \n" +
+	"\vcompletions\x18\x05 \x01(\x05R\vcompletions\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12 \n" +
+	"\vlastEventId\x18\a \x01(\tR\vlastEventId\x12 \n" +
+	"\vcompletedAt\x18\b \x01(\x03R\vcompletedAt\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\x03R\tupdatedAt\"\xb2\x01\n" +
+	"\x17ListTaskProgressRequest\x12\x16\n" +
+	"\x06taskId\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x127\n" +
+	"\x06status\x18\x03 \x01(\tB\x1f\xfaB\x1cr\x1aR\x00R\vIN_PROGRESSR\tCOMPLETEDR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x05 \x01(\x05R\bpageSize\"\xa6\x01\n" +
+	"\x15ListTaskProgressReply\x12G\n" +
+	"\bprogress\x18\x01 \x03(\v2+.platform.marketing_service.v1.TaskProgressR\bprogress\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\x82\x03\n" +
+	"\x13TriggerEventRequest\x12#\n" +
+	"\aeventId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\aeventId\x12L\n" +
+	"\teventType\x18\x02 \x01(\tB.\xfaB+r)R\rUSER_REGISTERR\n" +
+	"ORDER_PAIDR\fUSER_SIGN_INR\teventType\x12!\n" +
+	"\x06userId\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12b\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v2B.platform.marketing_service.v1.TriggerEventRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x02\n" +
+	"\x11TaskTriggerResult\x12\x16\n" +
+	"\x06taskId\x18\x01 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\btaskName\x18\x02 \x01(\tR\btaskName\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x05R\bprogress\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\x05R\x06target\x12 \n" +
+	"\vcompletions\x18\x06 \x01(\x05R\vcompletions\x12\x1c\n" +
+	"\tcompleted\x18\a \x01(\bR\tcompleted\x12\x1c\n" +
+	"\tduplicate\x18\b \x01(\bR\tduplicate\x12\x18\n" +
+	"\aignored\x18\t \x01(\bR\aignored\x12\x18\n" +
+	"\agrantId\x18\n" +
+	" \x01(\tR\agrantId\x12 \n" +
+	"\vrewardError\x18\v \x01(\tR\vrewardError\"_\n" +
+	"\x11TriggerEventReply\x12J\n" +
+	"\aresults\x18\x01 \x03(\v20.platform.marketing_service.v1.TaskTriggerResultR\aresults\"\xb7\x02\n" +
 	"\x15ValidateCouponRequest\x12'\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"couponCode\x12\x1f\n" +
-	"\x06amount\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06amount\"\xce\x01\n" +
+	"\x06amount\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06amount\x12\x1f\n" +
+	"\x06userId\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18$R\x06userId\x12p\n" +
+	"\x0euserAttributes\x18\x04 \x03(\v2H.platform.marketing_service.v1.ValidateCouponRequest.UserAttributesEntryR\x0euserAttributes\x1aA\n" +
+	"\x13UserAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbd\x03\n" +
 	"\x13ValidateCouponReply\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
 	"\x0ediscountAmount\x18\x03 \x01(\x03R\x0ediscountAmount\x12 \n" +
 	"\vfinalAmount\x18\x04 \x01(\x03R\vfinalAmount\x12=\n" +
-	"\x06coupon\x18\x05 \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\"\xe0\x02\n" +
+	"\x06coupon\x18\x05 \x01(\v2%.platform.marketing_service.v1.CouponR\x06coupon\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12(\n" +
+	"\x0fnextAvailableAt\x18\a \x01(\x03R\x0fnextAvailableAt\x12B\n" +
+	"\x06quotas\x18\b \x03(\v2*.platform.marketing_service.v1.CouponQuotaR\x06quotas\x12-\n" +
+	"\x0fbudgetRemaining\x18\t \x01(\x03H\x00R\x0fbudgetRemaining\x88\x01\x01\x12$\n" +
+	"\rbudgetClamped\x18\n" +
+	" \x01(\bR\rbudgetClampedB\x12\n" +
+	"\x10_budgetRemaining\"\xe0\x02\n" +
 	"\x10UseCouponRequest\x12'\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -1730,11 +11837,12 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\vfinalAmount\x18\b \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vfinalAmount\"D\n" +
 	"\x0eUseCouponReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"l\n" +
 	"\x15GetCouponStatsRequest\x12'\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
-	"couponCode\"\xe7\x01\n" +
+	"couponCode\x12*\n" +
+	"\x10exactUniqueUsers\x18\x02 \x01(\bR\x10exactUniqueUsers\"\xda\x04\n" +
 	"\x13GetCouponStatsReply\x12\x1e\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tR\n" +
@@ -1743,7 +11851,21 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\vtotalOrders\x18\x03 \x01(\x05R\vtotalOrders\x12\"\n" +
 	"\ftotalRevenue\x18\x04 \x01(\x03R\ftotalRevenue\x12$\n" +
 	"\rtotalDiscount\x18\x05 \x01(\x03R\rtotalDiscount\x12&\n" +
-	"\x0econversionRate\x18\x06 \x01(\x02R\x0econversionRate\"\xd1\x02\n" +
+	"\x0econversionRate\x18\x06 \x01(\x02R\x0econversionRate\x12.\n" +
+	"\x12validationAttempts\x18\a \x01(\x05R\x12validationAttempts\x12$\n" +
+	"\rvalidAttempts\x18\b \x01(\x05R\rvalidAttempts\x12\x1c\n" +
+	"\tvalidRate\x18\t \x01(\x02R\tvalidRate\x12&\n" +
+	"\x0eredemptionRate\x18\n" +
+	" \x01(\x02R\x0eredemptionRate\x12*\n" +
+	"\x10quotaUtilization\x18\v \x01(\x02R\x10quotaUtilization\x12[\n" +
+	"\x11amountsByCurrency\x18\f \x03(\v2-.platform.marketing_service.v1.CurrencyAmountR\x11amountsByCurrency\x12 \n" +
+	"\vuniqueUsers\x18\r \x01(\x03R\vuniqueUsers\x12*\n" +
+	"\x10uniqueUsersExact\x18\x0e \x01(\bR\x10uniqueUsersExact\"\x94\x01\n" +
+	"\x0eCurrencyAmount\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\ttotalUses\x18\x02 \x01(\x05R\ttotalUses\x12\"\n" +
+	"\ftotalRevenue\x18\x03 \x01(\x03R\ftotalRevenue\x12$\n" +
+	"\rtotalDiscount\x18\x04 \x01(\x03R\rtotalDiscount\"\xed\x02\n" +
 	"\vCouponUsage\x12$\n" +
 	"\rcouponUsageId\x18\x01 \x01(\tR\rcouponUsageId\x12\x1e\n" +
 	"\n" +
@@ -1757,7 +11879,8 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\x0ediscountAmount\x18\b \x01(\x03R\x0ediscountAmount\x12 \n" +
 	"\vfinalAmount\x18\t \x01(\x03R\vfinalAmount\x12\x16\n" +
 	"\x06usedAt\x18\n" +
-	" \x01(\x03R\x06usedAt\"r\n" +
+	" \x01(\x03R\x06usedAt\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\"r\n" +
 	"\x17ListCouponUsagesRequest\x12'\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -1768,9 +11891,58 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\x06usages\x18\x01 \x03(\v2*.platform.marketing_service.v1.CouponUsageR\x06usages\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"5\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\"\xd4\x01\n" +
+	"\x1fGetCouponUsageTimeSeriesRequest\x12\x1b\n" +
+	"\x04from\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x04from\x12\x17\n" +
+	"\x02to\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02to\x12?\n" +
+	"\vgranularity\x18\x03 \x01(\tB\x1d\xfaB\x1ar\x18R\x04hourR\x03dayR\x04weekR\x05monthR\vgranularity\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x05 \x01(\tR\n" +
+	"couponCode\"\xd6\x01\n" +
+	"\x1aCouponUsageTimeSeriesPoint\x12 \n" +
+	"\vbucketStart\x18\x01 \x01(\x03R\vbucketStart\x12\x12\n" +
+	"\x04uses\x18\x02 \x01(\x05R\x04uses\x12&\n" +
+	"\x0edistinctOrders\x18\x03 \x01(\x05R\x0edistinctOrders\x12$\n" +
+	"\rdistinctUsers\x18\x04 \x01(\x05R\rdistinctUsers\x12\x18\n" +
+	"\arevenue\x18\x05 \x01(\x03R\arevenue\x12\x1a\n" +
+	"\bdiscount\x18\x06 \x01(\x03R\bdiscount\"\xb0\x01\n" +
+	"\x1dGetCouponUsageTimeSeriesReply\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12Q\n" +
+	"\x06points\x18\x03 \x03(\v29.platform.marketing_service.v1.CouponUsageTimeSeriesPointR\x06points\"\xa0\x01\n" +
+	"\x17ListUsagesByUserRequest\x12!\n" +
+	"\x06userId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18$R\x06userId\x12\x1a\n" +
+	"\busedFrom\x18\x02 \x01(\x03R\busedFrom\x12\x16\n" +
+	"\x06usedTo\x18\x03 \x01(\x03R\x06usedTo\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x05 \x01(\x05R\bpageSize\"\x86\x01\n" +
+	"\x1dGetUsageByPaymentOrderRequest\x121\n" +
+	"\x0epaymentOrderId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x0epaymentOrderId\x12\x1a\n" +
+	"\busedFrom\x18\x02 \x01(\x03R\busedFrom\x12\x16\n" +
+	"\x06usedTo\x18\x03 \x01(\x03R\x06usedTo\"y\n" +
+	"\x1aGetUsageByPaymentIdRequest\x12'\n" +
+	"\tpaymentId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\tpaymentId\x12\x1a\n" +
+	"\busedFrom\x18\x02 \x01(\x03R\busedFrom\x12\x16\n" +
+	"\x06usedTo\x18\x03 \x01(\x03R\x06usedTo\"W\n" +
+	"\x13GetCouponUsageReply\x12@\n" +
+	"\x05usage\x18\x01 \x01(\v2*.platform.marketing_service.v1.CouponUsageR\x05usage\"u\n" +
+	"\x19RebuildCouponStatsRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x03R\x02to\"-\n" +
+	"\x17RebuildCouponStatsReply\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x05R\x04rows\"\xee\x01\n" +
 	"\x1dGetCouponsSummaryStatsRequest\x12\x14\n" +
-	"\x05appId\x18\x01 \x01(\tR\x05appId\"\xf3\x02\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x12\x1d\n" +
+	"\x04topN\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x04topN\x12H\n" +
+	"\x06rankBy\x18\x03 \x01(\tB0\xfaB-r+R\x00R\x04usesR\arevenueR\bdiscountR\x0edistinct_usersR\x06rankBy\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\x03R\x02to\x12*\n" +
+	"\x10exactUniqueUsers\x18\x06 \x01(\bR\x10exactUniqueUsers\"\xf6\x05\n" +
 	"\x1bGetCouponsSummaryStatsReply\x12\"\n" +
 	"\ftotalCoupons\x18\x01 \x01(\x05R\ftotalCoupons\x12$\n" +
 	"\ractiveCoupons\x18\x02 \x01(\x05R\ractiveCoupons\x12\x1c\n" +
@@ -1781,7 +11953,16 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\x15averageConversionRate\x18\a \x01(\x02R\x15averageConversionRate\x12J\n" +
 	"\n" +
 	"topCoupons\x18\b \x03(\v2*.platform.marketing_service.v1.CouponStatsR\n" +
-	"topCoupons\"\xdf\x01\n" +
+	"topCoupons\x128\n" +
+	"\x17totalValidationAttempts\x18\t \x01(\x05R\x17totalValidationAttempts\x12.\n" +
+	"\x12totalValidAttempts\x18\n" +
+	" \x01(\x05R\x12totalValidAttempts\x12&\n" +
+	"\x0econversionRate\x18\v \x01(\x02R\x0econversionRate\x12\x1c\n" +
+	"\tvalidRate\x18\f \x01(\x02R\tvalidRate\x12&\n" +
+	"\x0eredemptionRate\x18\r \x01(\x02R\x0eredemptionRate\x12[\n" +
+	"\x11amountsByCurrency\x18\x0e \x03(\v2-.platform.marketing_service.v1.CurrencyAmountR\x11amountsByCurrency\x12 \n" +
+	"\vuniqueUsers\x18\x0f \x01(\x03R\vuniqueUsers\x12*\n" +
+	"\x10uniqueUsersExact\x18\x10 \x01(\bR\x10uniqueUsersExact\"\xaa\x04\n" +
 	"\vCouponStats\x12\x1e\n" +
 	"\n" +
 	"couponCode\x18\x01 \x01(\tR\n" +
@@ -1790,18 +11971,125 @@ const file_marketing_service_v1_marketing_proto_rawDesc = "" +
 	"\vtotalOrders\x18\x03 \x01(\x05R\vtotalOrders\x12\"\n" +
 	"\ftotalRevenue\x18\x04 \x01(\x03R\ftotalRevenue\x12$\n" +
 	"\rtotalDiscount\x18\x05 \x01(\x03R\rtotalDiscount\x12&\n" +
-	"\x0econversionRate\x18\x06 \x01(\x02R\x0econversionRate2\xe3\f\n" +
+	"\x0econversionRate\x18\x06 \x01(\x02R\x0econversionRate\x12.\n" +
+	"\x12validationAttempts\x18\a \x01(\x05R\x12validationAttempts\x12$\n" +
+	"\rvalidAttempts\x18\b \x01(\x05R\rvalidAttempts\x12\x1c\n" +
+	"\tvalidRate\x18\t \x01(\x02R\tvalidRate\x12&\n" +
+	"\x0eredemptionRate\x18\n" +
+	" \x01(\x02R\x0eredemptionRate\x12*\n" +
+	"\x10quotaUtilization\x18\v \x01(\x02R\x10quotaUtilization\x12[\n" +
+	"\x11amountsByCurrency\x18\f \x03(\v2-.platform.marketing_service.v1.CurrencyAmountR\x11amountsByCurrency\x12$\n" +
+	"\rdistinctUsers\x18\r \x01(\x05R\rdistinctUsers\"\xcc\x01\n" +
+	"\x18ExportCouponUsagesFilter\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x01 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12&\n" +
+	"\x0epaymentOrderId\x18\x03 \x01(\tR\x0epaymentOrderId\x12\x1c\n" +
+	"\tpaymentId\x18\x04 \x01(\tR\tpaymentId\x12\x1a\n" +
+	"\busedFrom\x18\x05 \x01(\x03R\busedFrom\x12\x16\n" +
+	"\x06usedTo\x18\x06 \x01(\x03R\x06usedTo\"\x93\x02\n" +
+	"\tExportJob\x12\x14\n" +
+	"\x05jobId\x18\x01 \x01(\tR\x05jobId\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04rows\x18\x05 \x01(\x03R\x04rows\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1a\n" +
+	"\bfileName\x18\a \x01(\tR\bfileName\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\x03R\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"finishedAt\x18\t \x01(\x03R\n" +
+	"finishedAt\x12 \n" +
+	"\vdownloadUrl\x18\n" +
+	" \x01(\tR\vdownloadUrl\"\xc5\x02\n" +
+	"\x16CreateExportJobRequest\x12\x14\n" +
+	"\x05appId\x18\x01 \x01(\tR\x05appId\x129\n" +
+	"\bresource\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x18R\acouponsR\rcoupon_usagesR\bresource\x12(\n" +
+	"\x06format\x18\x03 \x01(\tB\x10\xfaB\rr\vR\x03csvR\x04xlsxR\x06format\x12U\n" +
+	"\fcouponFilter\x18\x04 \x01(\v21.platform.marketing_service.v1.ListCouponsRequestR\fcouponFilter\x12Y\n" +
+	"\vusageFilter\x18\x05 \x01(\v27.platform.marketing_service.v1.ExportCouponUsagesFilterR\vusageFilter\"R\n" +
+	"\x14CreateExportJobReply\x12:\n" +
+	"\x03job\x18\x01 \x01(\v2(.platform.marketing_service.v1.ExportJobR\x03job\"4\n" +
+	"\x13GetExportJobRequest\x12\x1d\n" +
+	"\x05jobId\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05jobId\"O\n" +
+	"\x11GetExportJobReply\x12:\n" +
+	"\x03job\x18\x01 \x01(\v2(.platform.marketing_service.v1.ExportJobR\x03job2\xf5[\n" +
 	"\tMarketing\x12\x96\x01\n" +
 	"\fCreateCoupon\x122.platform.marketing_service.v1.CreateCouponRequest\x1a0.platform.marketing_service.v1.CreateCouponReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/marketing/v1/coupons\x12\x97\x01\n" +
 	"\tGetCoupon\x12/.platform.marketing_service.v1.GetCouponRequest\x1a-.platform.marketing_service.v1.GetCouponReply\"*\x82\xd3\xe4\x93\x02$\x12\"/marketing/v1/coupons/{couponCode}\x12\x90\x01\n" +
 	"\vListCoupons\x121.platform.marketing_service.v1.ListCouponsRequest\x1a/.platform.marketing_service.v1.ListCouponsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/marketing/v1/coupons\x12\xa3\x01\n" +
 	"\fUpdateCoupon\x122.platform.marketing_service.v1.UpdateCouponRequest\x1a0.platform.marketing_service.v1.UpdateCouponReply\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/marketing/v1/coupons/{couponCode}\x12\x86\x01\n" +
-	"\fDeleteCoupon\x122.platform.marketing_service.v1.DeleteCouponRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/marketing/v1/coupons/{couponCode}\x12\xa5\x01\n" +
+	"\fDeleteCoupon\x122.platform.marketing_service.v1.DeleteCouponRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/marketing/v1/coupons/{couponCode}\x12\xa0\x01\n" +
+	"\rImportCoupons\x123.platform.marketing_service.v1.ImportCouponsRequest\x1a1.platform.marketing_service.v1.ImportCouponsReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/marketing/v1/coupons/import\x12\xc0\x01\n" +
+	"\x17BatchUpdateCouponStatus\x12=.platform.marketing_service.v1.BatchUpdateCouponStatusRequest\x1a0.platform.marketing_service.v1.BatchCouponsReply\"4\x82\xd3\xe4\x93\x02.:\x01*\")/marketing/v1/coupons/batch-update-status\x12\xaf\x01\n" +
+	"\x12BatchDeleteCoupons\x128.platform.marketing_service.v1.BatchDeleteCouponsRequest\x1a0.platform.marketing_service.v1.BatchCouponsReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/marketing/v1/coupons/batch-delete\x12\xa5\x01\n" +
+	"\vIssueCoupon\x121.platform.marketing_service.v1.IssueCouponRequest\x1a..platform.marketing_service.v1.UserCouponReply\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/marketing/v1/coupons/{couponCode}/issue\x12\xa5\x01\n" +
+	"\vClaimCoupon\x121.platform.marketing_service.v1.ClaimCouponRequest\x1a..platform.marketing_service.v1.UserCouponReply\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/marketing/v1/coupons/{couponCode}/claim\x12\xab\x01\n" +
+	"\x0fListUserCoupons\x125.platform.marketing_service.v1.ListUserCouponsRequest\x1a3.platform.marketing_service.v1.ListUserCouponsReply\",\x82\xd3\xe4\x93\x02&\x12$/marketing/v1/users/{userId}/coupons\x12\xa7\x01\n" +
+	"\rGetUserCoupon\x123.platform.marketing_service.v1.GetUserCouponRequest\x1a..platform.marketing_service.v1.UserCouponReply\"1\x82\xd3\xe4\x93\x02+\x12)/marketing/v1/user-coupons/{userCouponId}\x12\xa7\x01\n" +
+	"\vCloneCoupon\x121.platform.marketing_service.v1.CloneCouponRequest\x1a0.platform.marketing_service.v1.CreateCouponReply\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/marketing/v1/coupons/{couponCode}/clone\x12\xb1\x01\n" +
+	"\x14CreateCouponTemplate\x12:.platform.marketing_service.v1.CreateCouponTemplateRequest\x1a2.platform.marketing_service.v1.CouponTemplateReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/marketing/v1/coupon-templates\x12\xb5\x01\n" +
+	"\x11GetCouponTemplate\x127.platform.marketing_service.v1.GetCouponTemplateRequest\x1a2.platform.marketing_service.v1.CouponTemplateReply\"3\x82\xd3\xe4\x93\x02-\x12+/marketing/v1/coupon-templates/{templateId}\x12\xb1\x01\n" +
+	"\x13ListCouponTemplates\x129.platform.marketing_service.v1.ListCouponTemplatesRequest\x1a7.platform.marketing_service.v1.ListCouponTemplatesReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/marketing/v1/coupon-templates\x12\xbe\x01\n" +
+	"\x14UpdateCouponTemplate\x12:.platform.marketing_service.v1.UpdateCouponTemplateRequest\x1a2.platform.marketing_service.v1.CouponTemplateReply\"6\x82\xd3\xe4\x93\x020:\x01*\x1a+/marketing/v1/coupon-templates/{templateId}\x12\x9f\x01\n" +
+	"\x14DeleteCouponTemplate\x12:.platform.marketing_service.v1.DeleteCouponTemplateRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-*+/marketing/v1/coupon-templates/{templateId}\x12\xcc\x01\n" +
+	"\x18CreateCouponFromTemplate\x12>.platform.marketing_service.v1.CreateCouponFromTemplateRequest\x1a0.platform.marketing_service.v1.CreateCouponReply\">\x82\xd3\xe4\x93\x028:\x01*\"3/marketing/v1/coupon-templates/{templateId}/coupons\x12\x98\x01\n" +
+	"\x0eCreateAudience\x124.platform.marketing_service.v1.CreateAudienceRequest\x1a,.platform.marketing_service.v1.AudienceReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/marketing/v1/audiences\x12\x9c\x01\n" +
+	"\vGetAudience\x121.platform.marketing_service.v1.GetAudienceRequest\x1a,.platform.marketing_service.v1.AudienceReply\",\x82\xd3\xe4\x93\x02&\x12$/marketing/v1/audiences/{audienceId}\x12\x98\x01\n" +
+	"\rListAudiences\x123.platform.marketing_service.v1.ListAudiencesRequest\x1a1.platform.marketing_service.v1.ListAudiencesReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/marketing/v1/audiences\x12\xa5\x01\n" +
+	"\x0eUpdateAudience\x124.platform.marketing_service.v1.UpdateAudienceRequest\x1a,.platform.marketing_service.v1.AudienceReply\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/marketing/v1/audiences/{audienceId}\x12\x8c\x01\n" +
+	"\x0eDeleteAudience\x124.platform.marketing_service.v1.DeleteAudienceRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&*$/marketing/v1/audiences/{audienceId}\x12\xc8\x01\n" +
+	"\x15UploadAudienceMembers\x12;.platform.marketing_service.v1.UploadAudienceMembersRequest\x1a9.platform.marketing_service.v1.UploadAudienceMembersReply\"7\x82\xd3\xe4\x93\x021:\x01*\",/marketing/v1/audiences/{audienceId}/members\x12\xae\x01\n" +
+	"\rCheckAudience\x123.platform.marketing_service.v1.CheckAudienceRequest\x1a1.platform.marketing_service.v1.CheckAudienceReply\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/marketing/v1/audiences/{audienceId}/check\x12\x98\x01\n" +
+	"\x0eCreateCampaign\x124.platform.marketing_service.v1.CreateCampaignRequest\x1a,.platform.marketing_service.v1.CampaignReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/marketing/v1/campaigns\x12\x9c\x01\n" +
+	"\vGetCampaign\x121.platform.marketing_service.v1.GetCampaignRequest\x1a,.platform.marketing_service.v1.CampaignReply\",\x82\xd3\xe4\x93\x02&\x12$/marketing/v1/campaigns/{campaignId}\x12\x98\x01\n" +
+	"\rListCampaigns\x123.platform.marketing_service.v1.ListCampaignsRequest\x1a1.platform.marketing_service.v1.ListCampaignsReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/marketing/v1/campaigns\x12\xa5\x01\n" +
+	"\x0eUpdateCampaign\x124.platform.marketing_service.v1.UpdateCampaignRequest\x1a,.platform.marketing_service.v1.CampaignReply\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/marketing/v1/campaigns/{campaignId}\x12\x8c\x01\n" +
+	"\x0eDeleteCampaign\x124.platform.marketing_service.v1.DeleteCampaignRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&*$/marketing/v1/campaigns/{campaignId}\x12\xaf\x01\n" +
+	"\x0fPublishCampaign\x125.platform.marketing_service.v1.PublishCampaignRequest\x1a,.platform.marketing_service.v1.CampaignReply\"7\x82\xd3\xe4\x93\x021:\x01*\",/marketing/v1/campaigns/{campaignId}/publish\x12\xa9\x01\n" +
+	"\rPauseCampaign\x123.platform.marketing_service.v1.PauseCampaignRequest\x1a,.platform.marketing_service.v1.CampaignReply\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/marketing/v1/campaigns/{campaignId}/pause\x12\xa3\x01\n" +
+	"\vEndCampaign\x121.platform.marketing_service.v1.EndCampaignRequest\x1a,.platform.marketing_service.v1.CampaignReply\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/marketing/v1/campaigns/{campaignId}/end\x12\xb0\x01\n" +
+	"\x11CreateRedeemCodes\x127.platform.marketing_service.v1.CreateRedeemCodesRequest\x1a5.platform.marketing_service.v1.CreateRedeemCodesReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /marketing/v1/redeem-codes/batch\x12\x9f\x01\n" +
+	"\rGetRedeemCode\x123.platform.marketing_service.v1.GetRedeemCodeRequest\x1a..platform.marketing_service.v1.RedeemCodeReply\")\x82\xd3\xe4\x93\x02#\x12!/marketing/v1/redeem-codes/{code}\x12\xa1\x01\n" +
+	"\x0fListRedeemCodes\x125.platform.marketing_service.v1.ListRedeemCodesRequest\x1a3.platform.marketing_service.v1.ListRedeemCodesReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/marketing/v1/redeem-codes\x12\xad\x01\n" +
+	"\n" +
+	"RedeemCode\x120.platform.marketing_service.v1.RedeemCodeRequest\x1a8.platform.marketing_service.v1.RedeemCodeRedemptionReply\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/marketing/v1/redeem-codes/{code}/redeem\x12\xaf\x01\n" +
+	"\x10RevokeRedeemCode\x126.platform.marketing_service.v1.RevokeRedeemCodeRequest\x1a..platform.marketing_service.v1.RedeemCodeReply\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/marketing/v1/redeem-codes/{code}/revoke\x12\xca\x01\n" +
+	"\x19ListRedeemCodeRedemptions\x12?.platform.marketing_service.v1.ListRedeemCodeRedemptionsRequest\x1a=.platform.marketing_service.v1.ListRedeemCodeRedemptionsReply\"-\x82\xd3\xe4\x93\x02'\x12%/marketing/v1/redeem-code-redemptions\x12\xc6\x01\n" +
+	"\x14RetryRedeemCodeGrant\x12:.platform.marketing_service.v1.RetryRedeemCodeGrantRequest\x1a8.platform.marketing_service.v1.RedeemCodeRedemptionReply\"8\x82\xd3\xe4\x93\x022:\x01*\"-/marketing/v1/redeem-codes/{code}/retry-grant\x12\x90\x01\n" +
+	"\fCreateReward\x122.platform.marketing_service.v1.CreateRewardRequest\x1a*.platform.marketing_service.v1.RewardReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/marketing/v1/rewards\x12\x92\x01\n" +
+	"\tGetReward\x12/.platform.marketing_service.v1.GetRewardRequest\x1a*.platform.marketing_service.v1.RewardReply\"(\x82\xd3\xe4\x93\x02\"\x12 /marketing/v1/rewards/{rewardId}\x12\x90\x01\n" +
+	"\vListRewards\x121.platform.marketing_service.v1.ListRewardsRequest\x1a/.platform.marketing_service.v1.ListRewardsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/marketing/v1/rewards\x12\x9b\x01\n" +
+	"\fUpdateReward\x122.platform.marketing_service.v1.UpdateRewardRequest\x1a*.platform.marketing_service.v1.RewardReply\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /marketing/v1/rewards/{rewardId}\x12\x84\x01\n" +
+	"\fDeleteReward\x122.platform.marketing_service.v1.DeleteRewardRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\"* /marketing/v1/rewards/{rewardId}\x12\xa4\x01\n" +
+	"\vIssueReward\x121.platform.marketing_service.v1.IssueRewardRequest\x1a/.platform.marketing_service.v1.RewardGrantReply\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/marketing/v1/rewards/{rewardId}/issue\x12\xa6\x01\n" +
+	"\x0eGetRewardGrant\x124.platform.marketing_service.v1.GetRewardGrantRequest\x1a/.platform.marketing_service.v1.RewardGrantReply\"-\x82\xd3\xe4\x93\x02'\x12%/marketing/v1/reward-grants/{grantId}\x12\xa5\x01\n" +
+	"\x10ListRewardGrants\x126.platform.marketing_service.v1.ListRewardGrantsRequest\x1a4.platform.marketing_service.v1.ListRewardGrantsReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/marketing/v1/reward-grants\x12\xc2\x01\n" +
+	"\x15DistributeRewardGrant\x12;.platform.marketing_service.v1.DistributeRewardGrantRequest\x1a/.platform.marketing_service.v1.RewardGrantReply\";\x82\xd3\xe4\x93\x025:\x01*\"0/marketing/v1/reward-grants/{grantId}/distribute\x12\xad\x01\n" +
+	"\x0eUseRewardGrant\x124.platform.marketing_service.v1.UseRewardGrantRequest\x1a/.platform.marketing_service.v1.RewardGrantReply\"4\x82\xd3\xe4\x93\x02.:\x01*\")/marketing/v1/reward-grants/{grantId}/use\x12\x88\x01\n" +
+	"\n" +
+	"CreateTask\x120.platform.marketing_service.v1.CreateTaskRequest\x1a(.platform.marketing_service.v1.TaskReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/marketing/v1/tasks\x12\x88\x01\n" +
+	"\aGetTask\x12-.platform.marketing_service.v1.GetTaskRequest\x1a(.platform.marketing_service.v1.TaskReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/marketing/v1/tasks/{taskId}\x12\x88\x01\n" +
+	"\tListTasks\x12/.platform.marketing_service.v1.ListTasksRequest\x1a-.platform.marketing_service.v1.ListTasksReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/marketing/v1/tasks\x12\x91\x01\n" +
+	"\n" +
+	"UpdateTask\x120.platform.marketing_service.v1.UpdateTaskRequest\x1a(.platform.marketing_service.v1.TaskReply\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/marketing/v1/tasks/{taskId}\x12|\n" +
+	"\n" +
+	"DeleteTask\x120.platform.marketing_service.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/marketing/v1/tasks/{taskId}\x12\xa5\x01\n" +
+	"\x10ListTaskProgress\x126.platform.marketing_service.v1.ListTaskProgressRequest\x1a4.platform.marketing_service.v1.ListTaskProgressReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/marketing/v1/task-progress\x12\x95\x01\n" +
+	"\fTriggerEvent\x122.platform.marketing_service.v1.TriggerEventRequest\x1a0.platform.marketing_service.v1.TriggerEventReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/marketing/v1/events\x12\xa5\x01\n" +
 	"\x0eValidateCoupon\x124.platform.marketing_service.v1.ValidateCouponRequest\x1a2.platform.marketing_service.v1.ValidateCouponReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/marketing/v1/coupons/validate\x12\x91\x01\n" +
 	"\tUseCoupon\x12/.platform.marketing_service.v1.UseCouponRequest\x1a-.platform.marketing_service.v1.UseCouponReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/marketing/v1/coupons/use\x12\xac\x01\n" +
 	"\x0eGetCouponStats\x124.platform.marketing_service.v1.GetCouponStatsRequest\x1a2.platform.marketing_service.v1.GetCouponStatsReply\"0\x82\xd3\xe4\x93\x02*\x12(/marketing/v1/coupons/{couponCode}/stats\x12\xb3\x01\n" +
 	"\x10ListCouponUsages\x126.platform.marketing_service.v1.ListCouponUsagesRequest\x1a4.platform.marketing_service.v1.ListCouponUsagesReply\"1\x82\xd3\xe4\x93\x02+\x12)/marketing/v1/coupons/{couponCode}/usages\x12\xbf\x01\n" +
-	"\x16GetCouponsSummaryStats\x12<.platform.marketing_service.v1.GetCouponsSummaryStatsRequest\x1a:.platform.marketing_service.v1.GetCouponsSummaryStatsReply\"+\x82\xd3\xe4\x93\x02%\x12#/marketing/v1/coupons/summary-statsB/Z-marketing-service/api/marketing_service/v1;v1b\x06proto3"
+	"\x16GetCouponsSummaryStats\x12<.platform.marketing_service.v1.GetCouponsSummaryStatsRequest\x1a:.platform.marketing_service.v1.GetCouponsSummaryStatsReply\"+\x82\xd3\xe4\x93\x02%\x12#/marketing/v1/coupons/summary-stats\x12\xc8\x01\n" +
+	"\x18GetCouponUsageTimeSeries\x12>.platform.marketing_service.v1.GetCouponUsageTimeSeriesRequest\x1a<.platform.marketing_service.v1.GetCouponUsageTimeSeriesReply\".\x82\xd3\xe4\x93\x02(\x12&/marketing/v1/coupon-stats/time-series\x12\xb4\x01\n" +
+	"\x10ListUsagesByUser\x126.platform.marketing_service.v1.ListUsagesByUserRequest\x1a4.platform.marketing_service.v1.ListCouponUsagesReply\"2\x82\xd3\xe4\x93\x02,\x12*/marketing/v1/users/{userId}/coupon-usages\x12\xc7\x01\n" +
+	"\x16GetUsageByPaymentOrder\x12<.platform.marketing_service.v1.GetUsageByPaymentOrderRequest\x1a2.platform.marketing_service.v1.GetCouponUsageReply\";\x82\xd3\xe4\x93\x025\x123/marketing/v1/coupon-usages/orders/{paymentOrderId}\x12\xbe\x01\n" +
+	"\x13GetUsageByPaymentId\x129.platform.marketing_service.v1.GetUsageByPaymentIdRequest\x1a2.platform.marketing_service.v1.GetCouponUsageReply\"8\x82\xd3\xe4\x93\x022\x120/marketing/v1/coupon-usages/payments/{paymentId}\x12\xb5\x01\n" +
+	"\x12RebuildCouponStats\x128.platform.marketing_service.v1.RebuildCouponStatsRequest\x1a6.platform.marketing_service.v1.RebuildCouponStatsReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/marketing/v1/coupon-stats/rebuild\x12\xa3\x01\n" +
+	"\x0fCreateExportJob\x125.platform.marketing_service.v1.CreateExportJobRequest\x1a3.platform.marketing_service.v1.CreateExportJobReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/marketing/v1/export-jobs\x12\x9f\x01\n" +
+	"\fGetExportJob\x122.platform.marketing_service.v1.GetExportJobRequest\x1a0.platform.marketing_service.v1.GetExportJobReply\")\x82\xd3\xe4\x93\x02#\x12!/marketing/v1/export-jobs/{jobId}B/Z-marketing-service/api/marketing_service/v1;v1b\x06proto3"
 
 var (
 	file_marketing_service_v1_marketing_proto_rawDescOnce sync.Once
@@ -1815,65 +12103,380 @@ func file_marketing_service_v1_marketing_proto_rawDescGZIP() []byte {
 	return file_marketing_service_v1_marketing_proto_rawDescData
 }
 
-var file_marketing_service_v1_marketing_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_marketing_service_v1_marketing_proto_msgTypes = make([]protoimpl.MessageInfo, 146)
 var file_marketing_service_v1_marketing_proto_goTypes = []any{
-	(*Coupon)(nil),                        // 0: platform.marketing_service.v1.Coupon
-	(*CreateCouponRequest)(nil),           // 1: platform.marketing_service.v1.CreateCouponRequest
-	(*CreateCouponReply)(nil),             // 2: platform.marketing_service.v1.CreateCouponReply
-	(*GetCouponRequest)(nil),              // 3: platform.marketing_service.v1.GetCouponRequest
-	(*GetCouponReply)(nil),                // 4: platform.marketing_service.v1.GetCouponReply
-	(*ListCouponsRequest)(nil),            // 5: platform.marketing_service.v1.ListCouponsRequest
-	(*ListCouponsReply)(nil),              // 6: platform.marketing_service.v1.ListCouponsReply
-	(*UpdateCouponRequest)(nil),           // 7: platform.marketing_service.v1.UpdateCouponRequest
-	(*UpdateCouponReply)(nil),             // 8: platform.marketing_service.v1.UpdateCouponReply
-	(*DeleteCouponRequest)(nil),           // 9: platform.marketing_service.v1.DeleteCouponRequest
-	(*ValidateCouponRequest)(nil),         // 10: platform.marketing_service.v1.ValidateCouponRequest
-	(*ValidateCouponReply)(nil),           // 11: platform.marketing_service.v1.ValidateCouponReply
-	(*UseCouponRequest)(nil),              // 12: platform.marketing_service.v1.UseCouponRequest
-	(*UseCouponReply)(nil),                // 13: platform.marketing_service.v1.UseCouponReply
-	(*GetCouponStatsRequest)(nil),         // 14: platform.marketing_service.v1.GetCouponStatsRequest
-	(*GetCouponStatsReply)(nil),           // 15: platform.marketing_service.v1.GetCouponStatsReply
-	(*CouponUsage)(nil),                   // 16: platform.marketing_service.v1.CouponUsage
-	(*ListCouponUsagesRequest)(nil),       // 17: platform.marketing_service.v1.ListCouponUsagesRequest
-	(*ListCouponUsagesReply)(nil),         // 18: platform.marketing_service.v1.ListCouponUsagesReply
-	(*GetCouponsSummaryStatsRequest)(nil), // 19: platform.marketing_service.v1.GetCouponsSummaryStatsRequest
-	(*GetCouponsSummaryStatsReply)(nil),   // 20: platform.marketing_service.v1.GetCouponsSummaryStatsReply
-	(*CouponStats)(nil),                   // 21: platform.marketing_service.v1.CouponStats
-	(*emptypb.Empty)(nil),                 // 22: google.protobuf.Empty
+	(*Coupon)(nil),                           // 0: platform.marketing_service.v1.Coupon
+	(*CouponQuota)(nil),                      // 1: platform.marketing_service.v1.CouponQuota
+	(*CouponTimeWindow)(nil),                 // 2: platform.marketing_service.v1.CouponTimeWindow
+	(*CouponSchedule)(nil),                   // 3: platform.marketing_service.v1.CouponSchedule
+	(*CouponPacing)(nil),                     // 4: platform.marketing_service.v1.CouponPacing
+	(*CreateCouponRequest)(nil),              // 5: platform.marketing_service.v1.CreateCouponRequest
+	(*CreateCouponReply)(nil),                // 6: platform.marketing_service.v1.CreateCouponReply
+	(*GetCouponRequest)(nil),                 // 7: platform.marketing_service.v1.GetCouponRequest
+	(*GetCouponReply)(nil),                   // 8: platform.marketing_service.v1.GetCouponReply
+	(*ListCouponsRequest)(nil),               // 9: platform.marketing_service.v1.ListCouponsRequest
+	(*ListCouponsReply)(nil),                 // 10: platform.marketing_service.v1.ListCouponsReply
+	(*UpdateCouponRequest)(nil),              // 11: platform.marketing_service.v1.UpdateCouponRequest
+	(*UpdateCouponReply)(nil),                // 12: platform.marketing_service.v1.UpdateCouponReply
+	(*DeleteCouponRequest)(nil),              // 13: platform.marketing_service.v1.DeleteCouponRequest
+	(*ImportCouponsRequest)(nil),             // 14: platform.marketing_service.v1.ImportCouponsRequest
+	(*ImportCouponRowResult)(nil),            // 15: platform.marketing_service.v1.ImportCouponRowResult
+	(*ImportCouponsReply)(nil),               // 16: platform.marketing_service.v1.ImportCouponsReply
+	(*BatchUpdateCouponStatusRequest)(nil),   // 17: platform.marketing_service.v1.BatchUpdateCouponStatusRequest
+	(*BatchDeleteCouponsRequest)(nil),        // 18: platform.marketing_service.v1.BatchDeleteCouponsRequest
+	(*BatchCouponItemResult)(nil),            // 19: platform.marketing_service.v1.BatchCouponItemResult
+	(*BatchCouponsReply)(nil),                // 20: platform.marketing_service.v1.BatchCouponsReply
+	(*UserCoupon)(nil),                       // 21: platform.marketing_service.v1.UserCoupon
+	(*IssueCouponRequest)(nil),               // 22: platform.marketing_service.v1.IssueCouponRequest
+	(*UserCouponReply)(nil),                  // 23: platform.marketing_service.v1.UserCouponReply
+	(*ClaimCouponRequest)(nil),               // 24: platform.marketing_service.v1.ClaimCouponRequest
+	(*ListUserCouponsRequest)(nil),           // 25: platform.marketing_service.v1.ListUserCouponsRequest
+	(*ListUserCouponsReply)(nil),             // 26: platform.marketing_service.v1.ListUserCouponsReply
+	(*GetUserCouponRequest)(nil),             // 27: platform.marketing_service.v1.GetUserCouponRequest
+	(*CloneCouponRequest)(nil),               // 28: platform.marketing_service.v1.CloneCouponRequest
+	(*CouponTemplate)(nil),                   // 29: platform.marketing_service.v1.CouponTemplate
+	(*CreateCouponTemplateRequest)(nil),      // 30: platform.marketing_service.v1.CreateCouponTemplateRequest
+	(*GetCouponTemplateRequest)(nil),         // 31: platform.marketing_service.v1.GetCouponTemplateRequest
+	(*CouponTemplateReply)(nil),              // 32: platform.marketing_service.v1.CouponTemplateReply
+	(*ListCouponTemplatesRequest)(nil),       // 33: platform.marketing_service.v1.ListCouponTemplatesRequest
+	(*ListCouponTemplatesReply)(nil),         // 34: platform.marketing_service.v1.ListCouponTemplatesReply
+	(*UpdateCouponTemplateRequest)(nil),      // 35: platform.marketing_service.v1.UpdateCouponTemplateRequest
+	(*DeleteCouponTemplateRequest)(nil),      // 36: platform.marketing_service.v1.DeleteCouponTemplateRequest
+	(*CreateCouponFromTemplateRequest)(nil),  // 37: platform.marketing_service.v1.CreateCouponFromTemplateRequest
+	(*AudienceCondition)(nil),                // 38: platform.marketing_service.v1.AudienceCondition
+	(*AudienceRule)(nil),                     // 39: platform.marketing_service.v1.AudienceRule
+	(*Audience)(nil),                         // 40: platform.marketing_service.v1.Audience
+	(*CreateAudienceRequest)(nil),            // 41: platform.marketing_service.v1.CreateAudienceRequest
+	(*AudienceReply)(nil),                    // 42: platform.marketing_service.v1.AudienceReply
+	(*GetAudienceRequest)(nil),               // 43: platform.marketing_service.v1.GetAudienceRequest
+	(*ListAudiencesRequest)(nil),             // 44: platform.marketing_service.v1.ListAudiencesRequest
+	(*ListAudiencesReply)(nil),               // 45: platform.marketing_service.v1.ListAudiencesReply
+	(*UpdateAudienceRequest)(nil),            // 46: platform.marketing_service.v1.UpdateAudienceRequest
+	(*DeleteAudienceRequest)(nil),            // 47: platform.marketing_service.v1.DeleteAudienceRequest
+	(*UploadAudienceMembersRequest)(nil),     // 48: platform.marketing_service.v1.UploadAudienceMembersRequest
+	(*UploadAudienceMembersReply)(nil),       // 49: platform.marketing_service.v1.UploadAudienceMembersReply
+	(*CheckAudienceRequest)(nil),             // 50: platform.marketing_service.v1.CheckAudienceRequest
+	(*CheckAudienceReply)(nil),               // 51: platform.marketing_service.v1.CheckAudienceReply
+	(*Campaign)(nil),                         // 52: platform.marketing_service.v1.Campaign
+	(*CreateCampaignRequest)(nil),            // 53: platform.marketing_service.v1.CreateCampaignRequest
+	(*CampaignReply)(nil),                    // 54: platform.marketing_service.v1.CampaignReply
+	(*GetCampaignRequest)(nil),               // 55: platform.marketing_service.v1.GetCampaignRequest
+	(*ListCampaignsRequest)(nil),             // 56: platform.marketing_service.v1.ListCampaignsRequest
+	(*ListCampaignsReply)(nil),               // 57: platform.marketing_service.v1.ListCampaignsReply
+	(*UpdateCampaignRequest)(nil),            // 58: platform.marketing_service.v1.UpdateCampaignRequest
+	(*DeleteCampaignRequest)(nil),            // 59: platform.marketing_service.v1.DeleteCampaignRequest
+	(*PublishCampaignRequest)(nil),           // 60: platform.marketing_service.v1.PublishCampaignRequest
+	(*PauseCampaignRequest)(nil),             // 61: platform.marketing_service.v1.PauseCampaignRequest
+	(*EndCampaignRequest)(nil),               // 62: platform.marketing_service.v1.EndCampaignRequest
+	(*RedeemCode)(nil),                       // 63: platform.marketing_service.v1.RedeemCode
+	(*RedeemCodeRedemption)(nil),             // 64: platform.marketing_service.v1.RedeemCodeRedemption
+	(*CreateRedeemCodesRequest)(nil),         // 65: platform.marketing_service.v1.CreateRedeemCodesRequest
+	(*CreateRedeemCodesReply)(nil),           // 66: platform.marketing_service.v1.CreateRedeemCodesReply
+	(*GetRedeemCodeRequest)(nil),             // 67: platform.marketing_service.v1.GetRedeemCodeRequest
+	(*RedeemCodeReply)(nil),                  // 68: platform.marketing_service.v1.RedeemCodeReply
+	(*ListRedeemCodesRequest)(nil),           // 69: platform.marketing_service.v1.ListRedeemCodesRequest
+	(*ListRedeemCodesReply)(nil),             // 70: platform.marketing_service.v1.ListRedeemCodesReply
+	(*RedeemCodeRequest)(nil),                // 71: platform.marketing_service.v1.RedeemCodeRequest
+	(*RedeemCodeRedemptionReply)(nil),        // 72: platform.marketing_service.v1.RedeemCodeRedemptionReply
+	(*RevokeRedeemCodeRequest)(nil),          // 73: platform.marketing_service.v1.RevokeRedeemCodeRequest
+	(*ListRedeemCodeRedemptionsRequest)(nil), // 74: platform.marketing_service.v1.ListRedeemCodeRedemptionsRequest
+	(*ListRedeemCodeRedemptionsReply)(nil),   // 75: platform.marketing_service.v1.ListRedeemCodeRedemptionsReply
+	(*RetryRedeemCodeGrantRequest)(nil),      // 76: platform.marketing_service.v1.RetryRedeemCodeGrantRequest
+	(*RewardContent)(nil),                    // 77: platform.marketing_service.v1.RewardContent
+	(*RewardGeneratorConfig)(nil),            // 78: platform.marketing_service.v1.RewardGeneratorConfig
+	(*RewardValidatorConfig)(nil),            // 79: platform.marketing_service.v1.RewardValidatorConfig
+	(*RewardDistributorConfig)(nil),          // 80: platform.marketing_service.v1.RewardDistributorConfig
+	(*Reward)(nil),                           // 81: platform.marketing_service.v1.Reward
+	(*CreateRewardRequest)(nil),              // 82: platform.marketing_service.v1.CreateRewardRequest
+	(*RewardReply)(nil),                      // 83: platform.marketing_service.v1.RewardReply
+	(*GetRewardRequest)(nil),                 // 84: platform.marketing_service.v1.GetRewardRequest
+	(*ListRewardsRequest)(nil),               // 85: platform.marketing_service.v1.ListRewardsRequest
+	(*ListRewardsReply)(nil),                 // 86: platform.marketing_service.v1.ListRewardsReply
+	(*UpdateRewardRequest)(nil),              // 87: platform.marketing_service.v1.UpdateRewardRequest
+	(*DeleteRewardRequest)(nil),              // 88: platform.marketing_service.v1.DeleteRewardRequest
+	(*IssueRewardRequest)(nil),               // 89: platform.marketing_service.v1.IssueRewardRequest
+	(*RewardGrant)(nil),                      // 90: platform.marketing_service.v1.RewardGrant
+	(*RewardGrantReply)(nil),                 // 91: platform.marketing_service.v1.RewardGrantReply
+	(*GetRewardGrantRequest)(nil),            // 92: platform.marketing_service.v1.GetRewardGrantRequest
+	(*ListRewardGrantsRequest)(nil),          // 93: platform.marketing_service.v1.ListRewardGrantsRequest
+	(*ListRewardGrantsReply)(nil),            // 94: platform.marketing_service.v1.ListRewardGrantsReply
+	(*DistributeRewardGrantRequest)(nil),     // 95: platform.marketing_service.v1.DistributeRewardGrantRequest
+	(*UseRewardGrantRequest)(nil),            // 96: platform.marketing_service.v1.UseRewardGrantRequest
+	(*TaskTriggerConfig)(nil),                // 97: platform.marketing_service.v1.TaskTriggerConfig
+	(*TaskConditionConfig)(nil),              // 98: platform.marketing_service.v1.TaskConditionConfig
+	(*Task)(nil),                             // 99: platform.marketing_service.v1.Task
+	(*CreateTaskRequest)(nil),                // 100: platform.marketing_service.v1.CreateTaskRequest
+	(*TaskReply)(nil),                        // 101: platform.marketing_service.v1.TaskReply
+	(*GetTaskRequest)(nil),                   // 102: platform.marketing_service.v1.GetTaskRequest
+	(*ListTasksRequest)(nil),                 // 103: platform.marketing_service.v1.ListTasksRequest
+	(*ListTasksReply)(nil),                   // 104: platform.marketing_service.v1.ListTasksReply
+	(*UpdateTaskRequest)(nil),                // 105: platform.marketing_service.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),                // 106: platform.marketing_service.v1.DeleteTaskRequest
+	(*TaskProgress)(nil),                     // 107: platform.marketing_service.v1.TaskProgress
+	(*ListTaskProgressRequest)(nil),          // 108: platform.marketing_service.v1.ListTaskProgressRequest
+	(*ListTaskProgressReply)(nil),            // 109: platform.marketing_service.v1.ListTaskProgressReply
+	(*TriggerEventRequest)(nil),              // 110: platform.marketing_service.v1.TriggerEventRequest
+	(*TaskTriggerResult)(nil),                // 111: platform.marketing_service.v1.TaskTriggerResult
+	(*TriggerEventReply)(nil),                // 112: platform.marketing_service.v1.TriggerEventReply
+	(*ValidateCouponRequest)(nil),            // 113: platform.marketing_service.v1.ValidateCouponRequest
+	(*ValidateCouponReply)(nil),              // 114: platform.marketing_service.v1.ValidateCouponReply
+	(*UseCouponRequest)(nil),                 // 115: platform.marketing_service.v1.UseCouponRequest
+	(*UseCouponReply)(nil),                   // 116: platform.marketing_service.v1.UseCouponReply
+	(*GetCouponStatsRequest)(nil),            // 117: platform.marketing_service.v1.GetCouponStatsRequest
+	(*GetCouponStatsReply)(nil),              // 118: platform.marketing_service.v1.GetCouponStatsReply
+	(*CurrencyAmount)(nil),                   // 119: platform.marketing_service.v1.CurrencyAmount
+	(*CouponUsage)(nil),                      // 120: platform.marketing_service.v1.CouponUsage
+	(*ListCouponUsagesRequest)(nil),          // 121: platform.marketing_service.v1.ListCouponUsagesRequest
+	(*ListCouponUsagesReply)(nil),            // 122: platform.marketing_service.v1.ListCouponUsagesReply
+	(*GetCouponUsageTimeSeriesRequest)(nil),  // 123: platform.marketing_service.v1.GetCouponUsageTimeSeriesRequest
+	(*CouponUsageTimeSeriesPoint)(nil),       // 124: platform.marketing_service.v1.CouponUsageTimeSeriesPoint
+	(*GetCouponUsageTimeSeriesReply)(nil),    // 125: platform.marketing_service.v1.GetCouponUsageTimeSeriesReply
+	(*ListUsagesByUserRequest)(nil),          // 126: platform.marketing_service.v1.ListUsagesByUserRequest
+	(*GetUsageByPaymentOrderRequest)(nil),    // 127: platform.marketing_service.v1.GetUsageByPaymentOrderRequest
+	(*GetUsageByPaymentIdRequest)(nil),       // 128: platform.marketing_service.v1.GetUsageByPaymentIdRequest
+	(*GetCouponUsageReply)(nil),              // 129: platform.marketing_service.v1.GetCouponUsageReply
+	(*RebuildCouponStatsRequest)(nil),        // 130: platform.marketing_service.v1.RebuildCouponStatsRequest
+	(*RebuildCouponStatsReply)(nil),          // 131: platform.marketing_service.v1.RebuildCouponStatsReply
+	(*GetCouponsSummaryStatsRequest)(nil),    // 132: platform.marketing_service.v1.GetCouponsSummaryStatsRequest
+	(*GetCouponsSummaryStatsReply)(nil),      // 133: platform.marketing_service.v1.GetCouponsSummaryStatsReply
+	(*CouponStats)(nil),                      // 134: platform.marketing_service.v1.CouponStats
+	(*ExportCouponUsagesFilter)(nil),         // 135: platform.marketing_service.v1.ExportCouponUsagesFilter
+	(*ExportJob)(nil),                        // 136: platform.marketing_service.v1.ExportJob
+	(*CreateExportJobRequest)(nil),           // 137: platform.marketing_service.v1.CreateExportJobRequest
+	(*CreateExportJobReply)(nil),             // 138: platform.marketing_service.v1.CreateExportJobReply
+	(*GetExportJobRequest)(nil),              // 139: platform.marketing_service.v1.GetExportJobRequest
+	(*GetExportJobReply)(nil),                // 140: platform.marketing_service.v1.GetExportJobReply
+	nil,                                      // 141: platform.marketing_service.v1.CheckAudienceRequest.UserAttributesEntry
+	nil,                                      // 142: platform.marketing_service.v1.IssueRewardRequest.UserAttributesEntry
+	nil,                                      // 143: platform.marketing_service.v1.TaskConditionConfig.AttributesEntry
+	nil,                                      // 144: platform.marketing_service.v1.TriggerEventRequest.AttributesEntry
+	nil,                                      // 145: platform.marketing_service.v1.ValidateCouponRequest.UserAttributesEntry
+	(*emptypb.Empty)(nil),                    // 146: google.protobuf.Empty
 }
 var file_marketing_service_v1_marketing_proto_depIdxs = []int32{
-	0,  // 0: platform.marketing_service.v1.CreateCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
-	0,  // 1: platform.marketing_service.v1.GetCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
-	0,  // 2: platform.marketing_service.v1.ListCouponsReply.coupons:type_name -> platform.marketing_service.v1.Coupon
-	0,  // 3: platform.marketing_service.v1.UpdateCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
-	0,  // 4: platform.marketing_service.v1.ValidateCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
-	16, // 5: platform.marketing_service.v1.ListCouponUsagesReply.usages:type_name -> platform.marketing_service.v1.CouponUsage
-	21, // 6: platform.marketing_service.v1.GetCouponsSummaryStatsReply.topCoupons:type_name -> platform.marketing_service.v1.CouponStats
-	1,  // 7: platform.marketing_service.v1.Marketing.CreateCoupon:input_type -> platform.marketing_service.v1.CreateCouponRequest
-	3,  // 8: platform.marketing_service.v1.Marketing.GetCoupon:input_type -> platform.marketing_service.v1.GetCouponRequest
-	5,  // 9: platform.marketing_service.v1.Marketing.ListCoupons:input_type -> platform.marketing_service.v1.ListCouponsRequest
-	7,  // 10: platform.marketing_service.v1.Marketing.UpdateCoupon:input_type -> platform.marketing_service.v1.UpdateCouponRequest
-	9,  // 11: platform.marketing_service.v1.Marketing.DeleteCoupon:input_type -> platform.marketing_service.v1.DeleteCouponRequest
-	10, // 12: platform.marketing_service.v1.Marketing.ValidateCoupon:input_type -> platform.marketing_service.v1.ValidateCouponRequest
-	12, // 13: platform.marketing_service.v1.Marketing.UseCoupon:input_type -> platform.marketing_service.v1.UseCouponRequest
-	14, // 14: platform.marketing_service.v1.Marketing.GetCouponStats:input_type -> platform.marketing_service.v1.GetCouponStatsRequest
-	17, // 15: platform.marketing_service.v1.Marketing.ListCouponUsages:input_type -> platform.marketing_service.v1.ListCouponUsagesRequest
-	19, // 16: platform.marketing_service.v1.Marketing.GetCouponsSummaryStats:input_type -> platform.marketing_service.v1.GetCouponsSummaryStatsRequest
-	2,  // 17: platform.marketing_service.v1.Marketing.CreateCoupon:output_type -> platform.marketing_service.v1.CreateCouponReply
-	4,  // 18: platform.marketing_service.v1.Marketing.GetCoupon:output_type -> platform.marketing_service.v1.GetCouponReply
-	6,  // 19: platform.marketing_service.v1.Marketing.ListCoupons:output_type -> platform.marketing_service.v1.ListCouponsReply
-	8,  // 20: platform.marketing_service.v1.Marketing.UpdateCoupon:output_type -> platform.marketing_service.v1.UpdateCouponReply
-	22, // 21: platform.marketing_service.v1.Marketing.DeleteCoupon:output_type -> google.protobuf.Empty
-	11, // 22: platform.marketing_service.v1.Marketing.ValidateCoupon:output_type -> platform.marketing_service.v1.ValidateCouponReply
-	13, // 23: platform.marketing_service.v1.Marketing.UseCoupon:output_type -> platform.marketing_service.v1.UseCouponReply
-	15, // 24: platform.marketing_service.v1.Marketing.GetCouponStats:output_type -> platform.marketing_service.v1.GetCouponStatsReply
-	18, // 25: platform.marketing_service.v1.Marketing.ListCouponUsages:output_type -> platform.marketing_service.v1.ListCouponUsagesReply
-	20, // 26: platform.marketing_service.v1.Marketing.GetCouponsSummaryStats:output_type -> platform.marketing_service.v1.GetCouponsSummaryStatsReply
-	17, // [17:27] is the sub-list for method output_type
-	7,  // [7:17] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	3,   // 0: platform.marketing_service.v1.Coupon.schedule:type_name -> platform.marketing_service.v1.CouponSchedule
+	4,   // 1: platform.marketing_service.v1.Coupon.pacing:type_name -> platform.marketing_service.v1.CouponPacing
+	2,   // 2: platform.marketing_service.v1.CouponSchedule.windows:type_name -> platform.marketing_service.v1.CouponTimeWindow
+	3,   // 3: platform.marketing_service.v1.CreateCouponRequest.schedule:type_name -> platform.marketing_service.v1.CouponSchedule
+	4,   // 4: platform.marketing_service.v1.CreateCouponRequest.pacing:type_name -> platform.marketing_service.v1.CouponPacing
+	0,   // 5: platform.marketing_service.v1.CreateCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
+	0,   // 6: platform.marketing_service.v1.GetCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
+	0,   // 7: platform.marketing_service.v1.ListCouponsReply.coupons:type_name -> platform.marketing_service.v1.Coupon
+	3,   // 8: platform.marketing_service.v1.UpdateCouponRequest.schedule:type_name -> platform.marketing_service.v1.CouponSchedule
+	4,   // 9: platform.marketing_service.v1.UpdateCouponRequest.pacing:type_name -> platform.marketing_service.v1.CouponPacing
+	0,   // 10: platform.marketing_service.v1.UpdateCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
+	0,   // 11: platform.marketing_service.v1.ImportCouponRowResult.coupon:type_name -> platform.marketing_service.v1.Coupon
+	15,  // 12: platform.marketing_service.v1.ImportCouponsReply.rows:type_name -> platform.marketing_service.v1.ImportCouponRowResult
+	9,   // 13: platform.marketing_service.v1.BatchUpdateCouponStatusRequest.filter:type_name -> platform.marketing_service.v1.ListCouponsRequest
+	9,   // 14: platform.marketing_service.v1.BatchDeleteCouponsRequest.filter:type_name -> platform.marketing_service.v1.ListCouponsRequest
+	19,  // 15: platform.marketing_service.v1.BatchCouponsReply.items:type_name -> platform.marketing_service.v1.BatchCouponItemResult
+	0,   // 16: platform.marketing_service.v1.UserCoupon.coupon:type_name -> platform.marketing_service.v1.Coupon
+	21,  // 17: platform.marketing_service.v1.UserCouponReply.userCoupon:type_name -> platform.marketing_service.v1.UserCoupon
+	21,  // 18: platform.marketing_service.v1.ListUserCouponsReply.userCoupons:type_name -> platform.marketing_service.v1.UserCoupon
+	29,  // 19: platform.marketing_service.v1.CouponTemplateReply.template:type_name -> platform.marketing_service.v1.CouponTemplate
+	29,  // 20: platform.marketing_service.v1.ListCouponTemplatesReply.templates:type_name -> platform.marketing_service.v1.CouponTemplate
+	38,  // 21: platform.marketing_service.v1.AudienceRule.conditions:type_name -> platform.marketing_service.v1.AudienceCondition
+	39,  // 22: platform.marketing_service.v1.Audience.rule:type_name -> platform.marketing_service.v1.AudienceRule
+	39,  // 23: platform.marketing_service.v1.CreateAudienceRequest.rule:type_name -> platform.marketing_service.v1.AudienceRule
+	40,  // 24: platform.marketing_service.v1.AudienceReply.audience:type_name -> platform.marketing_service.v1.Audience
+	40,  // 25: platform.marketing_service.v1.ListAudiencesReply.audiences:type_name -> platform.marketing_service.v1.Audience
+	39,  // 26: platform.marketing_service.v1.UpdateAudienceRequest.rule:type_name -> platform.marketing_service.v1.AudienceRule
+	141, // 27: platform.marketing_service.v1.CheckAudienceRequest.userAttributes:type_name -> platform.marketing_service.v1.CheckAudienceRequest.UserAttributesEntry
+	52,  // 28: platform.marketing_service.v1.CampaignReply.campaign:type_name -> platform.marketing_service.v1.Campaign
+	52,  // 29: platform.marketing_service.v1.ListCampaignsReply.campaigns:type_name -> platform.marketing_service.v1.Campaign
+	63,  // 30: platform.marketing_service.v1.RedeemCodeReply.redeemCode:type_name -> platform.marketing_service.v1.RedeemCode
+	63,  // 31: platform.marketing_service.v1.ListRedeemCodesReply.redeemCodes:type_name -> platform.marketing_service.v1.RedeemCode
+	64,  // 32: platform.marketing_service.v1.RedeemCodeRedemptionReply.redemption:type_name -> platform.marketing_service.v1.RedeemCodeRedemption
+	64,  // 33: platform.marketing_service.v1.ListRedeemCodeRedemptionsReply.redemptions:type_name -> platform.marketing_service.v1.RedeemCodeRedemption
+	77,  // 34: platform.marketing_service.v1.Reward.content:type_name -> platform.marketing_service.v1.RewardContent
+	78,  // 35: platform.marketing_service.v1.Reward.generatorConfig:type_name -> platform.marketing_service.v1.RewardGeneratorConfig
+	79,  // 36: platform.marketing_service.v1.Reward.validatorConfig:type_name -> platform.marketing_service.v1.RewardValidatorConfig
+	80,  // 37: platform.marketing_service.v1.Reward.distributorConfig:type_name -> platform.marketing_service.v1.RewardDistributorConfig
+	77,  // 38: platform.marketing_service.v1.CreateRewardRequest.content:type_name -> platform.marketing_service.v1.RewardContent
+	78,  // 39: platform.marketing_service.v1.CreateRewardRequest.generatorConfig:type_name -> platform.marketing_service.v1.RewardGeneratorConfig
+	79,  // 40: platform.marketing_service.v1.CreateRewardRequest.validatorConfig:type_name -> platform.marketing_service.v1.RewardValidatorConfig
+	80,  // 41: platform.marketing_service.v1.CreateRewardRequest.distributorConfig:type_name -> platform.marketing_service.v1.RewardDistributorConfig
+	81,  // 42: platform.marketing_service.v1.RewardReply.reward:type_name -> platform.marketing_service.v1.Reward
+	81,  // 43: platform.marketing_service.v1.ListRewardsReply.rewards:type_name -> platform.marketing_service.v1.Reward
+	77,  // 44: platform.marketing_service.v1.UpdateRewardRequest.content:type_name -> platform.marketing_service.v1.RewardContent
+	78,  // 45: platform.marketing_service.v1.UpdateRewardRequest.generatorConfig:type_name -> platform.marketing_service.v1.RewardGeneratorConfig
+	79,  // 46: platform.marketing_service.v1.UpdateRewardRequest.validatorConfig:type_name -> platform.marketing_service.v1.RewardValidatorConfig
+	80,  // 47: platform.marketing_service.v1.UpdateRewardRequest.distributorConfig:type_name -> platform.marketing_service.v1.RewardDistributorConfig
+	142, // 48: platform.marketing_service.v1.IssueRewardRequest.userAttributes:type_name -> platform.marketing_service.v1.IssueRewardRequest.UserAttributesEntry
+	77,  // 49: platform.marketing_service.v1.RewardGrant.contentSnapshot:type_name -> platform.marketing_service.v1.RewardContent
+	90,  // 50: platform.marketing_service.v1.RewardGrantReply.grant:type_name -> platform.marketing_service.v1.RewardGrant
+	90,  // 51: platform.marketing_service.v1.ListRewardGrantsReply.grants:type_name -> platform.marketing_service.v1.RewardGrant
+	143, // 52: platform.marketing_service.v1.TaskConditionConfig.attributes:type_name -> platform.marketing_service.v1.TaskConditionConfig.AttributesEntry
+	97,  // 53: platform.marketing_service.v1.Task.triggerConfig:type_name -> platform.marketing_service.v1.TaskTriggerConfig
+	98,  // 54: platform.marketing_service.v1.Task.conditionConfig:type_name -> platform.marketing_service.v1.TaskConditionConfig
+	97,  // 55: platform.marketing_service.v1.CreateTaskRequest.triggerConfig:type_name -> platform.marketing_service.v1.TaskTriggerConfig
+	98,  // 56: platform.marketing_service.v1.CreateTaskRequest.conditionConfig:type_name -> platform.marketing_service.v1.TaskConditionConfig
+	99,  // 57: platform.marketing_service.v1.TaskReply.task:type_name -> platform.marketing_service.v1.Task
+	99,  // 58: platform.marketing_service.v1.ListTasksReply.tasks:type_name -> platform.marketing_service.v1.Task
+	97,  // 59: platform.marketing_service.v1.UpdateTaskRequest.triggerConfig:type_name -> platform.marketing_service.v1.TaskTriggerConfig
+	98,  // 60: platform.marketing_service.v1.UpdateTaskRequest.conditionConfig:type_name -> platform.marketing_service.v1.TaskConditionConfig
+	107, // 61: platform.marketing_service.v1.ListTaskProgressReply.progress:type_name -> platform.marketing_service.v1.TaskProgress
+	144, // 62: platform.marketing_service.v1.TriggerEventRequest.attributes:type_name -> platform.marketing_service.v1.TriggerEventRequest.AttributesEntry
+	111, // 63: platform.marketing_service.v1.TriggerEventReply.results:type_name -> platform.marketing_service.v1.TaskTriggerResult
+	145, // 64: platform.marketing_service.v1.ValidateCouponRequest.userAttributes:type_name -> platform.marketing_service.v1.ValidateCouponRequest.UserAttributesEntry
+	0,   // 65: platform.marketing_service.v1.ValidateCouponReply.coupon:type_name -> platform.marketing_service.v1.Coupon
+	1,   // 66: platform.marketing_service.v1.ValidateCouponReply.quotas:type_name -> platform.marketing_service.v1.CouponQuota
+	119, // 67: platform.marketing_service.v1.GetCouponStatsReply.amountsByCurrency:type_name -> platform.marketing_service.v1.CurrencyAmount
+	120, // 68: platform.marketing_service.v1.ListCouponUsagesReply.usages:type_name -> platform.marketing_service.v1.CouponUsage
+	124, // 69: platform.marketing_service.v1.GetCouponUsageTimeSeriesReply.points:type_name -> platform.marketing_service.v1.CouponUsageTimeSeriesPoint
+	120, // 70: platform.marketing_service.v1.GetCouponUsageReply.usage:type_name -> platform.marketing_service.v1.CouponUsage
+	134, // 71: platform.marketing_service.v1.GetCouponsSummaryStatsReply.topCoupons:type_name -> platform.marketing_service.v1.CouponStats
+	119, // 72: platform.marketing_service.v1.GetCouponsSummaryStatsReply.amountsByCurrency:type_name -> platform.marketing_service.v1.CurrencyAmount
+	119, // 73: platform.marketing_service.v1.CouponStats.amountsByCurrency:type_name -> platform.marketing_service.v1.CurrencyAmount
+	9,   // 74: platform.marketing_service.v1.CreateExportJobRequest.couponFilter:type_name -> platform.marketing_service.v1.ListCouponsRequest
+	135, // 75: platform.marketing_service.v1.CreateExportJobRequest.usageFilter:type_name -> platform.marketing_service.v1.ExportCouponUsagesFilter
+	136, // 76: platform.marketing_service.v1.CreateExportJobReply.job:type_name -> platform.marketing_service.v1.ExportJob
+	136, // 77: platform.marketing_service.v1.GetExportJobReply.job:type_name -> platform.marketing_service.v1.ExportJob
+	5,   // 78: platform.marketing_service.v1.Marketing.CreateCoupon:input_type -> platform.marketing_service.v1.CreateCouponRequest
+	7,   // 79: platform.marketing_service.v1.Marketing.GetCoupon:input_type -> platform.marketing_service.v1.GetCouponRequest
+	9,   // 80: platform.marketing_service.v1.Marketing.ListCoupons:input_type -> platform.marketing_service.v1.ListCouponsRequest
+	11,  // 81: platform.marketing_service.v1.Marketing.UpdateCoupon:input_type -> platform.marketing_service.v1.UpdateCouponRequest
+	13,  // 82: platform.marketing_service.v1.Marketing.DeleteCoupon:input_type -> platform.marketing_service.v1.DeleteCouponRequest
+	14,  // 83: platform.marketing_service.v1.Marketing.ImportCoupons:input_type -> platform.marketing_service.v1.ImportCouponsRequest
+	17,  // 84: platform.marketing_service.v1.Marketing.BatchUpdateCouponStatus:input_type -> platform.marketing_service.v1.BatchUpdateCouponStatusRequest
+	18,  // 85: platform.marketing_service.v1.Marketing.BatchDeleteCoupons:input_type -> platform.marketing_service.v1.BatchDeleteCouponsRequest
+	22,  // 86: platform.marketing_service.v1.Marketing.IssueCoupon:input_type -> platform.marketing_service.v1.IssueCouponRequest
+	24,  // 87: platform.marketing_service.v1.Marketing.ClaimCoupon:input_type -> platform.marketing_service.v1.ClaimCouponRequest
+	25,  // 88: platform.marketing_service.v1.Marketing.ListUserCoupons:input_type -> platform.marketing_service.v1.ListUserCouponsRequest
+	27,  // 89: platform.marketing_service.v1.Marketing.GetUserCoupon:input_type -> platform.marketing_service.v1.GetUserCouponRequest
+	28,  // 90: platform.marketing_service.v1.Marketing.CloneCoupon:input_type -> platform.marketing_service.v1.CloneCouponRequest
+	30,  // 91: platform.marketing_service.v1.Marketing.CreateCouponTemplate:input_type -> platform.marketing_service.v1.CreateCouponTemplateRequest
+	31,  // 92: platform.marketing_service.v1.Marketing.GetCouponTemplate:input_type -> platform.marketing_service.v1.GetCouponTemplateRequest
+	33,  // 93: platform.marketing_service.v1.Marketing.ListCouponTemplates:input_type -> platform.marketing_service.v1.ListCouponTemplatesRequest
+	35,  // 94: platform.marketing_service.v1.Marketing.UpdateCouponTemplate:input_type -> platform.marketing_service.v1.UpdateCouponTemplateRequest
+	36,  // 95: platform.marketing_service.v1.Marketing.DeleteCouponTemplate:input_type -> platform.marketing_service.v1.DeleteCouponTemplateRequest
+	37,  // 96: platform.marketing_service.v1.Marketing.CreateCouponFromTemplate:input_type -> platform.marketing_service.v1.CreateCouponFromTemplateRequest
+	41,  // 97: platform.marketing_service.v1.Marketing.CreateAudience:input_type -> platform.marketing_service.v1.CreateAudienceRequest
+	43,  // 98: platform.marketing_service.v1.Marketing.GetAudience:input_type -> platform.marketing_service.v1.GetAudienceRequest
+	44,  // 99: platform.marketing_service.v1.Marketing.ListAudiences:input_type -> platform.marketing_service.v1.ListAudiencesRequest
+	46,  // 100: platform.marketing_service.v1.Marketing.UpdateAudience:input_type -> platform.marketing_service.v1.UpdateAudienceRequest
+	47,  // 101: platform.marketing_service.v1.Marketing.DeleteAudience:input_type -> platform.marketing_service.v1.DeleteAudienceRequest
+	48,  // 102: platform.marketing_service.v1.Marketing.UploadAudienceMembers:input_type -> platform.marketing_service.v1.UploadAudienceMembersRequest
+	50,  // 103: platform.marketing_service.v1.Marketing.CheckAudience:input_type -> platform.marketing_service.v1.CheckAudienceRequest
+	53,  // 104: platform.marketing_service.v1.Marketing.CreateCampaign:input_type -> platform.marketing_service.v1.CreateCampaignRequest
+	55,  // 105: platform.marketing_service.v1.Marketing.GetCampaign:input_type -> platform.marketing_service.v1.GetCampaignRequest
+	56,  // 106: platform.marketing_service.v1.Marketing.ListCampaigns:input_type -> platform.marketing_service.v1.ListCampaignsRequest
+	58,  // 107: platform.marketing_service.v1.Marketing.UpdateCampaign:input_type -> platform.marketing_service.v1.UpdateCampaignRequest
+	59,  // 108: platform.marketing_service.v1.Marketing.DeleteCampaign:input_type -> platform.marketing_service.v1.DeleteCampaignRequest
+	60,  // 109: platform.marketing_service.v1.Marketing.PublishCampaign:input_type -> platform.marketing_service.v1.PublishCampaignRequest
+	61,  // 110: platform.marketing_service.v1.Marketing.PauseCampaign:input_type -> platform.marketing_service.v1.PauseCampaignRequest
+	62,  // 111: platform.marketing_service.v1.Marketing.EndCampaign:input_type -> platform.marketing_service.v1.EndCampaignRequest
+	65,  // 112: platform.marketing_service.v1.Marketing.CreateRedeemCodes:input_type -> platform.marketing_service.v1.CreateRedeemCodesRequest
+	67,  // 113: platform.marketing_service.v1.Marketing.GetRedeemCode:input_type -> platform.marketing_service.v1.GetRedeemCodeRequest
+	69,  // 114: platform.marketing_service.v1.Marketing.ListRedeemCodes:input_type -> platform.marketing_service.v1.ListRedeemCodesRequest
+	71,  // 115: platform.marketing_service.v1.Marketing.RedeemCode:input_type -> platform.marketing_service.v1.RedeemCodeRequest
+	73,  // 116: platform.marketing_service.v1.Marketing.RevokeRedeemCode:input_type -> platform.marketing_service.v1.RevokeRedeemCodeRequest
+	74,  // 117: platform.marketing_service.v1.Marketing.ListRedeemCodeRedemptions:input_type -> platform.marketing_service.v1.ListRedeemCodeRedemptionsRequest
+	76,  // 118: platform.marketing_service.v1.Marketing.RetryRedeemCodeGrant:input_type -> platform.marketing_service.v1.RetryRedeemCodeGrantRequest
+	82,  // 119: platform.marketing_service.v1.Marketing.CreateReward:input_type -> platform.marketing_service.v1.CreateRewardRequest
+	84,  // 120: platform.marketing_service.v1.Marketing.GetReward:input_type -> platform.marketing_service.v1.GetRewardRequest
+	85,  // 121: platform.marketing_service.v1.Marketing.ListRewards:input_type -> platform.marketing_service.v1.ListRewardsRequest
+	87,  // 122: platform.marketing_service.v1.Marketing.UpdateReward:input_type -> platform.marketing_service.v1.UpdateRewardRequest
+	88,  // 123: platform.marketing_service.v1.Marketing.DeleteReward:input_type -> platform.marketing_service.v1.DeleteRewardRequest
+	89,  // 124: platform.marketing_service.v1.Marketing.IssueReward:input_type -> platform.marketing_service.v1.IssueRewardRequest
+	92,  // 125: platform.marketing_service.v1.Marketing.GetRewardGrant:input_type -> platform.marketing_service.v1.GetRewardGrantRequest
+	93,  // 126: platform.marketing_service.v1.Marketing.ListRewardGrants:input_type -> platform.marketing_service.v1.ListRewardGrantsRequest
+	95,  // 127: platform.marketing_service.v1.Marketing.DistributeRewardGrant:input_type -> platform.marketing_service.v1.DistributeRewardGrantRequest
+	96,  // 128: platform.marketing_service.v1.Marketing.UseRewardGrant:input_type -> platform.marketing_service.v1.UseRewardGrantRequest
+	100, // 129: platform.marketing_service.v1.Marketing.CreateTask:input_type -> platform.marketing_service.v1.CreateTaskRequest
+	102, // 130: platform.marketing_service.v1.Marketing.GetTask:input_type -> platform.marketing_service.v1.GetTaskRequest
+	103, // 131: platform.marketing_service.v1.Marketing.ListTasks:input_type -> platform.marketing_service.v1.ListTasksRequest
+	105, // 132: platform.marketing_service.v1.Marketing.UpdateTask:input_type -> platform.marketing_service.v1.UpdateTaskRequest
+	106, // 133: platform.marketing_service.v1.Marketing.DeleteTask:input_type -> platform.marketing_service.v1.DeleteTaskRequest
+	108, // 134: platform.marketing_service.v1.Marketing.ListTaskProgress:input_type -> platform.marketing_service.v1.ListTaskProgressRequest
+	110, // 135: platform.marketing_service.v1.Marketing.TriggerEvent:input_type -> platform.marketing_service.v1.TriggerEventRequest
+	113, // 136: platform.marketing_service.v1.Marketing.ValidateCoupon:input_type -> platform.marketing_service.v1.ValidateCouponRequest
+	115, // 137: platform.marketing_service.v1.Marketing.UseCoupon:input_type -> platform.marketing_service.v1.UseCouponRequest
+	117, // 138: platform.marketing_service.v1.Marketing.GetCouponStats:input_type -> platform.marketing_service.v1.GetCouponStatsRequest
+	121, // 139: platform.marketing_service.v1.Marketing.ListCouponUsages:input_type -> platform.marketing_service.v1.ListCouponUsagesRequest
+	132, // 140: platform.marketing_service.v1.Marketing.GetCouponsSummaryStats:input_type -> platform.marketing_service.v1.GetCouponsSummaryStatsRequest
+	123, // 141: platform.marketing_service.v1.Marketing.GetCouponUsageTimeSeries:input_type -> platform.marketing_service.v1.GetCouponUsageTimeSeriesRequest
+	126, // 142: platform.marketing_service.v1.Marketing.ListUsagesByUser:input_type -> platform.marketing_service.v1.ListUsagesByUserRequest
+	127, // 143: platform.marketing_service.v1.Marketing.GetUsageByPaymentOrder:input_type -> platform.marketing_service.v1.GetUsageByPaymentOrderRequest
+	128, // 144: platform.marketing_service.v1.Marketing.GetUsageByPaymentId:input_type -> platform.marketing_service.v1.GetUsageByPaymentIdRequest
+	130, // 145: platform.marketing_service.v1.Marketing.RebuildCouponStats:input_type -> platform.marketing_service.v1.RebuildCouponStatsRequest
+	137, // 146: platform.marketing_service.v1.Marketing.CreateExportJob:input_type -> platform.marketing_service.v1.CreateExportJobRequest
+	139, // 147: platform.marketing_service.v1.Marketing.GetExportJob:input_type -> platform.marketing_service.v1.GetExportJobRequest
+	6,   // 148: platform.marketing_service.v1.Marketing.CreateCoupon:output_type -> platform.marketing_service.v1.CreateCouponReply
+	8,   // 149: platform.marketing_service.v1.Marketing.GetCoupon:output_type -> platform.marketing_service.v1.GetCouponReply
+	10,  // 150: platform.marketing_service.v1.Marketing.ListCoupons:output_type -> platform.marketing_service.v1.ListCouponsReply
+	12,  // 151: platform.marketing_service.v1.Marketing.UpdateCoupon:output_type -> platform.marketing_service.v1.UpdateCouponReply
+	146, // 152: platform.marketing_service.v1.Marketing.DeleteCoupon:output_type -> google.protobuf.Empty
+	16,  // 153: platform.marketing_service.v1.Marketing.ImportCoupons:output_type -> platform.marketing_service.v1.ImportCouponsReply
+	20,  // 154: platform.marketing_service.v1.Marketing.BatchUpdateCouponStatus:output_type -> platform.marketing_service.v1.BatchCouponsReply
+	20,  // 155: platform.marketing_service.v1.Marketing.BatchDeleteCoupons:output_type -> platform.marketing_service.v1.BatchCouponsReply
+	23,  // 156: platform.marketing_service.v1.Marketing.IssueCoupon:output_type -> platform.marketing_service.v1.UserCouponReply
+	23,  // 157: platform.marketing_service.v1.Marketing.ClaimCoupon:output_type -> platform.marketing_service.v1.UserCouponReply
+	26,  // 158: platform.marketing_service.v1.Marketing.ListUserCoupons:output_type -> platform.marketing_service.v1.ListUserCouponsReply
+	23,  // 159: platform.marketing_service.v1.Marketing.GetUserCoupon:output_type -> platform.marketing_service.v1.UserCouponReply
+	6,   // 160: platform.marketing_service.v1.Marketing.CloneCoupon:output_type -> platform.marketing_service.v1.CreateCouponReply
+	32,  // 161: platform.marketing_service.v1.Marketing.CreateCouponTemplate:output_type -> platform.marketing_service.v1.CouponTemplateReply
+	32,  // 162: platform.marketing_service.v1.Marketing.GetCouponTemplate:output_type -> platform.marketing_service.v1.CouponTemplateReply
+	34,  // 163: platform.marketing_service.v1.Marketing.ListCouponTemplates:output_type -> platform.marketing_service.v1.ListCouponTemplatesReply
+	32,  // 164: platform.marketing_service.v1.Marketing.UpdateCouponTemplate:output_type -> platform.marketing_service.v1.CouponTemplateReply
+	146, // 165: platform.marketing_service.v1.Marketing.DeleteCouponTemplate:output_type -> google.protobuf.Empty
+	6,   // 166: platform.marketing_service.v1.Marketing.CreateCouponFromTemplate:output_type -> platform.marketing_service.v1.CreateCouponReply
+	42,  // 167: platform.marketing_service.v1.Marketing.CreateAudience:output_type -> platform.marketing_service.v1.AudienceReply
+	42,  // 168: platform.marketing_service.v1.Marketing.GetAudience:output_type -> platform.marketing_service.v1.AudienceReply
+	45,  // 169: platform.marketing_service.v1.Marketing.ListAudiences:output_type -> platform.marketing_service.v1.ListAudiencesReply
+	42,  // 170: platform.marketing_service.v1.Marketing.UpdateAudience:output_type -> platform.marketing_service.v1.AudienceReply
+	146, // 171: platform.marketing_service.v1.Marketing.DeleteAudience:output_type -> google.protobuf.Empty
+	49,  // 172: platform.marketing_service.v1.Marketing.UploadAudienceMembers:output_type -> platform.marketing_service.v1.UploadAudienceMembersReply
+	51,  // 173: platform.marketing_service.v1.Marketing.CheckAudience:output_type -> platform.marketing_service.v1.CheckAudienceReply
+	54,  // 174: platform.marketing_service.v1.Marketing.CreateCampaign:output_type -> platform.marketing_service.v1.CampaignReply
+	54,  // 175: platform.marketing_service.v1.Marketing.GetCampaign:output_type -> platform.marketing_service.v1.CampaignReply
+	57,  // 176: platform.marketing_service.v1.Marketing.ListCampaigns:output_type -> platform.marketing_service.v1.ListCampaignsReply
+	54,  // 177: platform.marketing_service.v1.Marketing.UpdateCampaign:output_type -> platform.marketing_service.v1.CampaignReply
+	146, // 178: platform.marketing_service.v1.Marketing.DeleteCampaign:output_type -> google.protobuf.Empty
+	54,  // 179: platform.marketing_service.v1.Marketing.PublishCampaign:output_type -> platform.marketing_service.v1.CampaignReply
+	54,  // 180: platform.marketing_service.v1.Marketing.PauseCampaign:output_type -> platform.marketing_service.v1.CampaignReply
+	54,  // 181: platform.marketing_service.v1.Marketing.EndCampaign:output_type -> platform.marketing_service.v1.CampaignReply
+	66,  // 182: platform.marketing_service.v1.Marketing.CreateRedeemCodes:output_type -> platform.marketing_service.v1.CreateRedeemCodesReply
+	68,  // 183: platform.marketing_service.v1.Marketing.GetRedeemCode:output_type -> platform.marketing_service.v1.RedeemCodeReply
+	70,  // 184: platform.marketing_service.v1.Marketing.ListRedeemCodes:output_type -> platform.marketing_service.v1.ListRedeemCodesReply
+	72,  // 185: platform.marketing_service.v1.Marketing.RedeemCode:output_type -> platform.marketing_service.v1.RedeemCodeRedemptionReply
+	68,  // 186: platform.marketing_service.v1.Marketing.RevokeRedeemCode:output_type -> platform.marketing_service.v1.RedeemCodeReply
+	75,  // 187: platform.marketing_service.v1.Marketing.ListRedeemCodeRedemptions:output_type -> platform.marketing_service.v1.ListRedeemCodeRedemptionsReply
+	72,  // 188: platform.marketing_service.v1.Marketing.RetryRedeemCodeGrant:output_type -> platform.marketing_service.v1.RedeemCodeRedemptionReply
+	83,  // 189: platform.marketing_service.v1.Marketing.CreateReward:output_type -> platform.marketing_service.v1.RewardReply
+	83,  // 190: platform.marketing_service.v1.Marketing.GetReward:output_type -> platform.marketing_service.v1.RewardReply
+	86,  // 191: platform.marketing_service.v1.Marketing.ListRewards:output_type -> platform.marketing_service.v1.ListRewardsReply
+	83,  // 192: platform.marketing_service.v1.Marketing.UpdateReward:output_type -> platform.marketing_service.v1.RewardReply
+	146, // 193: platform.marketing_service.v1.Marketing.DeleteReward:output_type -> google.protobuf.Empty
+	91,  // 194: platform.marketing_service.v1.Marketing.IssueReward:output_type -> platform.marketing_service.v1.RewardGrantReply
+	91,  // 195: platform.marketing_service.v1.Marketing.GetRewardGrant:output_type -> platform.marketing_service.v1.RewardGrantReply
+	94,  // 196: platform.marketing_service.v1.Marketing.ListRewardGrants:output_type -> platform.marketing_service.v1.ListRewardGrantsReply
+	91,  // 197: platform.marketing_service.v1.Marketing.DistributeRewardGrant:output_type -> platform.marketing_service.v1.RewardGrantReply
+	91,  // 198: platform.marketing_service.v1.Marketing.UseRewardGrant:output_type -> platform.marketing_service.v1.RewardGrantReply
+	101, // 199: platform.marketing_service.v1.Marketing.CreateTask:output_type -> platform.marketing_service.v1.TaskReply
+	101, // 200: platform.marketing_service.v1.Marketing.GetTask:output_type -> platform.marketing_service.v1.TaskReply
+	104, // 201: platform.marketing_service.v1.Marketing.ListTasks:output_type -> platform.marketing_service.v1.ListTasksReply
+	101, // 202: platform.marketing_service.v1.Marketing.UpdateTask:output_type -> platform.marketing_service.v1.TaskReply
+	146, // 203: platform.marketing_service.v1.Marketing.DeleteTask:output_type -> google.protobuf.Empty
+	109, // 204: platform.marketing_service.v1.Marketing.ListTaskProgress:output_type -> platform.marketing_service.v1.ListTaskProgressReply
+	112, // 205: platform.marketing_service.v1.Marketing.TriggerEvent:output_type -> platform.marketing_service.v1.TriggerEventReply
+	114, // 206: platform.marketing_service.v1.Marketing.ValidateCoupon:output_type -> platform.marketing_service.v1.ValidateCouponReply
+	116, // 207: platform.marketing_service.v1.Marketing.UseCoupon:output_type -> platform.marketing_service.v1.UseCouponReply
+	118, // 208: platform.marketing_service.v1.Marketing.GetCouponStats:output_type -> platform.marketing_service.v1.GetCouponStatsReply
+	122, // 209: platform.marketing_service.v1.Marketing.ListCouponUsages:output_type -> platform.marketing_service.v1.ListCouponUsagesReply
+	133, // 210: platform.marketing_service.v1.Marketing.GetCouponsSummaryStats:output_type -> platform.marketing_service.v1.GetCouponsSummaryStatsReply
+	125, // 211: platform.marketing_service.v1.Marketing.GetCouponUsageTimeSeries:output_type -> platform.marketing_service.v1.GetCouponUsageTimeSeriesReply
+	122, // 212: platform.marketing_service.v1.Marketing.ListUsagesByUser:output_type -> platform.marketing_service.v1.ListCouponUsagesReply
+	129, // 213: platform.marketing_service.v1.Marketing.GetUsageByPaymentOrder:output_type -> platform.marketing_service.v1.GetCouponUsageReply
+	129, // 214: platform.marketing_service.v1.Marketing.GetUsageByPaymentId:output_type -> platform.marketing_service.v1.GetCouponUsageReply
+	131, // 215: platform.marketing_service.v1.Marketing.RebuildCouponStats:output_type -> platform.marketing_service.v1.RebuildCouponStatsReply
+	138, // 216: platform.marketing_service.v1.Marketing.CreateExportJob:output_type -> platform.marketing_service.v1.CreateExportJobReply
+	140, // 217: platform.marketing_service.v1.Marketing.GetExportJob:output_type -> platform.marketing_service.v1.GetExportJobReply
+	148, // [148:218] is the sub-list for method output_type
+	78,  // [78:148] is the sub-list for method input_type
+	78,  // [78:78] is the sub-list for extension type_name
+	78,  // [78:78] is the sub-list for extension extendee
+	0,   // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_marketing_service_v1_marketing_proto_init() }
@@ -1881,13 +12484,21 @@ func file_marketing_service_v1_marketing_proto_init() {
 	if File_marketing_service_v1_marketing_proto != nil {
 		return
 	}
+	file_marketing_service_v1_marketing_proto_msgTypes[9].OneofWrappers = []any{}
+	file_marketing_service_v1_marketing_proto_msgTypes[11].OneofWrappers = []any{}
+	file_marketing_service_v1_marketing_proto_msgTypes[35].OneofWrappers = []any{}
+	file_marketing_service_v1_marketing_proto_msgTypes[46].OneofWrappers = []any{}
+	file_marketing_service_v1_marketing_proto_msgTypes[58].OneofWrappers = []any{}
+	file_marketing_service_v1_marketing_proto_msgTypes[87].OneofWrappers = []any{}
+	file_marketing_service_v1_marketing_proto_msgTypes[105].OneofWrappers = []any{}
+	file_marketing_service_v1_marketing_proto_msgTypes[114].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_marketing_service_v1_marketing_proto_rawDesc), len(file_marketing_service_v1_marketing_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   146,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -82,6 +82,94 @@ func (m *Coupon) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for ValidDays
+
+	// no validation rules for ClaimLimit
+
+	// no validation rules for ClaimStock
+
+	// no validation rules for ClaimedCount
+
+	// no validation rules for BoundUserId
+
+	// no validation rules for AudienceId
+
+	// no validation rules for Eligibility
+
+	// no validation rules for Timezone
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CouponValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CouponValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CouponValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UserDailyLimit
+
+	// no validation rules for UserWeeklyLimit
+
+	// no validation rules for UserMonthlyLimit
+
+	// no validation rules for DailyLimit
+
+	// no validation rules for CampaignId
+
+	// no validation rules for Budget
+
+	// no validation rules for BudgetUsed
+
+	if all {
+		switch v := interface{}(m.GetPacing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CouponValidationError{
+					field:  "Pacing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CouponValidationError{
+					field:  "Pacing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPacing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CouponValidationError{
+				field:  "Pacing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CouponMultiError(errors)
 	}
@@ -159,94 +247,51 @@ var _ interface {
 	ErrorName() string
 } = CouponValidationError{}
 
-// Validate checks the field values on CreateCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCouponRequest) Validate() error {
+// Validate checks the field values on CouponQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CouponQuota) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateCouponRequestMultiError, or nil if none found.
-func (m *CreateCouponRequest) ValidateAll() error {
+// ValidateAll checks the field values on CouponQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CouponQuotaMultiError, or
+// nil if none found.
+func (m *CouponQuota) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCouponRequest) validate(all bool) error {
+func (m *CouponQuota) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetCouponCode()); l < 1 || l > 50 {
-		err := CreateCouponRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be between 1 and 50 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _CreateCouponRequest_DiscountType_InLookup[m.GetDiscountType()]; !ok {
-		err := CreateCouponRequestValidationError{
-			field:  "DiscountType",
-			reason: "value must be in list [percent fixed]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetDiscountValue() <= 0 {
-		err := CreateCouponRequestValidationError{
-			field:  "DiscountValue",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Currency
+	// no validation rules for Scope
 
-	// no validation rules for ValidFrom
+	// no validation rules for Limit
 
-	// no validation rules for ValidUntil
+	// no validation rules for Used
 
-	if m.GetMaxUses() <= 0 {
-		err := CreateCouponRequestValidationError{
-			field:  "MaxUses",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Remaining
 
-	// no validation rules for MinAmount
+	// no validation rules for ResetAt
 
 	if len(errors) > 0 {
-		return CreateCouponRequestMultiError(errors)
+		return CouponQuotaMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCouponRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateCouponRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateCouponRequestMultiError []error
+// CouponQuotaMultiError is an error wrapping multiple validation errors
+// returned by CouponQuota.ValidateAll() if the designated constraints aren't met.
+type CouponQuotaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCouponRequestMultiError) Error() string {
+func (m CouponQuotaMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -255,11 +300,11 @@ func (m CreateCouponRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCouponRequestMultiError) AllErrors() []error { return m }
+func (m CouponQuotaMultiError) AllErrors() []error { return m }
 
-// CreateCouponRequestValidationError is the validation error returned by
-// CreateCouponRequest.Validate if the designated constraints aren't met.
-type CreateCouponRequestValidationError struct {
+// CouponQuotaValidationError is the validation error returned by
+// CouponQuota.Validate if the designated constraints aren't met.
+type CouponQuotaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -267,24 +312,22 @@ type CreateCouponRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCouponRequestValidationError) Field() string { return e.field }
+func (e CouponQuotaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCouponRequestValidationError) Reason() string { return e.reason }
+func (e CouponQuotaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCouponRequestValidationError) Cause() error { return e.cause }
+func (e CouponQuotaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCouponRequestValidationError) Key() bool { return e.key }
+func (e CouponQuotaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCouponRequestValidationError) ErrorName() string {
-	return "CreateCouponRequestValidationError"
-}
+func (e CouponQuotaValidationError) ErrorName() string { return "CouponQuotaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateCouponRequestValidationError) Error() string {
+func (e CouponQuotaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -296,14 +339,14 @@ func (e CreateCouponRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCouponRequest.%s: %s%s",
+		"invalid %sCouponQuota.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCouponRequestValidationError{}
+var _ error = CouponQuotaValidationError{}
 
 var _ interface {
 	Field() string
@@ -311,78 +354,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCouponRequestValidationError{}
-
-var _CreateCouponRequest_DiscountType_InLookup = map[string]struct{}{
-	"percent": {},
-	"fixed":   {},
-}
+} = CouponQuotaValidationError{}
 
-// Validate checks the field values on CreateCouponReply with the rules defined
+// Validate checks the field values on CouponTimeWindow with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateCouponReply) Validate() error {
+func (m *CouponTimeWindow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCouponReply with the rules
+// ValidateAll checks the field values on CouponTimeWindow with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCouponReplyMultiError, or nil if none found.
-func (m *CreateCouponReply) ValidateAll() error {
+// CouponTimeWindowMultiError, or nil if none found.
+func (m *CouponTimeWindow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCouponReply) validate(all bool) error {
+func (m *CouponTimeWindow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCoupon()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateCouponReplyValidationError{
-					field:  "Coupon",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(m.GetWeekdays()) > 7 {
+		err := CouponTimeWindowValidationError{
+			field:  "Weekdays",
+			reason: "value must contain no more than 7 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetWeekdays() {
+		_, _ = idx, item
+
+		if val := item; val < 0 || val > 6 {
+			err := CouponTimeWindowValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "value must be inside range [0, 6]",
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateCouponReplyValidationError{
-					field:  "Coupon",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateCouponReplyValidationError{
-				field:  "Coupon",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+
+	}
+
+	if !_CouponTimeWindow_StartTime_Pattern.MatchString(m.GetStartTime()) {
+		err := CouponTimeWindowValidationError{
+			field:  "StartTime",
+			reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CouponTimeWindow_EndTime_Pattern.MatchString(m.GetEndTime()) {
+		err := CouponTimeWindowValidationError{
+			field:  "EndTime",
+			reason: "value does not match regex pattern \"^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$\"",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateCouponReplyMultiError(errors)
+		return CouponTimeWindowMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCouponReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateCouponReply.ValidateAll() if the designated constraints
+// CouponTimeWindowMultiError is an error wrapping multiple validation errors
+// returned by CouponTimeWindow.ValidateAll() if the designated constraints
 // aren't met.
-type CreateCouponReplyMultiError []error
+type CouponTimeWindowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCouponReplyMultiError) Error() string {
+func (m CouponTimeWindowMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -391,11 +449,11 @@ func (m CreateCouponReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCouponReplyMultiError) AllErrors() []error { return m }
+func (m CouponTimeWindowMultiError) AllErrors() []error { return m }
 
-// CreateCouponReplyValidationError is the validation error returned by
-// CreateCouponReply.Validate if the designated constraints aren't met.
-type CreateCouponReplyValidationError struct {
+// CouponTimeWindowValidationError is the validation error returned by
+// CouponTimeWindow.Validate if the designated constraints aren't met.
+type CouponTimeWindowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -403,24 +461,22 @@ type CreateCouponReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCouponReplyValidationError) Field() string { return e.field }
+func (e CouponTimeWindowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCouponReplyValidationError) Reason() string { return e.reason }
+func (e CouponTimeWindowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCouponReplyValidationError) Cause() error { return e.cause }
+func (e CouponTimeWindowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCouponReplyValidationError) Key() bool { return e.key }
+func (e CouponTimeWindowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCouponReplyValidationError) ErrorName() string {
-	return "CreateCouponReplyValidationError"
-}
+func (e CouponTimeWindowValidationError) ErrorName() string { return "CouponTimeWindowValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateCouponReplyValidationError) Error() string {
+func (e CouponTimeWindowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -432,14 +488,14 @@ func (e CreateCouponReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCouponReply.%s: %s%s",
+		"invalid %sCouponTimeWindow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCouponReplyValidationError{}
+var _ error = CouponTimeWindowValidationError{}
 
 var _ interface {
 	Field() string
@@ -447,34 +503,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCouponReplyValidationError{}
+} = CouponTimeWindowValidationError{}
 
-// Validate checks the field values on GetCouponRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCouponRequest) Validate() error {
+var _CouponTimeWindow_StartTime_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _CouponTimeWindow_EndTime_Pattern = regexp.MustCompile("^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$")
+
+// Validate checks the field values on CouponSchedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CouponSchedule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCouponRequestMultiError, or nil if none found.
-func (m *GetCouponRequest) ValidateAll() error {
+// ValidateAll checks the field values on CouponSchedule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CouponScheduleMultiError,
+// or nil if none found.
+func (m *CouponSchedule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCouponRequest) validate(all bool) error {
+func (m *CouponSchedule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
-		err := GetCouponRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be at least 1 runes",
+	if len(m.GetWindows()) > 20 {
+		err := CouponScheduleValidationError{
+			field:  "Windows",
+			reason: "value must contain no more than 20 item(s)",
 		}
 		if !all {
 			return err
@@ -482,33 +542,94 @@ func (m *GetCouponRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return GetCouponRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetCouponRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCouponRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCouponRequestMultiError []error
+	for idx, item := range m.GetWindows() {
+		_, _ = idx, item
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetCouponRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CouponScheduleValidationError{
+						field:  fmt.Sprintf("Windows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CouponScheduleValidationError{
+						field:  fmt.Sprintf("Windows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CouponScheduleValidationError{
+					field:  fmt.Sprintf("Windows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetBlackoutDates()) > 366 {
+		err := CouponScheduleValidationError{
+			field:  "BlackoutDates",
+			reason: "value must contain no more than 366 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBlackoutDates() {
+		_, _ = idx, item
+
+		if !_CouponSchedule_BlackoutDates_Pattern.MatchString(item) {
+			err := CouponScheduleValidationError{
+				field:  fmt.Sprintf("BlackoutDates[%v]", idx),
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CouponScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// CouponScheduleMultiError is an error wrapping multiple validation errors
+// returned by CouponSchedule.ValidateAll() if the designated constraints
+// aren't met.
+type CouponScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CouponScheduleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCouponRequestMultiError) AllErrors() []error { return m }
+func (m CouponScheduleMultiError) AllErrors() []error { return m }
 
-// GetCouponRequestValidationError is the validation error returned by
-// GetCouponRequest.Validate if the designated constraints aren't met.
-type GetCouponRequestValidationError struct {
+// CouponScheduleValidationError is the validation error returned by
+// CouponSchedule.Validate if the designated constraints aren't met.
+type CouponScheduleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -516,22 +637,22 @@ type GetCouponRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCouponRequestValidationError) Field() string { return e.field }
+func (e CouponScheduleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCouponRequestValidationError) Reason() string { return e.reason }
+func (e CouponScheduleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCouponRequestValidationError) Cause() error { return e.cause }
+func (e CouponScheduleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCouponRequestValidationError) Key() bool { return e.key }
+func (e CouponScheduleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCouponRequestValidationError) ErrorName() string { return "GetCouponRequestValidationError" }
+func (e CouponScheduleValidationError) ErrorName() string { return "CouponScheduleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCouponRequestValidationError) Error() string {
+func (e CouponScheduleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -543,14 +664,14 @@ func (e GetCouponRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCouponRequest.%s: %s%s",
+		"invalid %sCouponSchedule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCouponRequestValidationError{}
+var _ error = CouponScheduleValidationError{}
 
 var _ interface {
 	Field() string
@@ -558,73 +679,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCouponRequestValidationError{}
+} = CouponScheduleValidationError{}
 
-// Validate checks the field values on GetCouponReply with the rules defined in
+var _CouponSchedule_BlackoutDates_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on CouponPacing with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GetCouponReply) Validate() error {
+func (m *CouponPacing) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCouponReply with the rules defined
+// ValidateAll checks the field values on CouponPacing with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetCouponReplyMultiError,
-// or nil if none found.
-func (m *GetCouponReply) ValidateAll() error {
+// result is a list of violation errors wrapped in CouponPacingMultiError, or
+// nil if none found.
+func (m *CouponPacing) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCouponReply) validate(all bool) error {
+func (m *CouponPacing) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCoupon()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCouponReplyValidationError{
-					field:  "Coupon",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCouponReplyValidationError{
-					field:  "Coupon",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if _, ok := _CouponPacing_Interval_InLookup[m.GetInterval()]; !ok {
+		err := CouponPacingValidationError{
+			field:  "Interval",
+			reason: "value must be in list [ HOUR DAY]",
 		}
-	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCouponReplyValidationError{
-				field:  "Coupon",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCurve()) > 100 {
+		err := CouponPacingValidationError{
+			field:  "Curve",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCurve() {
+		_, _ = idx, item
+
+		if val := item; val < 0 || val > 10000 {
+			err := CouponPacingValidationError{
+				field:  fmt.Sprintf("Curve[%v]", idx),
+				reason: "value must be inside range [0, 10000]",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetCouponReplyMultiError(errors)
+		return CouponPacingMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCouponReplyMultiError is an error wrapping multiple validation errors
-// returned by GetCouponReply.ValidateAll() if the designated constraints
-// aren't met.
-type GetCouponReplyMultiError []error
+// CouponPacingMultiError is an error wrapping multiple validation errors
+// returned by CouponPacing.ValidateAll() if the designated constraints aren't met.
+type CouponPacingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCouponReplyMultiError) Error() string {
+func (m CouponPacingMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -633,11 +764,11 @@ func (m GetCouponReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCouponReplyMultiError) AllErrors() []error { return m }
+func (m CouponPacingMultiError) AllErrors() []error { return m }
 
-// GetCouponReplyValidationError is the validation error returned by
-// GetCouponReply.Validate if the designated constraints aren't met.
-type GetCouponReplyValidationError struct {
+// CouponPacingValidationError is the validation error returned by
+// CouponPacing.Validate if the designated constraints aren't met.
+type CouponPacingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -645,22 +776,22 @@ type GetCouponReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCouponReplyValidationError) Field() string { return e.field }
+func (e CouponPacingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCouponReplyValidationError) Reason() string { return e.reason }
+func (e CouponPacingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCouponReplyValidationError) Cause() error { return e.cause }
+func (e CouponPacingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCouponReplyValidationError) Key() bool { return e.key }
+func (e CouponPacingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCouponReplyValidationError) ErrorName() string { return "GetCouponReplyValidationError" }
+func (e CouponPacingValidationError) ErrorName() string { return "CouponPacingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCouponReplyValidationError) Error() string {
+func (e CouponPacingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -672,14 +803,14 @@ func (e GetCouponReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCouponReply.%s: %s%s",
+		"invalid %sCouponPacing.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCouponReplyValidationError{}
+var _ error = CouponPacingValidationError{}
 
 var _ interface {
 	Field() string
@@ -687,52 +818,16928 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCouponReplyValidationError{}
+} = CouponPacingValidationError{}
 
-// Validate checks the field values on ListCouponsRequest with the rules
+var _CouponPacing_Interval_InLookup = map[string]struct{}{
+	"":     {},
+	"HOUR": {},
+	"DAY":  {},
+}
+
+// Validate checks the field values on CreateCouponRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCouponsRequest) Validate() error {
+func (m *CreateCouponRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCouponsRequest with the rules
+// ValidateAll checks the field values on CreateCouponRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCouponsRequestMultiError, or nil if none found.
-func (m *ListCouponsRequest) ValidateAll() error {
+// CreateCouponRequestMultiError, or nil if none found.
+func (m *CreateCouponRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCouponsRequest) validate(all bool) error {
+func (m *CreateCouponRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AppId
+	if l := utf8.RuneCountInString(m.GetCouponCode()); l < 1 || l > 50 {
+		err := CreateCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Status
+	if _, ok := _CreateCouponRequest_DiscountType_InLookup[m.GetDiscountType()]; !ok {
+		err := CreateCouponRequestValidationError{
+			field:  "DiscountType",
+			reason: "value must be in list [percent fixed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Page
+	if m.GetDiscountValue() <= 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "DiscountValue",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for PageSize
+	// no validation rules for Currency
 
-	if len(errors) > 0 {
-		return ListCouponsRequestMultiError(errors)
+	// no validation rules for ValidFrom
+
+	// no validation rules for ValidUntil
+
+	if m.GetMaxUses() <= 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "MaxUses",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	// no validation rules for MinAmount
 
-// ListCouponsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListCouponsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListCouponsRequestMultiError []error
+	if val := m.GetValidDays(); val < 0 || val > 3650 {
+		err := CreateCouponRequestValidationError{
+			field:  "ValidDays",
+			reason: "value must be inside range [0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetClaimLimit() < 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "ClaimLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetClaimStock() < 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "ClaimStock",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBoundUserId()) > 36 {
+		err := CreateCouponRequestValidationError{
+			field:  "BoundUserId",
+			reason: "value length must be at most 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAudienceId()) > 32 {
+		err := CreateCouponRequestValidationError{
+			field:  "AudienceId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateCouponRequest_Eligibility_InLookup[m.GetEligibility()]; !ok {
+		err := CreateCouponRequestValidationError{
+			field:  "Eligibility",
+			reason: "value must be in list [ FIRST_COUPON_USE FIRST_ORDER]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := CreateCouponRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCouponRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCouponRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCouponRequestValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetUserDailyLimit() < 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "UserDailyLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserWeeklyLimit() < 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "UserWeeklyLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserMonthlyLimit() < 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "UserMonthlyLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDailyLimit() < 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "DailyLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCampaignId()) > 32 {
+		err := CreateCouponRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBudget() < 0 {
+		err := CreateCouponRequestValidationError{
+			field:  "Budget",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPacing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCouponRequestValidationError{
+					field:  "Pacing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCouponRequestValidationError{
+					field:  "Pacing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPacing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCouponRequestValidationError{
+				field:  "Pacing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCouponRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCouponRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCouponRequestMultiError) AllErrors() []error { return m }
+
+// CreateCouponRequestValidationError is the validation error returned by
+// CreateCouponRequest.Validate if the designated constraints aren't met.
+type CreateCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCouponRequestValidationError) ErrorName() string {
+	return "CreateCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCouponRequestValidationError{}
+
+var _CreateCouponRequest_DiscountType_InLookup = map[string]struct{}{
+	"percent": {},
+	"fixed":   {},
+}
+
+var _CreateCouponRequest_Eligibility_InLookup = map[string]struct{}{
+	"":                 {},
+	"FIRST_COUPON_USE": {},
+	"FIRST_ORDER":      {},
+}
+
+// Validate checks the field values on CreateCouponReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateCouponReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCouponReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCouponReplyMultiError, or nil if none found.
+func (m *CreateCouponReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCouponReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCoupon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCouponReplyValidationError{
+				field:  "Coupon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCouponReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCouponReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateCouponReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCouponReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCouponReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCouponReplyMultiError) AllErrors() []error { return m }
+
+// CreateCouponReplyValidationError is the validation error returned by
+// CreateCouponReply.Validate if the designated constraints aren't met.
+type CreateCouponReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCouponReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCouponReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCouponReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCouponReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCouponReplyValidationError) ErrorName() string {
+	return "CreateCouponReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCouponReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCouponReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCouponReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCouponReplyValidationError{}
+
+// Validate checks the field values on GetCouponRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCouponRequestMultiError, or nil if none found.
+func (m *GetCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := GetCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCouponRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCouponRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCouponRequestMultiError) AllErrors() []error { return m }
+
+// GetCouponRequestValidationError is the validation error returned by
+// GetCouponRequest.Validate if the designated constraints aren't met.
+type GetCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCouponRequestValidationError) ErrorName() string { return "GetCouponRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCouponRequestValidationError{}
+
+// Validate checks the field values on GetCouponReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCouponReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCouponReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCouponReplyMultiError,
+// or nil if none found.
+func (m *GetCouponReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCouponReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCoupon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCouponReplyValidationError{
+				field:  "Coupon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCouponReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCouponReplyMultiError is an error wrapping multiple validation errors
+// returned by GetCouponReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetCouponReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCouponReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCouponReplyMultiError) AllErrors() []error { return m }
+
+// GetCouponReplyValidationError is the validation error returned by
+// GetCouponReply.Validate if the designated constraints aren't met.
+type GetCouponReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCouponReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCouponReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCouponReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCouponReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCouponReplyValidationError) ErrorName() string { return "GetCouponReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCouponReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCouponReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCouponReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCouponReplyValidationError{}
+
+// Validate checks the field values on ListCouponsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCouponsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCouponsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCouponsRequestMultiError, or nil if none found.
+func (m *ListCouponsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCouponsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	// no validation rules for Status
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if utf8.RuneCountInString(m.GetCodePrefix()) > 50 {
+		err := ListCouponsRequestValidationError{
+			field:  "CodePrefix",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCodeContains()) > 50 {
+		err := ListCouponsRequestValidationError{
+			field:  "CodeContains",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListCouponsRequest_DiscountType_InLookup[m.GetDiscountType()]; !ok {
+		err := ListCouponsRequestValidationError{
+			field:  "DiscountType",
+			reason: "value must be in list [ percent fixed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Currency
+
+	// no validation rules for ActiveFrom
+
+	// no validation rules for ActiveUntil
+
+	if m.GetExpiringWithinDays() < 0 {
+		err := ListCouponsRequestValidationError{
+			field:  "ExpiringWithinDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CreatedFrom
+
+	// no validation rules for CreatedTo
+
+	if _, ok := _ListCouponsRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := ListCouponsRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ created_at valid_until used_count remaining_uses]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListCouponsRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := ListCouponsRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCampaignId()) > 32 {
+		err := ListCouponsRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Exhausted != nil {
+		// no validation rules for Exhausted
+	}
+
+	if len(errors) > 0 {
+		return ListCouponsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCouponsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCouponsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCouponsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCouponsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCouponsRequestMultiError) AllErrors() []error { return m }
+
+// ListCouponsRequestValidationError is the validation error returned by
+// ListCouponsRequest.Validate if the designated constraints aren't met.
+type ListCouponsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCouponsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCouponsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCouponsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCouponsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCouponsRequestValidationError) ErrorName() string {
+	return "ListCouponsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCouponsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCouponsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCouponsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCouponsRequestValidationError{}
+
+var _ListCouponsRequest_DiscountType_InLookup = map[string]struct{}{
+	"":        {},
+	"percent": {},
+	"fixed":   {},
+}
+
+var _ListCouponsRequest_SortBy_InLookup = map[string]struct{}{
+	"":               {},
+	"created_at":     {},
+	"valid_until":    {},
+	"used_count":     {},
+	"remaining_uses": {},
+}
+
+var _ListCouponsRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on ListCouponsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCouponsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCouponsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCouponsReplyMultiError, or nil if none found.
+func (m *ListCouponsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCouponsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCoupons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCouponsReplyValidationError{
+						field:  fmt.Sprintf("Coupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCouponsReplyValidationError{
+						field:  fmt.Sprintf("Coupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCouponsReplyValidationError{
+					field:  fmt.Sprintf("Coupons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCouponsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCouponsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListCouponsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListCouponsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCouponsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCouponsReplyMultiError) AllErrors() []error { return m }
+
+// ListCouponsReplyValidationError is the validation error returned by
+// ListCouponsReply.Validate if the designated constraints aren't met.
+type ListCouponsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCouponsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCouponsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCouponsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCouponsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCouponsReplyValidationError) ErrorName() string { return "ListCouponsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCouponsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCouponsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCouponsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCouponsReplyValidationError{}
+
+// Validate checks the field values on UpdateCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCouponRequestMultiError, or nil if none found.
+func (m *UpdateCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := UpdateCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DiscountType
+
+	// no validation rules for DiscountValue
+
+	// no validation rules for Currency
+
+	// no validation rules for ValidFrom
+
+	// no validation rules for ValidUntil
+
+	// no validation rules for MaxUses
+
+	// no validation rules for MinAmount
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCouponRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCouponRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCouponRequestValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPacing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCouponRequestValidationError{
+					field:  "Pacing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCouponRequestValidationError{
+					field:  "Pacing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPacing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCouponRequestValidationError{
+				field:  "Pacing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ValidDays != nil {
+
+		if val := m.GetValidDays(); val < 0 || val > 3650 {
+			err := UpdateCouponRequestValidationError{
+				field:  "ValidDays",
+				reason: "value must be inside range [0, 3650]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ClaimLimit != nil {
+
+		if m.GetClaimLimit() < 0 {
+			err := UpdateCouponRequestValidationError{
+				field:  "ClaimLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ClaimStock != nil {
+
+		if m.GetClaimStock() < 0 {
+			err := UpdateCouponRequestValidationError{
+				field:  "ClaimStock",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.BoundUserId != nil {
+
+		if utf8.RuneCountInString(m.GetBoundUserId()) > 36 {
+			err := UpdateCouponRequestValidationError{
+				field:  "BoundUserId",
+				reason: "value length must be at most 36 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.AudienceId != nil {
+
+		if utf8.RuneCountInString(m.GetAudienceId()) > 32 {
+			err := UpdateCouponRequestValidationError{
+				field:  "AudienceId",
+				reason: "value length must be at most 32 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Eligibility != nil {
+
+		if _, ok := _UpdateCouponRequest_Eligibility_InLookup[m.GetEligibility()]; !ok {
+			err := UpdateCouponRequestValidationError{
+				field:  "Eligibility",
+				reason: "value must be in list [ FIRST_COUPON_USE FIRST_ORDER]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Timezone != nil {
+
+		if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+			err := UpdateCouponRequestValidationError{
+				field:  "Timezone",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.UserDailyLimit != nil {
+
+		if m.GetUserDailyLimit() < 0 {
+			err := UpdateCouponRequestValidationError{
+				field:  "UserDailyLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.UserWeeklyLimit != nil {
+
+		if m.GetUserWeeklyLimit() < 0 {
+			err := UpdateCouponRequestValidationError{
+				field:  "UserWeeklyLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.UserMonthlyLimit != nil {
+
+		if m.GetUserMonthlyLimit() < 0 {
+			err := UpdateCouponRequestValidationError{
+				field:  "UserMonthlyLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.DailyLimit != nil {
+
+		if m.GetDailyLimit() < 0 {
+			err := UpdateCouponRequestValidationError{
+				field:  "DailyLimit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CampaignId != nil {
+
+		if utf8.RuneCountInString(m.GetCampaignId()) > 32 {
+			err := UpdateCouponRequestValidationError{
+				field:  "CampaignId",
+				reason: "value length must be at most 32 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Budget != nil {
+
+		if m.GetBudget() < 0 {
+			err := UpdateCouponRequestValidationError{
+				field:  "Budget",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCouponRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCouponRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCouponRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCouponRequestValidationError is the validation error returned by
+// UpdateCouponRequest.Validate if the designated constraints aren't met.
+type UpdateCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCouponRequestValidationError) ErrorName() string {
+	return "UpdateCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCouponRequestValidationError{}
+
+var _UpdateCouponRequest_Eligibility_InLookup = map[string]struct{}{
+	"":                 {},
+	"FIRST_COUPON_USE": {},
+	"FIRST_ORDER":      {},
+}
+
+// Validate checks the field values on UpdateCouponReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCouponReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCouponReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCouponReplyMultiError, or nil if none found.
+func (m *UpdateCouponReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCouponReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCoupon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCouponReplyValidationError{
+				field:  "Coupon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCouponReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCouponReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateCouponReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateCouponReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCouponReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCouponReplyMultiError) AllErrors() []error { return m }
+
+// UpdateCouponReplyValidationError is the validation error returned by
+// UpdateCouponReply.Validate if the designated constraints aren't met.
+type UpdateCouponReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCouponReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCouponReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCouponReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCouponReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCouponReplyValidationError) ErrorName() string {
+	return "UpdateCouponReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCouponReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCouponReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCouponReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCouponReplyValidationError{}
+
+// Validate checks the field values on DeleteCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCouponRequestMultiError, or nil if none found.
+func (m *DeleteCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := DeleteCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCouponRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCouponRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCouponRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCouponRequestValidationError is the validation error returned by
+// DeleteCouponRequest.Validate if the designated constraints aren't met.
+type DeleteCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCouponRequestValidationError) ErrorName() string {
+	return "DeleteCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCouponRequestValidationError{}
+
+// Validate checks the field values on ImportCouponsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCouponsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportCouponsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCouponsRequestMultiError, or nil if none found.
+func (m *ImportCouponsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportCouponsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	if utf8.RuneCountInString(m.GetContent()) < 1 {
+		err := ImportCouponsRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetContent()) > 2097152 {
+		err := ImportCouponsRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at most 2097152 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ImportCouponsRequest_Mode_InLookup[m.GetMode()]; !ok {
+		err := ImportCouponsRequestValidationError{
+			field:  "Mode",
+			reason: "value must be in list [ all_or_nothing best_effort]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportCouponsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportCouponsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportCouponsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportCouponsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCouponsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCouponsRequestMultiError) AllErrors() []error { return m }
+
+// ImportCouponsRequestValidationError is the validation error returned by
+// ImportCouponsRequest.Validate if the designated constraints aren't met.
+type ImportCouponsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCouponsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCouponsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCouponsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCouponsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCouponsRequestValidationError) ErrorName() string {
+	return "ImportCouponsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCouponsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCouponsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCouponsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCouponsRequestValidationError{}
+
+var _ImportCouponsRequest_Mode_InLookup = map[string]struct{}{
+	"":               {},
+	"all_or_nothing": {},
+	"best_effort":    {},
+}
+
+// Validate checks the field values on ImportCouponRowResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCouponRowResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportCouponRowResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCouponRowResultMultiError, or nil if none found.
+func (m *ImportCouponRowResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportCouponRowResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for CouponCode
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCoupon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportCouponRowResultValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportCouponRowResultValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportCouponRowResultValidationError{
+				field:  "Coupon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportCouponRowResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportCouponRowResultMultiError is an error wrapping multiple validation
+// errors returned by ImportCouponRowResult.ValidateAll() if the designated
+// constraints aren't met.
+type ImportCouponRowResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCouponRowResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCouponRowResultMultiError) AllErrors() []error { return m }
+
+// ImportCouponRowResultValidationError is the validation error returned by
+// ImportCouponRowResult.Validate if the designated constraints aren't met.
+type ImportCouponRowResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCouponRowResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCouponRowResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCouponRowResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCouponRowResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCouponRowResultValidationError) ErrorName() string {
+	return "ImportCouponRowResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCouponRowResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCouponRowResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCouponRowResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCouponRowResultValidationError{}
+
+// Validate checks the field values on ImportCouponsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCouponsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportCouponsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCouponsReplyMultiError, or nil if none found.
+func (m *ImportCouponsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportCouponsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Valid
+
+	// no validation rules for Created
+
+	// no validation rules for Failed
+
+	// no validation rules for Committed
+
+	// no validation rules for DryRun
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportCouponsReplyValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportCouponsReplyValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportCouponsReplyValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportCouponsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportCouponsReplyMultiError is an error wrapping multiple validation errors
+// returned by ImportCouponsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ImportCouponsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCouponsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCouponsReplyMultiError) AllErrors() []error { return m }
+
+// ImportCouponsReplyValidationError is the validation error returned by
+// ImportCouponsReply.Validate if the designated constraints aren't met.
+type ImportCouponsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCouponsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCouponsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCouponsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCouponsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCouponsReplyValidationError) ErrorName() string {
+	return "ImportCouponsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCouponsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCouponsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCouponsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCouponsReplyValidationError{}
+
+// Validate checks the field values on BatchUpdateCouponStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUpdateCouponStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUpdateCouponStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchUpdateCouponStatusRequestMultiError, or nil if none found.
+func (m *BatchUpdateCouponStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUpdateCouponStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	if len(m.GetCouponCodes()) > 5000 {
+		err := BatchUpdateCouponStatusRequestValidationError{
+			field:  "CouponCodes",
+			reason: "value must contain no more than 5000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchUpdateCouponStatusRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchUpdateCouponStatusRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchUpdateCouponStatusRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _BatchUpdateCouponStatusRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := BatchUpdateCouponStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [active inactive]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOperator()) > 64 {
+		err := BatchUpdateCouponStatusRequestValidationError{
+			field:  "Operator",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := BatchUpdateCouponStatusRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchUpdateCouponStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUpdateCouponStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchUpdateCouponStatusRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BatchUpdateCouponStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUpdateCouponStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUpdateCouponStatusRequestMultiError) AllErrors() []error { return m }
+
+// BatchUpdateCouponStatusRequestValidationError is the validation error
+// returned by BatchUpdateCouponStatusRequest.Validate if the designated
+// constraints aren't met.
+type BatchUpdateCouponStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateCouponStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateCouponStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateCouponStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateCouponStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateCouponStatusRequestValidationError) ErrorName() string {
+	return "BatchUpdateCouponStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateCouponStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateCouponStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateCouponStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateCouponStatusRequestValidationError{}
+
+var _BatchUpdateCouponStatusRequest_Status_InLookup = map[string]struct{}{
+	"active":   {},
+	"inactive": {},
+}
+
+// Validate checks the field values on BatchDeleteCouponsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteCouponsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteCouponsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteCouponsRequestMultiError, or nil if none found.
+func (m *BatchDeleteCouponsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteCouponsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	if len(m.GetCouponCodes()) > 5000 {
+		err := BatchDeleteCouponsRequestValidationError{
+			field:  "CouponCodes",
+			reason: "value must contain no more than 5000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchDeleteCouponsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchDeleteCouponsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchDeleteCouponsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetOperator()) > 64 {
+		err := BatchDeleteCouponsRequestValidationError{
+			field:  "Operator",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := BatchDeleteCouponsRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchDeleteCouponsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteCouponsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchDeleteCouponsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BatchDeleteCouponsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteCouponsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteCouponsRequestMultiError) AllErrors() []error { return m }
+
+// BatchDeleteCouponsRequestValidationError is the validation error returned by
+// BatchDeleteCouponsRequest.Validate if the designated constraints aren't met.
+type BatchDeleteCouponsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteCouponsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteCouponsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteCouponsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteCouponsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteCouponsRequestValidationError) ErrorName() string {
+	return "BatchDeleteCouponsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteCouponsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteCouponsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteCouponsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteCouponsRequestValidationError{}
+
+// Validate checks the field values on BatchCouponItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCouponItemResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCouponItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCouponItemResultMultiError, or nil if none found.
+func (m *BatchCouponItemResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCouponItemResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CouponCode
+
+	// no validation rules for Result
+
+	// no validation rules for OldStatus
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BatchCouponItemResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCouponItemResultMultiError is an error wrapping multiple validation
+// errors returned by BatchCouponItemResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCouponItemResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCouponItemResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCouponItemResultMultiError) AllErrors() []error { return m }
+
+// BatchCouponItemResultValidationError is the validation error returned by
+// BatchCouponItemResult.Validate if the designated constraints aren't met.
+type BatchCouponItemResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCouponItemResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCouponItemResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCouponItemResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCouponItemResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCouponItemResultValidationError) ErrorName() string {
+	return "BatchCouponItemResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCouponItemResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCouponItemResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCouponItemResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCouponItemResultValidationError{}
+
+// Validate checks the field values on BatchCouponsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchCouponsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCouponsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCouponsReplyMultiError, or nil if none found.
+func (m *BatchCouponsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCouponsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchId
+
+	// no validation rules for Matched
+
+	// no validation rules for Affected
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCouponsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCouponsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCouponsReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCouponsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCouponsReplyMultiError is an error wrapping multiple validation errors
+// returned by BatchCouponsReply.ValidateAll() if the designated constraints
+// aren't met.
+type BatchCouponsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCouponsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCouponsReplyMultiError) AllErrors() []error { return m }
+
+// BatchCouponsReplyValidationError is the validation error returned by
+// BatchCouponsReply.Validate if the designated constraints aren't met.
+type BatchCouponsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCouponsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCouponsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCouponsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCouponsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCouponsReplyValidationError) ErrorName() string {
+	return "BatchCouponsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCouponsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCouponsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCouponsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCouponsReplyValidationError{}
+
+// Validate checks the field values on UserCoupon with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserCoupon) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserCoupon with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserCouponMultiError, or
+// nil if none found.
+func (m *UserCoupon) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserCoupon) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserCouponId
+
+	// no validation rules for CouponCode
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	// no validation rules for ValidFrom
+
+	// no validation rules for ValidUntil
+
+	// no validation rules for IssuedAt
+
+	// no validation rules for UsedAt
+
+	// no validation rules for PaymentOrderId
+
+	if all {
+		switch v := interface{}(m.GetCoupon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCouponValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCouponValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCouponValidationError{
+				field:  "Coupon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserCouponMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserCouponMultiError is an error wrapping multiple validation errors
+// returned by UserCoupon.ValidateAll() if the designated constraints aren't met.
+type UserCouponMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserCouponMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserCouponMultiError) AllErrors() []error { return m }
+
+// UserCouponValidationError is the validation error returned by
+// UserCoupon.Validate if the designated constraints aren't met.
+type UserCouponValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserCouponValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserCouponValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserCouponValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserCouponValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserCouponValidationError) ErrorName() string { return "UserCouponValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserCouponValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserCoupon.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserCouponValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserCouponValidationError{}
+
+// Validate checks the field values on IssueCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueCouponRequestMultiError, or nil if none found.
+func (m *IssueCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := IssueCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := IssueCouponRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IssueCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueCouponRequestMultiError is an error wrapping multiple validation errors
+// returned by IssueCouponRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IssueCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueCouponRequestMultiError) AllErrors() []error { return m }
+
+// IssueCouponRequestValidationError is the validation error returned by
+// IssueCouponRequest.Validate if the designated constraints aren't met.
+type IssueCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueCouponRequestValidationError) ErrorName() string {
+	return "IssueCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueCouponRequestValidationError{}
+
+// Validate checks the field values on UserCouponReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserCouponReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserCouponReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserCouponReplyMultiError, or nil if none found.
+func (m *UserCouponReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserCouponReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUserCoupon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCouponReplyValidationError{
+					field:  "UserCoupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCouponReplyValidationError{
+					field:  "UserCoupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserCoupon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCouponReplyValidationError{
+				field:  "UserCoupon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserCouponReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserCouponReplyMultiError is an error wrapping multiple validation errors
+// returned by UserCouponReply.ValidateAll() if the designated constraints
+// aren't met.
+type UserCouponReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserCouponReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserCouponReplyMultiError) AllErrors() []error { return m }
+
+// UserCouponReplyValidationError is the validation error returned by
+// UserCouponReply.Validate if the designated constraints aren't met.
+type UserCouponReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserCouponReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserCouponReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserCouponReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserCouponReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserCouponReplyValidationError) ErrorName() string { return "UserCouponReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserCouponReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserCouponReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserCouponReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserCouponReplyValidationError{}
+
+// Validate checks the field values on ClaimCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClaimCouponRequestMultiError, or nil if none found.
+func (m *ClaimCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := ClaimCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := ClaimCouponRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClaimCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimCouponRequestMultiError is an error wrapping multiple validation errors
+// returned by ClaimCouponRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClaimCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimCouponRequestMultiError) AllErrors() []error { return m }
+
+// ClaimCouponRequestValidationError is the validation error returned by
+// ClaimCouponRequest.Validate if the designated constraints aren't met.
+type ClaimCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimCouponRequestValidationError) ErrorName() string {
+	return "ClaimCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClaimCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimCouponRequestValidationError{}
+
+// Validate checks the field values on ListUserCouponsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserCouponsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserCouponsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserCouponsRequestMultiError, or nil if none found.
+func (m *ListUserCouponsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserCouponsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := ListUserCouponsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListUserCouponsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListUserCouponsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ available used expired]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListUserCouponsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserCouponsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserCouponsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserCouponsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserCouponsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserCouponsRequestMultiError) AllErrors() []error { return m }
+
+// ListUserCouponsRequestValidationError is the validation error returned by
+// ListUserCouponsRequest.Validate if the designated constraints aren't met.
+type ListUserCouponsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserCouponsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserCouponsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserCouponsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserCouponsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserCouponsRequestValidationError) ErrorName() string {
+	return "ListUserCouponsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserCouponsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserCouponsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserCouponsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserCouponsRequestValidationError{}
+
+var _ListUserCouponsRequest_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"available": {},
+	"used":      {},
+	"expired":   {},
+}
+
+// Validate checks the field values on ListUserCouponsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserCouponsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserCouponsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserCouponsReplyMultiError, or nil if none found.
+func (m *ListUserCouponsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserCouponsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUserCoupons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserCouponsReplyValidationError{
+						field:  fmt.Sprintf("UserCoupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserCouponsReplyValidationError{
+						field:  fmt.Sprintf("UserCoupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserCouponsReplyValidationError{
+					field:  fmt.Sprintf("UserCoupons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListUserCouponsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserCouponsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListUserCouponsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserCouponsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserCouponsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserCouponsReplyMultiError) AllErrors() []error { return m }
+
+// ListUserCouponsReplyValidationError is the validation error returned by
+// ListUserCouponsReply.Validate if the designated constraints aren't met.
+type ListUserCouponsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserCouponsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserCouponsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserCouponsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserCouponsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserCouponsReplyValidationError) ErrorName() string {
+	return "ListUserCouponsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserCouponsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserCouponsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserCouponsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserCouponsReplyValidationError{}
+
+// Validate checks the field values on GetUserCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserCouponRequestMultiError, or nil if none found.
+func (m *GetUserCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserCouponId()) < 1 {
+		err := GetUserCouponRequestValidationError{
+			field:  "UserCouponId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserCouponRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserCouponRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserCouponRequestMultiError) AllErrors() []error { return m }
+
+// GetUserCouponRequestValidationError is the validation error returned by
+// GetUserCouponRequest.Validate if the designated constraints aren't met.
+type GetUserCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserCouponRequestValidationError) ErrorName() string {
+	return "GetUserCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserCouponRequestValidationError{}
+
+// Validate checks the field values on CloneCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneCouponRequestMultiError, or nil if none found.
+func (m *CloneCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := CloneCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewCouponCode()); l < 1 || l > 50 {
+		err := CloneCouponRequestValidationError{
+			field:  "NewCouponCode",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidFrom
+
+	// no validation rules for KeepBoundUser
+
+	if len(errors) > 0 {
+		return CloneCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneCouponRequestMultiError is an error wrapping multiple validation errors
+// returned by CloneCouponRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CloneCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneCouponRequestMultiError) AllErrors() []error { return m }
+
+// CloneCouponRequestValidationError is the validation error returned by
+// CloneCouponRequest.Validate if the designated constraints aren't met.
+type CloneCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneCouponRequestValidationError) ErrorName() string {
+	return "CloneCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneCouponRequestValidationError{}
+
+// Validate checks the field values on CouponTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CouponTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CouponTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CouponTemplateMultiError,
+// or nil if none found.
+func (m *CouponTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CouponTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for DiscountType
+
+	// no validation rules for DiscountValue
+
+	// no validation rules for Currency
+
+	// no validation rules for MaxUses
+
+	// no validation rules for MinAmount
+
+	// no validation rules for ValidDays
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return CouponTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// CouponTemplateMultiError is an error wrapping multiple validation errors
+// returned by CouponTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type CouponTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CouponTemplateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CouponTemplateMultiError) AllErrors() []error { return m }
+
+// CouponTemplateValidationError is the validation error returned by
+// CouponTemplate.Validate if the designated constraints aren't met.
+type CouponTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CouponTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CouponTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CouponTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CouponTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CouponTemplateValidationError) ErrorName() string { return "CouponTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CouponTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCouponTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CouponTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CouponTemplateValidationError{}
+
+// Validate checks the field values on CreateCouponTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCouponTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCouponTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCouponTemplateRequestMultiError, or nil if none found.
+func (m *CreateCouponTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCouponTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateCouponTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := CreateCouponTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateCouponTemplateRequest_DiscountType_InLookup[m.GetDiscountType()]; !ok {
+		err := CreateCouponTemplateRequestValidationError{
+			field:  "DiscountType",
+			reason: "value must be in list [percent fixed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDiscountValue() <= 0 {
+		err := CreateCouponTemplateRequestValidationError{
+			field:  "DiscountValue",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Currency
+
+	if m.GetMaxUses() <= 0 {
+		err := CreateCouponTemplateRequestValidationError{
+			field:  "MaxUses",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinAmount() < 0 {
+		err := CreateCouponTemplateRequestValidationError{
+			field:  "MinAmount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetValidDays(); val <= 0 || val > 3650 {
+		err := CreateCouponTemplateRequestValidationError{
+			field:  "ValidDays",
+			reason: "value must be inside range (0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateCouponTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCouponTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateCouponTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateCouponTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCouponTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCouponTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateCouponTemplateRequestValidationError is the validation error returned
+// by CreateCouponTemplateRequest.Validate if the designated constraints
+// aren't met.
+type CreateCouponTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCouponTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCouponTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCouponTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCouponTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCouponTemplateRequestValidationError) ErrorName() string {
+	return "CreateCouponTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCouponTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCouponTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCouponTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCouponTemplateRequestValidationError{}
+
+var _CreateCouponTemplateRequest_DiscountType_InLookup = map[string]struct{}{
+	"percent": {},
+	"fixed":   {},
+}
+
+// Validate checks the field values on GetCouponTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCouponTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCouponTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCouponTemplateRequestMultiError, or nil if none found.
+func (m *GetCouponTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCouponTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := GetCouponTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCouponTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCouponTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCouponTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCouponTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCouponTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCouponTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetCouponTemplateRequestValidationError is the validation error returned by
+// GetCouponTemplateRequest.Validate if the designated constraints aren't met.
+type GetCouponTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCouponTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCouponTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCouponTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCouponTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCouponTemplateRequestValidationError) ErrorName() string {
+	return "GetCouponTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCouponTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCouponTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCouponTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCouponTemplateRequestValidationError{}
+
+// Validate checks the field values on CouponTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CouponTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CouponTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CouponTemplateReplyMultiError, or nil if none found.
+func (m *CouponTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CouponTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CouponTemplateReplyValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CouponTemplateReplyValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CouponTemplateReplyValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CouponTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CouponTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by CouponTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type CouponTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CouponTemplateReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CouponTemplateReplyMultiError) AllErrors() []error { return m }
+
+// CouponTemplateReplyValidationError is the validation error returned by
+// CouponTemplateReply.Validate if the designated constraints aren't met.
+type CouponTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CouponTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CouponTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CouponTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CouponTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CouponTemplateReplyValidationError) ErrorName() string {
+	return "CouponTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CouponTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCouponTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CouponTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CouponTemplateReplyValidationError{}
+
+// Validate checks the field values on ListCouponTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCouponTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCouponTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCouponTemplatesRequestMultiError, or nil if none found.
+func (m *ListCouponTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCouponTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppId
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCouponTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCouponTemplatesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCouponTemplatesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListCouponTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCouponTemplatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCouponTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListCouponTemplatesRequestValidationError is the validation error returned
+// by ListCouponTemplatesRequest.Validate if the designated constraints aren't met.
+type ListCouponTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCouponTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCouponTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCouponTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCouponTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCouponTemplatesRequestValidationError) ErrorName() string {
+	return "ListCouponTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCouponTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCouponTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCouponTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCouponTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListCouponTemplatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCouponTemplatesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCouponTemplatesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCouponTemplatesReplyMultiError, or nil if none found.
+func (m *ListCouponTemplatesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCouponTemplatesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCouponTemplatesReplyValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCouponTemplatesReplyValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCouponTemplatesReplyValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCouponTemplatesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCouponTemplatesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListCouponTemplatesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListCouponTemplatesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCouponTemplatesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCouponTemplatesReplyMultiError) AllErrors() []error { return m }
+
+// ListCouponTemplatesReplyValidationError is the validation error returned by
+// ListCouponTemplatesReply.Validate if the designated constraints aren't met.
+type ListCouponTemplatesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCouponTemplatesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCouponTemplatesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCouponTemplatesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCouponTemplatesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCouponTemplatesReplyValidationError) ErrorName() string {
+	return "ListCouponTemplatesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCouponTemplatesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCouponTemplatesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCouponTemplatesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCouponTemplatesReplyValidationError{}
+
+// Validate checks the field values on UpdateCouponTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCouponTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCouponTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCouponTemplateRequestMultiError, or nil if none found.
+func (m *UpdateCouponTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCouponTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := UpdateCouponTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := UpdateCouponTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateCouponTemplateRequest_DiscountType_InLookup[m.GetDiscountType()]; !ok {
+		err := UpdateCouponTemplateRequestValidationError{
+			field:  "DiscountType",
+			reason: "value must be in list [ percent fixed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DiscountValue
+
+	// no validation rules for Currency
+
+	// no validation rules for MaxUses
+
+	// no validation rules for ValidDays
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 255 {
+			err := UpdateCouponTemplateRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MinAmount != nil {
+		// no validation rules for MinAmount
+	}
+
+	if len(errors) > 0 {
+		return UpdateCouponTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCouponTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateCouponTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateCouponTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCouponTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCouponTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCouponTemplateRequestValidationError is the validation error returned
+// by UpdateCouponTemplateRequest.Validate if the designated constraints
+// aren't met.
+type UpdateCouponTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCouponTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCouponTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCouponTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCouponTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCouponTemplateRequestValidationError) ErrorName() string {
+	return "UpdateCouponTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCouponTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCouponTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCouponTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCouponTemplateRequestValidationError{}
+
+var _UpdateCouponTemplateRequest_DiscountType_InLookup = map[string]struct{}{
+	"":        {},
+	"percent": {},
+	"fixed":   {},
+}
+
+// Validate checks the field values on DeleteCouponTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCouponTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCouponTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCouponTemplateRequestMultiError, or nil if none found.
+func (m *DeleteCouponTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCouponTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := DeleteCouponTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCouponTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCouponTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteCouponTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteCouponTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCouponTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCouponTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCouponTemplateRequestValidationError is the validation error returned
+// by DeleteCouponTemplateRequest.Validate if the designated constraints
+// aren't met.
+type DeleteCouponTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCouponTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCouponTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCouponTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCouponTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCouponTemplateRequestValidationError) ErrorName() string {
+	return "DeleteCouponTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCouponTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCouponTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCouponTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCouponTemplateRequestValidationError{}
+
+// Validate checks the field values on CreateCouponFromTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCouponFromTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCouponFromTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateCouponFromTemplateRequestMultiError, or nil if none found.
+func (m *CreateCouponFromTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCouponFromTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		err := CreateCouponFromTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCouponCode()); l < 1 || l > 50 {
+		err := CreateCouponFromTemplateRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidFrom
+
+	if len(errors) > 0 {
+		return CreateCouponFromTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCouponFromTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateCouponFromTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateCouponFromTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCouponFromTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCouponFromTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateCouponFromTemplateRequestValidationError is the validation error
+// returned by CreateCouponFromTemplateRequest.Validate if the designated
+// constraints aren't met.
+type CreateCouponFromTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCouponFromTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCouponFromTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCouponFromTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCouponFromTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCouponFromTemplateRequestValidationError) ErrorName() string {
+	return "CreateCouponFromTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCouponFromTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCouponFromTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCouponFromTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCouponFromTemplateRequestValidationError{}
+
+// Validate checks the field values on AudienceCondition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AudienceCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AudienceCondition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AudienceConditionMultiError, or nil if none found.
+func (m *AudienceCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AudienceCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAttribute()); l < 1 || l > 64 {
+		err := AudienceConditionValidationError{
+			field:  "Attribute",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AudienceCondition_Operator_InLookup[m.GetOperator()]; !ok {
+		err := AudienceConditionValidationError{
+			field:  "Operator",
+			reason: "value must be in list [EQ NE IN NOT_IN GT GTE LT LTE EXISTS]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AudienceConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AudienceConditionMultiError is an error wrapping multiple validation errors
+// returned by AudienceCondition.ValidateAll() if the designated constraints
+// aren't met.
+type AudienceConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AudienceConditionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AudienceConditionMultiError) AllErrors() []error { return m }
+
+// AudienceConditionValidationError is the validation error returned by
+// AudienceCondition.Validate if the designated constraints aren't met.
+type AudienceConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AudienceConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AudienceConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AudienceConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AudienceConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AudienceConditionValidationError) ErrorName() string {
+	return "AudienceConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AudienceConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudienceCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AudienceConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AudienceConditionValidationError{}
+
+var _AudienceCondition_Operator_InLookup = map[string]struct{}{
+	"EQ":     {},
+	"NE":     {},
+	"IN":     {},
+	"NOT_IN": {},
+	"GT":     {},
+	"GTE":    {},
+	"LT":     {},
+	"LTE":    {},
+	"EXISTS": {},
+}
+
+// Validate checks the field values on AudienceRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AudienceRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AudienceRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AudienceRuleMultiError, or
+// nil if none found.
+func (m *AudienceRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AudienceRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _AudienceRule_Match_InLookup[m.GetMatch()]; !ok {
+		err := AudienceRuleValidationError{
+			field:  "Match",
+			reason: "value must be in list [ ALL ANY]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetConditions()); l < 1 || l > 20 {
+		err := AudienceRuleValidationError{
+			field:  "Conditions",
+			reason: "value must contain between 1 and 20 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetConditions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AudienceRuleValidationError{
+						field:  fmt.Sprintf("Conditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AudienceRuleValidationError{
+						field:  fmt.Sprintf("Conditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AudienceRuleValidationError{
+					field:  fmt.Sprintf("Conditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AudienceRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// AudienceRuleMultiError is an error wrapping multiple validation errors
+// returned by AudienceRule.ValidateAll() if the designated constraints aren't met.
+type AudienceRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AudienceRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AudienceRuleMultiError) AllErrors() []error { return m }
+
+// AudienceRuleValidationError is the validation error returned by
+// AudienceRule.Validate if the designated constraints aren't met.
+type AudienceRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AudienceRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AudienceRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AudienceRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AudienceRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AudienceRuleValidationError) ErrorName() string { return "AudienceRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AudienceRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudienceRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AudienceRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AudienceRuleValidationError{}
+
+var _AudienceRule_Match_InLookup = map[string]struct{}{
+	"":    {},
+	"ALL": {},
+	"ANY": {},
+}
+
+// Validate checks the field values on Audience with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Audience) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Audience with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AudienceMultiError, or nil
+// if none found.
+func (m *Audience) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Audience) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AudienceId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AudienceValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AudienceValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AudienceValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for MemberCount
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return AudienceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AudienceMultiError is an error wrapping multiple validation errors returned
+// by Audience.ValidateAll() if the designated constraints aren't met.
+type AudienceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AudienceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AudienceMultiError) AllErrors() []error { return m }
+
+// AudienceValidationError is the validation error returned by
+// Audience.Validate if the designated constraints aren't met.
+type AudienceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AudienceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AudienceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AudienceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AudienceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AudienceValidationError) ErrorName() string { return "AudienceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AudienceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudience.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AudienceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AudienceValidationError{}
+
+// Validate checks the field values on CreateAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAudienceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAudienceRequestMultiError, or nil if none found.
+func (m *CreateAudienceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAudienceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateAudienceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := CreateAudienceRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateAudienceRequest_Type_InLookup[m.GetType()]; !ok {
+		err := CreateAudienceRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [TAG SEGMENT LIST ALL]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAudienceRequestValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAudienceRequestValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAudienceRequestValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _CreateAudienceRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := CreateAudienceRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateAudienceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAudienceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAudienceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAudienceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAudienceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAudienceRequestMultiError) AllErrors() []error { return m }
+
+// CreateAudienceRequestValidationError is the validation error returned by
+// CreateAudienceRequest.Validate if the designated constraints aren't met.
+type CreateAudienceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAudienceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAudienceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAudienceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAudienceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAudienceRequestValidationError) ErrorName() string {
+	return "CreateAudienceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAudienceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAudienceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAudienceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAudienceRequestValidationError{}
+
+var _CreateAudienceRequest_Type_InLookup = map[string]struct{}{
+	"TAG":     {},
+	"SEGMENT": {},
+	"LIST":    {},
+	"ALL":     {},
+}
+
+var _CreateAudienceRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+// Validate checks the field values on AudienceReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AudienceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AudienceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AudienceReplyMultiError, or
+// nil if none found.
+func (m *AudienceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AudienceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAudience()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AudienceReplyValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AudienceReplyValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAudience()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AudienceReplyValidationError{
+				field:  "Audience",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AudienceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AudienceReplyMultiError is an error wrapping multiple validation errors
+// returned by AudienceReply.ValidateAll() if the designated constraints
+// aren't met.
+type AudienceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AudienceReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AudienceReplyMultiError) AllErrors() []error { return m }
+
+// AudienceReplyValidationError is the validation error returned by
+// AudienceReply.Validate if the designated constraints aren't met.
+type AudienceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AudienceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AudienceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AudienceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AudienceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AudienceReplyValidationError) ErrorName() string { return "AudienceReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AudienceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudienceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AudienceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AudienceReplyValidationError{}
+
+// Validate checks the field values on GetAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAudienceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAudienceRequestMultiError, or nil if none found.
+func (m *GetAudienceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAudienceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAudienceId()) < 1 {
+		err := GetAudienceRequestValidationError{
+			field:  "AudienceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAudienceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAudienceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAudienceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAudienceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAudienceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAudienceRequestMultiError) AllErrors() []error { return m }
+
+// GetAudienceRequestValidationError is the validation error returned by
+// GetAudienceRequest.Validate if the designated constraints aren't met.
+type GetAudienceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAudienceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAudienceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAudienceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAudienceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAudienceRequestValidationError) ErrorName() string {
+	return "GetAudienceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAudienceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAudienceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAudienceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAudienceRequestValidationError{}
+
+// Validate checks the field values on ListAudiencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAudiencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAudiencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAudiencesRequestMultiError, or nil if none found.
+func (m *ListAudiencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAudiencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListAudiencesRequest_Type_InLookup[m.GetType()]; !ok {
+		err := ListAudiencesRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [ TAG SEGMENT LIST ALL]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListAudiencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAudiencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAudiencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAudiencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAudiencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAudiencesRequestMultiError) AllErrors() []error { return m }
+
+// ListAudiencesRequestValidationError is the validation error returned by
+// ListAudiencesRequest.Validate if the designated constraints aren't met.
+type ListAudiencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAudiencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAudiencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAudiencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAudiencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAudiencesRequestValidationError) ErrorName() string {
+	return "ListAudiencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAudiencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAudiencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAudiencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAudiencesRequestValidationError{}
+
+var _ListAudiencesRequest_Type_InLookup = map[string]struct{}{
+	"":        {},
+	"TAG":     {},
+	"SEGMENT": {},
+	"LIST":    {},
+	"ALL":     {},
+}
+
+// Validate checks the field values on ListAudiencesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAudiencesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAudiencesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAudiencesReplyMultiError, or nil if none found.
+func (m *ListAudiencesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAudiencesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAudiences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAudiencesReplyValidationError{
+						field:  fmt.Sprintf("Audiences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAudiencesReplyValidationError{
+						field:  fmt.Sprintf("Audiences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAudiencesReplyValidationError{
+					field:  fmt.Sprintf("Audiences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListAudiencesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAudiencesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListAudiencesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListAudiencesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAudiencesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAudiencesReplyMultiError) AllErrors() []error { return m }
+
+// ListAudiencesReplyValidationError is the validation error returned by
+// ListAudiencesReply.Validate if the designated constraints aren't met.
+type ListAudiencesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAudiencesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAudiencesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAudiencesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAudiencesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAudiencesReplyValidationError) ErrorName() string {
+	return "ListAudiencesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAudiencesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAudiencesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAudiencesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAudiencesReplyValidationError{}
+
+// Validate checks the field values on UpdateAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAudienceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAudienceRequestMultiError, or nil if none found.
+func (m *UpdateAudienceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAudienceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAudienceId()) < 1 {
+		err := UpdateAudienceRequestValidationError{
+			field:  "AudienceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := UpdateAudienceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAudienceRequestValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAudienceRequestValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAudienceRequestValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _UpdateAudienceRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := UpdateAudienceRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 255 {
+			err := UpdateAudienceRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateAudienceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAudienceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAudienceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAudienceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAudienceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAudienceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAudienceRequestValidationError is the validation error returned by
+// UpdateAudienceRequest.Validate if the designated constraints aren't met.
+type UpdateAudienceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAudienceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAudienceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAudienceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAudienceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAudienceRequestValidationError) ErrorName() string {
+	return "UpdateAudienceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAudienceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAudienceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAudienceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAudienceRequestValidationError{}
+
+var _UpdateAudienceRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+// Validate checks the field values on DeleteAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAudienceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAudienceRequestMultiError, or nil if none found.
+func (m *DeleteAudienceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAudienceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAudienceId()) < 1 {
+		err := DeleteAudienceRequestValidationError{
+			field:  "AudienceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteAudienceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAudienceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAudienceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAudienceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAudienceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAudienceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAudienceRequestValidationError is the validation error returned by
+// DeleteAudienceRequest.Validate if the designated constraints aren't met.
+type DeleteAudienceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAudienceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAudienceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAudienceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAudienceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAudienceRequestValidationError) ErrorName() string {
+	return "DeleteAudienceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAudienceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAudienceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAudienceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAudienceRequestValidationError{}
+
+// Validate checks the field values on UploadAudienceMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAudienceMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAudienceMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAudienceMembersRequestMultiError, or nil if none found.
+func (m *UploadAudienceMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAudienceMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAudienceId()) < 1 {
+		err := UploadAudienceMembersRequestValidationError{
+			field:  "AudienceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 4194304 {
+		err := UploadAudienceMembersRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 4194304 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UploadAudienceMembersRequest_Mode_InLookup[m.GetMode()]; !ok {
+		err := UploadAudienceMembersRequestValidationError{
+			field:  "Mode",
+			reason: "value must be in list [ REPLACE APPEND]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadAudienceMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAudienceMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by UploadAudienceMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UploadAudienceMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAudienceMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAudienceMembersRequestMultiError) AllErrors() []error { return m }
+
+// UploadAudienceMembersRequestValidationError is the validation error returned
+// by UploadAudienceMembersRequest.Validate if the designated constraints
+// aren't met.
+type UploadAudienceMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAudienceMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAudienceMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAudienceMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAudienceMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAudienceMembersRequestValidationError) ErrorName() string {
+	return "UploadAudienceMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAudienceMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAudienceMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAudienceMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAudienceMembersRequestValidationError{}
+
+var _UploadAudienceMembersRequest_Mode_InLookup = map[string]struct{}{
+	"":        {},
+	"REPLACE": {},
+	"APPEND":  {},
+}
+
+// Validate checks the field values on UploadAudienceMembersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAudienceMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAudienceMembersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAudienceMembersReplyMultiError, or nil if none found.
+func (m *UploadAudienceMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAudienceMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Invalid
+
+	// no validation rules for MemberCount
+
+	if len(errors) > 0 {
+		return UploadAudienceMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAudienceMembersReplyMultiError is an error wrapping multiple
+// validation errors returned by UploadAudienceMembersReply.ValidateAll() if
+// the designated constraints aren't met.
+type UploadAudienceMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAudienceMembersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAudienceMembersReplyMultiError) AllErrors() []error { return m }
+
+// UploadAudienceMembersReplyValidationError is the validation error returned
+// by UploadAudienceMembersReply.Validate if the designated constraints aren't met.
+type UploadAudienceMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAudienceMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAudienceMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAudienceMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAudienceMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAudienceMembersReplyValidationError) ErrorName() string {
+	return "UploadAudienceMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAudienceMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAudienceMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAudienceMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAudienceMembersReplyValidationError{}
+
+// Validate checks the field values on CheckAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckAudienceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckAudienceRequestMultiError, or nil if none found.
+func (m *CheckAudienceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAudienceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAudienceId()) < 1 {
+		err := CheckAudienceRequestValidationError{
+			field:  "AudienceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) > 36 {
+		err := CheckAudienceRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at most 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserAttributes
+
+	if len(errors) > 0 {
+		return CheckAudienceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAudienceRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckAudienceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckAudienceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAudienceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAudienceRequestMultiError) AllErrors() []error { return m }
+
+// CheckAudienceRequestValidationError is the validation error returned by
+// CheckAudienceRequest.Validate if the designated constraints aren't met.
+type CheckAudienceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAudienceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAudienceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAudienceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAudienceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAudienceRequestValidationError) ErrorName() string {
+	return "CheckAudienceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAudienceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAudienceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAudienceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAudienceRequestValidationError{}
+
+// Validate checks the field values on CheckAudienceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckAudienceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAudienceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckAudienceReplyMultiError, or nil if none found.
+func (m *CheckAudienceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAudienceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Matched
+
+	if len(errors) > 0 {
+		return CheckAudienceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAudienceReplyMultiError is an error wrapping multiple validation errors
+// returned by CheckAudienceReply.ValidateAll() if the designated constraints
+// aren't met.
+type CheckAudienceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAudienceReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAudienceReplyMultiError) AllErrors() []error { return m }
+
+// CheckAudienceReplyValidationError is the validation error returned by
+// CheckAudienceReply.Validate if the designated constraints aren't met.
+type CheckAudienceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAudienceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAudienceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAudienceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAudienceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAudienceReplyValidationError) ErrorName() string {
+	return "CheckAudienceReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAudienceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAudienceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAudienceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAudienceReplyValidationError{}
+
+// Validate checks the field values on Campaign with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Campaign) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Campaign with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CampaignMultiError, or nil
+// if none found.
+func (m *Campaign) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Campaign) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Budget
+
+	// no validation rules for BudgetUsed
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return CampaignMultiError(errors)
+	}
+
+	return nil
+}
+
+// CampaignMultiError is an error wrapping multiple validation errors returned
+// by Campaign.ValidateAll() if the designated constraints aren't met.
+type CampaignMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CampaignMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CampaignMultiError) AllErrors() []error { return m }
+
+// CampaignValidationError is the validation error returned by
+// Campaign.Validate if the designated constraints aren't met.
+type CampaignValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CampaignValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CampaignValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CampaignValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CampaignValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CampaignValidationError) ErrorName() string { return "CampaignValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CampaignValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCampaign.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CampaignValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CampaignValidationError{}
+
+// Validate checks the field values on CreateCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCampaignRequestMultiError, or nil if none found.
+func (m *CreateCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateCampaignRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateCampaignRequest_Type_InLookup[m.GetType()]; !ok {
+		err := CreateCampaignRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [REDEEM_CODE TASK_REWARD DIRECT_SEND]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := CreateCampaignRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() <= 0 {
+		err := CreateCampaignRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() <= 0 {
+		err := CreateCampaignRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBudget() < 0 {
+		err := CreateCampaignRequestValidationError{
+			field:  "Budget",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateCampaignRequest_Currency_InLookup[m.GetCurrency()]; !ok {
+		err := CreateCampaignRequestValidationError{
+			field:  "Currency",
+			reason: "value must be in list [ CNY USD EUR]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCampaignRequestMultiError) AllErrors() []error { return m }
+
+// CreateCampaignRequestValidationError is the validation error returned by
+// CreateCampaignRequest.Validate if the designated constraints aren't met.
+type CreateCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCampaignRequestValidationError) ErrorName() string {
+	return "CreateCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCampaignRequestValidationError{}
+
+var _CreateCampaignRequest_Type_InLookup = map[string]struct{}{
+	"REDEEM_CODE": {},
+	"TASK_REWARD": {},
+	"DIRECT_SEND": {},
+}
+
+var _CreateCampaignRequest_Currency_InLookup = map[string]struct{}{
+	"":    {},
+	"CNY": {},
+	"USD": {},
+	"EUR": {},
+}
+
+// Validate checks the field values on CampaignReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CampaignReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CampaignReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CampaignReplyMultiError, or
+// nil if none found.
+func (m *CampaignReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CampaignReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CampaignReplyValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CampaignReplyValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CampaignReplyValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CampaignReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CampaignReplyMultiError is an error wrapping multiple validation errors
+// returned by CampaignReply.ValidateAll() if the designated constraints
+// aren't met.
+type CampaignReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CampaignReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CampaignReplyMultiError) AllErrors() []error { return m }
+
+// CampaignReplyValidationError is the validation error returned by
+// CampaignReply.Validate if the designated constraints aren't met.
+type CampaignReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CampaignReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CampaignReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CampaignReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CampaignReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CampaignReplyValidationError) ErrorName() string { return "CampaignReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CampaignReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCampaignReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CampaignReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CampaignReplyValidationError{}
+
+// Validate checks the field values on GetCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCampaignRequestMultiError, or nil if none found.
+func (m *GetCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCampaignId()) < 1 {
+		err := GetCampaignRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCampaignRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCampaignRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCampaignRequestMultiError) AllErrors() []error { return m }
+
+// GetCampaignRequestValidationError is the validation error returned by
+// GetCampaignRequest.Validate if the designated constraints aren't met.
+type GetCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCampaignRequestValidationError) ErrorName() string {
+	return "GetCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCampaignRequestValidationError{}
+
+// Validate checks the field values on ListCampaignsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCampaignsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCampaignsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCampaignsRequestMultiError, or nil if none found.
+func (m *ListCampaignsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCampaignsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListCampaignsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListCampaignsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ DRAFT ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListCampaignsRequest_Type_InLookup[m.GetType()]; !ok {
+		err := ListCampaignsRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [ REDEEM_CODE TASK_REWARD DIRECT_SEND]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCampaignsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCampaignsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCampaignsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCampaignsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCampaignsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCampaignsRequestMultiError) AllErrors() []error { return m }
+
+// ListCampaignsRequestValidationError is the validation error returned by
+// ListCampaignsRequest.Validate if the designated constraints aren't met.
+type ListCampaignsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCampaignsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCampaignsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCampaignsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCampaignsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCampaignsRequestValidationError) ErrorName() string {
+	return "ListCampaignsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCampaignsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCampaignsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCampaignsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCampaignsRequestValidationError{}
+
+var _ListCampaignsRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"DRAFT":  {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+var _ListCampaignsRequest_Type_InLookup = map[string]struct{}{
+	"":            {},
+	"REDEEM_CODE": {},
+	"TASK_REWARD": {},
+	"DIRECT_SEND": {},
+}
+
+// Validate checks the field values on ListCampaignsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCampaignsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCampaignsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCampaignsReplyMultiError, or nil if none found.
+func (m *ListCampaignsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCampaignsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCampaigns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCampaignsReplyValidationError{
+						field:  fmt.Sprintf("Campaigns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCampaignsReplyValidationError{
+						field:  fmt.Sprintf("Campaigns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCampaignsReplyValidationError{
+					field:  fmt.Sprintf("Campaigns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCampaignsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCampaignsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListCampaignsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListCampaignsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCampaignsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCampaignsReplyMultiError) AllErrors() []error { return m }
+
+// ListCampaignsReplyValidationError is the validation error returned by
+// ListCampaignsReply.Validate if the designated constraints aren't met.
+type ListCampaignsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCampaignsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCampaignsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCampaignsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCampaignsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCampaignsReplyValidationError) ErrorName() string {
+	return "ListCampaignsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCampaignsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCampaignsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCampaignsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCampaignsReplyValidationError{}
+
+// Validate checks the field values on UpdateCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCampaignRequestMultiError, or nil if none found.
+func (m *UpdateCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCampaignId()) < 1 {
+		err := UpdateCampaignRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := UpdateCampaignRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateCampaignRequest_Type_InLookup[m.GetType()]; !ok {
+		err := UpdateCampaignRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [ REDEEM_CODE TASK_REWARD DIRECT_SEND]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 255 {
+			err := UpdateCampaignRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Budget != nil {
+
+		if m.GetBudget() < 0 {
+			err := UpdateCampaignRequestValidationError{
+				field:  "Budget",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCampaignRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCampaignRequestValidationError is the validation error returned by
+// UpdateCampaignRequest.Validate if the designated constraints aren't met.
+type UpdateCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCampaignRequestValidationError) ErrorName() string {
+	return "UpdateCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCampaignRequestValidationError{}
+
+var _UpdateCampaignRequest_Type_InLookup = map[string]struct{}{
+	"":            {},
+	"REDEEM_CODE": {},
+	"TASK_REWARD": {},
+	"DIRECT_SEND": {},
+}
+
+// Validate checks the field values on DeleteCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCampaignRequestMultiError, or nil if none found.
+func (m *DeleteCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCampaignId()) < 1 {
+		err := DeleteCampaignRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCampaignRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCampaignRequestValidationError is the validation error returned by
+// DeleteCampaignRequest.Validate if the designated constraints aren't met.
+type DeleteCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCampaignRequestValidationError) ErrorName() string {
+	return "DeleteCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCampaignRequestValidationError{}
+
+// Validate checks the field values on PublishCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishCampaignRequestMultiError, or nil if none found.
+func (m *PublishCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCampaignId()) < 1 {
+		err := PublishCampaignRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PublishCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by PublishCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PublishCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishCampaignRequestMultiError) AllErrors() []error { return m }
+
+// PublishCampaignRequestValidationError is the validation error returned by
+// PublishCampaignRequest.Validate if the designated constraints aren't met.
+type PublishCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishCampaignRequestValidationError) ErrorName() string {
+	return "PublishCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishCampaignRequestValidationError{}
+
+// Validate checks the field values on PauseCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseCampaignRequestMultiError, or nil if none found.
+func (m *PauseCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCampaignId()) < 1 {
+		err := PauseCampaignRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PauseCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseCampaignRequestMultiError) AllErrors() []error { return m }
+
+// PauseCampaignRequestValidationError is the validation error returned by
+// PauseCampaignRequest.Validate if the designated constraints aren't met.
+type PauseCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseCampaignRequestValidationError) ErrorName() string {
+	return "PauseCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseCampaignRequestValidationError{}
+
+// Validate checks the field values on EndCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EndCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EndCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EndCampaignRequestMultiError, or nil if none found.
+func (m *EndCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EndCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCampaignId()) < 1 {
+		err := EndCampaignRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EndCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndCampaignRequestMultiError is an error wrapping multiple validation errors
+// returned by EndCampaignRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EndCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndCampaignRequestMultiError) AllErrors() []error { return m }
+
+// EndCampaignRequestValidationError is the validation error returned by
+// EndCampaignRequest.Validate if the designated constraints aren't met.
+type EndCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndCampaignRequestValidationError) ErrorName() string {
+	return "EndCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndCampaignRequestValidationError{}
+
+// Validate checks the field values on RedeemCode with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RedeemCode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemCode with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RedeemCodeMultiError, or
+// nil if none found.
+func (m *RedeemCode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemCode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for BatchId
+
+	// no validation rules for CampaignId
+
+	// no validation rules for RewardType
+
+	// no validation rules for CouponCode
+
+	// no validation rules for Points
+
+	// no validation rules for SubscriptionPlan
+
+	// no validation rules for SubscriptionDays
+
+	// no validation rules for Status
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for RedeemedBy
+
+	// no validation rules for RedeemedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return RedeemCodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemCodeMultiError is an error wrapping multiple validation errors
+// returned by RedeemCode.ValidateAll() if the designated constraints aren't met.
+type RedeemCodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemCodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemCodeMultiError) AllErrors() []error { return m }
+
+// RedeemCodeValidationError is the validation error returned by
+// RedeemCode.Validate if the designated constraints aren't met.
+type RedeemCodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemCodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemCodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemCodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemCodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemCodeValidationError) ErrorName() string { return "RedeemCodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedeemCodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemCode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemCodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemCodeValidationError{}
+
+// Validate checks the field values on RedeemCodeRedemption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemCodeRedemption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemCodeRedemption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemCodeRedemptionMultiError, or nil if none found.
+func (m *RedeemCodeRedemption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemCodeRedemption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RedemptionId
+
+	// no validation rules for Code
+
+	// no validation rules for UserId
+
+	// no validation rules for RewardType
+
+	// no validation rules for CouponCode
+
+	// no validation rules for UserCouponId
+
+	// no validation rules for Points
+
+	// no validation rules for SubscriptionPlan
+
+	// no validation rules for SubscriptionDays
+
+	// no validation rules for RedeemedAt
+
+	// no validation rules for GrantStatus
+
+	// no validation rules for GrantError
+
+	// no validation rules for GrantAttempts
+
+	// no validation rules for GrantedAt
+
+	if len(errors) > 0 {
+		return RedeemCodeRedemptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemCodeRedemptionMultiError is an error wrapping multiple validation
+// errors returned by RedeemCodeRedemption.ValidateAll() if the designated
+// constraints aren't met.
+type RedeemCodeRedemptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemCodeRedemptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemCodeRedemptionMultiError) AllErrors() []error { return m }
+
+// RedeemCodeRedemptionValidationError is the validation error returned by
+// RedeemCodeRedemption.Validate if the designated constraints aren't met.
+type RedeemCodeRedemptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemCodeRedemptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemCodeRedemptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemCodeRedemptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemCodeRedemptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemCodeRedemptionValidationError) ErrorName() string {
+	return "RedeemCodeRedemptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemCodeRedemptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemCodeRedemption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemCodeRedemptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemCodeRedemptionValidationError{}
+
+// Validate checks the field values on CreateRedeemCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRedeemCodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRedeemCodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRedeemCodesRequestMultiError, or nil if none found.
+func (m *CreateRedeemCodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRedeemCodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetCount(); val < 1 || val > 10000 {
+		err := CreateRedeemCodesRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range [1, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPrefix()) > 16 {
+		err := CreateRedeemCodesRequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRedeemCodesRequest_Prefix_Pattern.MatchString(m.GetPrefix()) {
+		err := CreateRedeemCodesRequestValidationError{
+			field:  "Prefix",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLength(); val < 0 || val > 32 {
+		err := CreateRedeemCodesRequestValidationError{
+			field:  "Length",
+			reason: "value must be inside range [0, 32]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateRedeemCodesRequest_RewardType_InLookup[m.GetRewardType()]; !ok {
+		err := CreateRedeemCodesRequestValidationError{
+			field:  "RewardType",
+			reason: "value must be in list [COUPON POINTS SUBSCRIPTION]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CouponCode
+
+	// no validation rules for Points
+
+	if utf8.RuneCountInString(m.GetSubscriptionPlan()) > 64 {
+		err := CreateRedeemCodesRequestValidationError{
+			field:  "SubscriptionPlan",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SubscriptionDays
+
+	if m.GetExpiresAt() <= 0 {
+		err := CreateRedeemCodesRequestValidationError{
+			field:  "ExpiresAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CampaignId
+
+	if len(errors) > 0 {
+		return CreateRedeemCodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRedeemCodesRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRedeemCodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRedeemCodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRedeemCodesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRedeemCodesRequestMultiError) AllErrors() []error { return m }
+
+// CreateRedeemCodesRequestValidationError is the validation error returned by
+// CreateRedeemCodesRequest.Validate if the designated constraints aren't met.
+type CreateRedeemCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRedeemCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRedeemCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRedeemCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRedeemCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRedeemCodesRequestValidationError) ErrorName() string {
+	return "CreateRedeemCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRedeemCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRedeemCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRedeemCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRedeemCodesRequestValidationError{}
+
+var _CreateRedeemCodesRequest_Prefix_Pattern = regexp.MustCompile("^[A-Za-z0-9]*$")
+
+var _CreateRedeemCodesRequest_RewardType_InLookup = map[string]struct{}{
+	"COUPON":       {},
+	"POINTS":       {},
+	"SUBSCRIPTION": {},
+}
+
+// Validate checks the field values on CreateRedeemCodesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRedeemCodesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRedeemCodesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRedeemCodesReplyMultiError, or nil if none found.
+func (m *CreateRedeemCodesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRedeemCodesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchId
+
+	if len(errors) > 0 {
+		return CreateRedeemCodesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRedeemCodesReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateRedeemCodesReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRedeemCodesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRedeemCodesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRedeemCodesReplyMultiError) AllErrors() []error { return m }
+
+// CreateRedeemCodesReplyValidationError is the validation error returned by
+// CreateRedeemCodesReply.Validate if the designated constraints aren't met.
+type CreateRedeemCodesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRedeemCodesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRedeemCodesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRedeemCodesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRedeemCodesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRedeemCodesReplyValidationError) ErrorName() string {
+	return "CreateRedeemCodesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRedeemCodesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRedeemCodesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRedeemCodesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRedeemCodesReplyValidationError{}
+
+// Validate checks the field values on GetRedeemCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRedeemCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRedeemCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRedeemCodeRequestMultiError, or nil if none found.
+func (m *GetRedeemCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRedeemCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := GetRedeemCodeRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRedeemCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRedeemCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRedeemCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRedeemCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRedeemCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRedeemCodeRequestMultiError) AllErrors() []error { return m }
+
+// GetRedeemCodeRequestValidationError is the validation error returned by
+// GetRedeemCodeRequest.Validate if the designated constraints aren't met.
+type GetRedeemCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRedeemCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRedeemCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRedeemCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRedeemCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRedeemCodeRequestValidationError) ErrorName() string {
+	return "GetRedeemCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRedeemCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRedeemCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRedeemCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRedeemCodeRequestValidationError{}
+
+// Validate checks the field values on RedeemCodeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RedeemCodeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemCodeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemCodeReplyMultiError, or nil if none found.
+func (m *RedeemCodeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemCodeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRedeemCode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RedeemCodeReplyValidationError{
+					field:  "RedeemCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RedeemCodeReplyValidationError{
+					field:  "RedeemCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedeemCode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RedeemCodeReplyValidationError{
+				field:  "RedeemCode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RedeemCodeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemCodeReplyMultiError is an error wrapping multiple validation errors
+// returned by RedeemCodeReply.ValidateAll() if the designated constraints
+// aren't met.
+type RedeemCodeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemCodeReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemCodeReplyMultiError) AllErrors() []error { return m }
+
+// RedeemCodeReplyValidationError is the validation error returned by
+// RedeemCodeReply.Validate if the designated constraints aren't met.
+type RedeemCodeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemCodeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemCodeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemCodeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemCodeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemCodeReplyValidationError) ErrorName() string { return "RedeemCodeReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedeemCodeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemCodeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemCodeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemCodeReplyValidationError{}
+
+// Validate checks the field values on ListRedeemCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRedeemCodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRedeemCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRedeemCodesRequestMultiError, or nil if none found.
+func (m *ListRedeemCodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRedeemCodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchId
+
+	if _, ok := _ListRedeemCodesRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListRedeemCodesRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE REDEEMED EXPIRED REVOKED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RedeemedBy
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRedeemCodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRedeemCodesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRedeemCodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRedeemCodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRedeemCodesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRedeemCodesRequestMultiError) AllErrors() []error { return m }
+
+// ListRedeemCodesRequestValidationError is the validation error returned by
+// ListRedeemCodesRequest.Validate if the designated constraints aren't met.
+type ListRedeemCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRedeemCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRedeemCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRedeemCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRedeemCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRedeemCodesRequestValidationError) ErrorName() string {
+	return "ListRedeemCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRedeemCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRedeemCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRedeemCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRedeemCodesRequestValidationError{}
+
+var _ListRedeemCodesRequest_Status_InLookup = map[string]struct{}{
+	"":         {},
+	"ACTIVE":   {},
+	"REDEEMED": {},
+	"EXPIRED":  {},
+	"REVOKED":  {},
+}
+
+// Validate checks the field values on ListRedeemCodesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRedeemCodesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRedeemCodesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRedeemCodesReplyMultiError, or nil if none found.
+func (m *ListRedeemCodesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRedeemCodesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRedeemCodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRedeemCodesReplyValidationError{
+						field:  fmt.Sprintf("RedeemCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRedeemCodesReplyValidationError{
+						field:  fmt.Sprintf("RedeemCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRedeemCodesReplyValidationError{
+					field:  fmt.Sprintf("RedeemCodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRedeemCodesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRedeemCodesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListRedeemCodesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListRedeemCodesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRedeemCodesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRedeemCodesReplyMultiError) AllErrors() []error { return m }
+
+// ListRedeemCodesReplyValidationError is the validation error returned by
+// ListRedeemCodesReply.Validate if the designated constraints aren't met.
+type ListRedeemCodesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRedeemCodesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRedeemCodesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRedeemCodesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRedeemCodesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRedeemCodesReplyValidationError) ErrorName() string {
+	return "ListRedeemCodesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRedeemCodesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRedeemCodesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRedeemCodesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRedeemCodesReplyValidationError{}
+
+// Validate checks the field values on RedeemCodeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RedeemCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemCodeRequestMultiError, or nil if none found.
+func (m *RedeemCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := RedeemCodeRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := RedeemCodeRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RedeemCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemCodeRequestMultiError is an error wrapping multiple validation errors
+// returned by RedeemCodeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RedeemCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemCodeRequestMultiError) AllErrors() []error { return m }
+
+// RedeemCodeRequestValidationError is the validation error returned by
+// RedeemCodeRequest.Validate if the designated constraints aren't met.
+type RedeemCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemCodeRequestValidationError) ErrorName() string {
+	return "RedeemCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemCodeRequestValidationError{}
+
+// Validate checks the field values on RedeemCodeRedemptionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemCodeRedemptionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemCodeRedemptionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemCodeRedemptionReplyMultiError, or nil if none found.
+func (m *RedeemCodeRedemptionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemCodeRedemptionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRedemption()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RedeemCodeRedemptionReplyValidationError{
+					field:  "Redemption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RedeemCodeRedemptionReplyValidationError{
+					field:  "Redemption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedemption()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RedeemCodeRedemptionReplyValidationError{
+				field:  "Redemption",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RedeemCodeRedemptionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemCodeRedemptionReplyMultiError is an error wrapping multiple validation
+// errors returned by RedeemCodeRedemptionReply.ValidateAll() if the
+// designated constraints aren't met.
+type RedeemCodeRedemptionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemCodeRedemptionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemCodeRedemptionReplyMultiError) AllErrors() []error { return m }
+
+// RedeemCodeRedemptionReplyValidationError is the validation error returned by
+// RedeemCodeRedemptionReply.Validate if the designated constraints aren't met.
+type RedeemCodeRedemptionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemCodeRedemptionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemCodeRedemptionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemCodeRedemptionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemCodeRedemptionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemCodeRedemptionReplyValidationError) ErrorName() string {
+	return "RedeemCodeRedemptionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemCodeRedemptionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemCodeRedemptionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemCodeRedemptionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemCodeRedemptionReplyValidationError{}
+
+// Validate checks the field values on RevokeRedeemCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRedeemCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRedeemCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRedeemCodeRequestMultiError, or nil if none found.
+func (m *RevokeRedeemCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRedeemCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := RevokeRedeemCodeRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeRedeemCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeRedeemCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeRedeemCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeRedeemCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRedeemCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRedeemCodeRequestMultiError) AllErrors() []error { return m }
+
+// RevokeRedeemCodeRequestValidationError is the validation error returned by
+// RevokeRedeemCodeRequest.Validate if the designated constraints aren't met.
+type RevokeRedeemCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRedeemCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRedeemCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRedeemCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRedeemCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRedeemCodeRequestValidationError) ErrorName() string {
+	return "RevokeRedeemCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRedeemCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRedeemCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRedeemCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRedeemCodeRequestValidationError{}
+
+// Validate checks the field values on ListRedeemCodeRedemptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListRedeemCodeRedemptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRedeemCodeRedemptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListRedeemCodeRedemptionsRequestMultiError, or nil if none found.
+func (m *ListRedeemCodeRedemptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRedeemCodeRedemptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if _, ok := _ListRedeemCodeRedemptionsRequest_GrantStatus_InLookup[m.GetGrantStatus()]; !ok {
+		err := ListRedeemCodeRedemptionsRequestValidationError{
+			field:  "GrantStatus",
+			reason: "value must be in list [ PENDING GRANTED FAILED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRedeemCodeRedemptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRedeemCodeRedemptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListRedeemCodeRedemptionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRedeemCodeRedemptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRedeemCodeRedemptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRedeemCodeRedemptionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRedeemCodeRedemptionsRequestValidationError is the validation error
+// returned by ListRedeemCodeRedemptionsRequest.Validate if the designated
+// constraints aren't met.
+type ListRedeemCodeRedemptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRedeemCodeRedemptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRedeemCodeRedemptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRedeemCodeRedemptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRedeemCodeRedemptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRedeemCodeRedemptionsRequestValidationError) ErrorName() string {
+	return "ListRedeemCodeRedemptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRedeemCodeRedemptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRedeemCodeRedemptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRedeemCodeRedemptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRedeemCodeRedemptionsRequestValidationError{}
+
+var _ListRedeemCodeRedemptionsRequest_GrantStatus_InLookup = map[string]struct{}{
+	"":        {},
+	"PENDING": {},
+	"GRANTED": {},
+	"FAILED":  {},
+}
+
+// Validate checks the field values on ListRedeemCodeRedemptionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRedeemCodeRedemptionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRedeemCodeRedemptionsReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListRedeemCodeRedemptionsReplyMultiError, or nil if none found.
+func (m *ListRedeemCodeRedemptionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRedeemCodeRedemptionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRedemptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRedeemCodeRedemptionsReplyValidationError{
+						field:  fmt.Sprintf("Redemptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRedeemCodeRedemptionsReplyValidationError{
+						field:  fmt.Sprintf("Redemptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRedeemCodeRedemptionsReplyValidationError{
+					field:  fmt.Sprintf("Redemptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRedeemCodeRedemptionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRedeemCodeRedemptionsReplyMultiError is an error wrapping multiple
+// validation errors returned by ListRedeemCodeRedemptionsReply.ValidateAll()
+// if the designated constraints aren't met.
+type ListRedeemCodeRedemptionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRedeemCodeRedemptionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRedeemCodeRedemptionsReplyMultiError) AllErrors() []error { return m }
+
+// ListRedeemCodeRedemptionsReplyValidationError is the validation error
+// returned by ListRedeemCodeRedemptionsReply.Validate if the designated
+// constraints aren't met.
+type ListRedeemCodeRedemptionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRedeemCodeRedemptionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRedeemCodeRedemptionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRedeemCodeRedemptionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRedeemCodeRedemptionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRedeemCodeRedemptionsReplyValidationError) ErrorName() string {
+	return "ListRedeemCodeRedemptionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRedeemCodeRedemptionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRedeemCodeRedemptionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRedeemCodeRedemptionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRedeemCodeRedemptionsReplyValidationError{}
+
+// Validate checks the field values on RetryRedeemCodeGrantRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryRedeemCodeGrantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryRedeemCodeGrantRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryRedeemCodeGrantRequestMultiError, or nil if none found.
+func (m *RetryRedeemCodeGrantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryRedeemCodeGrantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := RetryRedeemCodeGrantRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetryRedeemCodeGrantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryRedeemCodeGrantRequestMultiError is an error wrapping multiple
+// validation errors returned by RetryRedeemCodeGrantRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RetryRedeemCodeGrantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryRedeemCodeGrantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryRedeemCodeGrantRequestMultiError) AllErrors() []error { return m }
+
+// RetryRedeemCodeGrantRequestValidationError is the validation error returned
+// by RetryRedeemCodeGrantRequest.Validate if the designated constraints
+// aren't met.
+type RetryRedeemCodeGrantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryRedeemCodeGrantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryRedeemCodeGrantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryRedeemCodeGrantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryRedeemCodeGrantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryRedeemCodeGrantRequestValidationError) ErrorName() string {
+	return "RetryRedeemCodeGrantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryRedeemCodeGrantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryRedeemCodeGrantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryRedeemCodeGrantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryRedeemCodeGrantRequestValidationError{}
+
+// Validate checks the field values on RewardContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RewardContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RewardContentMultiError, or
+// nil if none found.
+func (m *RewardContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DiscountType
+
+	// no validation rules for DiscountValue
+
+	// no validation rules for Currency
+
+	// no validation rules for MinAmount
+
+	// no validation rules for Points
+
+	// no validation rules for SubscriptionPlan
+
+	// no validation rules for SubscriptionDays
+
+	if len(errors) > 0 {
+		return RewardContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardContentMultiError is an error wrapping multiple validation errors
+// returned by RewardContent.ValidateAll() if the designated constraints
+// aren't met.
+type RewardContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardContentMultiError) AllErrors() []error { return m }
+
+// RewardContentValidationError is the validation error returned by
+// RewardContent.Validate if the designated constraints aren't met.
+type RewardContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardContentValidationError) ErrorName() string { return "RewardContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewardContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardContentValidationError{}
+
+// Validate checks the field values on RewardGeneratorConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RewardGeneratorConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardGeneratorConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardGeneratorConfigMultiError, or nil if none found.
+func (m *RewardGeneratorConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardGeneratorConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CodePrefix
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return RewardGeneratorConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardGeneratorConfigMultiError is an error wrapping multiple validation
+// errors returned by RewardGeneratorConfig.ValidateAll() if the designated
+// constraints aren't met.
+type RewardGeneratorConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardGeneratorConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardGeneratorConfigMultiError) AllErrors() []error { return m }
+
+// RewardGeneratorConfigValidationError is the validation error returned by
+// RewardGeneratorConfig.Validate if the designated constraints aren't met.
+type RewardGeneratorConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardGeneratorConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardGeneratorConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardGeneratorConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardGeneratorConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardGeneratorConfigValidationError) ErrorName() string {
+	return "RewardGeneratorConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardGeneratorConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardGeneratorConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardGeneratorConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardGeneratorConfigValidationError{}
+
+// Validate checks the field values on RewardValidatorConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RewardValidatorConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardValidatorConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardValidatorConfigMultiError, or nil if none found.
+func (m *RewardValidatorConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardValidatorConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for AudienceId
+
+	// no validation rules for PerUserLimit
+
+	if len(errors) > 0 {
+		return RewardValidatorConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardValidatorConfigMultiError is an error wrapping multiple validation
+// errors returned by RewardValidatorConfig.ValidateAll() if the designated
+// constraints aren't met.
+type RewardValidatorConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardValidatorConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardValidatorConfigMultiError) AllErrors() []error { return m }
+
+// RewardValidatorConfigValidationError is the validation error returned by
+// RewardValidatorConfig.Validate if the designated constraints aren't met.
+type RewardValidatorConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardValidatorConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardValidatorConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardValidatorConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardValidatorConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardValidatorConfigValidationError) ErrorName() string {
+	return "RewardValidatorConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardValidatorConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardValidatorConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardValidatorConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardValidatorConfigValidationError{}
+
+// Validate checks the field values on RewardDistributorConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RewardDistributorConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardDistributorConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardDistributorConfigMultiError, or nil if none found.
+func (m *RewardDistributorConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardDistributorConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return RewardDistributorConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardDistributorConfigMultiError is an error wrapping multiple validation
+// errors returned by RewardDistributorConfig.ValidateAll() if the designated
+// constraints aren't met.
+type RewardDistributorConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardDistributorConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardDistributorConfigMultiError) AllErrors() []error { return m }
+
+// RewardDistributorConfigValidationError is the validation error returned by
+// RewardDistributorConfig.Validate if the designated constraints aren't met.
+type RewardDistributorConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardDistributorConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardDistributorConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardDistributorConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardDistributorConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardDistributorConfigValidationError) ErrorName() string {
+	return "RewardDistributorConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardDistributorConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardDistributorConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardDistributorConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardDistributorConfigValidationError{}
+
+// Validate checks the field values on Reward with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reward) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Reward with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RewardMultiError, or nil if none found.
+func (m *Reward) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Reward) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RewardId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for RewardType
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RewardValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "GeneratorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "GeneratorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RewardValidationError{
+				field:  "GeneratorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidatorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "ValidatorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "ValidatorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidatorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RewardValidationError{
+				field:  "ValidatorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDistributorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "DistributorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RewardValidationError{
+					field:  "DistributorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDistributorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RewardValidationError{
+				field:  "DistributorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ValidDays
+
+	// no validation rules for Version
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return RewardMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardMultiError is an error wrapping multiple validation errors returned by
+// Reward.ValidateAll() if the designated constraints aren't met.
+type RewardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardMultiError) AllErrors() []error { return m }
+
+// RewardValidationError is the validation error returned by Reward.Validate if
+// the designated constraints aren't met.
+type RewardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardValidationError) ErrorName() string { return "RewardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReward.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardValidationError{}
+
+// Validate checks the field values on CreateRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRewardRequestMultiError, or nil if none found.
+func (m *CreateRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateRewardRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := CreateRewardRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateRewardRequest_RewardType_InLookup[m.GetRewardType()]; !ok {
+		err := CreateRewardRequestValidationError{
+			field:  "RewardType",
+			reason: "value must be in list [COUPON POINTS SUBSCRIPTION]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContent() == nil {
+		err := CreateRewardRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRewardRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "GeneratorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "GeneratorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRewardRequestValidationError{
+				field:  "GeneratorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidatorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "ValidatorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "ValidatorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidatorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRewardRequestValidationError{
+				field:  "ValidatorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDistributorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "DistributorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRewardRequestValidationError{
+					field:  "DistributorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDistributorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRewardRequestValidationError{
+				field:  "DistributorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetValidDays(); val < 0 || val > 3650 {
+		err := CreateRewardRequestValidationError{
+			field:  "ValidDays",
+			reason: "value must be inside range [0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRewardRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRewardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRewardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRewardRequestMultiError) AllErrors() []error { return m }
+
+// CreateRewardRequestValidationError is the validation error returned by
+// CreateRewardRequest.Validate if the designated constraints aren't met.
+type CreateRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRewardRequestValidationError) ErrorName() string {
+	return "CreateRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRewardRequestValidationError{}
+
+var _CreateRewardRequest_RewardType_InLookup = map[string]struct{}{
+	"COUPON":       {},
+	"POINTS":       {},
+	"SUBSCRIPTION": {},
+}
+
+// Validate checks the field values on RewardReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RewardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RewardReplyMultiError, or
+// nil if none found.
+func (m *RewardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReward()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RewardReplyValidationError{
+					field:  "Reward",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RewardReplyValidationError{
+					field:  "Reward",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReward()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RewardReplyValidationError{
+				field:  "Reward",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RewardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardReplyMultiError is an error wrapping multiple validation errors
+// returned by RewardReply.ValidateAll() if the designated constraints aren't met.
+type RewardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardReplyMultiError) AllErrors() []error { return m }
+
+// RewardReplyValidationError is the validation error returned by
+// RewardReply.Validate if the designated constraints aren't met.
+type RewardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardReplyValidationError) ErrorName() string { return "RewardReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardReplyValidationError{}
+
+// Validate checks the field values on GetRewardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRewardRequestMultiError, or nil if none found.
+func (m *GetRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRewardId()) < 1 {
+		err := GetRewardRequestValidationError{
+			field:  "RewardId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRewardRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRewardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRewardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRewardRequestMultiError) AllErrors() []error { return m }
+
+// GetRewardRequestValidationError is the validation error returned by
+// GetRewardRequest.Validate if the designated constraints aren't met.
+type GetRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRewardRequestValidationError) ErrorName() string { return "GetRewardRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRewardRequestValidationError{}
+
+// Validate checks the field values on ListRewardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRewardsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRewardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRewardsRequestMultiError, or nil if none found.
+func (m *ListRewardsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRewardsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListRewardsRequest_RewardType_InLookup[m.GetRewardType()]; !ok {
+		err := ListRewardsRequestValidationError{
+			field:  "RewardType",
+			reason: "value must be in list [ COUPON POINTS SUBSCRIPTION]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListRewardsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListRewardsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRewardsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRewardsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRewardsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRewardsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRewardsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRewardsRequestMultiError) AllErrors() []error { return m }
+
+// ListRewardsRequestValidationError is the validation error returned by
+// ListRewardsRequest.Validate if the designated constraints aren't met.
+type ListRewardsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRewardsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRewardsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRewardsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRewardsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRewardsRequestValidationError) ErrorName() string {
+	return "ListRewardsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRewardsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRewardsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRewardsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRewardsRequestValidationError{}
+
+var _ListRewardsRequest_RewardType_InLookup = map[string]struct{}{
+	"":             {},
+	"COUPON":       {},
+	"POINTS":       {},
+	"SUBSCRIPTION": {},
+}
+
+var _ListRewardsRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+// Validate checks the field values on ListRewardsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRewardsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRewardsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRewardsReplyMultiError, or nil if none found.
+func (m *ListRewardsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRewardsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRewardsReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRewardsReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRewardsReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRewardsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRewardsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListRewardsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListRewardsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRewardsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRewardsReplyMultiError) AllErrors() []error { return m }
+
+// ListRewardsReplyValidationError is the validation error returned by
+// ListRewardsReply.Validate if the designated constraints aren't met.
+type ListRewardsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRewardsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRewardsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRewardsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRewardsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRewardsReplyValidationError) ErrorName() string { return "ListRewardsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRewardsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRewardsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRewardsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRewardsReplyValidationError{}
+
+// Validate checks the field values on UpdateRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRewardRequestMultiError, or nil if none found.
+func (m *UpdateRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRewardId()) < 1 {
+		err := UpdateRewardRequestValidationError{
+			field:  "RewardId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Version
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := UpdateRewardRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateRewardRequest_RewardType_InLookup[m.GetRewardType()]; !ok {
+		err := UpdateRewardRequestValidationError{
+			field:  "RewardType",
+			reason: "value must be in list [ COUPON POINTS SUBSCRIPTION]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRewardRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "GeneratorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "GeneratorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRewardRequestValidationError{
+				field:  "GeneratorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidatorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "ValidatorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "ValidatorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidatorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRewardRequestValidationError{
+				field:  "ValidatorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDistributorConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "DistributorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRewardRequestValidationError{
+					field:  "DistributorConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDistributorConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRewardRequestValidationError{
+				field:  "DistributorConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _UpdateRewardRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := UpdateRewardRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 255 {
+			err := UpdateRewardRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ValidDays != nil {
+
+		if val := m.GetValidDays(); val < 0 || val > 3650 {
+			err := UpdateRewardRequestValidationError{
+				field:  "ValidDays",
+				reason: "value must be inside range [0, 3650]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRewardRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRewardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRewardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRewardRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRewardRequestValidationError is the validation error returned by
+// UpdateRewardRequest.Validate if the designated constraints aren't met.
+type UpdateRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRewardRequestValidationError) ErrorName() string {
+	return "UpdateRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRewardRequestValidationError{}
+
+var _UpdateRewardRequest_RewardType_InLookup = map[string]struct{}{
+	"":             {},
+	"COUPON":       {},
+	"POINTS":       {},
+	"SUBSCRIPTION": {},
+}
+
+var _UpdateRewardRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+// Validate checks the field values on DeleteRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRewardRequestMultiError, or nil if none found.
+func (m *DeleteRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRewardId()) < 1 {
+		err := DeleteRewardRequestValidationError{
+			field:  "RewardId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRewardRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRewardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRewardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRewardRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRewardRequestValidationError is the validation error returned by
+// DeleteRewardRequest.Validate if the designated constraints aren't met.
+type DeleteRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRewardRequestValidationError) ErrorName() string {
+	return "DeleteRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRewardRequestValidationError{}
+
+// Validate checks the field values on IssueRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueRewardRequestMultiError, or nil if none found.
+func (m *IssueRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRewardId()) < 1 {
+		err := IssueRewardRequestValidationError{
+			field:  "RewardId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := IssueRewardRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CampaignId
+
+	if utf8.RuneCountInString(m.GetRequestId()) > 128 {
+		err := IssueRewardRequestValidationError{
+			field:  "RequestId",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserAttributes
+
+	if len(errors) > 0 {
+		return IssueRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueRewardRequestMultiError is an error wrapping multiple validation errors
+// returned by IssueRewardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IssueRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueRewardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueRewardRequestMultiError) AllErrors() []error { return m }
+
+// IssueRewardRequestValidationError is the validation error returned by
+// IssueRewardRequest.Validate if the designated constraints aren't met.
+type IssueRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueRewardRequestValidationError) ErrorName() string {
+	return "IssueRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueRewardRequestValidationError{}
+
+// Validate checks the field values on RewardGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RewardGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RewardGrantMultiError, or
+// nil if none found.
+func (m *RewardGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GrantId
+
+	// no validation rules for RewardId
+
+	// no validation rules for RewardName
+
+	// no validation rules for RewardType
+
+	// no validation rules for RewardVersion
+
+	if all {
+		switch v := interface{}(m.GetContentSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RewardGrantValidationError{
+					field:  "ContentSnapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RewardGrantValidationError{
+					field:  "ContentSnapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContentSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RewardGrantValidationError{
+				field:  "ContentSnapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CampaignId
+
+	// no validation rules for CampaignName
+
+	// no validation rules for TaskId
+
+	// no validation rules for TaskName
+
+	// no validation rules for UserId
+
+	// no validation rules for RequestId
+
+	// no validation rules for Status
+
+	// no validation rules for CouponCode
+
+	// no validation rules for ExpireTime
+
+	// no validation rules for GeneratedAt
+
+	// no validation rules for DistributedAt
+
+	// no validation rules for UsedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return RewardGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardGrantMultiError is an error wrapping multiple validation errors
+// returned by RewardGrant.ValidateAll() if the designated constraints aren't met.
+type RewardGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardGrantMultiError) AllErrors() []error { return m }
+
+// RewardGrantValidationError is the validation error returned by
+// RewardGrant.Validate if the designated constraints aren't met.
+type RewardGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardGrantValidationError) ErrorName() string { return "RewardGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewardGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardGrantValidationError{}
+
+// Validate checks the field values on RewardGrantReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardGrantReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardGrantReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardGrantReplyMultiError, or nil if none found.
+func (m *RewardGrantReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardGrantReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGrant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RewardGrantReplyValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RewardGrantReplyValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGrant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RewardGrantReplyValidationError{
+				field:  "Grant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RewardGrantReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardGrantReplyMultiError is an error wrapping multiple validation errors
+// returned by RewardGrantReply.ValidateAll() if the designated constraints
+// aren't met.
+type RewardGrantReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardGrantReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardGrantReplyMultiError) AllErrors() []error { return m }
+
+// RewardGrantReplyValidationError is the validation error returned by
+// RewardGrantReply.Validate if the designated constraints aren't met.
+type RewardGrantReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardGrantReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardGrantReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardGrantReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardGrantReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardGrantReplyValidationError) ErrorName() string { return "RewardGrantReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewardGrantReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardGrantReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardGrantReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardGrantReplyValidationError{}
+
+// Validate checks the field values on GetRewardGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRewardGrantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRewardGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRewardGrantRequestMultiError, or nil if none found.
+func (m *GetRewardGrantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRewardGrantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetGrantId()) < 1 {
+		err := GetRewardGrantRequestValidationError{
+			field:  "GrantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRewardGrantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRewardGrantRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRewardGrantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRewardGrantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRewardGrantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRewardGrantRequestMultiError) AllErrors() []error { return m }
+
+// GetRewardGrantRequestValidationError is the validation error returned by
+// GetRewardGrantRequest.Validate if the designated constraints aren't met.
+type GetRewardGrantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRewardGrantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRewardGrantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRewardGrantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRewardGrantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRewardGrantRequestValidationError) ErrorName() string {
+	return "GetRewardGrantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRewardGrantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRewardGrantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRewardGrantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRewardGrantRequestValidationError{}
+
+// Validate checks the field values on ListRewardGrantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRewardGrantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRewardGrantsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRewardGrantsRequestMultiError, or nil if none found.
+func (m *ListRewardGrantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRewardGrantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RewardId
+
+	// no validation rules for UserId
+
+	// no validation rules for CampaignId
+
+	// no validation rules for TaskId
+
+	if _, ok := _ListRewardGrantsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListRewardGrantsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ PENDING GENERATED DISTRIBUTED USED EXPIRED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRewardGrantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRewardGrantsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRewardGrantsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRewardGrantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRewardGrantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRewardGrantsRequestMultiError) AllErrors() []error { return m }
+
+// ListRewardGrantsRequestValidationError is the validation error returned by
+// ListRewardGrantsRequest.Validate if the designated constraints aren't met.
+type ListRewardGrantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRewardGrantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRewardGrantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRewardGrantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRewardGrantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRewardGrantsRequestValidationError) ErrorName() string {
+	return "ListRewardGrantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRewardGrantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRewardGrantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRewardGrantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRewardGrantsRequestValidationError{}
+
+var _ListRewardGrantsRequest_Status_InLookup = map[string]struct{}{
+	"":            {},
+	"PENDING":     {},
+	"GENERATED":   {},
+	"DISTRIBUTED": {},
+	"USED":        {},
+	"EXPIRED":     {},
+}
+
+// Validate checks the field values on ListRewardGrantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRewardGrantsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRewardGrantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRewardGrantsReplyMultiError, or nil if none found.
+func (m *ListRewardGrantsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRewardGrantsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGrants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRewardGrantsReplyValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRewardGrantsReplyValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRewardGrantsReplyValidationError{
+					field:  fmt.Sprintf("Grants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListRewardGrantsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRewardGrantsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListRewardGrantsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListRewardGrantsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRewardGrantsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRewardGrantsReplyMultiError) AllErrors() []error { return m }
+
+// ListRewardGrantsReplyValidationError is the validation error returned by
+// ListRewardGrantsReply.Validate if the designated constraints aren't met.
+type ListRewardGrantsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRewardGrantsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRewardGrantsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRewardGrantsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRewardGrantsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRewardGrantsReplyValidationError) ErrorName() string {
+	return "ListRewardGrantsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRewardGrantsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRewardGrantsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRewardGrantsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRewardGrantsReplyValidationError{}
+
+// Validate checks the field values on DistributeRewardGrantRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DistributeRewardGrantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DistributeRewardGrantRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DistributeRewardGrantRequestMultiError, or nil if none found.
+func (m *DistributeRewardGrantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DistributeRewardGrantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetGrantId()) < 1 {
+		err := DistributeRewardGrantRequestValidationError{
+			field:  "GrantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DistributeRewardGrantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DistributeRewardGrantRequestMultiError is an error wrapping multiple
+// validation errors returned by DistributeRewardGrantRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DistributeRewardGrantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DistributeRewardGrantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DistributeRewardGrantRequestMultiError) AllErrors() []error { return m }
+
+// DistributeRewardGrantRequestValidationError is the validation error returned
+// by DistributeRewardGrantRequest.Validate if the designated constraints
+// aren't met.
+type DistributeRewardGrantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DistributeRewardGrantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DistributeRewardGrantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DistributeRewardGrantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DistributeRewardGrantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DistributeRewardGrantRequestValidationError) ErrorName() string {
+	return "DistributeRewardGrantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DistributeRewardGrantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDistributeRewardGrantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DistributeRewardGrantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DistributeRewardGrantRequestValidationError{}
+
+// Validate checks the field values on UseRewardGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UseRewardGrantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UseRewardGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UseRewardGrantRequestMultiError, or nil if none found.
+func (m *UseRewardGrantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UseRewardGrantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetGrantId()) < 1 {
+		err := UseRewardGrantRequestValidationError{
+			field:  "GrantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UseRewardGrantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UseRewardGrantRequestMultiError is an error wrapping multiple validation
+// errors returned by UseRewardGrantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UseRewardGrantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UseRewardGrantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UseRewardGrantRequestMultiError) AllErrors() []error { return m }
+
+// UseRewardGrantRequestValidationError is the validation error returned by
+// UseRewardGrantRequest.Validate if the designated constraints aren't met.
+type UseRewardGrantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UseRewardGrantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UseRewardGrantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UseRewardGrantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UseRewardGrantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UseRewardGrantRequestValidationError) ErrorName() string {
+	return "UseRewardGrantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UseRewardGrantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUseRewardGrantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UseRewardGrantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UseRewardGrantRequestValidationError{}
+
+// Validate checks the field values on TaskTriggerConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TaskTriggerConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskTriggerConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskTriggerConfigMultiError, or nil if none found.
+func (m *TaskTriggerConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskTriggerConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventType
+
+	// no validation rules for UserAttribute
+
+	if len(errors) > 0 {
+		return TaskTriggerConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskTriggerConfigMultiError is an error wrapping multiple validation errors
+// returned by TaskTriggerConfig.ValidateAll() if the designated constraints
+// aren't met.
+type TaskTriggerConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskTriggerConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskTriggerConfigMultiError) AllErrors() []error { return m }
+
+// TaskTriggerConfigValidationError is the validation error returned by
+// TaskTriggerConfig.Validate if the designated constraints aren't met.
+type TaskTriggerConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskTriggerConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskTriggerConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskTriggerConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskTriggerConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskTriggerConfigValidationError) ErrorName() string {
+	return "TaskTriggerConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskTriggerConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskTriggerConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskTriggerConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskTriggerConfigValidationError{}
+
+// Validate checks the field values on TaskConditionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TaskConditionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskConditionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskConditionConfigMultiError, or nil if none found.
+func (m *TaskConditionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskConditionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MinAmount
+
+	// no validation rules for Currency
+
+	// no validation rules for Attributes
+
+	// no validation rules for TargetCount
+
+	if len(errors) > 0 {
+		return TaskConditionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskConditionConfigMultiError is an error wrapping multiple validation
+// errors returned by TaskConditionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type TaskConditionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskConditionConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskConditionConfigMultiError) AllErrors() []error { return m }
+
+// TaskConditionConfigValidationError is the validation error returned by
+// TaskConditionConfig.Validate if the designated constraints aren't met.
+type TaskConditionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskConditionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskConditionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskConditionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskConditionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskConditionConfigValidationError) ErrorName() string {
+	return "TaskConditionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskConditionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskConditionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskConditionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskConditionConfigValidationError{}
+
+// Validate checks the field values on Task with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Task) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Task with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaskMultiError, or nil if none found.
+func (m *Task) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Task) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for CampaignId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for TaskType
+
+	if all {
+		switch v := interface{}(m.GetTriggerConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "TriggerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "TriggerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTriggerConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "TriggerConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConditionConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "ConditionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "ConditionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConditionConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "ConditionConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RewardId
+
+	// no validation rules for Repeatable
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return TaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskMultiError is an error wrapping multiple validation errors returned by
+// Task.ValidateAll() if the designated constraints aren't met.
+type TaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskMultiError) AllErrors() []error { return m }
+
+// TaskValidationError is the validation error returned by Task.Validate if the
+// designated constraints aren't met.
+type TaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskValidationError) ErrorName() string { return "TaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskValidationError{}
+
+// Validate checks the field values on CreateTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTaskRequestMultiError, or nil if none found.
+func (m *CreateTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateTaskRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := CreateTaskRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateTaskRequest_TaskType_InLookup[m.GetTaskType()]; !ok {
+		err := CreateTaskRequestValidationError{
+			field:  "TaskType",
+			reason: "value must be in list [INVITE PURCHASE SHARE SIGN_IN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTriggerConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "TriggerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "TriggerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTriggerConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "TriggerConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConditionConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "ConditionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "ConditionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConditionConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "ConditionConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetRewardId()) < 1 {
+		err := CreateTaskRequestValidationError{
+			field:  "RewardId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Repeatable
+
+	if _, ok := _CreateTaskRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := CreateTaskRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTaskRequestMultiError) AllErrors() []error { return m }
+
+// CreateTaskRequestValidationError is the validation error returned by
+// CreateTaskRequest.Validate if the designated constraints aren't met.
+type CreateTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTaskRequestValidationError) ErrorName() string {
+	return "CreateTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTaskRequestValidationError{}
+
+var _CreateTaskRequest_TaskType_InLookup = map[string]struct{}{
+	"INVITE":   {},
+	"PURCHASE": {},
+	"SHARE":    {},
+	"SIGN_IN":  {},
+}
+
+var _CreateTaskRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+// Validate checks the field values on TaskReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskReplyMultiError, or nil
+// if none found.
+func (m *TaskReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TaskReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskReplyMultiError is an error wrapping multiple validation errors returned
+// by TaskReply.ValidateAll() if the designated constraints aren't met.
+type TaskReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskReplyMultiError) AllErrors() []error { return m }
+
+// TaskReplyValidationError is the validation error returned by
+// TaskReply.Validate if the designated constraints aren't met.
+type TaskReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskReplyValidationError) ErrorName() string { return "TaskReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskReplyValidationError{}
+
+// Validate checks the field values on GetTaskRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTaskRequestMultiError,
+// or nil if none found.
+func (m *GetTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := GetTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTaskRequestMultiError) AllErrors() []error { return m }
+
+// GetTaskRequestValidationError is the validation error returned by
+// GetTaskRequest.Validate if the designated constraints aren't met.
+type GetTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTaskRequestValidationError) ErrorName() string { return "GetTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTaskRequestValidationError{}
+
+// Validate checks the field values on ListTasksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksRequestMultiError, or nil if none found.
+func (m *ListTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	if _, ok := _ListTasksRequest_TaskType_InLookup[m.GetTaskType()]; !ok {
+		err := ListTasksRequestValidationError{
+			field:  "TaskType",
+			reason: "value must be in list [ INVITE PURCHASE SHARE SIGN_IN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListTasksRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListTasksRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTasksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListTasksRequestValidationError is the validation error returned by
+// ListTasksRequest.Validate if the designated constraints aren't met.
+type ListTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksRequestValidationError) ErrorName() string { return "ListTasksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksRequestValidationError{}
+
+var _ListTasksRequest_TaskType_InLookup = map[string]struct{}{
+	"":         {},
+	"INVITE":   {},
+	"PURCHASE": {},
+	"SHARE":    {},
+	"SIGN_IN":  {},
+}
+
+var _ListTasksRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+// Validate checks the field values on ListTasksReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTasksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTasksReplyMultiError,
+// or nil if none found.
+func (m *ListTasksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTasksReplyValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTasksReplyValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTasksReplyValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListTasksReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTasksReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksReplyMultiError) AllErrors() []error { return m }
+
+// ListTasksReplyValidationError is the validation error returned by
+// ListTasksReply.Validate if the designated constraints aren't met.
+type ListTasksReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksReplyValidationError) ErrorName() string { return "ListTasksReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTasksReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksReplyValidationError{}
+
+// Validate checks the field values on UpdateTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTaskRequestMultiError, or nil if none found.
+func (m *UpdateTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := UpdateTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateTaskRequest_TaskType_InLookup[m.GetTaskType()]; !ok {
+		err := UpdateTaskRequestValidationError{
+			field:  "TaskType",
+			reason: "value must be in list [ INVITE PURCHASE SHARE SIGN_IN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTriggerConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "TriggerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "TriggerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTriggerConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "TriggerConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConditionConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "ConditionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "ConditionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConditionConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "ConditionConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RewardId
+
+	if _, ok := _UpdateTaskRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := UpdateTaskRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ ACTIVE PAUSED ENDED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 255 {
+			err := UpdateTaskRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Repeatable != nil {
+		// no validation rules for Repeatable
+	}
+
+	if len(errors) > 0 {
+		return UpdateTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTaskRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTaskRequestValidationError is the validation error returned by
+// UpdateTaskRequest.Validate if the designated constraints aren't met.
+type UpdateTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTaskRequestValidationError) ErrorName() string {
+	return "UpdateTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTaskRequestValidationError{}
+
+var _UpdateTaskRequest_TaskType_InLookup = map[string]struct{}{
+	"":         {},
+	"INVITE":   {},
+	"PURCHASE": {},
+	"SHARE":    {},
+	"SIGN_IN":  {},
+}
+
+var _UpdateTaskRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"ACTIVE": {},
+	"PAUSED": {},
+	"ENDED":  {},
+}
+
+// Validate checks the field values on DeleteTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaskRequestMultiError, or nil if none found.
+func (m *DeleteTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := DeleteTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTaskRequestValidationError is the validation error returned by
+// DeleteTaskRequest.Validate if the designated constraints aren't met.
+type DeleteTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaskRequestValidationError) ErrorName() string {
+	return "DeleteTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaskRequestValidationError{}
+
+// Validate checks the field values on TaskProgress with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskProgressMultiError, or
+// nil if none found.
+func (m *TaskProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for UserId
+
+	// no validation rules for Progress
+
+	// no validation rules for Target
+
+	// no validation rules for Completions
+
+	// no validation rules for Status
+
+	// no validation rules for LastEventId
+
+	// no validation rules for CompletedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return TaskProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskProgressMultiError is an error wrapping multiple validation errors
+// returned by TaskProgress.ValidateAll() if the designated constraints aren't met.
+type TaskProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskProgressMultiError) AllErrors() []error { return m }
+
+// TaskProgressValidationError is the validation error returned by
+// TaskProgress.Validate if the designated constraints aren't met.
+type TaskProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskProgressValidationError) ErrorName() string { return "TaskProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskProgressValidationError{}
+
+// Validate checks the field values on ListTaskProgressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskProgressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskProgressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskProgressRequestMultiError, or nil if none found.
+func (m *ListTaskProgressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskProgressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for UserId
+
+	if _, ok := _ListTaskProgressRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListTaskProgressRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ IN_PROGRESS COMPLETED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListTaskProgressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskProgressRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTaskProgressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskProgressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskProgressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskProgressRequestMultiError) AllErrors() []error { return m }
+
+// ListTaskProgressRequestValidationError is the validation error returned by
+// ListTaskProgressRequest.Validate if the designated constraints aren't met.
+type ListTaskProgressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskProgressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskProgressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskProgressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskProgressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskProgressRequestValidationError) ErrorName() string {
+	return "ListTaskProgressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskProgressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskProgressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskProgressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskProgressRequestValidationError{}
+
+var _ListTaskProgressRequest_Status_InLookup = map[string]struct{}{
+	"":            {},
+	"IN_PROGRESS": {},
+	"COMPLETED":   {},
+}
+
+// Validate checks the field values on ListTaskProgressReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskProgressReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskProgressReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskProgressReplyMultiError, or nil if none found.
+func (m *ListTaskProgressReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskProgressReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProgress() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskProgressReplyValidationError{
+						field:  fmt.Sprintf("Progress[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskProgressReplyValidationError{
+						field:  fmt.Sprintf("Progress[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskProgressReplyValidationError{
+					field:  fmt.Sprintf("Progress[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListTaskProgressReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskProgressReplyMultiError is an error wrapping multiple validation
+// errors returned by ListTaskProgressReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskProgressReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskProgressReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskProgressReplyMultiError) AllErrors() []error { return m }
+
+// ListTaskProgressReplyValidationError is the validation error returned by
+// ListTaskProgressReply.Validate if the designated constraints aren't met.
+type ListTaskProgressReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskProgressReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskProgressReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskProgressReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskProgressReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskProgressReplyValidationError) ErrorName() string {
+	return "ListTaskProgressReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskProgressReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskProgressReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskProgressReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskProgressReplyValidationError{}
+
+// Validate checks the field values on TriggerEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerEventRequestMultiError, or nil if none found.
+func (m *TriggerEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEventId()); l < 1 || l > 64 {
+		err := TriggerEventRequestValidationError{
+			field:  "EventId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TriggerEventRequest_EventType_InLookup[m.GetEventType()]; !ok {
+		err := TriggerEventRequestValidationError{
+			field:  "EventType",
+			reason: "value must be in list [USER_REGISTER ORDER_PAID USER_SIGN_IN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := TriggerEventRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for Attributes
+
+	if len(errors) > 0 {
+		return TriggerEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerEventRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerEventRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerEventRequestMultiError) AllErrors() []error { return m }
+
+// TriggerEventRequestValidationError is the validation error returned by
+// TriggerEventRequest.Validate if the designated constraints aren't met.
+type TriggerEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerEventRequestValidationError) ErrorName() string {
+	return "TriggerEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerEventRequestValidationError{}
+
+var _TriggerEventRequest_EventType_InLookup = map[string]struct{}{
+	"USER_REGISTER": {},
+	"ORDER_PAID":    {},
+	"USER_SIGN_IN":  {},
+}
+
+// Validate checks the field values on TaskTriggerResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TaskTriggerResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskTriggerResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskTriggerResultMultiError, or nil if none found.
+func (m *TaskTriggerResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskTriggerResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for TaskName
+
+	// no validation rules for UserId
+
+	// no validation rules for Progress
+
+	// no validation rules for Target
+
+	// no validation rules for Completions
+
+	// no validation rules for Completed
+
+	// no validation rules for Duplicate
+
+	// no validation rules for Ignored
+
+	// no validation rules for GrantId
+
+	// no validation rules for RewardError
+
+	if len(errors) > 0 {
+		return TaskTriggerResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskTriggerResultMultiError is an error wrapping multiple validation errors
+// returned by TaskTriggerResult.ValidateAll() if the designated constraints
+// aren't met.
+type TaskTriggerResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskTriggerResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskTriggerResultMultiError) AllErrors() []error { return m }
+
+// TaskTriggerResultValidationError is the validation error returned by
+// TaskTriggerResult.Validate if the designated constraints aren't met.
+type TaskTriggerResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskTriggerResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskTriggerResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskTriggerResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskTriggerResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskTriggerResultValidationError) ErrorName() string {
+	return "TaskTriggerResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskTriggerResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskTriggerResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskTriggerResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskTriggerResultValidationError{}
+
+// Validate checks the field values on TriggerEventReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerEventReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerEventReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerEventReplyMultiError, or nil if none found.
+func (m *TriggerEventReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerEventReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerEventReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerEventReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerEventReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TriggerEventReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerEventReplyMultiError is an error wrapping multiple validation errors
+// returned by TriggerEventReply.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerEventReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerEventReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerEventReplyMultiError) AllErrors() []error { return m }
+
+// TriggerEventReplyValidationError is the validation error returned by
+// TriggerEventReply.Validate if the designated constraints aren't met.
+type TriggerEventReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerEventReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerEventReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerEventReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerEventReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerEventReplyValidationError) ErrorName() string {
+	return "TriggerEventReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerEventReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerEventReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerEventReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerEventReplyValidationError{}
+
+// Validate checks the field values on ValidateCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateCouponRequestMultiError, or nil if none found.
+func (m *ValidateCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := ValidateCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := ValidateCouponRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) > 36 {
+		err := ValidateCouponRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at most 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserAttributes
+
+	if len(errors) > 0 {
+		return ValidateCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateCouponRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateCouponRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateCouponRequestMultiError) AllErrors() []error { return m }
+
+// ValidateCouponRequestValidationError is the validation error returned by
+// ValidateCouponRequest.Validate if the designated constraints aren't met.
+type ValidateCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateCouponRequestValidationError) ErrorName() string {
+	return "ValidateCouponRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateCouponRequestValidationError{}
+
+// Validate checks the field values on ValidateCouponReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateCouponReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateCouponReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateCouponReplyMultiError, or nil if none found.
+func (m *ValidateCouponReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateCouponReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	// no validation rules for Message
+
+	// no validation rules for DiscountAmount
+
+	// no validation rules for FinalAmount
+
+	if all {
+		switch v := interface{}(m.GetCoupon()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateCouponReplyValidationError{
+					field:  "Coupon",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateCouponReplyValidationError{
+				field:  "Coupon",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	// no validation rules for NextAvailableAt
+
+	for idx, item := range m.GetQuotas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateCouponReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateCouponReplyValidationError{
+						field:  fmt.Sprintf("Quotas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateCouponReplyValidationError{
+					field:  fmt.Sprintf("Quotas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for BudgetClamped
+
+	if m.BudgetRemaining != nil {
+		// no validation rules for BudgetRemaining
+	}
+
+	if len(errors) > 0 {
+		return ValidateCouponReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateCouponReplyMultiError is an error wrapping multiple validation
+// errors returned by ValidateCouponReply.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateCouponReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateCouponReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateCouponReplyMultiError) AllErrors() []error { return m }
+
+// ValidateCouponReplyValidationError is the validation error returned by
+// ValidateCouponReply.Validate if the designated constraints aren't met.
+type ValidateCouponReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateCouponReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateCouponReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateCouponReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateCouponReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateCouponReplyValidationError) ErrorName() string {
+	return "ValidateCouponReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateCouponReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateCouponReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateCouponReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateCouponReplyValidationError{}
+
+// Validate checks the field values on UseCouponRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UseCouponRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UseCouponRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UseCouponRequestMultiError, or nil if none found.
+func (m *UseCouponRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UseCouponRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := UseCouponRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAppId()) < 1 {
+		err := UseCouponRequestValidationError{
+			field:  "AppId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := UseCouponRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPaymentOrderId()) < 1 {
+		err := UseCouponRequestValidationError{
+			field:  "PaymentOrderId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPaymentId()) < 1 {
+		err := UseCouponRequestValidationError{
+			field:  "PaymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOriginalAmount() <= 0 {
+		err := UseCouponRequestValidationError{
+			field:  "OriginalAmount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDiscountAmount() < 0 {
+		err := UseCouponRequestValidationError{
+			field:  "DiscountAmount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFinalAmount() <= 0 {
+		err := UseCouponRequestValidationError{
+			field:  "FinalAmount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UseCouponRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UseCouponRequestMultiError is an error wrapping multiple validation errors
+// returned by UseCouponRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UseCouponRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UseCouponRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UseCouponRequestMultiError) AllErrors() []error { return m }
+
+// UseCouponRequestValidationError is the validation error returned by
+// UseCouponRequest.Validate if the designated constraints aren't met.
+type UseCouponRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UseCouponRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UseCouponRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UseCouponRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UseCouponRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UseCouponRequestValidationError) ErrorName() string { return "UseCouponRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UseCouponRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUseCouponRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UseCouponRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UseCouponRequestValidationError{}
+
+// Validate checks the field values on UseCouponReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UseCouponReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UseCouponReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UseCouponReplyMultiError,
+// or nil if none found.
+func (m *UseCouponReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UseCouponReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UseCouponReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UseCouponReplyMultiError is an error wrapping multiple validation errors
+// returned by UseCouponReply.ValidateAll() if the designated constraints
+// aren't met.
+type UseCouponReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UseCouponReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UseCouponReplyMultiError) AllErrors() []error { return m }
+
+// UseCouponReplyValidationError is the validation error returned by
+// UseCouponReply.Validate if the designated constraints aren't met.
+type UseCouponReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UseCouponReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UseCouponReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UseCouponReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UseCouponReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UseCouponReplyValidationError) ErrorName() string { return "UseCouponReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UseCouponReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUseCouponReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UseCouponReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UseCouponReplyValidationError{}
+
+// Validate checks the field values on GetCouponStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCouponStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCouponStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCouponStatsRequestMultiError, or nil if none found.
+func (m *GetCouponStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCouponStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := GetCouponStatsRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ExactUniqueUsers
+
+	if len(errors) > 0 {
+		return GetCouponStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCouponStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCouponStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCouponStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCouponStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCouponStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetCouponStatsRequestValidationError is the validation error returned by
+// GetCouponStatsRequest.Validate if the designated constraints aren't met.
+type GetCouponStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCouponStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCouponStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCouponStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCouponStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCouponStatsRequestValidationError) ErrorName() string {
+	return "GetCouponStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCouponStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCouponStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCouponStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCouponStatsRequestValidationError{}
+
+// Validate checks the field values on GetCouponStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCouponStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCouponStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCouponStatsReplyMultiError, or nil if none found.
+func (m *GetCouponStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCouponStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CouponCode
+
+	// no validation rules for TotalUses
+
+	// no validation rules for TotalOrders
+
+	// no validation rules for TotalRevenue
+
+	// no validation rules for TotalDiscount
+
+	// no validation rules for ConversionRate
+
+	// no validation rules for ValidationAttempts
+
+	// no validation rules for ValidAttempts
+
+	// no validation rules for ValidRate
+
+	// no validation rules for RedemptionRate
+
+	// no validation rules for QuotaUtilization
+
+	for idx, item := range m.GetAmountsByCurrency() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCouponStatsReplyValidationError{
+						field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCouponStatsReplyValidationError{
+						field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCouponStatsReplyValidationError{
+					field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UniqueUsers
+
+	// no validation rules for UniqueUsersExact
+
+	if len(errors) > 0 {
+		return GetCouponStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCouponStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetCouponStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetCouponStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCouponStatsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCouponStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetCouponStatsReplyValidationError is the validation error returned by
+// GetCouponStatsReply.Validate if the designated constraints aren't met.
+type GetCouponStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCouponStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCouponStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCouponStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCouponStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCouponStatsReplyValidationError) ErrorName() string {
+	return "GetCouponStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCouponStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCouponStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCouponStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCouponStatsReplyValidationError{}
+
+// Validate checks the field values on CurrencyAmount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CurrencyAmount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CurrencyAmount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CurrencyAmountMultiError,
+// or nil if none found.
+func (m *CurrencyAmount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CurrencyAmount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Currency
+
+	// no validation rules for TotalUses
+
+	// no validation rules for TotalRevenue
+
+	// no validation rules for TotalDiscount
+
+	if len(errors) > 0 {
+		return CurrencyAmountMultiError(errors)
+	}
+
+	return nil
+}
+
+// CurrencyAmountMultiError is an error wrapping multiple validation errors
+// returned by CurrencyAmount.ValidateAll() if the designated constraints
+// aren't met.
+type CurrencyAmountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CurrencyAmountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CurrencyAmountMultiError) AllErrors() []error { return m }
+
+// CurrencyAmountValidationError is the validation error returned by
+// CurrencyAmount.Validate if the designated constraints aren't met.
+type CurrencyAmountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CurrencyAmountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CurrencyAmountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CurrencyAmountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CurrencyAmountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CurrencyAmountValidationError) ErrorName() string { return "CurrencyAmountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CurrencyAmountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCurrencyAmount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CurrencyAmountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CurrencyAmountValidationError{}
+
+// Validate checks the field values on CouponUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CouponUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CouponUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CouponUsageMultiError, or
+// nil if none found.
+func (m *CouponUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CouponUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CouponUsageId
+
+	// no validation rules for CouponCode
+
+	// no validation rules for AppId
+
+	// no validation rules for UserId
+
+	// no validation rules for PaymentOrderId
+
+	// no validation rules for PaymentId
+
+	// no validation rules for OriginalAmount
+
+	// no validation rules for DiscountAmount
+
+	// no validation rules for FinalAmount
+
+	// no validation rules for UsedAt
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return CouponUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// CouponUsageMultiError is an error wrapping multiple validation errors
+// returned by CouponUsage.ValidateAll() if the designated constraints aren't met.
+type CouponUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CouponUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CouponUsageMultiError) AllErrors() []error { return m }
+
+// CouponUsageValidationError is the validation error returned by
+// CouponUsage.Validate if the designated constraints aren't met.
+type CouponUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CouponUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CouponUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CouponUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CouponUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CouponUsageValidationError) ErrorName() string { return "CouponUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CouponUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCouponUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CouponUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CouponUsageValidationError{}
+
+// Validate checks the field values on ListCouponUsagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCouponUsagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCouponUsagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCouponUsagesRequestMultiError, or nil if none found.
+func (m *ListCouponUsagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCouponUsagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
+		err := ListCouponUsagesRequestValidationError{
+			field:  "CouponCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCouponUsagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCouponUsagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCouponUsagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCouponUsagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCouponUsagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCouponUsagesRequestMultiError) AllErrors() []error { return m }
+
+// ListCouponUsagesRequestValidationError is the validation error returned by
+// ListCouponUsagesRequest.Validate if the designated constraints aren't met.
+type ListCouponUsagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCouponUsagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCouponUsagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCouponUsagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCouponUsagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCouponUsagesRequestValidationError) ErrorName() string {
+	return "ListCouponUsagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCouponUsagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCouponUsagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCouponUsagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCouponUsagesRequestValidationError{}
+
+// Validate checks the field values on ListCouponUsagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCouponUsagesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCouponUsagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCouponUsagesReplyMultiError, or nil if none found.
+func (m *ListCouponUsagesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCouponUsagesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCouponUsagesReplyValidationError{
+						field:  fmt.Sprintf("Usages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCouponUsagesReplyValidationError{
+						field:  fmt.Sprintf("Usages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCouponUsagesReplyValidationError{
+					field:  fmt.Sprintf("Usages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCouponUsagesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCouponUsagesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListCouponUsagesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListCouponUsagesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCouponUsagesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCouponUsagesReplyMultiError) AllErrors() []error { return m }
+
+// ListCouponUsagesReplyValidationError is the validation error returned by
+// ListCouponUsagesReply.Validate if the designated constraints aren't met.
+type ListCouponUsagesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCouponUsagesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCouponUsagesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCouponUsagesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCouponUsagesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCouponUsagesReplyValidationError) ErrorName() string {
+	return "ListCouponUsagesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCouponUsagesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCouponUsagesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCouponUsagesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCouponUsagesReplyValidationError{}
+
+// Validate checks the field values on GetCouponUsageTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCouponUsageTimeSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCouponUsageTimeSeriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCouponUsageTimeSeriesRequestMultiError, or nil if none found.
+func (m *GetCouponUsageTimeSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCouponUsageTimeSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFrom() <= 0 {
+		err := GetCouponUsageTimeSeriesRequestValidationError{
+			field:  "From",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTo() <= 0 {
+		err := GetCouponUsageTimeSeriesRequestValidationError{
+			field:  "To",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetCouponUsageTimeSeriesRequest_Granularity_InLookup[m.GetGranularity()]; !ok {
+		err := GetCouponUsageTimeSeriesRequestValidationError{
+			field:  "Granularity",
+			reason: "value must be in list [hour day week month]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Timezone
+
+	// no validation rules for CouponCode
+
+	if len(errors) > 0 {
+		return GetCouponUsageTimeSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCouponUsageTimeSeriesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCouponUsageTimeSeriesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetCouponUsageTimeSeriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCouponsRequestMultiError) Error() string {
+func (m GetCouponUsageTimeSeriesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -741,11 +17748,12 @@ func (m ListCouponsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCouponsRequestMultiError) AllErrors() []error { return m }
+func (m GetCouponUsageTimeSeriesRequestMultiError) AllErrors() []error { return m }
 
-// ListCouponsRequestValidationError is the validation error returned by
-// ListCouponsRequest.Validate if the designated constraints aren't met.
-type ListCouponsRequestValidationError struct {
+// GetCouponUsageTimeSeriesRequestValidationError is the validation error
+// returned by GetCouponUsageTimeSeriesRequest.Validate if the designated
+// constraints aren't met.
+type GetCouponUsageTimeSeriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -753,24 +17761,24 @@ type ListCouponsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCouponsRequestValidationError) Field() string { return e.field }
+func (e GetCouponUsageTimeSeriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCouponsRequestValidationError) Reason() string { return e.reason }
+func (e GetCouponUsageTimeSeriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCouponsRequestValidationError) Cause() error { return e.cause }
+func (e GetCouponUsageTimeSeriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCouponsRequestValidationError) Key() bool { return e.key }
+func (e GetCouponUsageTimeSeriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCouponsRequestValidationError) ErrorName() string {
-	return "ListCouponsRequestValidationError"
+func (e GetCouponUsageTimeSeriesRequestValidationError) ErrorName() string {
+	return "GetCouponUsageTimeSeriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCouponsRequestValidationError) Error() string {
+func (e GetCouponUsageTimeSeriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -782,14 +17790,14 @@ func (e ListCouponsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCouponsRequest.%s: %s%s",
+		"invalid %sGetCouponUsageTimeSeriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCouponsRequestValidationError{}
+var _ error = GetCouponUsageTimeSeriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -797,84 +17805,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCouponsRequestValidationError{}
+} = GetCouponUsageTimeSeriesRequestValidationError{}
 
-// Validate checks the field values on ListCouponsReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListCouponsReply) Validate() error {
+var _GetCouponUsageTimeSeriesRequest_Granularity_InLookup = map[string]struct{}{
+	"hour":  {},
+	"day":   {},
+	"week":  {},
+	"month": {},
+}
+
+// Validate checks the field values on CouponUsageTimeSeriesPoint with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CouponUsageTimeSeriesPoint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCouponsReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CouponUsageTimeSeriesPoint with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCouponsReplyMultiError, or nil if none found.
-func (m *ListCouponsReply) ValidateAll() error {
+// CouponUsageTimeSeriesPointMultiError, or nil if none found.
+func (m *CouponUsageTimeSeriesPoint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCouponsReply) validate(all bool) error {
+func (m *CouponUsageTimeSeriesPoint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCoupons() {
-		_, _ = idx, item
+	// no validation rules for BucketStart
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCouponsReplyValidationError{
-						field:  fmt.Sprintf("Coupons[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCouponsReplyValidationError{
-						field:  fmt.Sprintf("Coupons[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListCouponsReplyValidationError{
-					field:  fmt.Sprintf("Coupons[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Uses
 
-	}
+	// no validation rules for DistinctOrders
 
-	// no validation rules for Total
+	// no validation rules for DistinctUsers
 
-	// no validation rules for Page
+	// no validation rules for Revenue
 
-	// no validation rules for PageSize
+	// no validation rules for Discount
 
 	if len(errors) > 0 {
-		return ListCouponsReplyMultiError(errors)
+		return CouponUsageTimeSeriesPointMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCouponsReplyMultiError is an error wrapping multiple validation errors
-// returned by ListCouponsReply.ValidateAll() if the designated constraints
-// aren't met.
-type ListCouponsReplyMultiError []error
+// CouponUsageTimeSeriesPointMultiError is an error wrapping multiple
+// validation errors returned by CouponUsageTimeSeriesPoint.ValidateAll() if
+// the designated constraints aren't met.
+type CouponUsageTimeSeriesPointMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCouponsReplyMultiError) Error() string {
+func (m CouponUsageTimeSeriesPointMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -883,11 +17870,11 @@ func (m ListCouponsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCouponsReplyMultiError) AllErrors() []error { return m }
+func (m CouponUsageTimeSeriesPointMultiError) AllErrors() []error { return m }
 
-// ListCouponsReplyValidationError is the validation error returned by
-// ListCouponsReply.Validate if the designated constraints aren't met.
-type ListCouponsReplyValidationError struct {
+// CouponUsageTimeSeriesPointValidationError is the validation error returned
+// by CouponUsageTimeSeriesPoint.Validate if the designated constraints aren't met.
+type CouponUsageTimeSeriesPointValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -895,22 +17882,24 @@ type ListCouponsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCouponsReplyValidationError) Field() string { return e.field }
+func (e CouponUsageTimeSeriesPointValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCouponsReplyValidationError) Reason() string { return e.reason }
+func (e CouponUsageTimeSeriesPointValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCouponsReplyValidationError) Cause() error { return e.cause }
+func (e CouponUsageTimeSeriesPointValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCouponsReplyValidationError) Key() bool { return e.key }
+func (e CouponUsageTimeSeriesPointValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCouponsReplyValidationError) ErrorName() string { return "ListCouponsReplyValidationError" }
+func (e CouponUsageTimeSeriesPointValidationError) ErrorName() string {
+	return "CouponUsageTimeSeriesPointValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListCouponsReplyValidationError) Error() string {
+func (e CouponUsageTimeSeriesPointValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -922,14 +17911,14 @@ func (e ListCouponsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCouponsReply.%s: %s%s",
+		"invalid %sCouponUsageTimeSeriesPoint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCouponsReplyValidationError{}
+var _ error = CouponUsageTimeSeriesPointValidationError{}
 
 var _ interface {
 	Field() string
@@ -937,71 +17926,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCouponsReplyValidationError{}
+} = CouponUsageTimeSeriesPointValidationError{}
 
-// Validate checks the field values on UpdateCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetCouponUsageTimeSeriesReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateCouponRequest) Validate() error {
+func (m *GetCouponUsageTimeSeriesReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateCouponRequestMultiError, or nil if none found.
-func (m *UpdateCouponRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetCouponUsageTimeSeriesReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCouponUsageTimeSeriesReplyMultiError, or nil if none found.
+func (m *GetCouponUsageTimeSeriesReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateCouponRequest) validate(all bool) error {
+func (m *GetCouponUsageTimeSeriesReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
-		err := UpdateCouponRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for DiscountType
-
-	// no validation rules for DiscountValue
-
-	// no validation rules for Currency
-
-	// no validation rules for ValidFrom
+	// no validation rules for Granularity
 
-	// no validation rules for ValidUntil
+	// no validation rules for Timezone
 
-	// no validation rules for MaxUses
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
 
-	// no validation rules for MinAmount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCouponUsageTimeSeriesReplyValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCouponUsageTimeSeriesReplyValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCouponUsageTimeSeriesReplyValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Status
+	}
 
 	if len(errors) > 0 {
-		return UpdateCouponRequestMultiError(errors)
+		return GetCouponUsageTimeSeriesReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateCouponRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateCouponRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateCouponRequestMultiError []error
+// GetCouponUsageTimeSeriesReplyMultiError is an error wrapping multiple
+// validation errors returned by GetCouponUsageTimeSeriesReply.ValidateAll()
+// if the designated constraints aren't met.
+type GetCouponUsageTimeSeriesReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateCouponRequestMultiError) Error() string {
+func (m GetCouponUsageTimeSeriesReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1010,11 +18010,12 @@ func (m UpdateCouponRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateCouponRequestMultiError) AllErrors() []error { return m }
+func (m GetCouponUsageTimeSeriesReplyMultiError) AllErrors() []error { return m }
 
-// UpdateCouponRequestValidationError is the validation error returned by
-// UpdateCouponRequest.Validate if the designated constraints aren't met.
-type UpdateCouponRequestValidationError struct {
+// GetCouponUsageTimeSeriesReplyValidationError is the validation error
+// returned by GetCouponUsageTimeSeriesReply.Validate if the designated
+// constraints aren't met.
+type GetCouponUsageTimeSeriesReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1022,24 +18023,24 @@ type UpdateCouponRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateCouponRequestValidationError) Field() string { return e.field }
+func (e GetCouponUsageTimeSeriesReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateCouponRequestValidationError) Reason() string { return e.reason }
+func (e GetCouponUsageTimeSeriesReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateCouponRequestValidationError) Cause() error { return e.cause }
+func (e GetCouponUsageTimeSeriesReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateCouponRequestValidationError) Key() bool { return e.key }
+func (e GetCouponUsageTimeSeriesReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateCouponRequestValidationError) ErrorName() string {
-	return "UpdateCouponRequestValidationError"
+func (e GetCouponUsageTimeSeriesReplyValidationError) ErrorName() string {
+	return "GetCouponUsageTimeSeriesReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateCouponRequestValidationError) Error() string {
+func (e GetCouponUsageTimeSeriesReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1051,14 +18052,14 @@ func (e UpdateCouponRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateCouponRequest.%s: %s%s",
+		"invalid %sGetCouponUsageTimeSeriesReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateCouponRequestValidationError{}
+var _ error = GetCouponUsageTimeSeriesReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1066,73 +18067,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateCouponRequestValidationError{}
+} = GetCouponUsageTimeSeriesReplyValidationError{}
 
-// Validate checks the field values on UpdateCouponReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateCouponReply) Validate() error {
+// Validate checks the field values on ListUsagesByUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsagesByUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateCouponReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUsagesByUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateCouponReplyMultiError, or nil if none found.
-func (m *UpdateCouponReply) ValidateAll() error {
+// ListUsagesByUserRequestMultiError, or nil if none found.
+func (m *ListUsagesByUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateCouponReply) validate(all bool) error {
+func (m *ListUsagesByUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCoupon()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateCouponReplyValidationError{
-					field:  "Coupon",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateCouponReplyValidationError{
-					field:  "Coupon",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := ListUsagesByUserRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateCouponReplyValidationError{
-				field:  "Coupon",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for UsedFrom
+
+	// no validation rules for UsedTo
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
 	if len(errors) > 0 {
-		return UpdateCouponReplyMultiError(errors)
+		return ListUsagesByUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateCouponReplyMultiError is an error wrapping multiple validation errors
-// returned by UpdateCouponReply.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateCouponReplyMultiError []error
+// ListUsagesByUserRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUsagesByUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsagesByUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateCouponReplyMultiError) Error() string {
+func (m ListUsagesByUserRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1141,11 +18132,11 @@ func (m UpdateCouponReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateCouponReplyMultiError) AllErrors() []error { return m }
+func (m ListUsagesByUserRequestMultiError) AllErrors() []error { return m }
 
-// UpdateCouponReplyValidationError is the validation error returned by
-// UpdateCouponReply.Validate if the designated constraints aren't met.
-type UpdateCouponReplyValidationError struct {
+// ListUsagesByUserRequestValidationError is the validation error returned by
+// ListUsagesByUserRequest.Validate if the designated constraints aren't met.
+type ListUsagesByUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1153,24 +18144,24 @@ type UpdateCouponReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateCouponReplyValidationError) Field() string { return e.field }
+func (e ListUsagesByUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateCouponReplyValidationError) Reason() string { return e.reason }
+func (e ListUsagesByUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateCouponReplyValidationError) Cause() error { return e.cause }
+func (e ListUsagesByUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateCouponReplyValidationError) Key() bool { return e.key }
+func (e ListUsagesByUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateCouponReplyValidationError) ErrorName() string {
-	return "UpdateCouponReplyValidationError"
+func (e ListUsagesByUserRequestValidationError) ErrorName() string {
+	return "ListUsagesByUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateCouponReplyValidationError) Error() string {
+func (e ListUsagesByUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1182,14 +18173,14 @@ func (e UpdateCouponReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateCouponReply.%s: %s%s",
+		"invalid %sListUsagesByUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateCouponReplyValidationError{}
+var _ error = ListUsagesByUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1197,34 +18188,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateCouponReplyValidationError{}
+} = ListUsagesByUserRequestValidationError{}
 
-// Validate checks the field values on DeleteCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetUsageByPaymentOrderRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteCouponRequest) Validate() error {
+func (m *GetUsageByPaymentOrderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteCouponRequestMultiError, or nil if none found.
-func (m *DeleteCouponRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetUsageByPaymentOrderRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetUsageByPaymentOrderRequestMultiError, or nil if none found.
+func (m *GetUsageByPaymentOrderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteCouponRequest) validate(all bool) error {
+func (m *GetUsageByPaymentOrderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
-		err := DeleteCouponRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be at least 1 runes",
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPaymentOrderId()); l < 1 || l > 64 {
+		err := GetUsageByPaymentOrderRequestValidationError{
+			field:  "PaymentOrderId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1232,20 +18223,24 @@ func (m *DeleteCouponRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for UsedFrom
+
+	// no validation rules for UsedTo
+
 	if len(errors) > 0 {
-		return DeleteCouponRequestMultiError(errors)
+		return GetUsageByPaymentOrderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteCouponRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteCouponRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteCouponRequestMultiError []error
+// GetUsageByPaymentOrderRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUsageByPaymentOrderRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetUsageByPaymentOrderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteCouponRequestMultiError) Error() string {
+func (m GetUsageByPaymentOrderRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1254,11 +18249,12 @@ func (m DeleteCouponRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteCouponRequestMultiError) AllErrors() []error { return m }
+func (m GetUsageByPaymentOrderRequestMultiError) AllErrors() []error { return m }
 
-// DeleteCouponRequestValidationError is the validation error returned by
-// DeleteCouponRequest.Validate if the designated constraints aren't met.
-type DeleteCouponRequestValidationError struct {
+// GetUsageByPaymentOrderRequestValidationError is the validation error
+// returned by GetUsageByPaymentOrderRequest.Validate if the designated
+// constraints aren't met.
+type GetUsageByPaymentOrderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1266,24 +18262,24 @@ type DeleteCouponRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteCouponRequestValidationError) Field() string { return e.field }
+func (e GetUsageByPaymentOrderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteCouponRequestValidationError) Reason() string { return e.reason }
+func (e GetUsageByPaymentOrderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteCouponRequestValidationError) Cause() error { return e.cause }
+func (e GetUsageByPaymentOrderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteCouponRequestValidationError) Key() bool { return e.key }
+func (e GetUsageByPaymentOrderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteCouponRequestValidationError) ErrorName() string {
-	return "DeleteCouponRequestValidationError"
+func (e GetUsageByPaymentOrderRequestValidationError) ErrorName() string {
+	return "GetUsageByPaymentOrderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteCouponRequestValidationError) Error() string {
+func (e GetUsageByPaymentOrderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1295,14 +18291,14 @@ func (e DeleteCouponRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteCouponRequest.%s: %s%s",
+		"invalid %sGetUsageByPaymentOrderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteCouponRequestValidationError{}
+var _ error = GetUsageByPaymentOrderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1310,34 +18306,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteCouponRequestValidationError{}
+} = GetUsageByPaymentOrderRequestValidationError{}
 
-// Validate checks the field values on ValidateCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetUsageByPaymentIdRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateCouponRequest) Validate() error {
+func (m *GetUsageByPaymentIdRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUsageByPaymentIdRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ValidateCouponRequestMultiError, or nil if none found.
-func (m *ValidateCouponRequest) ValidateAll() error {
+// GetUsageByPaymentIdRequestMultiError, or nil if none found.
+func (m *GetUsageByPaymentIdRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateCouponRequest) validate(all bool) error {
+func (m *GetUsageByPaymentIdRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
-		err := ValidateCouponRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be at least 1 runes",
+	if l := utf8.RuneCountInString(m.GetPaymentId()); l < 1 || l > 64 {
+		err := GetUsageByPaymentIdRequestValidationError{
+			field:  "PaymentId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1345,31 +18341,24 @@ func (m *ValidateCouponRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetAmount() <= 0 {
-		err := ValidateCouponRequestValidationError{
-			field:  "Amount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for UsedFrom
+
+	// no validation rules for UsedTo
 
 	if len(errors) > 0 {
-		return ValidateCouponRequestMultiError(errors)
+		return GetUsageByPaymentIdRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateCouponRequestMultiError is an error wrapping multiple validation
-// errors returned by ValidateCouponRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ValidateCouponRequestMultiError []error
+// GetUsageByPaymentIdRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUsageByPaymentIdRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUsageByPaymentIdRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateCouponRequestMultiError) Error() string {
+func (m GetUsageByPaymentIdRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1378,11 +18367,11 @@ func (m ValidateCouponRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateCouponRequestMultiError) AllErrors() []error { return m }
+func (m GetUsageByPaymentIdRequestMultiError) AllErrors() []error { return m }
 
-// ValidateCouponRequestValidationError is the validation error returned by
-// ValidateCouponRequest.Validate if the designated constraints aren't met.
-type ValidateCouponRequestValidationError struct {
+// GetUsageByPaymentIdRequestValidationError is the validation error returned
+// by GetUsageByPaymentIdRequest.Validate if the designated constraints aren't met.
+type GetUsageByPaymentIdRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1390,24 +18379,24 @@ type ValidateCouponRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateCouponRequestValidationError) Field() string { return e.field }
+func (e GetUsageByPaymentIdRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateCouponRequestValidationError) Reason() string { return e.reason }
+func (e GetUsageByPaymentIdRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateCouponRequestValidationError) Cause() error { return e.cause }
+func (e GetUsageByPaymentIdRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateCouponRequestValidationError) Key() bool { return e.key }
+func (e GetUsageByPaymentIdRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateCouponRequestValidationError) ErrorName() string {
-	return "ValidateCouponRequestValidationError"
+func (e GetUsageByPaymentIdRequestValidationError) ErrorName() string {
+	return "GetUsageByPaymentIdRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateCouponRequestValidationError) Error() string {
+func (e GetUsageByPaymentIdRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1419,14 +18408,14 @@ func (e ValidateCouponRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateCouponRequest.%s: %s%s",
+		"invalid %sGetUsageByPaymentIdRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateCouponRequestValidationError{}
+var _ error = GetUsageByPaymentIdRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1434,61 +18423,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateCouponRequestValidationError{}
+} = GetUsageByPaymentIdRequestValidationError{}
 
-// Validate checks the field values on ValidateCouponReply with the rules
+// Validate checks the field values on GetCouponUsageReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateCouponReply) Validate() error {
+func (m *GetCouponUsageReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateCouponReply with the rules
+// ValidateAll checks the field values on GetCouponUsageReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ValidateCouponReplyMultiError, or nil if none found.
-func (m *ValidateCouponReply) ValidateAll() error {
+// GetCouponUsageReplyMultiError, or nil if none found.
+func (m *GetCouponUsageReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateCouponReply) validate(all bool) error {
+func (m *GetCouponUsageReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Valid
-
-	// no validation rules for Message
-
-	// no validation rules for DiscountAmount
-
-	// no validation rules for FinalAmount
-
 	if all {
-		switch v := interface{}(m.GetCoupon()).(type) {
+		switch v := interface{}(m.GetUsage()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ValidateCouponReplyValidationError{
-					field:  "Coupon",
+				errors = append(errors, GetCouponUsageReplyValidationError{
+					field:  "Usage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ValidateCouponReplyValidationError{
-					field:  "Coupon",
+				errors = append(errors, GetCouponUsageReplyValidationError{
+					field:  "Usage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCoupon()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUsage()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ValidateCouponReplyValidationError{
-				field:  "Coupon",
+			return GetCouponUsageReplyValidationError{
+				field:  "Usage",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1496,19 +18477,19 @@ func (m *ValidateCouponReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ValidateCouponReplyMultiError(errors)
+		return GetCouponUsageReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateCouponReplyMultiError is an error wrapping multiple validation
-// errors returned by ValidateCouponReply.ValidateAll() if the designated
+// GetCouponUsageReplyMultiError is an error wrapping multiple validation
+// errors returned by GetCouponUsageReply.ValidateAll() if the designated
 // constraints aren't met.
-type ValidateCouponReplyMultiError []error
+type GetCouponUsageReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateCouponReplyMultiError) Error() string {
+func (m GetCouponUsageReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1517,11 +18498,11 @@ func (m ValidateCouponReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateCouponReplyMultiError) AllErrors() []error { return m }
+func (m GetCouponUsageReplyMultiError) AllErrors() []error { return m }
 
-// ValidateCouponReplyValidationError is the validation error returned by
-// ValidateCouponReply.Validate if the designated constraints aren't met.
-type ValidateCouponReplyValidationError struct {
+// GetCouponUsageReplyValidationError is the validation error returned by
+// GetCouponUsageReply.Validate if the designated constraints aren't met.
+type GetCouponUsageReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1529,24 +18510,24 @@ type ValidateCouponReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateCouponReplyValidationError) Field() string { return e.field }
+func (e GetCouponUsageReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateCouponReplyValidationError) Reason() string { return e.reason }
+func (e GetCouponUsageReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateCouponReplyValidationError) Cause() error { return e.cause }
+func (e GetCouponUsageReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateCouponReplyValidationError) Key() bool { return e.key }
+func (e GetCouponUsageReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateCouponReplyValidationError) ErrorName() string {
-	return "ValidateCouponReplyValidationError"
+func (e GetCouponUsageReplyValidationError) ErrorName() string {
+	return "GetCouponUsageReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateCouponReplyValidationError) Error() string {
+func (e GetCouponUsageReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1558,14 +18539,14 @@ func (e ValidateCouponReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateCouponReply.%s: %s%s",
+		"invalid %sGetCouponUsageReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateCouponReplyValidationError{}
+var _ error = GetCouponUsageReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1573,132 +18554,156 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateCouponReplyValidationError{}
+} = GetCouponUsageReplyValidationError{}
 
-// Validate checks the field values on UseCouponRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UseCouponRequest) Validate() error {
+// Validate checks the field values on RebuildCouponStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebuildCouponStatsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UseCouponRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RebuildCouponStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UseCouponRequestMultiError, or nil if none found.
-func (m *UseCouponRequest) ValidateAll() error {
+// RebuildCouponStatsRequestMultiError, or nil if none found.
+func (m *RebuildCouponStatsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UseCouponRequest) validate(all bool) error {
+func (m *RebuildCouponStatsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
-		err := UseCouponRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for AppId
 
-	if utf8.RuneCountInString(m.GetAppId()) < 1 {
-		err := UseCouponRequestValidationError{
-			field:  "AppId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for CouponCode
 
-	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := UseCouponRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for From
 
-	if utf8.RuneCountInString(m.GetPaymentOrderId()) < 1 {
-		err := UseCouponRequestValidationError{
-			field:  "PaymentOrderId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return RebuildCouponStatsRequestMultiError(errors)
 	}
 
-	if utf8.RuneCountInString(m.GetPaymentId()) < 1 {
-		err := UseCouponRequestValidationError{
-			field:  "PaymentId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// RebuildCouponStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by RebuildCouponStatsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RebuildCouponStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebuildCouponStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.GetOriginalAmount() <= 0 {
-		err := UseCouponRequestValidationError{
-			field:  "OriginalAmount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+// AllErrors returns a list of validation violation errors.
+func (m RebuildCouponStatsRequestMultiError) AllErrors() []error { return m }
+
+// RebuildCouponStatsRequestValidationError is the validation error returned by
+// RebuildCouponStatsRequest.Validate if the designated constraints aren't met.
+type RebuildCouponStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebuildCouponStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebuildCouponStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebuildCouponStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebuildCouponStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebuildCouponStatsRequestValidationError) ErrorName() string {
+	return "RebuildCouponStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebuildCouponStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if m.GetDiscountAmount() < 0 {
-		err := UseCouponRequestValidationError{
-			field:  "DiscountAmount",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if m.GetFinalAmount() <= 0 {
-		err := UseCouponRequestValidationError{
-			field:  "FinalAmount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return fmt.Sprintf(
+		"invalid %sRebuildCouponStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebuildCouponStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebuildCouponStatsRequestValidationError{}
+
+// Validate checks the field values on RebuildCouponStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebuildCouponStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebuildCouponStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebuildCouponStatsReplyMultiError, or nil if none found.
+func (m *RebuildCouponStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebuildCouponStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Rows
+
 	if len(errors) > 0 {
-		return UseCouponRequestMultiError(errors)
+		return RebuildCouponStatsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UseCouponRequestMultiError is an error wrapping multiple validation errors
-// returned by UseCouponRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UseCouponRequestMultiError []error
+// RebuildCouponStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by RebuildCouponStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type RebuildCouponStatsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UseCouponRequestMultiError) Error() string {
+func (m RebuildCouponStatsReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1707,11 +18712,11 @@ func (m UseCouponRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UseCouponRequestMultiError) AllErrors() []error { return m }
+func (m RebuildCouponStatsReplyMultiError) AllErrors() []error { return m }
 
-// UseCouponRequestValidationError is the validation error returned by
-// UseCouponRequest.Validate if the designated constraints aren't met.
-type UseCouponRequestValidationError struct {
+// RebuildCouponStatsReplyValidationError is the validation error returned by
+// RebuildCouponStatsReply.Validate if the designated constraints aren't met.
+type RebuildCouponStatsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1719,22 +18724,24 @@ type UseCouponRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UseCouponRequestValidationError) Field() string { return e.field }
+func (e RebuildCouponStatsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UseCouponRequestValidationError) Reason() string { return e.reason }
+func (e RebuildCouponStatsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UseCouponRequestValidationError) Cause() error { return e.cause }
+func (e RebuildCouponStatsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UseCouponRequestValidationError) Key() bool { return e.key }
+func (e RebuildCouponStatsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UseCouponRequestValidationError) ErrorName() string { return "UseCouponRequestValidationError" }
+func (e RebuildCouponStatsReplyValidationError) ErrorName() string {
+	return "RebuildCouponStatsReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UseCouponRequestValidationError) Error() string {
+func (e RebuildCouponStatsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1746,14 +18753,14 @@ func (e UseCouponRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUseCouponRequest.%s: %s%s",
+		"invalid %sRebuildCouponStatsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UseCouponRequestValidationError{}
+var _ error = RebuildCouponStatsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1761,48 +18768,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UseCouponRequestValidationError{}
+} = RebuildCouponStatsReplyValidationError{}
 
-// Validate checks the field values on UseCouponReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UseCouponReply) Validate() error {
+// Validate checks the field values on GetCouponsSummaryStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCouponsSummaryStatsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UseCouponReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UseCouponReplyMultiError,
-// or nil if none found.
-func (m *UseCouponReply) ValidateAll() error {
+// ValidateAll checks the field values on GetCouponsSummaryStatsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetCouponsSummaryStatsRequestMultiError, or nil if none found.
+func (m *GetCouponsSummaryStatsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UseCouponReply) validate(all bool) error {
+func (m *GetCouponsSummaryStatsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for AppId
 
-	// no validation rules for Message
+	if val := m.GetTopN(); val < 0 || val > 100 {
+		err := GetCouponsSummaryStatsRequestValidationError{
+			field:  "TopN",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetCouponsSummaryStatsRequest_RankBy_InLookup[m.GetRankBy()]; !ok {
+		err := GetCouponsSummaryStatsRequestValidationError{
+			field:  "RankBy",
+			reason: "value must be in list [ uses revenue discount distinct_users]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for ExactUniqueUsers
 
 	if len(errors) > 0 {
-		return UseCouponReplyMultiError(errors)
+		return GetCouponsSummaryStatsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UseCouponReplyMultiError is an error wrapping multiple validation errors
-// returned by UseCouponReply.ValidateAll() if the designated constraints
-// aren't met.
-type UseCouponReplyMultiError []error
+// GetCouponsSummaryStatsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCouponsSummaryStatsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetCouponsSummaryStatsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UseCouponReplyMultiError) Error() string {
+func (m GetCouponsSummaryStatsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1811,11 +18844,12 @@ func (m UseCouponReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UseCouponReplyMultiError) AllErrors() []error { return m }
+func (m GetCouponsSummaryStatsRequestMultiError) AllErrors() []error { return m }
 
-// UseCouponReplyValidationError is the validation error returned by
-// UseCouponReply.Validate if the designated constraints aren't met.
-type UseCouponReplyValidationError struct {
+// GetCouponsSummaryStatsRequestValidationError is the validation error
+// returned by GetCouponsSummaryStatsRequest.Validate if the designated
+// constraints aren't met.
+type GetCouponsSummaryStatsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1823,22 +18857,24 @@ type UseCouponReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UseCouponReplyValidationError) Field() string { return e.field }
+func (e GetCouponsSummaryStatsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UseCouponReplyValidationError) Reason() string { return e.reason }
+func (e GetCouponsSummaryStatsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UseCouponReplyValidationError) Cause() error { return e.cause }
+func (e GetCouponsSummaryStatsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UseCouponReplyValidationError) Key() bool { return e.key }
+func (e GetCouponsSummaryStatsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UseCouponReplyValidationError) ErrorName() string { return "UseCouponReplyValidationError" }
+func (e GetCouponsSummaryStatsRequestValidationError) ErrorName() string {
+	return "GetCouponsSummaryStatsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UseCouponReplyValidationError) Error() string {
+func (e GetCouponsSummaryStatsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1850,14 +18886,14 @@ func (e UseCouponReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUseCouponReply.%s: %s%s",
+		"invalid %sGetCouponsSummaryStatsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UseCouponReplyValidationError{}
+var _ error = GetCouponsSummaryStatsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1865,55 +18901,148 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UseCouponReplyValidationError{}
+} = GetCouponsSummaryStatsRequestValidationError{}
 
-// Validate checks the field values on GetCouponStatsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+var _GetCouponsSummaryStatsRequest_RankBy_InLookup = map[string]struct{}{
+	"":               {},
+	"uses":           {},
+	"revenue":        {},
+	"discount":       {},
+	"distinct_users": {},
+}
+
+// Validate checks the field values on GetCouponsSummaryStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCouponStatsRequest) Validate() error {
+func (m *GetCouponsSummaryStatsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCouponStatsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCouponsSummaryStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCouponStatsRequestMultiError, or nil if none found.
-func (m *GetCouponStatsRequest) ValidateAll() error {
+// GetCouponsSummaryStatsReplyMultiError, or nil if none found.
+func (m *GetCouponsSummaryStatsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCouponStatsRequest) validate(all bool) error {
+func (m *GetCouponsSummaryStatsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
-		err := GetCouponStatsRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for TotalCoupons
+
+	// no validation rules for ActiveCoupons
+
+	// no validation rules for TotalUses
+
+	// no validation rules for TotalOrders
+
+	// no validation rules for TotalRevenue
+
+	// no validation rules for TotalDiscount
+
+	// no validation rules for AverageConversionRate
+
+	for idx, item := range m.GetTopCoupons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCouponsSummaryStatsReplyValidationError{
+						field:  fmt.Sprintf("TopCoupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCouponsSummaryStatsReplyValidationError{
+						field:  fmt.Sprintf("TopCoupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCouponsSummaryStatsReplyValidationError{
+					field:  fmt.Sprintf("TopCoupons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		if !all {
-			return err
+
+	}
+
+	// no validation rules for TotalValidationAttempts
+
+	// no validation rules for TotalValidAttempts
+
+	// no validation rules for ConversionRate
+
+	// no validation rules for ValidRate
+
+	// no validation rules for RedemptionRate
+
+	for idx, item := range m.GetAmountsByCurrency() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCouponsSummaryStatsReplyValidationError{
+						field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCouponsSummaryStatsReplyValidationError{
+						field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCouponsSummaryStatsReplyValidationError{
+					field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for UniqueUsers
+
+	// no validation rules for UniqueUsersExact
+
 	if len(errors) > 0 {
-		return GetCouponStatsRequestMultiError(errors)
+		return GetCouponsSummaryStatsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCouponStatsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCouponStatsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCouponStatsRequestMultiError []error
+// GetCouponsSummaryStatsReplyMultiError is an error wrapping multiple
+// validation errors returned by GetCouponsSummaryStatsReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetCouponsSummaryStatsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCouponStatsRequestMultiError) Error() string {
+func (m GetCouponsSummaryStatsReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1922,11 +19051,12 @@ func (m GetCouponStatsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCouponStatsRequestMultiError) AllErrors() []error { return m }
+func (m GetCouponsSummaryStatsReplyMultiError) AllErrors() []error { return m }
 
-// GetCouponStatsRequestValidationError is the validation error returned by
-// GetCouponStatsRequest.Validate if the designated constraints aren't met.
-type GetCouponStatsRequestValidationError struct {
+// GetCouponsSummaryStatsReplyValidationError is the validation error returned
+// by GetCouponsSummaryStatsReply.Validate if the designated constraints
+// aren't met.
+type GetCouponsSummaryStatsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1934,24 +19064,24 @@ type GetCouponStatsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCouponStatsRequestValidationError) Field() string { return e.field }
+func (e GetCouponsSummaryStatsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCouponStatsRequestValidationError) Reason() string { return e.reason }
+func (e GetCouponsSummaryStatsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCouponStatsRequestValidationError) Cause() error { return e.cause }
+func (e GetCouponsSummaryStatsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCouponStatsRequestValidationError) Key() bool { return e.key }
+func (e GetCouponsSummaryStatsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCouponStatsRequestValidationError) ErrorName() string {
-	return "GetCouponStatsRequestValidationError"
+func (e GetCouponsSummaryStatsReplyValidationError) ErrorName() string {
+	return "GetCouponsSummaryStatsReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCouponStatsRequestValidationError) Error() string {
+func (e GetCouponsSummaryStatsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1963,14 +19093,14 @@ func (e GetCouponStatsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCouponStatsRequest.%s: %s%s",
+		"invalid %sGetCouponsSummaryStatsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCouponStatsRequestValidationError{}
+var _ error = GetCouponsSummaryStatsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1978,24 +19108,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCouponStatsRequestValidationError{}
+} = GetCouponsSummaryStatsReplyValidationError{}
 
-// Validate checks the field values on GetCouponStatsReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCouponStatsReply) Validate() error {
+// Validate checks the field values on CouponStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CouponStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCouponStatsReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCouponStatsReplyMultiError, or nil if none found.
-func (m *GetCouponStatsReply) ValidateAll() error {
+// ValidateAll checks the field values on CouponStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CouponStatsMultiError, or
+// nil if none found.
+func (m *CouponStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCouponStatsReply) validate(all bool) error {
+func (m *CouponStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2014,20 +19144,65 @@ func (m *GetCouponStatsReply) validate(all bool) error {
 
 	// no validation rules for ConversionRate
 
+	// no validation rules for ValidationAttempts
+
+	// no validation rules for ValidAttempts
+
+	// no validation rules for ValidRate
+
+	// no validation rules for RedemptionRate
+
+	// no validation rules for QuotaUtilization
+
+	for idx, item := range m.GetAmountsByCurrency() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CouponStatsValidationError{
+						field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CouponStatsValidationError{
+						field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CouponStatsValidationError{
+					field:  fmt.Sprintf("AmountsByCurrency[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DistinctUsers
+
 	if len(errors) > 0 {
-		return GetCouponStatsReplyMultiError(errors)
+		return CouponStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCouponStatsReplyMultiError is an error wrapping multiple validation
-// errors returned by GetCouponStatsReply.ValidateAll() if the designated
-// constraints aren't met.
-type GetCouponStatsReplyMultiError []error
+// CouponStatsMultiError is an error wrapping multiple validation errors
+// returned by CouponStats.ValidateAll() if the designated constraints aren't met.
+type CouponStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCouponStatsReplyMultiError) Error() string {
+func (m CouponStatsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2036,11 +19211,11 @@ func (m GetCouponStatsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCouponStatsReplyMultiError) AllErrors() []error { return m }
+func (m CouponStatsMultiError) AllErrors() []error { return m }
 
-// GetCouponStatsReplyValidationError is the validation error returned by
-// GetCouponStatsReply.Validate if the designated constraints aren't met.
-type GetCouponStatsReplyValidationError struct {
+// CouponStatsValidationError is the validation error returned by
+// CouponStats.Validate if the designated constraints aren't met.
+type CouponStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2048,24 +19223,22 @@ type GetCouponStatsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCouponStatsReplyValidationError) Field() string { return e.field }
+func (e CouponStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCouponStatsReplyValidationError) Reason() string { return e.reason }
+func (e CouponStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCouponStatsReplyValidationError) Cause() error { return e.cause }
+func (e CouponStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCouponStatsReplyValidationError) Key() bool { return e.key }
+func (e CouponStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCouponStatsReplyValidationError) ErrorName() string {
-	return "GetCouponStatsReplyValidationError"
-}
+func (e CouponStatsValidationError) ErrorName() string { return "CouponStatsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCouponStatsReplyValidationError) Error() string {
+func (e CouponStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2077,14 +19250,14 @@ func (e GetCouponStatsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCouponStatsReply.%s: %s%s",
+		"invalid %sCouponStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCouponStatsReplyValidationError{}
+var _ error = CouponStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2092,63 +19265,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCouponStatsReplyValidationError{}
+} = CouponStatsValidationError{}
 
-// Validate checks the field values on CouponUsage with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CouponUsage) Validate() error {
+// Validate checks the field values on ExportCouponUsagesFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCouponUsagesFilter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CouponUsage with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CouponUsageMultiError, or
-// nil if none found.
-func (m *CouponUsage) ValidateAll() error {
+// ValidateAll checks the field values on ExportCouponUsagesFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCouponUsagesFilterMultiError, or nil if none found.
+func (m *ExportCouponUsagesFilter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CouponUsage) validate(all bool) error {
+func (m *ExportCouponUsagesFilter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CouponUsageId
-
 	// no validation rules for CouponCode
 
-	// no validation rules for AppId
-
 	// no validation rules for UserId
 
 	// no validation rules for PaymentOrderId
 
 	// no validation rules for PaymentId
 
-	// no validation rules for OriginalAmount
-
-	// no validation rules for DiscountAmount
-
-	// no validation rules for FinalAmount
+	// no validation rules for UsedFrom
 
-	// no validation rules for UsedAt
+	// no validation rules for UsedTo
 
 	if len(errors) > 0 {
-		return CouponUsageMultiError(errors)
+		return ExportCouponUsagesFilterMultiError(errors)
 	}
 
 	return nil
 }
 
-// CouponUsageMultiError is an error wrapping multiple validation errors
-// returned by CouponUsage.ValidateAll() if the designated constraints aren't met.
-type CouponUsageMultiError []error
+// ExportCouponUsagesFilterMultiError is an error wrapping multiple validation
+// errors returned by ExportCouponUsagesFilter.ValidateAll() if the designated
+// constraints aren't met.
+type ExportCouponUsagesFilterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CouponUsageMultiError) Error() string {
+func (m ExportCouponUsagesFilterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2157,11 +19323,11 @@ func (m CouponUsageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CouponUsageMultiError) AllErrors() []error { return m }
+func (m ExportCouponUsagesFilterMultiError) AllErrors() []error { return m }
 
-// CouponUsageValidationError is the validation error returned by
-// CouponUsage.Validate if the designated constraints aren't met.
-type CouponUsageValidationError struct {
+// ExportCouponUsagesFilterValidationError is the validation error returned by
+// ExportCouponUsagesFilter.Validate if the designated constraints aren't met.
+type ExportCouponUsagesFilterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2169,22 +19335,24 @@ type CouponUsageValidationError struct {
 }
 
 // Field function returns field value.
-func (e CouponUsageValidationError) Field() string { return e.field }
+func (e ExportCouponUsagesFilterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CouponUsageValidationError) Reason() string { return e.reason }
+func (e ExportCouponUsagesFilterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CouponUsageValidationError) Cause() error { return e.cause }
+func (e ExportCouponUsagesFilterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CouponUsageValidationError) Key() bool { return e.key }
+func (e ExportCouponUsagesFilterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CouponUsageValidationError) ErrorName() string { return "CouponUsageValidationError" }
+func (e ExportCouponUsagesFilterValidationError) ErrorName() string {
+	return "ExportCouponUsagesFilterValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CouponUsageValidationError) Error() string {
+func (e ExportCouponUsagesFilterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2196,14 +19364,14 @@ func (e CouponUsageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCouponUsage.%s: %s%s",
+		"invalid %sExportCouponUsagesFilter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CouponUsageValidationError{}
+var _ error = ExportCouponUsagesFilterValidationError{}
 
 var _ interface {
 	Field() string
@@ -2211,59 +19379,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CouponUsageValidationError{}
+} = ExportCouponUsagesFilterValidationError{}
 
-// Validate checks the field values on ListCouponUsagesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCouponUsagesRequest) Validate() error {
+// Validate checks the field values on ExportJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportJob) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCouponUsagesRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListCouponUsagesRequestMultiError, or nil if none found.
-func (m *ListCouponUsagesRequest) ValidateAll() error {
+// ValidateAll checks the field values on ExportJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportJobMultiError, or nil
+// if none found.
+func (m *ExportJob) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCouponUsagesRequest) validate(all bool) error {
+func (m *ExportJob) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCouponCode()) < 1 {
-		err := ListCouponUsagesRequestValidationError{
-			field:  "CouponCode",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for JobId
 
-	// no validation rules for Page
+	// no validation rules for Resource
 
-	// no validation rules for PageSize
+	// no validation rules for Format
+
+	// no validation rules for Status
+
+	// no validation rules for Rows
+
+	// no validation rules for Error
+
+	// no validation rules for FileName
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for FinishedAt
+
+	// no validation rules for DownloadUrl
 
 	if len(errors) > 0 {
-		return ListCouponUsagesRequestMultiError(errors)
+		return ExportJobMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCouponUsagesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListCouponUsagesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListCouponUsagesRequestMultiError []error
+// ExportJobMultiError is an error wrapping multiple validation errors returned
+// by ExportJob.ValidateAll() if the designated constraints aren't met.
+type ExportJobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCouponUsagesRequestMultiError) Error() string {
+func (m ExportJobMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2272,11 +19444,11 @@ func (m ListCouponUsagesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCouponUsagesRequestMultiError) AllErrors() []error { return m }
+func (m ExportJobMultiError) AllErrors() []error { return m }
 
-// ListCouponUsagesRequestValidationError is the validation error returned by
-// ListCouponUsagesRequest.Validate if the designated constraints aren't met.
-type ListCouponUsagesRequestValidationError struct {
+// ExportJobValidationError is the validation error returned by
+// ExportJob.Validate if the designated constraints aren't met.
+type ExportJobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2284,24 +19456,22 @@ type ListCouponUsagesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCouponUsagesRequestValidationError) Field() string { return e.field }
+func (e ExportJobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCouponUsagesRequestValidationError) Reason() string { return e.reason }
+func (e ExportJobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCouponUsagesRequestValidationError) Cause() error { return e.cause }
+func (e ExportJobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCouponUsagesRequestValidationError) Key() bool { return e.key }
+func (e ExportJobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCouponUsagesRequestValidationError) ErrorName() string {
-	return "ListCouponUsagesRequestValidationError"
-}
+func (e ExportJobValidationError) ErrorName() string { return "ExportJobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListCouponUsagesRequestValidationError) Error() string {
+func (e ExportJobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2313,14 +19483,14 @@ func (e ListCouponUsagesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCouponUsagesRequest.%s: %s%s",
+		"invalid %sExportJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCouponUsagesRequestValidationError{}
+var _ error = ExportJobValidationError{}
 
 var _ interface {
 	Field() string
@@ -2328,84 +19498,126 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCouponUsagesRequestValidationError{}
+} = ExportJobValidationError{}
 
-// Validate checks the field values on ListCouponUsagesReply with the rules
+// Validate checks the field values on CreateExportJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCouponUsagesReply) Validate() error {
+func (m *CreateExportJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCouponUsagesReply with the rules
+// ValidateAll checks the field values on CreateExportJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCouponUsagesReplyMultiError, or nil if none found.
-func (m *ListCouponUsagesReply) ValidateAll() error {
+// CreateExportJobRequestMultiError, or nil if none found.
+func (m *CreateExportJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCouponUsagesReply) validate(all bool) error {
+func (m *CreateExportJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsages() {
-		_, _ = idx, item
+	// no validation rules for AppId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCouponUsagesReplyValidationError{
-						field:  fmt.Sprintf("Usages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCouponUsagesReplyValidationError{
-						field:  fmt.Sprintf("Usages[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if _, ok := _CreateExportJobRequest_Resource_InLookup[m.GetResource()]; !ok {
+		err := CreateExportJobRequestValidationError{
+			field:  "Resource",
+			reason: "value must be in list [coupons coupon_usages]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateExportJobRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := CreateExportJobRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv xlsx]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCouponFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExportJobRequestValidationError{
+					field:  "CouponFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExportJobRequestValidationError{
+					field:  "CouponFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCouponFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExportJobRequestValidationError{
+				field:  "CouponFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUsageFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExportJobRequestValidationError{
+					field:  "UsageFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListCouponUsagesReplyValidationError{
-					field:  fmt.Sprintf("Usages[%v]", idx),
+				errors = append(errors, CreateExportJobRequestValidationError{
+					field:  "UsageFilter",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsageFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExportJobRequestValidationError{
+				field:  "UsageFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Total
-
-	// no validation rules for Page
-
-	// no validation rules for PageSize
-
 	if len(errors) > 0 {
-		return ListCouponUsagesReplyMultiError(errors)
+		return CreateExportJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCouponUsagesReplyMultiError is an error wrapping multiple validation
-// errors returned by ListCouponUsagesReply.ValidateAll() if the designated
+// CreateExportJobRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateExportJobRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListCouponUsagesReplyMultiError []error
+type CreateExportJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCouponUsagesReplyMultiError) Error() string {
+func (m CreateExportJobRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2414,11 +19626,11 @@ func (m ListCouponUsagesReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCouponUsagesReplyMultiError) AllErrors() []error { return m }
+func (m CreateExportJobRequestMultiError) AllErrors() []error { return m }
 
-// ListCouponUsagesReplyValidationError is the validation error returned by
-// ListCouponUsagesReply.Validate if the designated constraints aren't met.
-type ListCouponUsagesReplyValidationError struct {
+// CreateExportJobRequestValidationError is the validation error returned by
+// CreateExportJobRequest.Validate if the designated constraints aren't met.
+type CreateExportJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2426,24 +19638,24 @@ type ListCouponUsagesReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCouponUsagesReplyValidationError) Field() string { return e.field }
+func (e CreateExportJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCouponUsagesReplyValidationError) Reason() string { return e.reason }
+func (e CreateExportJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCouponUsagesReplyValidationError) Cause() error { return e.cause }
+func (e CreateExportJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCouponUsagesReplyValidationError) Key() bool { return e.key }
+func (e CreateExportJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCouponUsagesReplyValidationError) ErrorName() string {
-	return "ListCouponUsagesReplyValidationError"
+func (e CreateExportJobRequestValidationError) ErrorName() string {
+	return "CreateExportJobRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCouponUsagesReplyValidationError) Error() string {
+func (e CreateExportJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2455,14 +19667,14 @@ func (e ListCouponUsagesReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCouponUsagesReply.%s: %s%s",
+		"invalid %sCreateExportJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCouponUsagesReplyValidationError{}
+var _ error = CreateExportJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2470,46 +19682,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCouponUsagesReplyValidationError{}
+} = CreateExportJobRequestValidationError{}
 
-// Validate checks the field values on GetCouponsSummaryStatsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+var _CreateExportJobRequest_Resource_InLookup = map[string]struct{}{
+	"coupons":       {},
+	"coupon_usages": {},
+}
+
+var _CreateExportJobRequest_Format_InLookup = map[string]struct{}{
+	"csv":  {},
+	"xlsx": {},
+}
+
+// Validate checks the field values on CreateExportJobReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCouponsSummaryStatsRequest) Validate() error {
+func (m *CreateExportJobReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCouponsSummaryStatsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetCouponsSummaryStatsRequestMultiError, or nil if none found.
-func (m *GetCouponsSummaryStatsRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateExportJobReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateExportJobReplyMultiError, or nil if none found.
+func (m *CreateExportJobReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCouponsSummaryStatsRequest) validate(all bool) error {
+func (m *CreateExportJobReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AppId
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExportJobReplyValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExportJobReplyValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExportJobReplyValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetCouponsSummaryStatsRequestMultiError(errors)
+		return CreateExportJobReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCouponsSummaryStatsRequestMultiError is an error wrapping multiple
-// validation errors returned by GetCouponsSummaryStatsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetCouponsSummaryStatsRequestMultiError []error
+// CreateExportJobReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateExportJobReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateExportJobReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCouponsSummaryStatsRequestMultiError) Error() string {
+func (m CreateExportJobReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2518,12 +19767,11 @@ func (m GetCouponsSummaryStatsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCouponsSummaryStatsRequestMultiError) AllErrors() []error { return m }
+func (m CreateExportJobReplyMultiError) AllErrors() []error { return m }
 
-// GetCouponsSummaryStatsRequestValidationError is the validation error
-// returned by GetCouponsSummaryStatsRequest.Validate if the designated
-// constraints aren't met.
-type GetCouponsSummaryStatsRequestValidationError struct {
+// CreateExportJobReplyValidationError is the validation error returned by
+// CreateExportJobReply.Validate if the designated constraints aren't met.
+type CreateExportJobReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2531,24 +19779,24 @@ type GetCouponsSummaryStatsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCouponsSummaryStatsRequestValidationError) Field() string { return e.field }
+func (e CreateExportJobReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCouponsSummaryStatsRequestValidationError) Reason() string { return e.reason }
+func (e CreateExportJobReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCouponsSummaryStatsRequestValidationError) Cause() error { return e.cause }
+func (e CreateExportJobReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCouponsSummaryStatsRequestValidationError) Key() bool { return e.key }
+func (e CreateExportJobReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCouponsSummaryStatsRequestValidationError) ErrorName() string {
-	return "GetCouponsSummaryStatsRequestValidationError"
+func (e CreateExportJobReplyValidationError) ErrorName() string {
+	return "CreateExportJobReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCouponsSummaryStatsRequestValidationError) Error() string {
+func (e CreateExportJobReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2560,14 +19808,14 @@ func (e GetCouponsSummaryStatsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCouponsSummaryStatsRequest.%s: %s%s",
+		"invalid %sCreateExportJobReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCouponsSummaryStatsRequestValidationError{}
+var _ error = CreateExportJobReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2575,92 +19823,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCouponsSummaryStatsRequestValidationError{}
+} = CreateExportJobReplyValidationError{}
 
-// Validate checks the field values on GetCouponsSummaryStatsReply with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetExportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCouponsSummaryStatsReply) Validate() error {
+func (m *GetExportJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCouponsSummaryStatsReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetExportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCouponsSummaryStatsReplyMultiError, or nil if none found.
-func (m *GetCouponsSummaryStatsReply) ValidateAll() error {
+// GetExportJobRequestMultiError, or nil if none found.
+func (m *GetExportJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCouponsSummaryStatsReply) validate(all bool) error {
+func (m *GetExportJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TotalCoupons
-
-	// no validation rules for ActiveCoupons
-
-	// no validation rules for TotalUses
-
-	// no validation rules for TotalOrders
-
-	// no validation rules for TotalRevenue
-
-	// no validation rules for TotalDiscount
-
-	// no validation rules for AverageConversionRate
-
-	for idx, item := range m.GetTopCoupons() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetCouponsSummaryStatsReplyValidationError{
-						field:  fmt.Sprintf("TopCoupons[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetCouponsSummaryStatsReplyValidationError{
-						field:  fmt.Sprintf("TopCoupons[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetCouponsSummaryStatsReplyValidationError{
-					field:  fmt.Sprintf("TopCoupons[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetJobId()) < 1 {
+		err := GetExportJobRequestValidationError{
+			field:  "JobId",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetCouponsSummaryStatsReplyMultiError(errors)
+		return GetExportJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCouponsSummaryStatsReplyMultiError is an error wrapping multiple
-// validation errors returned by GetCouponsSummaryStatsReply.ValidateAll() if
-// the designated constraints aren't met.
-type GetCouponsSummaryStatsReplyMultiError []error
+// GetExportJobRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExportJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetExportJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCouponsSummaryStatsReplyMultiError) Error() string {
+func (m GetExportJobRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2669,12 +19880,11 @@ func (m GetCouponsSummaryStatsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCouponsSummaryStatsReplyMultiError) AllErrors() []error { return m }
+func (m GetExportJobRequestMultiError) AllErrors() []error { return m }
 
-// GetCouponsSummaryStatsReplyValidationError is the validation error returned
-// by GetCouponsSummaryStatsReply.Validate if the designated constraints
-// aren't met.
-type GetCouponsSummaryStatsReplyValidationError struct {
+// GetExportJobRequestValidationError is the validation error returned by
+// GetExportJobRequest.Validate if the designated constraints aren't met.
+type GetExportJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2682,24 +19892,24 @@ type GetCouponsSummaryStatsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCouponsSummaryStatsReplyValidationError) Field() string { return e.field }
+func (e GetExportJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCouponsSummaryStatsReplyValidationError) Reason() string { return e.reason }
+func (e GetExportJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCouponsSummaryStatsReplyValidationError) Cause() error { return e.cause }
+func (e GetExportJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCouponsSummaryStatsReplyValidationError) Key() bool { return e.key }
+func (e GetExportJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCouponsSummaryStatsReplyValidationError) ErrorName() string {
-	return "GetCouponsSummaryStatsReplyValidationError"
+func (e GetExportJobRequestValidationError) ErrorName() string {
+	return "GetExportJobRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCouponsSummaryStatsReplyValidationError) Error() string {
+func (e GetExportJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2711,14 +19921,14 @@ func (e GetCouponsSummaryStatsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCouponsSummaryStatsReply.%s: %s%s",
+		"invalid %sGetExportJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCouponsSummaryStatsReplyValidationError{}
+var _ error = GetExportJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2726,55 +19936,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCouponsSummaryStatsReplyValidationError{}
+} = GetExportJobRequestValidationError{}
 
-// Validate checks the field values on CouponStats with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CouponStats) Validate() error {
+// Validate checks the field values on GetExportJobReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetExportJobReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CouponStats with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CouponStatsMultiError, or
-// nil if none found.
-func (m *CouponStats) ValidateAll() error {
+// ValidateAll checks the field values on GetExportJobReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExportJobReplyMultiError, or nil if none found.
+func (m *GetExportJobReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CouponStats) validate(all bool) error {
+func (m *GetExportJobReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CouponCode
-
-	// no validation rules for TotalUses
-
-	// no validation rules for TotalOrders
-
-	// no validation rules for TotalRevenue
-
-	// no validation rules for TotalDiscount
-
-	// no validation rules for ConversionRate
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExportJobReplyValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExportJobReplyValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExportJobReplyValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CouponStatsMultiError(errors)
+		return GetExportJobReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CouponStatsMultiError is an error wrapping multiple validation errors
-// returned by CouponStats.ValidateAll() if the designated constraints aren't met.
-type CouponStatsMultiError []error
+// GetExportJobReplyMultiError is an error wrapping multiple validation errors
+// returned by GetExportJobReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetExportJobReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CouponStatsMultiError) Error() string {
+func (m GetExportJobReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2783,11 +20011,11 @@ func (m CouponStatsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CouponStatsMultiError) AllErrors() []error { return m }
+func (m GetExportJobReplyMultiError) AllErrors() []error { return m }
 
-// CouponStatsValidationError is the validation error returned by
-// CouponStats.Validate if the designated constraints aren't met.
-type CouponStatsValidationError struct {
+// GetExportJobReplyValidationError is the validation error returned by
+// GetExportJobReply.Validate if the designated constraints aren't met.
+type GetExportJobReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2795,22 +20023,24 @@ type CouponStatsValidationError struct {
 }
 
 // Field function returns field value.
-func (e CouponStatsValidationError) Field() string { return e.field }
+func (e GetExportJobReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CouponStatsValidationError) Reason() string { return e.reason }
+func (e GetExportJobReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CouponStatsValidationError) Cause() error { return e.cause }
+func (e GetExportJobReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CouponStatsValidationError) Key() bool { return e.key }
+func (e GetExportJobReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CouponStatsValidationError) ErrorName() string { return "CouponStatsValidationError" }
+func (e GetExportJobReplyValidationError) ErrorName() string {
+	return "GetExportJobReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CouponStatsValidationError) Error() string {
+func (e GetExportJobReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2822,14 +20052,14 @@ func (e CouponStatsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCouponStats.%s: %s%s",
+		"invalid %sGetExportJobReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CouponStatsValidationError{}
+var _ error = GetExportJobReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2837,4 +20067,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CouponStatsValidationError{}
+} = GetExportJobReplyValidationError{}
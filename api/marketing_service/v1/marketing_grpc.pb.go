@@ -20,16 +20,76 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Marketing_CreateCoupon_FullMethodName           = "/platform.marketing_service.v1.Marketing/CreateCoupon"
-	Marketing_GetCoupon_FullMethodName              = "/platform.marketing_service.v1.Marketing/GetCoupon"
-	Marketing_ListCoupons_FullMethodName            = "/platform.marketing_service.v1.Marketing/ListCoupons"
-	Marketing_UpdateCoupon_FullMethodName           = "/platform.marketing_service.v1.Marketing/UpdateCoupon"
-	Marketing_DeleteCoupon_FullMethodName           = "/platform.marketing_service.v1.Marketing/DeleteCoupon"
-	Marketing_ValidateCoupon_FullMethodName         = "/platform.marketing_service.v1.Marketing/ValidateCoupon"
-	Marketing_UseCoupon_FullMethodName              = "/platform.marketing_service.v1.Marketing/UseCoupon"
-	Marketing_GetCouponStats_FullMethodName         = "/platform.marketing_service.v1.Marketing/GetCouponStats"
-	Marketing_ListCouponUsages_FullMethodName       = "/platform.marketing_service.v1.Marketing/ListCouponUsages"
-	Marketing_GetCouponsSummaryStats_FullMethodName = "/platform.marketing_service.v1.Marketing/GetCouponsSummaryStats"
+	Marketing_CreateCoupon_FullMethodName              = "/platform.marketing_service.v1.Marketing/CreateCoupon"
+	Marketing_GetCoupon_FullMethodName                 = "/platform.marketing_service.v1.Marketing/GetCoupon"
+	Marketing_ListCoupons_FullMethodName               = "/platform.marketing_service.v1.Marketing/ListCoupons"
+	Marketing_UpdateCoupon_FullMethodName              = "/platform.marketing_service.v1.Marketing/UpdateCoupon"
+	Marketing_DeleteCoupon_FullMethodName              = "/platform.marketing_service.v1.Marketing/DeleteCoupon"
+	Marketing_ImportCoupons_FullMethodName             = "/platform.marketing_service.v1.Marketing/ImportCoupons"
+	Marketing_BatchUpdateCouponStatus_FullMethodName   = "/platform.marketing_service.v1.Marketing/BatchUpdateCouponStatus"
+	Marketing_BatchDeleteCoupons_FullMethodName        = "/platform.marketing_service.v1.Marketing/BatchDeleteCoupons"
+	Marketing_IssueCoupon_FullMethodName               = "/platform.marketing_service.v1.Marketing/IssueCoupon"
+	Marketing_ClaimCoupon_FullMethodName               = "/platform.marketing_service.v1.Marketing/ClaimCoupon"
+	Marketing_ListUserCoupons_FullMethodName           = "/platform.marketing_service.v1.Marketing/ListUserCoupons"
+	Marketing_GetUserCoupon_FullMethodName             = "/platform.marketing_service.v1.Marketing/GetUserCoupon"
+	Marketing_CloneCoupon_FullMethodName               = "/platform.marketing_service.v1.Marketing/CloneCoupon"
+	Marketing_CreateCouponTemplate_FullMethodName      = "/platform.marketing_service.v1.Marketing/CreateCouponTemplate"
+	Marketing_GetCouponTemplate_FullMethodName         = "/platform.marketing_service.v1.Marketing/GetCouponTemplate"
+	Marketing_ListCouponTemplates_FullMethodName       = "/platform.marketing_service.v1.Marketing/ListCouponTemplates"
+	Marketing_UpdateCouponTemplate_FullMethodName      = "/platform.marketing_service.v1.Marketing/UpdateCouponTemplate"
+	Marketing_DeleteCouponTemplate_FullMethodName      = "/platform.marketing_service.v1.Marketing/DeleteCouponTemplate"
+	Marketing_CreateCouponFromTemplate_FullMethodName  = "/platform.marketing_service.v1.Marketing/CreateCouponFromTemplate"
+	Marketing_CreateAudience_FullMethodName            = "/platform.marketing_service.v1.Marketing/CreateAudience"
+	Marketing_GetAudience_FullMethodName               = "/platform.marketing_service.v1.Marketing/GetAudience"
+	Marketing_ListAudiences_FullMethodName             = "/platform.marketing_service.v1.Marketing/ListAudiences"
+	Marketing_UpdateAudience_FullMethodName            = "/platform.marketing_service.v1.Marketing/UpdateAudience"
+	Marketing_DeleteAudience_FullMethodName            = "/platform.marketing_service.v1.Marketing/DeleteAudience"
+	Marketing_UploadAudienceMembers_FullMethodName     = "/platform.marketing_service.v1.Marketing/UploadAudienceMembers"
+	Marketing_CheckAudience_FullMethodName             = "/platform.marketing_service.v1.Marketing/CheckAudience"
+	Marketing_CreateCampaign_FullMethodName            = "/platform.marketing_service.v1.Marketing/CreateCampaign"
+	Marketing_GetCampaign_FullMethodName               = "/platform.marketing_service.v1.Marketing/GetCampaign"
+	Marketing_ListCampaigns_FullMethodName             = "/platform.marketing_service.v1.Marketing/ListCampaigns"
+	Marketing_UpdateCampaign_FullMethodName            = "/platform.marketing_service.v1.Marketing/UpdateCampaign"
+	Marketing_DeleteCampaign_FullMethodName            = "/platform.marketing_service.v1.Marketing/DeleteCampaign"
+	Marketing_PublishCampaign_FullMethodName           = "/platform.marketing_service.v1.Marketing/PublishCampaign"
+	Marketing_PauseCampaign_FullMethodName             = "/platform.marketing_service.v1.Marketing/PauseCampaign"
+	Marketing_EndCampaign_FullMethodName               = "/platform.marketing_service.v1.Marketing/EndCampaign"
+	Marketing_CreateRedeemCodes_FullMethodName         = "/platform.marketing_service.v1.Marketing/CreateRedeemCodes"
+	Marketing_GetRedeemCode_FullMethodName             = "/platform.marketing_service.v1.Marketing/GetRedeemCode"
+	Marketing_ListRedeemCodes_FullMethodName           = "/platform.marketing_service.v1.Marketing/ListRedeemCodes"
+	Marketing_RedeemCode_FullMethodName                = "/platform.marketing_service.v1.Marketing/RedeemCode"
+	Marketing_RevokeRedeemCode_FullMethodName          = "/platform.marketing_service.v1.Marketing/RevokeRedeemCode"
+	Marketing_ListRedeemCodeRedemptions_FullMethodName = "/platform.marketing_service.v1.Marketing/ListRedeemCodeRedemptions"
+	Marketing_RetryRedeemCodeGrant_FullMethodName      = "/platform.marketing_service.v1.Marketing/RetryRedeemCodeGrant"
+	Marketing_CreateReward_FullMethodName              = "/platform.marketing_service.v1.Marketing/CreateReward"
+	Marketing_GetReward_FullMethodName                 = "/platform.marketing_service.v1.Marketing/GetReward"
+	Marketing_ListRewards_FullMethodName               = "/platform.marketing_service.v1.Marketing/ListRewards"
+	Marketing_UpdateReward_FullMethodName              = "/platform.marketing_service.v1.Marketing/UpdateReward"
+	Marketing_DeleteReward_FullMethodName              = "/platform.marketing_service.v1.Marketing/DeleteReward"
+	Marketing_IssueReward_FullMethodName               = "/platform.marketing_service.v1.Marketing/IssueReward"
+	Marketing_GetRewardGrant_FullMethodName            = "/platform.marketing_service.v1.Marketing/GetRewardGrant"
+	Marketing_ListRewardGrants_FullMethodName          = "/platform.marketing_service.v1.Marketing/ListRewardGrants"
+	Marketing_DistributeRewardGrant_FullMethodName     = "/platform.marketing_service.v1.Marketing/DistributeRewardGrant"
+	Marketing_UseRewardGrant_FullMethodName            = "/platform.marketing_service.v1.Marketing/UseRewardGrant"
+	Marketing_CreateTask_FullMethodName                = "/platform.marketing_service.v1.Marketing/CreateTask"
+	Marketing_GetTask_FullMethodName                   = "/platform.marketing_service.v1.Marketing/GetTask"
+	Marketing_ListTasks_FullMethodName                 = "/platform.marketing_service.v1.Marketing/ListTasks"
+	Marketing_UpdateTask_FullMethodName                = "/platform.marketing_service.v1.Marketing/UpdateTask"
+	Marketing_DeleteTask_FullMethodName                = "/platform.marketing_service.v1.Marketing/DeleteTask"
+	Marketing_ListTaskProgress_FullMethodName          = "/platform.marketing_service.v1.Marketing/ListTaskProgress"
+	Marketing_TriggerEvent_FullMethodName              = "/platform.marketing_service.v1.Marketing/TriggerEvent"
+	Marketing_ValidateCoupon_FullMethodName            = "/platform.marketing_service.v1.Marketing/ValidateCoupon"
+	Marketing_UseCoupon_FullMethodName                 = "/platform.marketing_service.v1.Marketing/UseCoupon"
+	Marketing_GetCouponStats_FullMethodName            = "/platform.marketing_service.v1.Marketing/GetCouponStats"
+	Marketing_ListCouponUsages_FullMethodName          = "/platform.marketing_service.v1.Marketing/ListCouponUsages"
+	Marketing_GetCouponsSummaryStats_FullMethodName    = "/platform.marketing_service.v1.Marketing/GetCouponsSummaryStats"
+	Marketing_GetCouponUsageTimeSeries_FullMethodName  = "/platform.marketing_service.v1.Marketing/GetCouponUsageTimeSeries"
+	Marketing_ListUsagesByUser_FullMethodName          = "/platform.marketing_service.v1.Marketing/ListUsagesByUser"
+	Marketing_GetUsageByPaymentOrder_FullMethodName    = "/platform.marketing_service.v1.Marketing/GetUsageByPaymentOrder"
+	Marketing_GetUsageByPaymentId_FullMethodName       = "/platform.marketing_service.v1.Marketing/GetUsageByPaymentId"
+	Marketing_RebuildCouponStats_FullMethodName        = "/platform.marketing_service.v1.Marketing/RebuildCouponStats"
+	Marketing_CreateExportJob_FullMethodName           = "/platform.marketing_service.v1.Marketing/CreateExportJob"
+	Marketing_GetExportJob_FullMethodName              = "/platform.marketing_service.v1.Marketing/GetExportJob"
 )
 
 // MarketingClient is the client API for Marketing service.
@@ -49,6 +109,115 @@ type MarketingClient interface {
 	UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*UpdateCouponReply, error)
 	// DeleteCoupon 删除优惠券
 	DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ImportCoupons 从 CSV 批量导入优惠券（也可通过 multipart 上传：POST /marketing/v1/imports/coupons）
+	ImportCoupons(ctx context.Context, in *ImportCouponsRequest, opts ...grpc.CallOption) (*ImportCouponsReply, error)
+	// BatchUpdateCouponStatus 批量修改优惠券状态（指定优惠码或按筛选条件）
+	BatchUpdateCouponStatus(ctx context.Context, in *BatchUpdateCouponStatusRequest, opts ...grpc.CallOption) (*BatchCouponsReply, error)
+	// BatchDeleteCoupons 批量删除优惠券（指定优惠码或按筛选条件）
+	BatchDeleteCoupons(ctx context.Context, in *BatchDeleteCouponsRequest, opts ...grpc.CallOption) (*BatchCouponsReply, error)
+	// IssueCoupon 将优惠券发放给用户（生成独立有效期的用户优惠券实例）
+	IssueCoupon(ctx context.Context, in *IssueCouponRequest, opts ...grpc.CallOption) (*UserCouponReply, error)
+	// ClaimCoupon 用户领取优惠券到券包（受每用户领取次数和领取总量限制）
+	ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponReply, error)
+	// ListUserCoupons 列出用户券包（按 available/used/expired 分栏）
+	ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsReply, error)
+	// GetUserCoupon 获取用户优惠券
+	GetUserCoupon(ctx context.Context, in *GetUserCouponRequest, opts ...grpc.CallOption) (*UserCouponReply, error)
+	// CloneCoupon 以新优惠码复制已有优惠券（有效期平移到 validFrom，时长不变；不可用日期同步平移，默认不保留绑定用户）
+	CloneCoupon(ctx context.Context, in *CloneCouponRequest, opts ...grpc.CallOption) (*CreateCouponReply, error)
+	// CreateCouponTemplate 创建优惠券模板
+	CreateCouponTemplate(ctx context.Context, in *CreateCouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateReply, error)
+	// GetCouponTemplate 获取优惠券模板
+	GetCouponTemplate(ctx context.Context, in *GetCouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateReply, error)
+	// ListCouponTemplates 列出优惠券模板
+	ListCouponTemplates(ctx context.Context, in *ListCouponTemplatesRequest, opts ...grpc.CallOption) (*ListCouponTemplatesReply, error)
+	// UpdateCouponTemplate 更新优惠券模板
+	UpdateCouponTemplate(ctx context.Context, in *UpdateCouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateReply, error)
+	// DeleteCouponTemplate 删除优惠券模板
+	DeleteCouponTemplate(ctx context.Context, in *DeleteCouponTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateCouponFromTemplate 按模板创建优惠券
+	CreateCouponFromTemplate(ctx context.Context, in *CreateCouponFromTemplateRequest, opts ...grpc.CallOption) (*CreateCouponReply, error)
+	// CreateAudience 创建受众
+	CreateAudience(ctx context.Context, in *CreateAudienceRequest, opts ...grpc.CallOption) (*AudienceReply, error)
+	// GetAudience 获取受众
+	GetAudience(ctx context.Context, in *GetAudienceRequest, opts ...grpc.CallOption) (*AudienceReply, error)
+	// ListAudiences 列出受众
+	ListAudiences(ctx context.Context, in *ListAudiencesRequest, opts ...grpc.CallOption) (*ListAudiencesReply, error)
+	// UpdateAudience 更新受众
+	UpdateAudience(ctx context.Context, in *UpdateAudienceRequest, opts ...grpc.CallOption) (*AudienceReply, error)
+	// DeleteAudience 删除受众（仍被优惠券引用时拒绝）
+	DeleteAudience(ctx context.Context, in *DeleteAudienceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UploadAudienceMembers 上传 LIST 类型受众的名单
+	UploadAudienceMembers(ctx context.Context, in *UploadAudienceMembersRequest, opts ...grpc.CallOption) (*UploadAudienceMembersReply, error)
+	// CheckAudience 检查用户是否属于受众
+	CheckAudience(ctx context.Context, in *CheckAudienceRequest, opts ...grpc.CallOption) (*CheckAudienceReply, error)
+	// CreateCampaign 创建活动（草稿状态）
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error)
+	// GetCampaign 获取活动
+	GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error)
+	// ListCampaigns 列出活动
+	ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsReply, error)
+	// UpdateCampaign 更新活动
+	UpdateCampaign(ctx context.Context, in *UpdateCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error)
+	// DeleteCampaign 删除活动
+	DeleteCampaign(ctx context.Context, in *DeleteCampaignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PublishCampaign 发布活动（草稿或已暂停 -> 进行中）
+	PublishCampaign(ctx context.Context, in *PublishCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error)
+	// PauseCampaign 暂停活动（其下优惠券立即不可用）
+	PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error)
+	// EndCampaign 结束活动（不可恢复）
+	EndCampaign(ctx context.Context, in *EndCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error)
+	// ========== Redeem Code API ==========
+	// CreateRedeemCodes 批量生成兑换码（兑换后发放优惠券、积分或订阅时长）
+	CreateRedeemCodes(ctx context.Context, in *CreateRedeemCodesRequest, opts ...grpc.CallOption) (*CreateRedeemCodesReply, error)
+	// GetRedeemCode 获取兑换码
+	GetRedeemCode(ctx context.Context, in *GetRedeemCodeRequest, opts ...grpc.CallOption) (*RedeemCodeReply, error)
+	// ListRedeemCodes 列出兑换码
+	ListRedeemCodes(ctx context.Context, in *ListRedeemCodesRequest, opts ...grpc.CallOption) (*ListRedeemCodesReply, error)
+	// RedeemCode 用户兑换兑换码（每个兑换码只能兑换一次）
+	RedeemCode(ctx context.Context, in *RedeemCodeRequest, opts ...grpc.CallOption) (*RedeemCodeRedemptionReply, error)
+	// RevokeRedeemCode 作废兑换码（已兑换的不可作废）
+	RevokeRedeemCode(ctx context.Context, in *RevokeRedeemCodeRequest, opts ...grpc.CallOption) (*RedeemCodeReply, error)
+	// ListRedeemCodeRedemptions 列出兑换记录（可按发放状态筛选出积分、订阅时长发放失败的记录）
+	ListRedeemCodeRedemptions(ctx context.Context, in *ListRedeemCodeRedemptionsRequest, opts ...grpc.CallOption) (*ListRedeemCodeRedemptionsReply, error)
+	// RetryRedeemCodeGrant 重试兑换记录中未成功发放的积分或订阅时长（已发放时直接返回）
+	RetryRedeemCodeGrant(ctx context.Context, in *RetryRedeemCodeGrantRequest, opts ...grpc.CallOption) (*RedeemCodeRedemptionReply, error)
+	// ========== Reward API ==========
+	// CreateReward 创建奖励模板
+	CreateReward(ctx context.Context, in *CreateRewardRequest, opts ...grpc.CallOption) (*RewardReply, error)
+	// GetReward 获取奖励模板
+	GetReward(ctx context.Context, in *GetRewardRequest, opts ...grpc.CallOption) (*RewardReply, error)
+	// ListRewards 列出奖励模板
+	ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsReply, error)
+	// UpdateReward 更新奖励模板（版本号加一，已发放的记录保留原版本快照）
+	UpdateReward(ctx context.Context, in *UpdateRewardRequest, opts ...grpc.CallOption) (*RewardReply, error)
+	// DeleteReward 删除奖励模板（仍有未完成的发放记录时拒绝）
+	DeleteReward(ctx context.Context, in *DeleteRewardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// IssueReward 发放奖励（创建发放记录并生成、发放奖励内容）
+	IssueReward(ctx context.Context, in *IssueRewardRequest, opts ...grpc.CallOption) (*RewardGrantReply, error)
+	// GetRewardGrant 获取奖励发放记录
+	GetRewardGrant(ctx context.Context, in *GetRewardGrantRequest, opts ...grpc.CallOption) (*RewardGrantReply, error)
+	// ListRewardGrants 列出奖励发放记录
+	ListRewardGrants(ctx context.Context, in *ListRewardGrantsRequest, opts ...grpc.CallOption) (*ListRewardGrantsReply, error)
+	// DistributeRewardGrant 确认发放（MANUAL 发放器，或自动发放失败后重试）
+	DistributeRewardGrant(ctx context.Context, in *DistributeRewardGrantRequest, opts ...grpc.CallOption) (*RewardGrantReply, error)
+	// UseRewardGrant 标记奖励已使用（积分、订阅等下游回传，COUPON 奖励在使用优惠券时自动标记）
+	UseRewardGrant(ctx context.Context, in *UseRewardGrantRequest, opts ...grpc.CallOption) (*RewardGrantReply, error)
+	// ========== Task API ==========
+	// CreateTask 创建任务
+	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
+	// GetTask 获取任务
+	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
+	// ListTasks 列出任务
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksReply, error)
+	// UpdateTask 更新任务（已计入的进度保留）
+	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
+	// DeleteTask 删除任务（同时删除用户进度）
+	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTaskProgress 列出用户任务进度
+	ListTaskProgress(ctx context.Context, in *ListTaskProgressRequest, opts ...grpc.CallOption) (*ListTaskProgressReply, error)
+	// TriggerEvent 上报业务事件：推进匹配任务的用户进度，完成任务时发放奖励（按 eventId 幂等）
+	TriggerEvent(ctx context.Context, in *TriggerEventRequest, opts ...grpc.CallOption) (*TriggerEventReply, error)
 	// ValidateCoupon 验证优惠券 (供 Payment Service 调用)
 	ValidateCoupon(ctx context.Context, in *ValidateCouponRequest, opts ...grpc.CallOption) (*ValidateCouponReply, error)
 	// UseCoupon 使用优惠券 (供 Payment Service 调用)
@@ -59,6 +228,20 @@ type MarketingClient interface {
 	ListCouponUsages(ctx context.Context, in *ListCouponUsagesRequest, opts ...grpc.CallOption) (*ListCouponUsagesReply, error)
 	// GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
 	GetCouponsSummaryStats(ctx context.Context, in *GetCouponsSummaryStatsRequest, opts ...grpc.CallOption) (*GetCouponsSummaryStatsReply, error)
+	// GetCouponUsageTimeSeries 获取优惠券使用时间序列统计（供营销效果仪表板使用）
+	GetCouponUsageTimeSeries(ctx context.Context, in *GetCouponUsageTimeSeriesRequest, opts ...grpc.CallOption) (*GetCouponUsageTimeSeriesReply, error)
+	// ListUsagesByUser 按用户列出优惠券使用记录 (供客服使用)
+	ListUsagesByUser(ctx context.Context, in *ListUsagesByUserRequest, opts ...grpc.CallOption) (*ListCouponUsagesReply, error)
+	// GetUsageByPaymentOrder 按支付订单查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentOrder(ctx context.Context, in *GetUsageByPaymentOrderRequest, opts ...grpc.CallOption) (*GetCouponUsageReply, error)
+	// GetUsageByPaymentId 按支付流水号查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentId(ctx context.Context, in *GetUsageByPaymentIdRequest, opts ...grpc.CallOption) (*GetCouponUsageReply, error)
+	// RebuildCouponStats 从使用记录重算每日统计汇总并回填去重用户 HyperLogLog (管理接口，用于修复或初始化汇总数据)
+	RebuildCouponStats(ctx context.Context, in *RebuildCouponStatsRequest, opts ...grpc.CallOption) (*RebuildCouponStatsReply, error)
+	// CreateExportJob 创建后台导出任务 (大数据量导出，生成文件后通过 /marketing/v1/export-jobs/{jobId}/download 下载)
+	CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*CreateExportJobReply, error)
+	// GetExportJob 获取后台导出任务状态
+	GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobReply, error)
 }
 
 type marketingClient struct {
@@ -119,230 +302,2087 @@ func (c *marketingClient) DeleteCoupon(ctx context.Context, in *DeleteCouponRequ
 	return out, nil
 }
 
-func (c *marketingClient) ValidateCoupon(ctx context.Context, in *ValidateCouponRequest, opts ...grpc.CallOption) (*ValidateCouponReply, error) {
+func (c *marketingClient) ImportCoupons(ctx context.Context, in *ImportCouponsRequest, opts ...grpc.CallOption) (*ImportCouponsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ValidateCouponReply)
-	err := c.cc.Invoke(ctx, Marketing_ValidateCoupon_FullMethodName, in, out, cOpts...)
+	out := new(ImportCouponsReply)
+	err := c.cc.Invoke(ctx, Marketing_ImportCoupons_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *marketingClient) UseCoupon(ctx context.Context, in *UseCouponRequest, opts ...grpc.CallOption) (*UseCouponReply, error) {
+func (c *marketingClient) BatchUpdateCouponStatus(ctx context.Context, in *BatchUpdateCouponStatusRequest, opts ...grpc.CallOption) (*BatchCouponsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UseCouponReply)
-	err := c.cc.Invoke(ctx, Marketing_UseCoupon_FullMethodName, in, out, cOpts...)
+	out := new(BatchCouponsReply)
+	err := c.cc.Invoke(ctx, Marketing_BatchUpdateCouponStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *marketingClient) GetCouponStats(ctx context.Context, in *GetCouponStatsRequest, opts ...grpc.CallOption) (*GetCouponStatsReply, error) {
+func (c *marketingClient) BatchDeleteCoupons(ctx context.Context, in *BatchDeleteCouponsRequest, opts ...grpc.CallOption) (*BatchCouponsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCouponStatsReply)
-	err := c.cc.Invoke(ctx, Marketing_GetCouponStats_FullMethodName, in, out, cOpts...)
+	out := new(BatchCouponsReply)
+	err := c.cc.Invoke(ctx, Marketing_BatchDeleteCoupons_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *marketingClient) ListCouponUsages(ctx context.Context, in *ListCouponUsagesRequest, opts ...grpc.CallOption) (*ListCouponUsagesReply, error) {
+func (c *marketingClient) IssueCoupon(ctx context.Context, in *IssueCouponRequest, opts ...grpc.CallOption) (*UserCouponReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListCouponUsagesReply)
-	err := c.cc.Invoke(ctx, Marketing_ListCouponUsages_FullMethodName, in, out, cOpts...)
+	out := new(UserCouponReply)
+	err := c.cc.Invoke(ctx, Marketing_IssueCoupon_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *marketingClient) GetCouponsSummaryStats(ctx context.Context, in *GetCouponsSummaryStatsRequest, opts ...grpc.CallOption) (*GetCouponsSummaryStatsReply, error) {
+func (c *marketingClient) ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*UserCouponReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCouponsSummaryStatsReply)
-	err := c.cc.Invoke(ctx, Marketing_GetCouponsSummaryStats_FullMethodName, in, out, cOpts...)
+	out := new(UserCouponReply)
+	err := c.cc.Invoke(ctx, Marketing_ClaimCoupon_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MarketingServer is the server API for Marketing service.
-// All implementations must embed UnimplementedMarketingServer
-// for forward compatibility.
-//
-// MarketingService 营销服务API（极简重构版：仅保留优惠券功能）
-type MarketingServer interface {
-	// ========== Coupon Management API (供开发者控制台使用) ==========
-	// CreateCoupon 创建优惠券
-	CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponReply, error)
-	// GetCoupon 获取优惠券
-	GetCoupon(context.Context, *GetCouponRequest) (*GetCouponReply, error)
-	// ListCoupons 列出优惠券
-	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsReply, error)
-	// UpdateCoupon 更新优惠券
-	UpdateCoupon(context.Context, *UpdateCouponRequest) (*UpdateCouponReply, error)
-	// DeleteCoupon 删除优惠券
-	DeleteCoupon(context.Context, *DeleteCouponRequest) (*emptypb.Empty, error)
-	// ValidateCoupon 验证优惠券 (供 Payment Service 调用)
-	ValidateCoupon(context.Context, *ValidateCouponRequest) (*ValidateCouponReply, error)
-	// UseCoupon 使用优惠券 (供 Payment Service 调用)
-	UseCoupon(context.Context, *UseCouponRequest) (*UseCouponReply, error)
-	// GetCouponStats 获取优惠券统计
-	GetCouponStats(context.Context, *GetCouponStatsRequest) (*GetCouponStatsReply, error)
-	// ListCouponUsages 列出优惠券使用记录
-	ListCouponUsages(context.Context, *ListCouponUsagesRequest) (*ListCouponUsagesReply, error)
-	// GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
-	GetCouponsSummaryStats(context.Context, *GetCouponsSummaryStatsRequest) (*GetCouponsSummaryStatsReply, error)
-	mustEmbedUnimplementedMarketingServer()
+func (c *marketingClient) ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserCouponsReply)
+	err := c.cc.Invoke(ctx, Marketing_ListUserCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMarketingServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMarketingServer struct{}
+func (c *marketingClient) GetUserCoupon(ctx context.Context, in *GetUserCouponRequest, opts ...grpc.CallOption) (*UserCouponReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCouponReply)
+	err := c.cc.Invoke(ctx, Marketing_GetUserCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedMarketingServer) CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateCoupon not implemented")
+func (c *marketingClient) CloneCoupon(ctx context.Context, in *CloneCouponRequest, opts ...grpc.CallOption) (*CreateCouponReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCouponReply)
+	err := c.cc.Invoke(ctx, Marketing_CloneCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) GetCoupon(context.Context, *GetCouponRequest) (*GetCouponReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCoupon not implemented")
+
+func (c *marketingClient) CreateCouponTemplate(ctx context.Context, in *CreateCouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponTemplateReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateCouponTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListCoupons not implemented")
+
+func (c *marketingClient) GetCouponTemplate(ctx context.Context, in *GetCouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponTemplateReply)
+	err := c.cc.Invoke(ctx, Marketing_GetCouponTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) UpdateCoupon(context.Context, *UpdateCouponRequest) (*UpdateCouponReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateCoupon not implemented")
+
+func (c *marketingClient) ListCouponTemplates(ctx context.Context, in *ListCouponTemplatesRequest, opts ...grpc.CallOption) (*ListCouponTemplatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponTemplatesReply)
+	err := c.cc.Invoke(ctx, Marketing_ListCouponTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) DeleteCoupon(context.Context, *DeleteCouponRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteCoupon not implemented")
+
+func (c *marketingClient) UpdateCouponTemplate(ctx context.Context, in *UpdateCouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponTemplateReply)
+	err := c.cc.Invoke(ctx, Marketing_UpdateCouponTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) ValidateCoupon(context.Context, *ValidateCouponRequest) (*ValidateCouponReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ValidateCoupon not implemented")
+
+func (c *marketingClient) DeleteCouponTemplate(ctx context.Context, in *DeleteCouponTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Marketing_DeleteCouponTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) UseCoupon(context.Context, *UseCouponRequest) (*UseCouponReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method UseCoupon not implemented")
+
+func (c *marketingClient) CreateCouponFromTemplate(ctx context.Context, in *CreateCouponFromTemplateRequest, opts ...grpc.CallOption) (*CreateCouponReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCouponReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateCouponFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) GetCouponStats(context.Context, *GetCouponStatsRequest) (*GetCouponStatsReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCouponStats not implemented")
+
+func (c *marketingClient) CreateAudience(ctx context.Context, in *CreateAudienceRequest, opts ...grpc.CallOption) (*AudienceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudienceReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) ListCouponUsages(context.Context, *ListCouponUsagesRequest) (*ListCouponUsagesReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListCouponUsages not implemented")
+
+func (c *marketingClient) GetAudience(ctx context.Context, in *GetAudienceRequest, opts ...grpc.CallOption) (*AudienceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudienceReply)
+	err := c.cc.Invoke(ctx, Marketing_GetAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) GetCouponsSummaryStats(context.Context, *GetCouponsSummaryStatsRequest) (*GetCouponsSummaryStatsReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCouponsSummaryStats not implemented")
+
+func (c *marketingClient) ListAudiences(ctx context.Context, in *ListAudiencesRequest, opts ...grpc.CallOption) (*ListAudiencesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAudiencesReply)
+	err := c.cc.Invoke(ctx, Marketing_ListAudiences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMarketingServer) mustEmbedUnimplementedMarketingServer() {}
-func (UnimplementedMarketingServer) testEmbeddedByValue()                   {}
 
-// UnsafeMarketingServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MarketingServer will
-// result in compilation errors.
-type UnsafeMarketingServer interface {
-	mustEmbedUnimplementedMarketingServer()
+func (c *marketingClient) UpdateAudience(ctx context.Context, in *UpdateAudienceRequest, opts ...grpc.CallOption) (*AudienceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudienceReply)
+	err := c.cc.Invoke(ctx, Marketing_UpdateAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) DeleteAudience(ctx context.Context, in *DeleteAudienceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Marketing_DeleteAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) UploadAudienceMembers(ctx context.Context, in *UploadAudienceMembersRequest, opts ...grpc.CallOption) (*UploadAudienceMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadAudienceMembersReply)
+	err := c.cc.Invoke(ctx, Marketing_UploadAudienceMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) CheckAudience(ctx context.Context, in *CheckAudienceRequest, opts ...grpc.CallOption) (*CheckAudienceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAudienceReply)
+	err := c.cc.Invoke(ctx, Marketing_CheckAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CampaignReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CampaignReply)
+	err := c.cc.Invoke(ctx, Marketing_GetCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignsReply)
+	err := c.cc.Invoke(ctx, Marketing_ListCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) UpdateCampaign(ctx context.Context, in *UpdateCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CampaignReply)
+	err := c.cc.Invoke(ctx, Marketing_UpdateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) DeleteCampaign(ctx context.Context, in *DeleteCampaignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Marketing_DeleteCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) PublishCampaign(ctx context.Context, in *PublishCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CampaignReply)
+	err := c.cc.Invoke(ctx, Marketing_PublishCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CampaignReply)
+	err := c.cc.Invoke(ctx, Marketing_PauseCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) EndCampaign(ctx context.Context, in *EndCampaignRequest, opts ...grpc.CallOption) (*CampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CampaignReply)
+	err := c.cc.Invoke(ctx, Marketing_EndCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) CreateRedeemCodes(ctx context.Context, in *CreateRedeemCodesRequest, opts ...grpc.CallOption) (*CreateRedeemCodesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRedeemCodesReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateRedeemCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetRedeemCode(ctx context.Context, in *GetRedeemCodeRequest, opts ...grpc.CallOption) (*RedeemCodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCodeReply)
+	err := c.cc.Invoke(ctx, Marketing_GetRedeemCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListRedeemCodes(ctx context.Context, in *ListRedeemCodesRequest, opts ...grpc.CallOption) (*ListRedeemCodesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRedeemCodesReply)
+	err := c.cc.Invoke(ctx, Marketing_ListRedeemCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) RedeemCode(ctx context.Context, in *RedeemCodeRequest, opts ...grpc.CallOption) (*RedeemCodeRedemptionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCodeRedemptionReply)
+	err := c.cc.Invoke(ctx, Marketing_RedeemCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) RevokeRedeemCode(ctx context.Context, in *RevokeRedeemCodeRequest, opts ...grpc.CallOption) (*RedeemCodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCodeReply)
+	err := c.cc.Invoke(ctx, Marketing_RevokeRedeemCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListRedeemCodeRedemptions(ctx context.Context, in *ListRedeemCodeRedemptionsRequest, opts ...grpc.CallOption) (*ListRedeemCodeRedemptionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRedeemCodeRedemptionsReply)
+	err := c.cc.Invoke(ctx, Marketing_ListRedeemCodeRedemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) RetryRedeemCodeGrant(ctx context.Context, in *RetryRedeemCodeGrantRequest, opts ...grpc.CallOption) (*RedeemCodeRedemptionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCodeRedemptionReply)
+	err := c.cc.Invoke(ctx, Marketing_RetryRedeemCodeGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) CreateReward(ctx context.Context, in *CreateRewardRequest, opts ...grpc.CallOption) (*RewardReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetReward(ctx context.Context, in *GetRewardRequest, opts ...grpc.CallOption) (*RewardReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardReply)
+	err := c.cc.Invoke(ctx, Marketing_GetReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRewardsReply)
+	err := c.cc.Invoke(ctx, Marketing_ListRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) UpdateReward(ctx context.Context, in *UpdateRewardRequest, opts ...grpc.CallOption) (*RewardReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardReply)
+	err := c.cc.Invoke(ctx, Marketing_UpdateReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) DeleteReward(ctx context.Context, in *DeleteRewardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Marketing_DeleteReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) IssueReward(ctx context.Context, in *IssueRewardRequest, opts ...grpc.CallOption) (*RewardGrantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardGrantReply)
+	err := c.cc.Invoke(ctx, Marketing_IssueReward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetRewardGrant(ctx context.Context, in *GetRewardGrantRequest, opts ...grpc.CallOption) (*RewardGrantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardGrantReply)
+	err := c.cc.Invoke(ctx, Marketing_GetRewardGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListRewardGrants(ctx context.Context, in *ListRewardGrantsRequest, opts ...grpc.CallOption) (*ListRewardGrantsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRewardGrantsReply)
+	err := c.cc.Invoke(ctx, Marketing_ListRewardGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) DistributeRewardGrant(ctx context.Context, in *DistributeRewardGrantRequest, opts ...grpc.CallOption) (*RewardGrantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardGrantReply)
+	err := c.cc.Invoke(ctx, Marketing_DistributeRewardGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) UseRewardGrant(ctx context.Context, in *UseRewardGrantRequest, opts ...grpc.CallOption) (*RewardGrantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewardGrantReply)
+	err := c.cc.Invoke(ctx, Marketing_UseRewardGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, Marketing_GetTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksReply)
+	err := c.cc.Invoke(ctx, Marketing_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, Marketing_UpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Marketing_DeleteTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListTaskProgress(ctx context.Context, in *ListTaskProgressRequest, opts ...grpc.CallOption) (*ListTaskProgressReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskProgressReply)
+	err := c.cc.Invoke(ctx, Marketing_ListTaskProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) TriggerEvent(ctx context.Context, in *TriggerEventRequest, opts ...grpc.CallOption) (*TriggerEventReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerEventReply)
+	err := c.cc.Invoke(ctx, Marketing_TriggerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ValidateCoupon(ctx context.Context, in *ValidateCouponRequest, opts ...grpc.CallOption) (*ValidateCouponReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCouponReply)
+	err := c.cc.Invoke(ctx, Marketing_ValidateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) UseCoupon(ctx context.Context, in *UseCouponRequest, opts ...grpc.CallOption) (*UseCouponReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UseCouponReply)
+	err := c.cc.Invoke(ctx, Marketing_UseCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetCouponStats(ctx context.Context, in *GetCouponStatsRequest, opts ...grpc.CallOption) (*GetCouponStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCouponStatsReply)
+	err := c.cc.Invoke(ctx, Marketing_GetCouponStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListCouponUsages(ctx context.Context, in *ListCouponUsagesRequest, opts ...grpc.CallOption) (*ListCouponUsagesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponUsagesReply)
+	err := c.cc.Invoke(ctx, Marketing_ListCouponUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetCouponsSummaryStats(ctx context.Context, in *GetCouponsSummaryStatsRequest, opts ...grpc.CallOption) (*GetCouponsSummaryStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCouponsSummaryStatsReply)
+	err := c.cc.Invoke(ctx, Marketing_GetCouponsSummaryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetCouponUsageTimeSeries(ctx context.Context, in *GetCouponUsageTimeSeriesRequest, opts ...grpc.CallOption) (*GetCouponUsageTimeSeriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCouponUsageTimeSeriesReply)
+	err := c.cc.Invoke(ctx, Marketing_GetCouponUsageTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) ListUsagesByUser(ctx context.Context, in *ListUsagesByUserRequest, opts ...grpc.CallOption) (*ListCouponUsagesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponUsagesReply)
+	err := c.cc.Invoke(ctx, Marketing_ListUsagesByUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetUsageByPaymentOrder(ctx context.Context, in *GetUsageByPaymentOrderRequest, opts ...grpc.CallOption) (*GetCouponUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCouponUsageReply)
+	err := c.cc.Invoke(ctx, Marketing_GetUsageByPaymentOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetUsageByPaymentId(ctx context.Context, in *GetUsageByPaymentIdRequest, opts ...grpc.CallOption) (*GetCouponUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCouponUsageReply)
+	err := c.cc.Invoke(ctx, Marketing_GetUsageByPaymentId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) RebuildCouponStats(ctx context.Context, in *RebuildCouponStatsRequest, opts ...grpc.CallOption) (*RebuildCouponStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildCouponStatsReply)
+	err := c.cc.Invoke(ctx, Marketing_RebuildCouponStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*CreateExportJobReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExportJobReply)
+	err := c.cc.Invoke(ctx, Marketing_CreateExportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketingClient) GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportJobReply)
+	err := c.cc.Invoke(ctx, Marketing_GetExportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarketingServer is the server API for Marketing service.
+// All implementations must embed UnimplementedMarketingServer
+// for forward compatibility.
+//
+// MarketingService 营销服务API（极简重构版：仅保留优惠券功能）
+type MarketingServer interface {
+	// ========== Coupon Management API (供开发者控制台使用) ==========
+	// CreateCoupon 创建优惠券
+	CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponReply, error)
+	// GetCoupon 获取优惠券
+	GetCoupon(context.Context, *GetCouponRequest) (*GetCouponReply, error)
+	// ListCoupons 列出优惠券
+	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsReply, error)
+	// UpdateCoupon 更新优惠券
+	UpdateCoupon(context.Context, *UpdateCouponRequest) (*UpdateCouponReply, error)
+	// DeleteCoupon 删除优惠券
+	DeleteCoupon(context.Context, *DeleteCouponRequest) (*emptypb.Empty, error)
+	// ImportCoupons 从 CSV 批量导入优惠券（也可通过 multipart 上传：POST /marketing/v1/imports/coupons）
+	ImportCoupons(context.Context, *ImportCouponsRequest) (*ImportCouponsReply, error)
+	// BatchUpdateCouponStatus 批量修改优惠券状态（指定优惠码或按筛选条件）
+	BatchUpdateCouponStatus(context.Context, *BatchUpdateCouponStatusRequest) (*BatchCouponsReply, error)
+	// BatchDeleteCoupons 批量删除优惠券（指定优惠码或按筛选条件）
+	BatchDeleteCoupons(context.Context, *BatchDeleteCouponsRequest) (*BatchCouponsReply, error)
+	// IssueCoupon 将优惠券发放给用户（生成独立有效期的用户优惠券实例）
+	IssueCoupon(context.Context, *IssueCouponRequest) (*UserCouponReply, error)
+	// ClaimCoupon 用户领取优惠券到券包（受每用户领取次数和领取总量限制）
+	ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponReply, error)
+	// ListUserCoupons 列出用户券包（按 available/used/expired 分栏）
+	ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsReply, error)
+	// GetUserCoupon 获取用户优惠券
+	GetUserCoupon(context.Context, *GetUserCouponRequest) (*UserCouponReply, error)
+	// CloneCoupon 以新优惠码复制已有优惠券（有效期平移到 validFrom，时长不变；不可用日期同步平移，默认不保留绑定用户）
+	CloneCoupon(context.Context, *CloneCouponRequest) (*CreateCouponReply, error)
+	// CreateCouponTemplate 创建优惠券模板
+	CreateCouponTemplate(context.Context, *CreateCouponTemplateRequest) (*CouponTemplateReply, error)
+	// GetCouponTemplate 获取优惠券模板
+	GetCouponTemplate(context.Context, *GetCouponTemplateRequest) (*CouponTemplateReply, error)
+	// ListCouponTemplates 列出优惠券模板
+	ListCouponTemplates(context.Context, *ListCouponTemplatesRequest) (*ListCouponTemplatesReply, error)
+	// UpdateCouponTemplate 更新优惠券模板
+	UpdateCouponTemplate(context.Context, *UpdateCouponTemplateRequest) (*CouponTemplateReply, error)
+	// DeleteCouponTemplate 删除优惠券模板
+	DeleteCouponTemplate(context.Context, *DeleteCouponTemplateRequest) (*emptypb.Empty, error)
+	// CreateCouponFromTemplate 按模板创建优惠券
+	CreateCouponFromTemplate(context.Context, *CreateCouponFromTemplateRequest) (*CreateCouponReply, error)
+	// CreateAudience 创建受众
+	CreateAudience(context.Context, *CreateAudienceRequest) (*AudienceReply, error)
+	// GetAudience 获取受众
+	GetAudience(context.Context, *GetAudienceRequest) (*AudienceReply, error)
+	// ListAudiences 列出受众
+	ListAudiences(context.Context, *ListAudiencesRequest) (*ListAudiencesReply, error)
+	// UpdateAudience 更新受众
+	UpdateAudience(context.Context, *UpdateAudienceRequest) (*AudienceReply, error)
+	// DeleteAudience 删除受众（仍被优惠券引用时拒绝）
+	DeleteAudience(context.Context, *DeleteAudienceRequest) (*emptypb.Empty, error)
+	// UploadAudienceMembers 上传 LIST 类型受众的名单
+	UploadAudienceMembers(context.Context, *UploadAudienceMembersRequest) (*UploadAudienceMembersReply, error)
+	// CheckAudience 检查用户是否属于受众
+	CheckAudience(context.Context, *CheckAudienceRequest) (*CheckAudienceReply, error)
+	// CreateCampaign 创建活动（草稿状态）
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CampaignReply, error)
+	// GetCampaign 获取活动
+	GetCampaign(context.Context, *GetCampaignRequest) (*CampaignReply, error)
+	// ListCampaigns 列出活动
+	ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsReply, error)
+	// UpdateCampaign 更新活动
+	UpdateCampaign(context.Context, *UpdateCampaignRequest) (*CampaignReply, error)
+	// DeleteCampaign 删除活动
+	DeleteCampaign(context.Context, *DeleteCampaignRequest) (*emptypb.Empty, error)
+	// PublishCampaign 发布活动（草稿或已暂停 -> 进行中）
+	PublishCampaign(context.Context, *PublishCampaignRequest) (*CampaignReply, error)
+	// PauseCampaign 暂停活动（其下优惠券立即不可用）
+	PauseCampaign(context.Context, *PauseCampaignRequest) (*CampaignReply, error)
+	// EndCampaign 结束活动（不可恢复）
+	EndCampaign(context.Context, *EndCampaignRequest) (*CampaignReply, error)
+	// ========== Redeem Code API ==========
+	// CreateRedeemCodes 批量生成兑换码（兑换后发放优惠券、积分或订阅时长）
+	CreateRedeemCodes(context.Context, *CreateRedeemCodesRequest) (*CreateRedeemCodesReply, error)
+	// GetRedeemCode 获取兑换码
+	GetRedeemCode(context.Context, *GetRedeemCodeRequest) (*RedeemCodeReply, error)
+	// ListRedeemCodes 列出兑换码
+	ListRedeemCodes(context.Context, *ListRedeemCodesRequest) (*ListRedeemCodesReply, error)
+	// RedeemCode 用户兑换兑换码（每个兑换码只能兑换一次）
+	RedeemCode(context.Context, *RedeemCodeRequest) (*RedeemCodeRedemptionReply, error)
+	// RevokeRedeemCode 作废兑换码（已兑换的不可作废）
+	RevokeRedeemCode(context.Context, *RevokeRedeemCodeRequest) (*RedeemCodeReply, error)
+	// ListRedeemCodeRedemptions 列出兑换记录（可按发放状态筛选出积分、订阅时长发放失败的记录）
+	ListRedeemCodeRedemptions(context.Context, *ListRedeemCodeRedemptionsRequest) (*ListRedeemCodeRedemptionsReply, error)
+	// RetryRedeemCodeGrant 重试兑换记录中未成功发放的积分或订阅时长（已发放时直接返回）
+	RetryRedeemCodeGrant(context.Context, *RetryRedeemCodeGrantRequest) (*RedeemCodeRedemptionReply, error)
+	// ========== Reward API ==========
+	// CreateReward 创建奖励模板
+	CreateReward(context.Context, *CreateRewardRequest) (*RewardReply, error)
+	// GetReward 获取奖励模板
+	GetReward(context.Context, *GetRewardRequest) (*RewardReply, error)
+	// ListRewards 列出奖励模板
+	ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsReply, error)
+	// UpdateReward 更新奖励模板（版本号加一，已发放的记录保留原版本快照）
+	UpdateReward(context.Context, *UpdateRewardRequest) (*RewardReply, error)
+	// DeleteReward 删除奖励模板（仍有未完成的发放记录时拒绝）
+	DeleteReward(context.Context, *DeleteRewardRequest) (*emptypb.Empty, error)
+	// IssueReward 发放奖励（创建发放记录并生成、发放奖励内容）
+	IssueReward(context.Context, *IssueRewardRequest) (*RewardGrantReply, error)
+	// GetRewardGrant 获取奖励发放记录
+	GetRewardGrant(context.Context, *GetRewardGrantRequest) (*RewardGrantReply, error)
+	// ListRewardGrants 列出奖励发放记录
+	ListRewardGrants(context.Context, *ListRewardGrantsRequest) (*ListRewardGrantsReply, error)
+	// DistributeRewardGrant 确认发放（MANUAL 发放器，或自动发放失败后重试）
+	DistributeRewardGrant(context.Context, *DistributeRewardGrantRequest) (*RewardGrantReply, error)
+	// UseRewardGrant 标记奖励已使用（积分、订阅等下游回传，COUPON 奖励在使用优惠券时自动标记）
+	UseRewardGrant(context.Context, *UseRewardGrantRequest) (*RewardGrantReply, error)
+	// ========== Task API ==========
+	// CreateTask 创建任务
+	CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error)
+	// GetTask 获取任务
+	GetTask(context.Context, *GetTaskRequest) (*TaskReply, error)
+	// ListTasks 列出任务
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error)
+	// UpdateTask 更新任务（已计入的进度保留）
+	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error)
+	// DeleteTask 删除任务（同时删除用户进度）
+	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
+	// ListTaskProgress 列出用户任务进度
+	ListTaskProgress(context.Context, *ListTaskProgressRequest) (*ListTaskProgressReply, error)
+	// TriggerEvent 上报业务事件：推进匹配任务的用户进度，完成任务时发放奖励（按 eventId 幂等）
+	TriggerEvent(context.Context, *TriggerEventRequest) (*TriggerEventReply, error)
+	// ValidateCoupon 验证优惠券 (供 Payment Service 调用)
+	ValidateCoupon(context.Context, *ValidateCouponRequest) (*ValidateCouponReply, error)
+	// UseCoupon 使用优惠券 (供 Payment Service 调用)
+	UseCoupon(context.Context, *UseCouponRequest) (*UseCouponReply, error)
+	// GetCouponStats 获取优惠券统计
+	GetCouponStats(context.Context, *GetCouponStatsRequest) (*GetCouponStatsReply, error)
+	// ListCouponUsages 列出优惠券使用记录
+	ListCouponUsages(context.Context, *ListCouponUsagesRequest) (*ListCouponUsagesReply, error)
+	// GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
+	GetCouponsSummaryStats(context.Context, *GetCouponsSummaryStatsRequest) (*GetCouponsSummaryStatsReply, error)
+	// GetCouponUsageTimeSeries 获取优惠券使用时间序列统计（供营销效果仪表板使用）
+	GetCouponUsageTimeSeries(context.Context, *GetCouponUsageTimeSeriesRequest) (*GetCouponUsageTimeSeriesReply, error)
+	// ListUsagesByUser 按用户列出优惠券使用记录 (供客服使用)
+	ListUsagesByUser(context.Context, *ListUsagesByUserRequest) (*ListCouponUsagesReply, error)
+	// GetUsageByPaymentOrder 按支付订单查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentOrder(context.Context, *GetUsageByPaymentOrderRequest) (*GetCouponUsageReply, error)
+	// GetUsageByPaymentId 按支付流水号查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentId(context.Context, *GetUsageByPaymentIdRequest) (*GetCouponUsageReply, error)
+	// RebuildCouponStats 从使用记录重算每日统计汇总并回填去重用户 HyperLogLog (管理接口，用于修复或初始化汇总数据)
+	RebuildCouponStats(context.Context, *RebuildCouponStatsRequest) (*RebuildCouponStatsReply, error)
+	// CreateExportJob 创建后台导出任务 (大数据量导出，生成文件后通过 /marketing/v1/export-jobs/{jobId}/download 下载)
+	CreateExportJob(context.Context, *CreateExportJobRequest) (*CreateExportJobReply, error)
+	// GetExportJob 获取后台导出任务状态
+	GetExportJob(context.Context, *GetExportJobRequest) (*GetExportJobReply, error)
+	mustEmbedUnimplementedMarketingServer()
+}
+
+// UnimplementedMarketingServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMarketingServer struct{}
+
+func (UnimplementedMarketingServer) CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCoupon not implemented")
+}
+func (UnimplementedMarketingServer) GetCoupon(context.Context, *GetCouponRequest) (*GetCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCoupon not implemented")
+}
+func (UnimplementedMarketingServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedMarketingServer) UpdateCoupon(context.Context, *UpdateCouponRequest) (*UpdateCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCoupon not implemented")
+}
+func (UnimplementedMarketingServer) DeleteCoupon(context.Context, *DeleteCouponRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCoupon not implemented")
+}
+func (UnimplementedMarketingServer) ImportCoupons(context.Context, *ImportCouponsRequest) (*ImportCouponsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportCoupons not implemented")
+}
+func (UnimplementedMarketingServer) BatchUpdateCouponStatus(context.Context, *BatchUpdateCouponStatusRequest) (*BatchCouponsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateCouponStatus not implemented")
+}
+func (UnimplementedMarketingServer) BatchDeleteCoupons(context.Context, *BatchDeleteCouponsRequest) (*BatchCouponsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteCoupons not implemented")
+}
+func (UnimplementedMarketingServer) IssueCoupon(context.Context, *IssueCouponRequest) (*UserCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueCoupon not implemented")
+}
+func (UnimplementedMarketingServer) ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimCoupon not implemented")
+}
+func (UnimplementedMarketingServer) ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserCoupons not implemented")
+}
+func (UnimplementedMarketingServer) GetUserCoupon(context.Context, *GetUserCouponRequest) (*UserCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserCoupon not implemented")
+}
+func (UnimplementedMarketingServer) CloneCoupon(context.Context, *CloneCouponRequest) (*CreateCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneCoupon not implemented")
+}
+func (UnimplementedMarketingServer) CreateCouponTemplate(context.Context, *CreateCouponTemplateRequest) (*CouponTemplateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCouponTemplate not implemented")
+}
+func (UnimplementedMarketingServer) GetCouponTemplate(context.Context, *GetCouponTemplateRequest) (*CouponTemplateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCouponTemplate not implemented")
+}
+func (UnimplementedMarketingServer) ListCouponTemplates(context.Context, *ListCouponTemplatesRequest) (*ListCouponTemplatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCouponTemplates not implemented")
+}
+func (UnimplementedMarketingServer) UpdateCouponTemplate(context.Context, *UpdateCouponTemplateRequest) (*CouponTemplateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCouponTemplate not implemented")
+}
+func (UnimplementedMarketingServer) DeleteCouponTemplate(context.Context, *DeleteCouponTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCouponTemplate not implemented")
+}
+func (UnimplementedMarketingServer) CreateCouponFromTemplate(context.Context, *CreateCouponFromTemplateRequest) (*CreateCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCouponFromTemplate not implemented")
+}
+func (UnimplementedMarketingServer) CreateAudience(context.Context, *CreateAudienceRequest) (*AudienceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAudience not implemented")
+}
+func (UnimplementedMarketingServer) GetAudience(context.Context, *GetAudienceRequest) (*AudienceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAudience not implemented")
+}
+func (UnimplementedMarketingServer) ListAudiences(context.Context, *ListAudiencesRequest) (*ListAudiencesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAudiences not implemented")
+}
+func (UnimplementedMarketingServer) UpdateAudience(context.Context, *UpdateAudienceRequest) (*AudienceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAudience not implemented")
+}
+func (UnimplementedMarketingServer) DeleteAudience(context.Context, *DeleteAudienceRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAudience not implemented")
+}
+func (UnimplementedMarketingServer) UploadAudienceMembers(context.Context, *UploadAudienceMembersRequest) (*UploadAudienceMembersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadAudienceMembers not implemented")
+}
+func (UnimplementedMarketingServer) CheckAudience(context.Context, *CheckAudienceRequest) (*CheckAudienceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckAudience not implemented")
+}
+func (UnimplementedMarketingServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedMarketingServer) GetCampaign(context.Context, *GetCampaignRequest) (*CampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedMarketingServer) ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedMarketingServer) UpdateCampaign(context.Context, *UpdateCampaignRequest) (*CampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCampaign not implemented")
+}
+func (UnimplementedMarketingServer) DeleteCampaign(context.Context, *DeleteCampaignRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCampaign not implemented")
+}
+func (UnimplementedMarketingServer) PublishCampaign(context.Context, *PublishCampaignRequest) (*CampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishCampaign not implemented")
+}
+func (UnimplementedMarketingServer) PauseCampaign(context.Context, *PauseCampaignRequest) (*CampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseCampaign not implemented")
+}
+func (UnimplementedMarketingServer) EndCampaign(context.Context, *EndCampaignRequest) (*CampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndCampaign not implemented")
+}
+func (UnimplementedMarketingServer) CreateRedeemCodes(context.Context, *CreateRedeemCodesRequest) (*CreateRedeemCodesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRedeemCodes not implemented")
+}
+func (UnimplementedMarketingServer) GetRedeemCode(context.Context, *GetRedeemCodeRequest) (*RedeemCodeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRedeemCode not implemented")
+}
+func (UnimplementedMarketingServer) ListRedeemCodes(context.Context, *ListRedeemCodesRequest) (*ListRedeemCodesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRedeemCodes not implemented")
+}
+func (UnimplementedMarketingServer) RedeemCode(context.Context, *RedeemCodeRequest) (*RedeemCodeRedemptionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeemCode not implemented")
+}
+func (UnimplementedMarketingServer) RevokeRedeemCode(context.Context, *RevokeRedeemCodeRequest) (*RedeemCodeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeRedeemCode not implemented")
+}
+func (UnimplementedMarketingServer) ListRedeemCodeRedemptions(context.Context, *ListRedeemCodeRedemptionsRequest) (*ListRedeemCodeRedemptionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRedeemCodeRedemptions not implemented")
+}
+func (UnimplementedMarketingServer) RetryRedeemCodeGrant(context.Context, *RetryRedeemCodeGrantRequest) (*RedeemCodeRedemptionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryRedeemCodeGrant not implemented")
+}
+func (UnimplementedMarketingServer) CreateReward(context.Context, *CreateRewardRequest) (*RewardReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateReward not implemented")
+}
+func (UnimplementedMarketingServer) GetReward(context.Context, *GetRewardRequest) (*RewardReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReward not implemented")
+}
+func (UnimplementedMarketingServer) ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRewards not implemented")
+}
+func (UnimplementedMarketingServer) UpdateReward(context.Context, *UpdateRewardRequest) (*RewardReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateReward not implemented")
+}
+func (UnimplementedMarketingServer) DeleteReward(context.Context, *DeleteRewardRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReward not implemented")
+}
+func (UnimplementedMarketingServer) IssueReward(context.Context, *IssueRewardRequest) (*RewardGrantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueReward not implemented")
+}
+func (UnimplementedMarketingServer) GetRewardGrant(context.Context, *GetRewardGrantRequest) (*RewardGrantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRewardGrant not implemented")
+}
+func (UnimplementedMarketingServer) ListRewardGrants(context.Context, *ListRewardGrantsRequest) (*ListRewardGrantsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRewardGrants not implemented")
+}
+func (UnimplementedMarketingServer) DistributeRewardGrant(context.Context, *DistributeRewardGrantRequest) (*RewardGrantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DistributeRewardGrant not implemented")
+}
+func (UnimplementedMarketingServer) UseRewardGrant(context.Context, *UseRewardGrantRequest) (*RewardGrantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UseRewardGrant not implemented")
+}
+func (UnimplementedMarketingServer) CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (UnimplementedMarketingServer) GetTask(context.Context, *GetTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedMarketingServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedMarketingServer) UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (UnimplementedMarketingServer) DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (UnimplementedMarketingServer) ListTaskProgress(context.Context, *ListTaskProgressRequest) (*ListTaskProgressReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskProgress not implemented")
+}
+func (UnimplementedMarketingServer) TriggerEvent(context.Context, *TriggerEventRequest) (*TriggerEventReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerEvent not implemented")
+}
+func (UnimplementedMarketingServer) ValidateCoupon(context.Context, *ValidateCouponRequest) (*ValidateCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateCoupon not implemented")
+}
+func (UnimplementedMarketingServer) UseCoupon(context.Context, *UseCouponRequest) (*UseCouponReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UseCoupon not implemented")
+}
+func (UnimplementedMarketingServer) GetCouponStats(context.Context, *GetCouponStatsRequest) (*GetCouponStatsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCouponStats not implemented")
+}
+func (UnimplementedMarketingServer) ListCouponUsages(context.Context, *ListCouponUsagesRequest) (*ListCouponUsagesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCouponUsages not implemented")
+}
+func (UnimplementedMarketingServer) GetCouponsSummaryStats(context.Context, *GetCouponsSummaryStatsRequest) (*GetCouponsSummaryStatsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCouponsSummaryStats not implemented")
+}
+func (UnimplementedMarketingServer) GetCouponUsageTimeSeries(context.Context, *GetCouponUsageTimeSeriesRequest) (*GetCouponUsageTimeSeriesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCouponUsageTimeSeries not implemented")
+}
+func (UnimplementedMarketingServer) ListUsagesByUser(context.Context, *ListUsagesByUserRequest) (*ListCouponUsagesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsagesByUser not implemented")
+}
+func (UnimplementedMarketingServer) GetUsageByPaymentOrder(context.Context, *GetUsageByPaymentOrderRequest) (*GetCouponUsageReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsageByPaymentOrder not implemented")
+}
+func (UnimplementedMarketingServer) GetUsageByPaymentId(context.Context, *GetUsageByPaymentIdRequest) (*GetCouponUsageReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsageByPaymentId not implemented")
+}
+func (UnimplementedMarketingServer) RebuildCouponStats(context.Context, *RebuildCouponStatsRequest) (*RebuildCouponStatsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RebuildCouponStats not implemented")
+}
+func (UnimplementedMarketingServer) CreateExportJob(context.Context, *CreateExportJobRequest) (*CreateExportJobReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExportJob not implemented")
+}
+func (UnimplementedMarketingServer) GetExportJob(context.Context, *GetExportJobRequest) (*GetExportJobReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExportJob not implemented")
+}
+func (UnimplementedMarketingServer) mustEmbedUnimplementedMarketingServer() {}
+func (UnimplementedMarketingServer) testEmbeddedByValue()                   {}
+
+// UnsafeMarketingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketingServer will
+// result in compilation errors.
+type UnsafeMarketingServer interface {
+	mustEmbedUnimplementedMarketingServer()
+}
+
+func RegisterMarketingServer(s grpc.ServiceRegistrar, srv MarketingServer) {
+	// If the following call panics, it indicates UnimplementedMarketingServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Marketing_ServiceDesc, srv)
+}
+
+func _Marketing_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetCoupon(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).UpdateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_UpdateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).UpdateCoupon(ctx, req.(*UpdateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).DeleteCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_DeleteCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ImportCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ImportCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ImportCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ImportCoupons(ctx, req.(*ImportCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_BatchUpdateCouponStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateCouponStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).BatchUpdateCouponStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_BatchUpdateCouponStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).BatchUpdateCouponStatus(ctx, req.(*BatchUpdateCouponStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_BatchDeleteCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).BatchDeleteCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_BatchDeleteCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).BatchDeleteCoupons(ctx, req.(*BatchDeleteCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_IssueCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).IssueCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_IssueCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).IssueCoupon(ctx, req.(*IssueCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ClaimCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ClaimCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ClaimCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ClaimCoupon(ctx, req.(*ClaimCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListUserCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListUserCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListUserCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListUserCoupons(ctx, req.(*ListUserCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetUserCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetUserCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetUserCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetUserCoupon(ctx, req.(*GetUserCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CloneCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CloneCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CloneCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CloneCoupon(ctx, req.(*CloneCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateCouponTemplate(ctx, req.(*CreateCouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetCouponTemplate(ctx, req.(*GetCouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListCouponTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListCouponTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListCouponTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListCouponTemplates(ctx, req.(*ListCouponTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_UpdateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).UpdateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_UpdateCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).UpdateCouponTemplate(ctx, req.(*UpdateCouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_DeleteCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).DeleteCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_DeleteCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).DeleteCouponTemplate(ctx, req.(*DeleteCouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateCouponFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateCouponFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateCouponFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateCouponFromTemplate(ctx, req.(*CreateCouponFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateAudience(ctx, req.(*CreateAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetAudience(ctx, req.(*GetAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListAudiences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAudiencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListAudiences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListAudiences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListAudiences(ctx, req.(*ListAudiencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_UpdateAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).UpdateAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_UpdateAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).UpdateAudience(ctx, req.(*UpdateAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_DeleteAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).DeleteAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_DeleteAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).DeleteAudience(ctx, req.(*DeleteAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_UploadAudienceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAudienceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).UploadAudienceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_UploadAudienceMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).UploadAudienceMembers(ctx, req.(*UploadAudienceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CheckAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CheckAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CheckAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CheckAudience(ctx, req.(*CheckAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetCampaign(ctx, req.(*GetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListCampaigns(ctx, req.(*ListCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_UpdateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).UpdateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_UpdateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).UpdateCampaign(ctx, req.(*UpdateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_DeleteCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).DeleteCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_DeleteCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).DeleteCampaign(ctx, req.(*DeleteCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_PublishCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).PublishCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_PublishCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).PublishCampaign(ctx, req.(*PublishCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_PauseCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).PauseCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_PauseCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).PauseCampaign(ctx, req.(*PauseCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_EndCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).EndCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_EndCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).EndCampaign(ctx, req.(*EndCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateRedeemCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRedeemCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateRedeemCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateRedeemCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateRedeemCodes(ctx, req.(*CreateRedeemCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetRedeemCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedeemCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetRedeemCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetRedeemCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetRedeemCode(ctx, req.(*GetRedeemCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListRedeemCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRedeemCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListRedeemCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListRedeemCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListRedeemCodes(ctx, req.(*ListRedeemCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_RedeemCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).RedeemCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_RedeemCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).RedeemCode(ctx, req.(*RedeemCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_RevokeRedeemCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRedeemCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).RevokeRedeemCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_RevokeRedeemCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).RevokeRedeemCode(ctx, req.(*RevokeRedeemCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListRedeemCodeRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRedeemCodeRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListRedeemCodeRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListRedeemCodeRedemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListRedeemCodeRedemptions(ctx, req.(*ListRedeemCodeRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_RetryRedeemCodeGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryRedeemCodeGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).RetryRedeemCodeGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_RetryRedeemCodeGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).RetryRedeemCodeGrant(ctx, req.(*RetryRedeemCodeGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateReward(ctx, req.(*CreateRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetReward(ctx, req.(*GetRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListRewards(ctx, req.(*ListRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_UpdateReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).UpdateReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_UpdateReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).UpdateReward(ctx, req.(*UpdateRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_DeleteReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).DeleteReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_DeleteReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).DeleteReward(ctx, req.(*DeleteRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_IssueReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).IssueReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_IssueReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).IssueReward(ctx, req.(*IssueRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetRewardGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRewardGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetRewardGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetRewardGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetRewardGrant(ctx, req.(*GetRewardGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListRewardGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRewardGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListRewardGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListRewardGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListRewardGrants(ctx, req.(*ListRewardGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_DistributeRewardGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributeRewardGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).DistributeRewardGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_DistributeRewardGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).DistributeRewardGrant(ctx, req.(*DistributeRewardGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_UseRewardGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseRewardGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).UseRewardGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_UseRewardGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).UseRewardGrant(ctx, req.(*UseRewardGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateTask(ctx, req.(*CreateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMarketingServer(s grpc.ServiceRegistrar, srv MarketingServer) {
-	// If the following call panics, it indicates UnimplementedMarketingServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Marketing_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Marketing_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MarketingServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Marketing_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCouponRequest)
+func _Marketing_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarketingServer).CreateCoupon(ctx, in)
+		return srv.(MarketingServer).ListTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Marketing_CreateCoupon_FullMethodName,
+		FullMethod: Marketing_ListTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarketingServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+		return srv.(MarketingServer).ListTasks(ctx, req.(*ListTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Marketing_GetCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCouponRequest)
+func _Marketing_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarketingServer).GetCoupon(ctx, in)
+		return srv.(MarketingServer).UpdateTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Marketing_GetCoupon_FullMethodName,
+		FullMethod: Marketing_UpdateTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarketingServer).GetCoupon(ctx, req.(*GetCouponRequest))
+		return srv.(MarketingServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Marketing_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCouponsRequest)
+func _Marketing_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarketingServer).ListCoupons(ctx, in)
+		return srv.(MarketingServer).DeleteTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Marketing_ListCoupons_FullMethodName,
+		FullMethod: Marketing_DeleteTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarketingServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+		return srv.(MarketingServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Marketing_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCouponRequest)
+func _Marketing_ListTaskProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskProgressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarketingServer).UpdateCoupon(ctx, in)
+		return srv.(MarketingServer).ListTaskProgress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Marketing_UpdateCoupon_FullMethodName,
+		FullMethod: Marketing_ListTaskProgress_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarketingServer).UpdateCoupon(ctx, req.(*UpdateCouponRequest))
+		return srv.(MarketingServer).ListTaskProgress(ctx, req.(*ListTaskProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Marketing_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCouponRequest)
+func _Marketing_TriggerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MarketingServer).DeleteCoupon(ctx, in)
+		return srv.(MarketingServer).TriggerEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Marketing_DeleteCoupon_FullMethodName,
+		FullMethod: Marketing_TriggerEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MarketingServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+		return srv.(MarketingServer).TriggerEvent(ctx, req.(*TriggerEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -437,6 +2477,132 @@ func _Marketing_GetCouponsSummaryStats_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Marketing_GetCouponUsageTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponUsageTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetCouponUsageTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetCouponUsageTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetCouponUsageTimeSeries(ctx, req.(*GetCouponUsageTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_ListUsagesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsagesByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).ListUsagesByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_ListUsagesByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).ListUsagesByUser(ctx, req.(*ListUsagesByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetUsageByPaymentOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageByPaymentOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetUsageByPaymentOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetUsageByPaymentOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetUsageByPaymentOrder(ctx, req.(*GetUsageByPaymentOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetUsageByPaymentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageByPaymentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetUsageByPaymentId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetUsageByPaymentId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetUsageByPaymentId(ctx, req.(*GetUsageByPaymentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_RebuildCouponStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildCouponStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).RebuildCouponStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_RebuildCouponStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).RebuildCouponStats(ctx, req.(*RebuildCouponStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_CreateExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).CreateExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_CreateExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).CreateExportJob(ctx, req.(*CreateExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketing_GetExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketingServer).GetExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Marketing_GetExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketingServer).GetExportJob(ctx, req.(*GetExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Marketing_ServiceDesc is the grpc.ServiceDesc for Marketing service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +2630,218 @@ var Marketing_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCoupon",
 			Handler:    _Marketing_DeleteCoupon_Handler,
 		},
+		{
+			MethodName: "ImportCoupons",
+			Handler:    _Marketing_ImportCoupons_Handler,
+		},
+		{
+			MethodName: "BatchUpdateCouponStatus",
+			Handler:    _Marketing_BatchUpdateCouponStatus_Handler,
+		},
+		{
+			MethodName: "BatchDeleteCoupons",
+			Handler:    _Marketing_BatchDeleteCoupons_Handler,
+		},
+		{
+			MethodName: "IssueCoupon",
+			Handler:    _Marketing_IssueCoupon_Handler,
+		},
+		{
+			MethodName: "ClaimCoupon",
+			Handler:    _Marketing_ClaimCoupon_Handler,
+		},
+		{
+			MethodName: "ListUserCoupons",
+			Handler:    _Marketing_ListUserCoupons_Handler,
+		},
+		{
+			MethodName: "GetUserCoupon",
+			Handler:    _Marketing_GetUserCoupon_Handler,
+		},
+		{
+			MethodName: "CloneCoupon",
+			Handler:    _Marketing_CloneCoupon_Handler,
+		},
+		{
+			MethodName: "CreateCouponTemplate",
+			Handler:    _Marketing_CreateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "GetCouponTemplate",
+			Handler:    _Marketing_GetCouponTemplate_Handler,
+		},
+		{
+			MethodName: "ListCouponTemplates",
+			Handler:    _Marketing_ListCouponTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateCouponTemplate",
+			Handler:    _Marketing_UpdateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteCouponTemplate",
+			Handler:    _Marketing_DeleteCouponTemplate_Handler,
+		},
+		{
+			MethodName: "CreateCouponFromTemplate",
+			Handler:    _Marketing_CreateCouponFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateAudience",
+			Handler:    _Marketing_CreateAudience_Handler,
+		},
+		{
+			MethodName: "GetAudience",
+			Handler:    _Marketing_GetAudience_Handler,
+		},
+		{
+			MethodName: "ListAudiences",
+			Handler:    _Marketing_ListAudiences_Handler,
+		},
+		{
+			MethodName: "UpdateAudience",
+			Handler:    _Marketing_UpdateAudience_Handler,
+		},
+		{
+			MethodName: "DeleteAudience",
+			Handler:    _Marketing_DeleteAudience_Handler,
+		},
+		{
+			MethodName: "UploadAudienceMembers",
+			Handler:    _Marketing_UploadAudienceMembers_Handler,
+		},
+		{
+			MethodName: "CheckAudience",
+			Handler:    _Marketing_CheckAudience_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _Marketing_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _Marketing_GetCampaign_Handler,
+		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _Marketing_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "UpdateCampaign",
+			Handler:    _Marketing_UpdateCampaign_Handler,
+		},
+		{
+			MethodName: "DeleteCampaign",
+			Handler:    _Marketing_DeleteCampaign_Handler,
+		},
+		{
+			MethodName: "PublishCampaign",
+			Handler:    _Marketing_PublishCampaign_Handler,
+		},
+		{
+			MethodName: "PauseCampaign",
+			Handler:    _Marketing_PauseCampaign_Handler,
+		},
+		{
+			MethodName: "EndCampaign",
+			Handler:    _Marketing_EndCampaign_Handler,
+		},
+		{
+			MethodName: "CreateRedeemCodes",
+			Handler:    _Marketing_CreateRedeemCodes_Handler,
+		},
+		{
+			MethodName: "GetRedeemCode",
+			Handler:    _Marketing_GetRedeemCode_Handler,
+		},
+		{
+			MethodName: "ListRedeemCodes",
+			Handler:    _Marketing_ListRedeemCodes_Handler,
+		},
+		{
+			MethodName: "RedeemCode",
+			Handler:    _Marketing_RedeemCode_Handler,
+		},
+		{
+			MethodName: "RevokeRedeemCode",
+			Handler:    _Marketing_RevokeRedeemCode_Handler,
+		},
+		{
+			MethodName: "ListRedeemCodeRedemptions",
+			Handler:    _Marketing_ListRedeemCodeRedemptions_Handler,
+		},
+		{
+			MethodName: "RetryRedeemCodeGrant",
+			Handler:    _Marketing_RetryRedeemCodeGrant_Handler,
+		},
+		{
+			MethodName: "CreateReward",
+			Handler:    _Marketing_CreateReward_Handler,
+		},
+		{
+			MethodName: "GetReward",
+			Handler:    _Marketing_GetReward_Handler,
+		},
+		{
+			MethodName: "ListRewards",
+			Handler:    _Marketing_ListRewards_Handler,
+		},
+		{
+			MethodName: "UpdateReward",
+			Handler:    _Marketing_UpdateReward_Handler,
+		},
+		{
+			MethodName: "DeleteReward",
+			Handler:    _Marketing_DeleteReward_Handler,
+		},
+		{
+			MethodName: "IssueReward",
+			Handler:    _Marketing_IssueReward_Handler,
+		},
+		{
+			MethodName: "GetRewardGrant",
+			Handler:    _Marketing_GetRewardGrant_Handler,
+		},
+		{
+			MethodName: "ListRewardGrants",
+			Handler:    _Marketing_ListRewardGrants_Handler,
+		},
+		{
+			MethodName: "DistributeRewardGrant",
+			Handler:    _Marketing_DistributeRewardGrant_Handler,
+		},
+		{
+			MethodName: "UseRewardGrant",
+			Handler:    _Marketing_UseRewardGrant_Handler,
+		},
+		{
+			MethodName: "CreateTask",
+			Handler:    _Marketing_CreateTask_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _Marketing_GetTask_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _Marketing_ListTasks_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _Marketing_UpdateTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _Marketing_DeleteTask_Handler,
+		},
+		{
+			MethodName: "ListTaskProgress",
+			Handler:    _Marketing_ListTaskProgress_Handler,
+		},
+		{
+			MethodName: "TriggerEvent",
+			Handler:    _Marketing_TriggerEvent_Handler,
+		},
 		{
 			MethodName: "ValidateCoupon",
 			Handler:    _Marketing_ValidateCoupon_Handler,
@@ -484,6 +2862,34 @@ var Marketing_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCouponsSummaryStats",
 			Handler:    _Marketing_GetCouponsSummaryStats_Handler,
 		},
+		{
+			MethodName: "GetCouponUsageTimeSeries",
+			Handler:    _Marketing_GetCouponUsageTimeSeries_Handler,
+		},
+		{
+			MethodName: "ListUsagesByUser",
+			Handler:    _Marketing_ListUsagesByUser_Handler,
+		},
+		{
+			MethodName: "GetUsageByPaymentOrder",
+			Handler:    _Marketing_GetUsageByPaymentOrder_Handler,
+		},
+		{
+			MethodName: "GetUsageByPaymentId",
+			Handler:    _Marketing_GetUsageByPaymentId_Handler,
+		},
+		{
+			MethodName: "RebuildCouponStats",
+			Handler:    _Marketing_RebuildCouponStats_Handler,
+		},
+		{
+			MethodName: "CreateExportJob",
+			Handler:    _Marketing_CreateExportJob_Handler,
+		},
+		{
+			MethodName: "GetExportJob",
+			Handler:    _Marketing_GetExportJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "marketing_service/v1/marketing.proto",
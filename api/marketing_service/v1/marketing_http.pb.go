@@ -20,37 +20,220 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationMarketingBatchDeleteCoupons = "/platform.marketing_service.v1.Marketing/BatchDeleteCoupons"
+const OperationMarketingBatchUpdateCouponStatus = "/platform.marketing_service.v1.Marketing/BatchUpdateCouponStatus"
+const OperationMarketingCheckAudience = "/platform.marketing_service.v1.Marketing/CheckAudience"
+const OperationMarketingClaimCoupon = "/platform.marketing_service.v1.Marketing/ClaimCoupon"
+const OperationMarketingCloneCoupon = "/platform.marketing_service.v1.Marketing/CloneCoupon"
+const OperationMarketingCreateAudience = "/platform.marketing_service.v1.Marketing/CreateAudience"
+const OperationMarketingCreateCampaign = "/platform.marketing_service.v1.Marketing/CreateCampaign"
 const OperationMarketingCreateCoupon = "/platform.marketing_service.v1.Marketing/CreateCoupon"
+const OperationMarketingCreateCouponFromTemplate = "/platform.marketing_service.v1.Marketing/CreateCouponFromTemplate"
+const OperationMarketingCreateCouponTemplate = "/platform.marketing_service.v1.Marketing/CreateCouponTemplate"
+const OperationMarketingCreateExportJob = "/platform.marketing_service.v1.Marketing/CreateExportJob"
+const OperationMarketingCreateRedeemCodes = "/platform.marketing_service.v1.Marketing/CreateRedeemCodes"
+const OperationMarketingCreateReward = "/platform.marketing_service.v1.Marketing/CreateReward"
+const OperationMarketingCreateTask = "/platform.marketing_service.v1.Marketing/CreateTask"
+const OperationMarketingDeleteAudience = "/platform.marketing_service.v1.Marketing/DeleteAudience"
+const OperationMarketingDeleteCampaign = "/platform.marketing_service.v1.Marketing/DeleteCampaign"
 const OperationMarketingDeleteCoupon = "/platform.marketing_service.v1.Marketing/DeleteCoupon"
+const OperationMarketingDeleteCouponTemplate = "/platform.marketing_service.v1.Marketing/DeleteCouponTemplate"
+const OperationMarketingDeleteReward = "/platform.marketing_service.v1.Marketing/DeleteReward"
+const OperationMarketingDeleteTask = "/platform.marketing_service.v1.Marketing/DeleteTask"
+const OperationMarketingDistributeRewardGrant = "/platform.marketing_service.v1.Marketing/DistributeRewardGrant"
+const OperationMarketingEndCampaign = "/platform.marketing_service.v1.Marketing/EndCampaign"
+const OperationMarketingGetAudience = "/platform.marketing_service.v1.Marketing/GetAudience"
+const OperationMarketingGetCampaign = "/platform.marketing_service.v1.Marketing/GetCampaign"
 const OperationMarketingGetCoupon = "/platform.marketing_service.v1.Marketing/GetCoupon"
 const OperationMarketingGetCouponStats = "/platform.marketing_service.v1.Marketing/GetCouponStats"
+const OperationMarketingGetCouponTemplate = "/platform.marketing_service.v1.Marketing/GetCouponTemplate"
+const OperationMarketingGetCouponUsageTimeSeries = "/platform.marketing_service.v1.Marketing/GetCouponUsageTimeSeries"
 const OperationMarketingGetCouponsSummaryStats = "/platform.marketing_service.v1.Marketing/GetCouponsSummaryStats"
+const OperationMarketingGetExportJob = "/platform.marketing_service.v1.Marketing/GetExportJob"
+const OperationMarketingGetRedeemCode = "/platform.marketing_service.v1.Marketing/GetRedeemCode"
+const OperationMarketingGetReward = "/platform.marketing_service.v1.Marketing/GetReward"
+const OperationMarketingGetRewardGrant = "/platform.marketing_service.v1.Marketing/GetRewardGrant"
+const OperationMarketingGetTask = "/platform.marketing_service.v1.Marketing/GetTask"
+const OperationMarketingGetUsageByPaymentId = "/platform.marketing_service.v1.Marketing/GetUsageByPaymentId"
+const OperationMarketingGetUsageByPaymentOrder = "/platform.marketing_service.v1.Marketing/GetUsageByPaymentOrder"
+const OperationMarketingGetUserCoupon = "/platform.marketing_service.v1.Marketing/GetUserCoupon"
+const OperationMarketingImportCoupons = "/platform.marketing_service.v1.Marketing/ImportCoupons"
+const OperationMarketingIssueCoupon = "/platform.marketing_service.v1.Marketing/IssueCoupon"
+const OperationMarketingIssueReward = "/platform.marketing_service.v1.Marketing/IssueReward"
+const OperationMarketingListAudiences = "/platform.marketing_service.v1.Marketing/ListAudiences"
+const OperationMarketingListCampaigns = "/platform.marketing_service.v1.Marketing/ListCampaigns"
+const OperationMarketingListCouponTemplates = "/platform.marketing_service.v1.Marketing/ListCouponTemplates"
 const OperationMarketingListCouponUsages = "/platform.marketing_service.v1.Marketing/ListCouponUsages"
 const OperationMarketingListCoupons = "/platform.marketing_service.v1.Marketing/ListCoupons"
+const OperationMarketingListRedeemCodeRedemptions = "/platform.marketing_service.v1.Marketing/ListRedeemCodeRedemptions"
+const OperationMarketingListRedeemCodes = "/platform.marketing_service.v1.Marketing/ListRedeemCodes"
+const OperationMarketingListRewardGrants = "/platform.marketing_service.v1.Marketing/ListRewardGrants"
+const OperationMarketingListRewards = "/platform.marketing_service.v1.Marketing/ListRewards"
+const OperationMarketingListTaskProgress = "/platform.marketing_service.v1.Marketing/ListTaskProgress"
+const OperationMarketingListTasks = "/platform.marketing_service.v1.Marketing/ListTasks"
+const OperationMarketingListUsagesByUser = "/platform.marketing_service.v1.Marketing/ListUsagesByUser"
+const OperationMarketingListUserCoupons = "/platform.marketing_service.v1.Marketing/ListUserCoupons"
+const OperationMarketingPauseCampaign = "/platform.marketing_service.v1.Marketing/PauseCampaign"
+const OperationMarketingPublishCampaign = "/platform.marketing_service.v1.Marketing/PublishCampaign"
+const OperationMarketingRebuildCouponStats = "/platform.marketing_service.v1.Marketing/RebuildCouponStats"
+const OperationMarketingRedeemCode = "/platform.marketing_service.v1.Marketing/RedeemCode"
+const OperationMarketingRetryRedeemCodeGrant = "/platform.marketing_service.v1.Marketing/RetryRedeemCodeGrant"
+const OperationMarketingRevokeRedeemCode = "/platform.marketing_service.v1.Marketing/RevokeRedeemCode"
+const OperationMarketingTriggerEvent = "/platform.marketing_service.v1.Marketing/TriggerEvent"
+const OperationMarketingUpdateAudience = "/platform.marketing_service.v1.Marketing/UpdateAudience"
+const OperationMarketingUpdateCampaign = "/platform.marketing_service.v1.Marketing/UpdateCampaign"
 const OperationMarketingUpdateCoupon = "/platform.marketing_service.v1.Marketing/UpdateCoupon"
+const OperationMarketingUpdateCouponTemplate = "/platform.marketing_service.v1.Marketing/UpdateCouponTemplate"
+const OperationMarketingUpdateReward = "/platform.marketing_service.v1.Marketing/UpdateReward"
+const OperationMarketingUpdateTask = "/platform.marketing_service.v1.Marketing/UpdateTask"
+const OperationMarketingUploadAudienceMembers = "/platform.marketing_service.v1.Marketing/UploadAudienceMembers"
 const OperationMarketingUseCoupon = "/platform.marketing_service.v1.Marketing/UseCoupon"
+const OperationMarketingUseRewardGrant = "/platform.marketing_service.v1.Marketing/UseRewardGrant"
 const OperationMarketingValidateCoupon = "/platform.marketing_service.v1.Marketing/ValidateCoupon"
 
 type MarketingHTTPServer interface {
+	// BatchDeleteCoupons BatchDeleteCoupons 批量删除优惠券（指定优惠码或按筛选条件）
+	BatchDeleteCoupons(context.Context, *BatchDeleteCouponsRequest) (*BatchCouponsReply, error)
+	// BatchUpdateCouponStatus BatchUpdateCouponStatus 批量修改优惠券状态（指定优惠码或按筛选条件）
+	BatchUpdateCouponStatus(context.Context, *BatchUpdateCouponStatusRequest) (*BatchCouponsReply, error)
+	// CheckAudience CheckAudience 检查用户是否属于受众
+	CheckAudience(context.Context, *CheckAudienceRequest) (*CheckAudienceReply, error)
+	// ClaimCoupon ClaimCoupon 用户领取优惠券到券包（受每用户领取次数和领取总量限制）
+	ClaimCoupon(context.Context, *ClaimCouponRequest) (*UserCouponReply, error)
+	// CloneCoupon CloneCoupon 以新优惠码复制已有优惠券（有效期平移到 validFrom，时长不变；不可用日期同步平移，默认不保留绑定用户）
+	CloneCoupon(context.Context, *CloneCouponRequest) (*CreateCouponReply, error)
+	// CreateAudience CreateAudience 创建受众
+	CreateAudience(context.Context, *CreateAudienceRequest) (*AudienceReply, error)
+	// CreateCampaign CreateCampaign 创建活动（草稿状态）
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CampaignReply, error)
 	// CreateCoupon ========== Coupon Management API (供开发者控制台使用) ==========
 	// CreateCoupon 创建优惠券
 	CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponReply, error)
+	// CreateCouponFromTemplate CreateCouponFromTemplate 按模板创建优惠券
+	CreateCouponFromTemplate(context.Context, *CreateCouponFromTemplateRequest) (*CreateCouponReply, error)
+	// CreateCouponTemplate CreateCouponTemplate 创建优惠券模板
+	CreateCouponTemplate(context.Context, *CreateCouponTemplateRequest) (*CouponTemplateReply, error)
+	// CreateExportJob CreateExportJob 创建后台导出任务 (大数据量导出，生成文件后通过 /marketing/v1/export-jobs/{jobId}/download 下载)
+	CreateExportJob(context.Context, *CreateExportJobRequest) (*CreateExportJobReply, error)
+	// CreateRedeemCodes ========== Redeem Code API ==========
+	// CreateRedeemCodes 批量生成兑换码（兑换后发放优惠券、积分或订阅时长）
+	CreateRedeemCodes(context.Context, *CreateRedeemCodesRequest) (*CreateRedeemCodesReply, error)
+	// CreateReward ========== Reward API ==========
+	// CreateReward 创建奖励模板
+	CreateReward(context.Context, *CreateRewardRequest) (*RewardReply, error)
+	// CreateTask ========== Task API ==========
+	// CreateTask 创建任务
+	CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error)
+	// DeleteAudience DeleteAudience 删除受众（仍被优惠券引用时拒绝）
+	DeleteAudience(context.Context, *DeleteAudienceRequest) (*emptypb.Empty, error)
+	// DeleteCampaign DeleteCampaign 删除活动
+	DeleteCampaign(context.Context, *DeleteCampaignRequest) (*emptypb.Empty, error)
 	// DeleteCoupon DeleteCoupon 删除优惠券
 	DeleteCoupon(context.Context, *DeleteCouponRequest) (*emptypb.Empty, error)
+	// DeleteCouponTemplate DeleteCouponTemplate 删除优惠券模板
+	DeleteCouponTemplate(context.Context, *DeleteCouponTemplateRequest) (*emptypb.Empty, error)
+	// DeleteReward DeleteReward 删除奖励模板（仍有未完成的发放记录时拒绝）
+	DeleteReward(context.Context, *DeleteRewardRequest) (*emptypb.Empty, error)
+	// DeleteTask DeleteTask 删除任务（同时删除用户进度）
+	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
+	// DistributeRewardGrant DistributeRewardGrant 确认发放（MANUAL 发放器，或自动发放失败后重试）
+	DistributeRewardGrant(context.Context, *DistributeRewardGrantRequest) (*RewardGrantReply, error)
+	// EndCampaign EndCampaign 结束活动（不可恢复）
+	EndCampaign(context.Context, *EndCampaignRequest) (*CampaignReply, error)
+	// GetAudience GetAudience 获取受众
+	GetAudience(context.Context, *GetAudienceRequest) (*AudienceReply, error)
+	// GetCampaign GetCampaign 获取活动
+	GetCampaign(context.Context, *GetCampaignRequest) (*CampaignReply, error)
 	// GetCoupon GetCoupon 获取优惠券
 	GetCoupon(context.Context, *GetCouponRequest) (*GetCouponReply, error)
 	// GetCouponStats GetCouponStats 获取优惠券统计
 	GetCouponStats(context.Context, *GetCouponStatsRequest) (*GetCouponStatsReply, error)
+	// GetCouponTemplate GetCouponTemplate 获取优惠券模板
+	GetCouponTemplate(context.Context, *GetCouponTemplateRequest) (*CouponTemplateReply, error)
+	// GetCouponUsageTimeSeries GetCouponUsageTimeSeries 获取优惠券使用时间序列统计（供营销效果仪表板使用）
+	GetCouponUsageTimeSeries(context.Context, *GetCouponUsageTimeSeriesRequest) (*GetCouponUsageTimeSeriesReply, error)
 	// GetCouponsSummaryStats GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
 	GetCouponsSummaryStats(context.Context, *GetCouponsSummaryStatsRequest) (*GetCouponsSummaryStatsReply, error)
+	// GetExportJob GetExportJob 获取后台导出任务状态
+	GetExportJob(context.Context, *GetExportJobRequest) (*GetExportJobReply, error)
+	// GetRedeemCode GetRedeemCode 获取兑换码
+	GetRedeemCode(context.Context, *GetRedeemCodeRequest) (*RedeemCodeReply, error)
+	// GetReward GetReward 获取奖励模板
+	GetReward(context.Context, *GetRewardRequest) (*RewardReply, error)
+	// GetRewardGrant GetRewardGrant 获取奖励发放记录
+	GetRewardGrant(context.Context, *GetRewardGrantRequest) (*RewardGrantReply, error)
+	// GetTask GetTask 获取任务
+	GetTask(context.Context, *GetTaskRequest) (*TaskReply, error)
+	// GetUsageByPaymentId GetUsageByPaymentId 按支付流水号查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentId(context.Context, *GetUsageByPaymentIdRequest) (*GetCouponUsageReply, error)
+	// GetUsageByPaymentOrder GetUsageByPaymentOrder 按支付订单查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentOrder(context.Context, *GetUsageByPaymentOrderRequest) (*GetCouponUsageReply, error)
+	// GetUserCoupon GetUserCoupon 获取用户优惠券
+	GetUserCoupon(context.Context, *GetUserCouponRequest) (*UserCouponReply, error)
+	// ImportCoupons ImportCoupons 从 CSV 批量导入优惠券（也可通过 multipart 上传：POST /marketing/v1/imports/coupons）
+	ImportCoupons(context.Context, *ImportCouponsRequest) (*ImportCouponsReply, error)
+	// IssueCoupon IssueCoupon 将优惠券发放给用户（生成独立有效期的用户优惠券实例）
+	IssueCoupon(context.Context, *IssueCouponRequest) (*UserCouponReply, error)
+	// IssueReward IssueReward 发放奖励（创建发放记录并生成、发放奖励内容）
+	IssueReward(context.Context, *IssueRewardRequest) (*RewardGrantReply, error)
+	// ListAudiences ListAudiences 列出受众
+	ListAudiences(context.Context, *ListAudiencesRequest) (*ListAudiencesReply, error)
+	// ListCampaigns ListCampaigns 列出活动
+	ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsReply, error)
+	// ListCouponTemplates ListCouponTemplates 列出优惠券模板
+	ListCouponTemplates(context.Context, *ListCouponTemplatesRequest) (*ListCouponTemplatesReply, error)
 	// ListCouponUsages ListCouponUsages 列出优惠券使用记录
 	ListCouponUsages(context.Context, *ListCouponUsagesRequest) (*ListCouponUsagesReply, error)
 	// ListCoupons ListCoupons 列出优惠券
 	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsReply, error)
+	// ListRedeemCodeRedemptions ListRedeemCodeRedemptions 列出兑换记录（可按发放状态筛选出积分、订阅时长发放失败的记录）
+	ListRedeemCodeRedemptions(context.Context, *ListRedeemCodeRedemptionsRequest) (*ListRedeemCodeRedemptionsReply, error)
+	// ListRedeemCodes ListRedeemCodes 列出兑换码
+	ListRedeemCodes(context.Context, *ListRedeemCodesRequest) (*ListRedeemCodesReply, error)
+	// ListRewardGrants ListRewardGrants 列出奖励发放记录
+	ListRewardGrants(context.Context, *ListRewardGrantsRequest) (*ListRewardGrantsReply, error)
+	// ListRewards ListRewards 列出奖励模板
+	ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsReply, error)
+	// ListTaskProgress ListTaskProgress 列出用户任务进度
+	ListTaskProgress(context.Context, *ListTaskProgressRequest) (*ListTaskProgressReply, error)
+	// ListTasks ListTasks 列出任务
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error)
+	// ListUsagesByUser ListUsagesByUser 按用户列出优惠券使用记录 (供客服使用)
+	ListUsagesByUser(context.Context, *ListUsagesByUserRequest) (*ListCouponUsagesReply, error)
+	// ListUserCoupons ListUserCoupons 列出用户券包（按 available/used/expired 分栏）
+	ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsReply, error)
+	// PauseCampaign PauseCampaign 暂停活动（其下优惠券立即不可用）
+	PauseCampaign(context.Context, *PauseCampaignRequest) (*CampaignReply, error)
+	// PublishCampaign PublishCampaign 发布活动（草稿或已暂停 -> 进行中）
+	PublishCampaign(context.Context, *PublishCampaignRequest) (*CampaignReply, error)
+	// RebuildCouponStats RebuildCouponStats 从使用记录重算每日统计汇总并回填去重用户 HyperLogLog (管理接口，用于修复或初始化汇总数据)
+	RebuildCouponStats(context.Context, *RebuildCouponStatsRequest) (*RebuildCouponStatsReply, error)
+	// RedeemCode RedeemCode 用户兑换兑换码（每个兑换码只能兑换一次）
+	RedeemCode(context.Context, *RedeemCodeRequest) (*RedeemCodeRedemptionReply, error)
+	// RetryRedeemCodeGrant RetryRedeemCodeGrant 重试兑换记录中未成功发放的积分或订阅时长（已发放时直接返回）
+	RetryRedeemCodeGrant(context.Context, *RetryRedeemCodeGrantRequest) (*RedeemCodeRedemptionReply, error)
+	// RevokeRedeemCode RevokeRedeemCode 作废兑换码（已兑换的不可作废）
+	RevokeRedeemCode(context.Context, *RevokeRedeemCodeRequest) (*RedeemCodeReply, error)
+	// TriggerEvent TriggerEvent 上报业务事件：推进匹配任务的用户进度，完成任务时发放奖励（按 eventId 幂等）
+	TriggerEvent(context.Context, *TriggerEventRequest) (*TriggerEventReply, error)
+	// UpdateAudience UpdateAudience 更新受众
+	UpdateAudience(context.Context, *UpdateAudienceRequest) (*AudienceReply, error)
+	// UpdateCampaign UpdateCampaign 更新活动
+	UpdateCampaign(context.Context, *UpdateCampaignRequest) (*CampaignReply, error)
 	// UpdateCoupon UpdateCoupon 更新优惠券
 	UpdateCoupon(context.Context, *UpdateCouponRequest) (*UpdateCouponReply, error)
+	// UpdateCouponTemplate UpdateCouponTemplate 更新优惠券模板
+	UpdateCouponTemplate(context.Context, *UpdateCouponTemplateRequest) (*CouponTemplateReply, error)
+	// UpdateReward UpdateReward 更新奖励模板（版本号加一，已发放的记录保留原版本快照）
+	UpdateReward(context.Context, *UpdateRewardRequest) (*RewardReply, error)
+	// UpdateTask UpdateTask 更新任务（已计入的进度保留）
+	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error)
+	// UploadAudienceMembers UploadAudienceMembers 上传 LIST 类型受众的名单
+	UploadAudienceMembers(context.Context, *UploadAudienceMembersRequest) (*UploadAudienceMembersReply, error)
 	// UseCoupon UseCoupon 使用优惠券 (供 Payment Service 调用)
 	UseCoupon(context.Context, *UseCouponRequest) (*UseCouponReply, error)
+	// UseRewardGrant UseRewardGrant 标记奖励已使用（积分、订阅等下游回传，COUPON 奖励在使用优惠券时自动标记）
+	UseRewardGrant(context.Context, *UseRewardGrantRequest) (*RewardGrantReply, error)
 	// ValidateCoupon ValidateCoupon 验证优惠券 (供 Payment Service 调用)
 	ValidateCoupon(context.Context, *ValidateCouponRequest) (*ValidateCouponReply, error)
 }
@@ -62,11 +245,71 @@ func RegisterMarketingHTTPServer(s *http.Server, srv MarketingHTTPServer) {
 	r.GET("/marketing/v1/coupons", _Marketing_ListCoupons0_HTTP_Handler(srv))
 	r.PUT("/marketing/v1/coupons/{couponCode}", _Marketing_UpdateCoupon0_HTTP_Handler(srv))
 	r.DELETE("/marketing/v1/coupons/{couponCode}", _Marketing_DeleteCoupon0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupons/import", _Marketing_ImportCoupons0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupons/batch-update-status", _Marketing_BatchUpdateCouponStatus0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupons/batch-delete", _Marketing_BatchDeleteCoupons0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupons/{couponCode}/issue", _Marketing_IssueCoupon0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupons/{couponCode}/claim", _Marketing_ClaimCoupon0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/users/{userId}/coupons", _Marketing_ListUserCoupons0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/user-coupons/{userCouponId}", _Marketing_GetUserCoupon0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupons/{couponCode}/clone", _Marketing_CloneCoupon0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupon-templates", _Marketing_CreateCouponTemplate0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/coupon-templates/{templateId}", _Marketing_GetCouponTemplate0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/coupon-templates", _Marketing_ListCouponTemplates0_HTTP_Handler(srv))
+	r.PUT("/marketing/v1/coupon-templates/{templateId}", _Marketing_UpdateCouponTemplate0_HTTP_Handler(srv))
+	r.DELETE("/marketing/v1/coupon-templates/{templateId}", _Marketing_DeleteCouponTemplate0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupon-templates/{templateId}/coupons", _Marketing_CreateCouponFromTemplate0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/audiences", _Marketing_CreateAudience0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/audiences/{audienceId}", _Marketing_GetAudience0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/audiences", _Marketing_ListAudiences0_HTTP_Handler(srv))
+	r.PUT("/marketing/v1/audiences/{audienceId}", _Marketing_UpdateAudience0_HTTP_Handler(srv))
+	r.DELETE("/marketing/v1/audiences/{audienceId}", _Marketing_DeleteAudience0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/audiences/{audienceId}/members", _Marketing_UploadAudienceMembers0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/audiences/{audienceId}/check", _Marketing_CheckAudience0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/campaigns", _Marketing_CreateCampaign0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/campaigns/{campaignId}", _Marketing_GetCampaign0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/campaigns", _Marketing_ListCampaigns0_HTTP_Handler(srv))
+	r.PUT("/marketing/v1/campaigns/{campaignId}", _Marketing_UpdateCampaign0_HTTP_Handler(srv))
+	r.DELETE("/marketing/v1/campaigns/{campaignId}", _Marketing_DeleteCampaign0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/campaigns/{campaignId}/publish", _Marketing_PublishCampaign0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/campaigns/{campaignId}/pause", _Marketing_PauseCampaign0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/campaigns/{campaignId}/end", _Marketing_EndCampaign0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/redeem-codes/batch", _Marketing_CreateRedeemCodes0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/redeem-codes/{code}", _Marketing_GetRedeemCode0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/redeem-codes", _Marketing_ListRedeemCodes0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/redeem-codes/{code}/redeem", _Marketing_RedeemCode0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/redeem-codes/{code}/revoke", _Marketing_RevokeRedeemCode0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/redeem-code-redemptions", _Marketing_ListRedeemCodeRedemptions0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/redeem-codes/{code}/retry-grant", _Marketing_RetryRedeemCodeGrant0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/rewards", _Marketing_CreateReward0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/rewards/{rewardId}", _Marketing_GetReward0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/rewards", _Marketing_ListRewards0_HTTP_Handler(srv))
+	r.PUT("/marketing/v1/rewards/{rewardId}", _Marketing_UpdateReward0_HTTP_Handler(srv))
+	r.DELETE("/marketing/v1/rewards/{rewardId}", _Marketing_DeleteReward0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/rewards/{rewardId}/issue", _Marketing_IssueReward0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/reward-grants/{grantId}", _Marketing_GetRewardGrant0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/reward-grants", _Marketing_ListRewardGrants0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/reward-grants/{grantId}/distribute", _Marketing_DistributeRewardGrant0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/reward-grants/{grantId}/use", _Marketing_UseRewardGrant0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/tasks", _Marketing_CreateTask0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/tasks/{taskId}", _Marketing_GetTask0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/tasks", _Marketing_ListTasks0_HTTP_Handler(srv))
+	r.PUT("/marketing/v1/tasks/{taskId}", _Marketing_UpdateTask0_HTTP_Handler(srv))
+	r.DELETE("/marketing/v1/tasks/{taskId}", _Marketing_DeleteTask0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/task-progress", _Marketing_ListTaskProgress0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/events", _Marketing_TriggerEvent0_HTTP_Handler(srv))
 	r.POST("/marketing/v1/coupons/validate", _Marketing_ValidateCoupon0_HTTP_Handler(srv))
 	r.POST("/marketing/v1/coupons/use", _Marketing_UseCoupon0_HTTP_Handler(srv))
 	r.GET("/marketing/v1/coupons/{couponCode}/stats", _Marketing_GetCouponStats0_HTTP_Handler(srv))
 	r.GET("/marketing/v1/coupons/{couponCode}/usages", _Marketing_ListCouponUsages0_HTTP_Handler(srv))
 	r.GET("/marketing/v1/coupons/summary-stats", _Marketing_GetCouponsSummaryStats0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/coupon-stats/time-series", _Marketing_GetCouponUsageTimeSeries0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/users/{userId}/coupon-usages", _Marketing_ListUsagesByUser0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/coupon-usages/orders/{paymentOrderId}", _Marketing_GetUsageByPaymentOrder0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/coupon-usages/payments/{paymentId}", _Marketing_GetUsageByPaymentId0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/coupon-stats/rebuild", _Marketing_RebuildCouponStats0_HTTP_Handler(srv))
+	r.POST("/marketing/v1/export-jobs", _Marketing_CreateExportJob0_HTTP_Handler(srv))
+	r.GET("/marketing/v1/export-jobs/{jobId}", _Marketing_GetExportJob0_HTTP_Handler(srv))
 }
 
 func _Marketing_CreateCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
@@ -179,234 +422,2242 @@ func _Marketing_DeleteCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx htt
 	}
 }
 
-func _Marketing_ValidateCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+func _Marketing_ImportCoupons0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ValidateCouponRequest
+		var in ImportCouponsRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationMarketingValidateCoupon)
+		http.SetOperation(ctx, OperationMarketingImportCoupons)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ValidateCoupon(ctx, req.(*ValidateCouponRequest))
+			return srv.ImportCoupons(ctx, req.(*ImportCouponsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ValidateCouponReply)
+		reply := out.(*ImportCouponsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Marketing_UseCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+func _Marketing_BatchUpdateCouponStatus0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UseCouponRequest
+		var in BatchUpdateCouponStatusRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationMarketingUseCoupon)
+		http.SetOperation(ctx, OperationMarketingBatchUpdateCouponStatus)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UseCoupon(ctx, req.(*UseCouponRequest))
+			return srv.BatchUpdateCouponStatus(ctx, req.(*BatchUpdateCouponStatusRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*UseCouponReply)
+		reply := out.(*BatchCouponsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Marketing_GetCouponStats0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+func _Marketing_BatchDeleteCoupons0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCouponStatsRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in BatchDeleteCouponsRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationMarketingGetCouponStats)
+		http.SetOperation(ctx, OperationMarketingBatchDeleteCoupons)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCouponStats(ctx, req.(*GetCouponStatsRequest))
+			return srv.BatchDeleteCoupons(ctx, req.(*BatchDeleteCouponsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCouponStatsReply)
+		reply := out.(*BatchCouponsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Marketing_ListCouponUsages0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+func _Marketing_IssueCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListCouponUsagesRequest
+		var in IssueCouponRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationMarketingListCouponUsages)
+		http.SetOperation(ctx, OperationMarketingIssueCoupon)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListCouponUsages(ctx, req.(*ListCouponUsagesRequest))
+			return srv.IssueCoupon(ctx, req.(*IssueCouponRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListCouponUsagesReply)
+		reply := out.(*UserCouponReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Marketing_GetCouponsSummaryStats0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+func _Marketing_ClaimCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCouponsSummaryStatsRequest
+		var in ClaimCouponRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationMarketingGetCouponsSummaryStats)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingClaimCoupon)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetCouponsSummaryStats(ctx, req.(*GetCouponsSummaryStatsRequest))
+			return srv.ClaimCoupon(ctx, req.(*ClaimCouponRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetCouponsSummaryStatsReply)
+		reply := out.(*UserCouponReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type MarketingHTTPClient interface {
-	// CreateCoupon ========== Coupon Management API (供开发者控制台使用) ==========
-	// CreateCoupon 创建优惠券
-	CreateCoupon(ctx context.Context, req *CreateCouponRequest, opts ...http.CallOption) (rsp *CreateCouponReply, err error)
-	// DeleteCoupon DeleteCoupon 删除优惠券
-	DeleteCoupon(ctx context.Context, req *DeleteCouponRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// GetCoupon GetCoupon 获取优惠券
-	GetCoupon(ctx context.Context, req *GetCouponRequest, opts ...http.CallOption) (rsp *GetCouponReply, err error)
-	// GetCouponStats GetCouponStats 获取优惠券统计
-	GetCouponStats(ctx context.Context, req *GetCouponStatsRequest, opts ...http.CallOption) (rsp *GetCouponStatsReply, err error)
-	// GetCouponsSummaryStats GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
-	GetCouponsSummaryStats(ctx context.Context, req *GetCouponsSummaryStatsRequest, opts ...http.CallOption) (rsp *GetCouponsSummaryStatsReply, err error)
-	// ListCouponUsages ListCouponUsages 列出优惠券使用记录
-	ListCouponUsages(ctx context.Context, req *ListCouponUsagesRequest, opts ...http.CallOption) (rsp *ListCouponUsagesReply, err error)
-	// ListCoupons ListCoupons 列出优惠券
-	ListCoupons(ctx context.Context, req *ListCouponsRequest, opts ...http.CallOption) (rsp *ListCouponsReply, err error)
-	// UpdateCoupon UpdateCoupon 更新优惠券
-	UpdateCoupon(ctx context.Context, req *UpdateCouponRequest, opts ...http.CallOption) (rsp *UpdateCouponReply, err error)
-	// UseCoupon UseCoupon 使用优惠券 (供 Payment Service 调用)
-	UseCoupon(ctx context.Context, req *UseCouponRequest, opts ...http.CallOption) (rsp *UseCouponReply, err error)
-	// ValidateCoupon ValidateCoupon 验证优惠券 (供 Payment Service 调用)
-	ValidateCoupon(ctx context.Context, req *ValidateCouponRequest, opts ...http.CallOption) (rsp *ValidateCouponReply, err error)
-}
-
-type MarketingHTTPClientImpl struct {
-	cc *http.Client
+func _Marketing_ListUserCoupons0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserCouponsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListUserCoupons)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserCoupons(ctx, req.(*ListUserCouponsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserCouponsReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewMarketingHTTPClient(client *http.Client) MarketingHTTPClient {
-	return &MarketingHTTPClientImpl{client}
+func _Marketing_GetUserCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserCouponRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetUserCoupon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserCoupon(ctx, req.(*GetUserCouponRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserCouponReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-// CreateCoupon ========== Coupon Management API (供开发者控制台使用) ==========
-// CreateCoupon 创建优惠券
-func (c *MarketingHTTPClientImpl) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...http.CallOption) (*CreateCouponReply, error) {
-	var out CreateCouponReply
-	pattern := "/marketing/v1/coupons"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationMarketingCreateCoupon))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Marketing_CloneCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CloneCouponRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCloneCoupon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CloneCoupon(ctx, req.(*CloneCouponRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCouponReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// DeleteCoupon DeleteCoupon 删除优惠券
-func (c *MarketingHTTPClientImpl) DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/marketing/v1/coupons/{couponCode}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationMarketingDeleteCoupon))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Marketing_CreateCouponTemplate0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCouponTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateCouponTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCouponTemplate(ctx, req.(*CreateCouponTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CouponTemplateReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// GetCoupon GetCoupon 获取优惠券
-func (c *MarketingHTTPClientImpl) GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...http.CallOption) (*GetCouponReply, error) {
-	var out GetCouponReply
-	pattern := "/marketing/v1/coupons/{couponCode}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationMarketingGetCoupon))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Marketing_GetCouponTemplate0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCouponTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetCouponTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCouponTemplate(ctx, req.(*GetCouponTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CouponTemplateReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// GetCouponStats GetCouponStats 获取优惠券统计
-func (c *MarketingHTTPClientImpl) GetCouponStats(ctx context.Context, in *GetCouponStatsRequest, opts ...http.CallOption) (*GetCouponStatsReply, error) {
-	var out GetCouponStatsReply
-	pattern := "/marketing/v1/coupons/{couponCode}/stats"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationMarketingGetCouponStats))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Marketing_ListCouponTemplates0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCouponTemplatesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListCouponTemplates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCouponTemplates(ctx, req.(*ListCouponTemplatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCouponTemplatesReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// GetCouponsSummaryStats GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
-func (c *MarketingHTTPClientImpl) GetCouponsSummaryStats(ctx context.Context, in *GetCouponsSummaryStatsRequest, opts ...http.CallOption) (*GetCouponsSummaryStatsReply, error) {
-	var out GetCouponsSummaryStatsReply
-	pattern := "/marketing/v1/coupons/summary-stats"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationMarketingGetCouponsSummaryStats))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Marketing_UpdateCouponTemplate0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCouponTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUpdateCouponTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCouponTemplate(ctx, req.(*UpdateCouponTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CouponTemplateReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// ListCouponUsages ListCouponUsages 列出优惠券使用记录
-func (c *MarketingHTTPClientImpl) ListCouponUsages(ctx context.Context, in *ListCouponUsagesRequest, opts ...http.CallOption) (*ListCouponUsagesReply, error) {
-	var out ListCouponUsagesReply
-	pattern := "/marketing/v1/coupons/{couponCode}/usages"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationMarketingListCouponUsages))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Marketing_DeleteCouponTemplate0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCouponTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingDeleteCouponTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCouponTemplate(ctx, req.(*DeleteCouponTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// ListCoupons ListCoupons 列出优惠券
-func (c *MarketingHTTPClientImpl) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...http.CallOption) (*ListCouponsReply, error) {
-	var out ListCouponsReply
-	pattern := "/marketing/v1/coupons"
+func _Marketing_CreateCouponFromTemplate0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCouponFromTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateCouponFromTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCouponFromTemplate(ctx, req.(*CreateCouponFromTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCouponReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_CreateAudience0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAudienceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateAudience)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAudience(ctx, req.(*CreateAudienceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AudienceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetAudience0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAudienceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetAudience)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAudience(ctx, req.(*GetAudienceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AudienceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListAudiences0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAudiencesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListAudiences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAudiences(ctx, req.(*ListAudiencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAudiencesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_UpdateAudience0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateAudienceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUpdateAudience)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateAudience(ctx, req.(*UpdateAudienceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AudienceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_DeleteAudience0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAudienceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingDeleteAudience)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAudience(ctx, req.(*DeleteAudienceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_UploadAudienceMembers0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UploadAudienceMembersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUploadAudienceMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UploadAudienceMembers(ctx, req.(*UploadAudienceMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UploadAudienceMembersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_CheckAudience0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckAudienceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCheckAudience)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckAudience(ctx, req.(*CheckAudienceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckAudienceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_CreateCampaign0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCampaignRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateCampaign)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCampaign(ctx, req.(*CreateCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CampaignReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetCampaign0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCampaignRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetCampaign)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCampaign(ctx, req.(*GetCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CampaignReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListCampaigns0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCampaignsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListCampaigns)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCampaigns(ctx, req.(*ListCampaignsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCampaignsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_UpdateCampaign0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCampaignRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUpdateCampaign)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCampaign(ctx, req.(*UpdateCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CampaignReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_DeleteCampaign0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCampaignRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingDeleteCampaign)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCampaign(ctx, req.(*DeleteCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_PublishCampaign0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishCampaignRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingPublishCampaign)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishCampaign(ctx, req.(*PublishCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CampaignReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_PauseCampaign0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PauseCampaignRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingPauseCampaign)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseCampaign(ctx, req.(*PauseCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CampaignReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_EndCampaign0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EndCampaignRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingEndCampaign)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EndCampaign(ctx, req.(*EndCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CampaignReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_CreateRedeemCodes0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRedeemCodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateRedeemCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRedeemCodes(ctx, req.(*CreateRedeemCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateRedeemCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetRedeemCode0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRedeemCodeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetRedeemCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRedeemCode(ctx, req.(*GetRedeemCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeemCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListRedeemCodes0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRedeemCodesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListRedeemCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRedeemCodes(ctx, req.(*ListRedeemCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRedeemCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_RedeemCode0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RedeemCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingRedeemCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RedeemCode(ctx, req.(*RedeemCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeemCodeRedemptionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_RevokeRedeemCode0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeRedeemCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingRevokeRedeemCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeRedeemCode(ctx, req.(*RevokeRedeemCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeemCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListRedeemCodeRedemptions0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRedeemCodeRedemptionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListRedeemCodeRedemptions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRedeemCodeRedemptions(ctx, req.(*ListRedeemCodeRedemptionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRedeemCodeRedemptionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_RetryRedeemCodeGrant0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RetryRedeemCodeGrantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingRetryRedeemCodeGrant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RetryRedeemCodeGrant(ctx, req.(*RetryRedeemCodeGrantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeemCodeRedemptionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_CreateReward0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRewardRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateReward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReward(ctx, req.(*CreateRewardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetReward0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRewardRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetReward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReward(ctx, req.(*GetRewardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListRewards0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRewardsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListRewards)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRewards(ctx, req.(*ListRewardsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRewardsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_UpdateReward0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRewardRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUpdateReward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReward(ctx, req.(*UpdateRewardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_DeleteReward0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRewardRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingDeleteReward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteReward(ctx, req.(*DeleteRewardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_IssueReward0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in IssueRewardRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingIssueReward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.IssueReward(ctx, req.(*IssueRewardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardGrantReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetRewardGrant0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRewardGrantRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetRewardGrant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRewardGrant(ctx, req.(*GetRewardGrantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardGrantReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListRewardGrants0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRewardGrantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListRewardGrants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRewardGrants(ctx, req.(*ListRewardGrantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRewardGrantsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_DistributeRewardGrant0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DistributeRewardGrantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingDistributeRewardGrant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DistributeRewardGrant(ctx, req.(*DistributeRewardGrantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardGrantReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_UseRewardGrant0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UseRewardGrantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUseRewardGrant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UseRewardGrant(ctx, req.(*UseRewardGrantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewardGrantReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_CreateTask0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTask(ctx, req.(*CreateTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetTask0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTaskRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTask(ctx, req.(*GetTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListTasks0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTasksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListTasks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTasks(ctx, req.(*ListTasksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTasksReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_UpdateTask0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUpdateTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTask(ctx, req.(*UpdateTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_DeleteTask0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTaskRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingDeleteTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTask(ctx, req.(*DeleteTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListTaskProgress0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTaskProgressRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListTaskProgress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaskProgress(ctx, req.(*ListTaskProgressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTaskProgressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_TriggerEvent0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TriggerEventRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingTriggerEvent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TriggerEvent(ctx, req.(*TriggerEventRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TriggerEventReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ValidateCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ValidateCouponRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingValidateCoupon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ValidateCoupon(ctx, req.(*ValidateCouponRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ValidateCouponReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_UseCoupon0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UseCouponRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingUseCoupon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UseCoupon(ctx, req.(*UseCouponRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UseCouponReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetCouponStats0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCouponStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetCouponStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCouponStats(ctx, req.(*GetCouponStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListCouponUsages0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCouponUsagesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListCouponUsages)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCouponUsages(ctx, req.(*ListCouponUsagesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCouponUsagesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetCouponsSummaryStats0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCouponsSummaryStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetCouponsSummaryStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCouponsSummaryStats(ctx, req.(*GetCouponsSummaryStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponsSummaryStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetCouponUsageTimeSeries0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCouponUsageTimeSeriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetCouponUsageTimeSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCouponUsageTimeSeries(ctx, req.(*GetCouponUsageTimeSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponUsageTimeSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_ListUsagesByUser0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUsagesByUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingListUsagesByUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUsagesByUser(ctx, req.(*ListUsagesByUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCouponUsagesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetUsageByPaymentOrder0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUsageByPaymentOrderRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetUsageByPaymentOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUsageByPaymentOrder(ctx, req.(*GetUsageByPaymentOrderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponUsageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetUsageByPaymentId0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUsageByPaymentIdRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetUsageByPaymentId)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUsageByPaymentId(ctx, req.(*GetUsageByPaymentIdRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponUsageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_RebuildCouponStats0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RebuildCouponStatsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingRebuildCouponStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RebuildCouponStats(ctx, req.(*RebuildCouponStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RebuildCouponStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_CreateExportJob0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateExportJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingCreateExportJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateExportJob(ctx, req.(*CreateExportJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateExportJobReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Marketing_GetExportJob0_HTTP_Handler(srv MarketingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetExportJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMarketingGetExportJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetExportJob(ctx, req.(*GetExportJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetExportJobReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type MarketingHTTPClient interface {
+	// BatchDeleteCoupons BatchDeleteCoupons 批量删除优惠券（指定优惠码或按筛选条件）
+	BatchDeleteCoupons(ctx context.Context, req *BatchDeleteCouponsRequest, opts ...http.CallOption) (rsp *BatchCouponsReply, err error)
+	// BatchUpdateCouponStatus BatchUpdateCouponStatus 批量修改优惠券状态（指定优惠码或按筛选条件）
+	BatchUpdateCouponStatus(ctx context.Context, req *BatchUpdateCouponStatusRequest, opts ...http.CallOption) (rsp *BatchCouponsReply, err error)
+	// CheckAudience CheckAudience 检查用户是否属于受众
+	CheckAudience(ctx context.Context, req *CheckAudienceRequest, opts ...http.CallOption) (rsp *CheckAudienceReply, err error)
+	// ClaimCoupon ClaimCoupon 用户领取优惠券到券包（受每用户领取次数和领取总量限制）
+	ClaimCoupon(ctx context.Context, req *ClaimCouponRequest, opts ...http.CallOption) (rsp *UserCouponReply, err error)
+	// CloneCoupon CloneCoupon 以新优惠码复制已有优惠券（有效期平移到 validFrom，时长不变；不可用日期同步平移，默认不保留绑定用户）
+	CloneCoupon(ctx context.Context, req *CloneCouponRequest, opts ...http.CallOption) (rsp *CreateCouponReply, err error)
+	// CreateAudience CreateAudience 创建受众
+	CreateAudience(ctx context.Context, req *CreateAudienceRequest, opts ...http.CallOption) (rsp *AudienceReply, err error)
+	// CreateCampaign CreateCampaign 创建活动（草稿状态）
+	CreateCampaign(ctx context.Context, req *CreateCampaignRequest, opts ...http.CallOption) (rsp *CampaignReply, err error)
+	// CreateCoupon ========== Coupon Management API (供开发者控制台使用) ==========
+	// CreateCoupon 创建优惠券
+	CreateCoupon(ctx context.Context, req *CreateCouponRequest, opts ...http.CallOption) (rsp *CreateCouponReply, err error)
+	// CreateCouponFromTemplate CreateCouponFromTemplate 按模板创建优惠券
+	CreateCouponFromTemplate(ctx context.Context, req *CreateCouponFromTemplateRequest, opts ...http.CallOption) (rsp *CreateCouponReply, err error)
+	// CreateCouponTemplate CreateCouponTemplate 创建优惠券模板
+	CreateCouponTemplate(ctx context.Context, req *CreateCouponTemplateRequest, opts ...http.CallOption) (rsp *CouponTemplateReply, err error)
+	// CreateExportJob CreateExportJob 创建后台导出任务 (大数据量导出，生成文件后通过 /marketing/v1/export-jobs/{jobId}/download 下载)
+	CreateExportJob(ctx context.Context, req *CreateExportJobRequest, opts ...http.CallOption) (rsp *CreateExportJobReply, err error)
+	// CreateRedeemCodes ========== Redeem Code API ==========
+	// CreateRedeemCodes 批量生成兑换码（兑换后发放优惠券、积分或订阅时长）
+	CreateRedeemCodes(ctx context.Context, req *CreateRedeemCodesRequest, opts ...http.CallOption) (rsp *CreateRedeemCodesReply, err error)
+	// CreateReward ========== Reward API ==========
+	// CreateReward 创建奖励模板
+	CreateReward(ctx context.Context, req *CreateRewardRequest, opts ...http.CallOption) (rsp *RewardReply, err error)
+	// CreateTask ========== Task API ==========
+	// CreateTask 创建任务
+	CreateTask(ctx context.Context, req *CreateTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// DeleteAudience DeleteAudience 删除受众（仍被优惠券引用时拒绝）
+	DeleteAudience(ctx context.Context, req *DeleteAudienceRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteCampaign DeleteCampaign 删除活动
+	DeleteCampaign(ctx context.Context, req *DeleteCampaignRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteCoupon DeleteCoupon 删除优惠券
+	DeleteCoupon(ctx context.Context, req *DeleteCouponRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteCouponTemplate DeleteCouponTemplate 删除优惠券模板
+	DeleteCouponTemplate(ctx context.Context, req *DeleteCouponTemplateRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteReward DeleteReward 删除奖励模板（仍有未完成的发放记录时拒绝）
+	DeleteReward(ctx context.Context, req *DeleteRewardRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteTask DeleteTask 删除任务（同时删除用户进度）
+	DeleteTask(ctx context.Context, req *DeleteTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DistributeRewardGrant DistributeRewardGrant 确认发放（MANUAL 发放器，或自动发放失败后重试）
+	DistributeRewardGrant(ctx context.Context, req *DistributeRewardGrantRequest, opts ...http.CallOption) (rsp *RewardGrantReply, err error)
+	// EndCampaign EndCampaign 结束活动（不可恢复）
+	EndCampaign(ctx context.Context, req *EndCampaignRequest, opts ...http.CallOption) (rsp *CampaignReply, err error)
+	// GetAudience GetAudience 获取受众
+	GetAudience(ctx context.Context, req *GetAudienceRequest, opts ...http.CallOption) (rsp *AudienceReply, err error)
+	// GetCampaign GetCampaign 获取活动
+	GetCampaign(ctx context.Context, req *GetCampaignRequest, opts ...http.CallOption) (rsp *CampaignReply, err error)
+	// GetCoupon GetCoupon 获取优惠券
+	GetCoupon(ctx context.Context, req *GetCouponRequest, opts ...http.CallOption) (rsp *GetCouponReply, err error)
+	// GetCouponStats GetCouponStats 获取优惠券统计
+	GetCouponStats(ctx context.Context, req *GetCouponStatsRequest, opts ...http.CallOption) (rsp *GetCouponStatsReply, err error)
+	// GetCouponTemplate GetCouponTemplate 获取优惠券模板
+	GetCouponTemplate(ctx context.Context, req *GetCouponTemplateRequest, opts ...http.CallOption) (rsp *CouponTemplateReply, err error)
+	// GetCouponUsageTimeSeries GetCouponUsageTimeSeries 获取优惠券使用时间序列统计（供营销效果仪表板使用）
+	GetCouponUsageTimeSeries(ctx context.Context, req *GetCouponUsageTimeSeriesRequest, opts ...http.CallOption) (rsp *GetCouponUsageTimeSeriesReply, err error)
+	// GetCouponsSummaryStats GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
+	GetCouponsSummaryStats(ctx context.Context, req *GetCouponsSummaryStatsRequest, opts ...http.CallOption) (rsp *GetCouponsSummaryStatsReply, err error)
+	// GetExportJob GetExportJob 获取后台导出任务状态
+	GetExportJob(ctx context.Context, req *GetExportJobRequest, opts ...http.CallOption) (rsp *GetExportJobReply, err error)
+	// GetRedeemCode GetRedeemCode 获取兑换码
+	GetRedeemCode(ctx context.Context, req *GetRedeemCodeRequest, opts ...http.CallOption) (rsp *RedeemCodeReply, err error)
+	// GetReward GetReward 获取奖励模板
+	GetReward(ctx context.Context, req *GetRewardRequest, opts ...http.CallOption) (rsp *RewardReply, err error)
+	// GetRewardGrant GetRewardGrant 获取奖励发放记录
+	GetRewardGrant(ctx context.Context, req *GetRewardGrantRequest, opts ...http.CallOption) (rsp *RewardGrantReply, err error)
+	// GetTask GetTask 获取任务
+	GetTask(ctx context.Context, req *GetTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// GetUsageByPaymentId GetUsageByPaymentId 按支付流水号查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentId(ctx context.Context, req *GetUsageByPaymentIdRequest, opts ...http.CallOption) (rsp *GetCouponUsageReply, err error)
+	// GetUsageByPaymentOrder GetUsageByPaymentOrder 按支付订单查询优惠券使用记录 (供 Payment Service 调用)
+	GetUsageByPaymentOrder(ctx context.Context, req *GetUsageByPaymentOrderRequest, opts ...http.CallOption) (rsp *GetCouponUsageReply, err error)
+	// GetUserCoupon GetUserCoupon 获取用户优惠券
+	GetUserCoupon(ctx context.Context, req *GetUserCouponRequest, opts ...http.CallOption) (rsp *UserCouponReply, err error)
+	// ImportCoupons ImportCoupons 从 CSV 批量导入优惠券（也可通过 multipart 上传：POST /marketing/v1/imports/coupons）
+	ImportCoupons(ctx context.Context, req *ImportCouponsRequest, opts ...http.CallOption) (rsp *ImportCouponsReply, err error)
+	// IssueCoupon IssueCoupon 将优惠券发放给用户（生成独立有效期的用户优惠券实例）
+	IssueCoupon(ctx context.Context, req *IssueCouponRequest, opts ...http.CallOption) (rsp *UserCouponReply, err error)
+	// IssueReward IssueReward 发放奖励（创建发放记录并生成、发放奖励内容）
+	IssueReward(ctx context.Context, req *IssueRewardRequest, opts ...http.CallOption) (rsp *RewardGrantReply, err error)
+	// ListAudiences ListAudiences 列出受众
+	ListAudiences(ctx context.Context, req *ListAudiencesRequest, opts ...http.CallOption) (rsp *ListAudiencesReply, err error)
+	// ListCampaigns ListCampaigns 列出活动
+	ListCampaigns(ctx context.Context, req *ListCampaignsRequest, opts ...http.CallOption) (rsp *ListCampaignsReply, err error)
+	// ListCouponTemplates ListCouponTemplates 列出优惠券模板
+	ListCouponTemplates(ctx context.Context, req *ListCouponTemplatesRequest, opts ...http.CallOption) (rsp *ListCouponTemplatesReply, err error)
+	// ListCouponUsages ListCouponUsages 列出优惠券使用记录
+	ListCouponUsages(ctx context.Context, req *ListCouponUsagesRequest, opts ...http.CallOption) (rsp *ListCouponUsagesReply, err error)
+	// ListCoupons ListCoupons 列出优惠券
+	ListCoupons(ctx context.Context, req *ListCouponsRequest, opts ...http.CallOption) (rsp *ListCouponsReply, err error)
+	// ListRedeemCodeRedemptions ListRedeemCodeRedemptions 列出兑换记录（可按发放状态筛选出积分、订阅时长发放失败的记录）
+	ListRedeemCodeRedemptions(ctx context.Context, req *ListRedeemCodeRedemptionsRequest, opts ...http.CallOption) (rsp *ListRedeemCodeRedemptionsReply, err error)
+	// ListRedeemCodes ListRedeemCodes 列出兑换码
+	ListRedeemCodes(ctx context.Context, req *ListRedeemCodesRequest, opts ...http.CallOption) (rsp *ListRedeemCodesReply, err error)
+	// ListRewardGrants ListRewardGrants 列出奖励发放记录
+	ListRewardGrants(ctx context.Context, req *ListRewardGrantsRequest, opts ...http.CallOption) (rsp *ListRewardGrantsReply, err error)
+	// ListRewards ListRewards 列出奖励模板
+	ListRewards(ctx context.Context, req *ListRewardsRequest, opts ...http.CallOption) (rsp *ListRewardsReply, err error)
+	// ListTaskProgress ListTaskProgress 列出用户任务进度
+	ListTaskProgress(ctx context.Context, req *ListTaskProgressRequest, opts ...http.CallOption) (rsp *ListTaskProgressReply, err error)
+	// ListTasks ListTasks 列出任务
+	ListTasks(ctx context.Context, req *ListTasksRequest, opts ...http.CallOption) (rsp *ListTasksReply, err error)
+	// ListUsagesByUser ListUsagesByUser 按用户列出优惠券使用记录 (供客服使用)
+	ListUsagesByUser(ctx context.Context, req *ListUsagesByUserRequest, opts ...http.CallOption) (rsp *ListCouponUsagesReply, err error)
+	// ListUserCoupons ListUserCoupons 列出用户券包（按 available/used/expired 分栏）
+	ListUserCoupons(ctx context.Context, req *ListUserCouponsRequest, opts ...http.CallOption) (rsp *ListUserCouponsReply, err error)
+	// PauseCampaign PauseCampaign 暂停活动（其下优惠券立即不可用）
+	PauseCampaign(ctx context.Context, req *PauseCampaignRequest, opts ...http.CallOption) (rsp *CampaignReply, err error)
+	// PublishCampaign PublishCampaign 发布活动（草稿或已暂停 -> 进行中）
+	PublishCampaign(ctx context.Context, req *PublishCampaignRequest, opts ...http.CallOption) (rsp *CampaignReply, err error)
+	// RebuildCouponStats RebuildCouponStats 从使用记录重算每日统计汇总并回填去重用户 HyperLogLog (管理接口，用于修复或初始化汇总数据)
+	RebuildCouponStats(ctx context.Context, req *RebuildCouponStatsRequest, opts ...http.CallOption) (rsp *RebuildCouponStatsReply, err error)
+	// RedeemCode RedeemCode 用户兑换兑换码（每个兑换码只能兑换一次）
+	RedeemCode(ctx context.Context, req *RedeemCodeRequest, opts ...http.CallOption) (rsp *RedeemCodeRedemptionReply, err error)
+	// RetryRedeemCodeGrant RetryRedeemCodeGrant 重试兑换记录中未成功发放的积分或订阅时长（已发放时直接返回）
+	RetryRedeemCodeGrant(ctx context.Context, req *RetryRedeemCodeGrantRequest, opts ...http.CallOption) (rsp *RedeemCodeRedemptionReply, err error)
+	// RevokeRedeemCode RevokeRedeemCode 作废兑换码（已兑换的不可作废）
+	RevokeRedeemCode(ctx context.Context, req *RevokeRedeemCodeRequest, opts ...http.CallOption) (rsp *RedeemCodeReply, err error)
+	// TriggerEvent TriggerEvent 上报业务事件：推进匹配任务的用户进度，完成任务时发放奖励（按 eventId 幂等）
+	TriggerEvent(ctx context.Context, req *TriggerEventRequest, opts ...http.CallOption) (rsp *TriggerEventReply, err error)
+	// UpdateAudience UpdateAudience 更新受众
+	UpdateAudience(ctx context.Context, req *UpdateAudienceRequest, opts ...http.CallOption) (rsp *AudienceReply, err error)
+	// UpdateCampaign UpdateCampaign 更新活动
+	UpdateCampaign(ctx context.Context, req *UpdateCampaignRequest, opts ...http.CallOption) (rsp *CampaignReply, err error)
+	// UpdateCoupon UpdateCoupon 更新优惠券
+	UpdateCoupon(ctx context.Context, req *UpdateCouponRequest, opts ...http.CallOption) (rsp *UpdateCouponReply, err error)
+	// UpdateCouponTemplate UpdateCouponTemplate 更新优惠券模板
+	UpdateCouponTemplate(ctx context.Context, req *UpdateCouponTemplateRequest, opts ...http.CallOption) (rsp *CouponTemplateReply, err error)
+	// UpdateReward UpdateReward 更新奖励模板（版本号加一，已发放的记录保留原版本快照）
+	UpdateReward(ctx context.Context, req *UpdateRewardRequest, opts ...http.CallOption) (rsp *RewardReply, err error)
+	// UpdateTask UpdateTask 更新任务（已计入的进度保留）
+	UpdateTask(ctx context.Context, req *UpdateTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// UploadAudienceMembers UploadAudienceMembers 上传 LIST 类型受众的名单
+	UploadAudienceMembers(ctx context.Context, req *UploadAudienceMembersRequest, opts ...http.CallOption) (rsp *UploadAudienceMembersReply, err error)
+	// UseCoupon UseCoupon 使用优惠券 (供 Payment Service 调用)
+	UseCoupon(ctx context.Context, req *UseCouponRequest, opts ...http.CallOption) (rsp *UseCouponReply, err error)
+	// UseRewardGrant UseRewardGrant 标记奖励已使用（积分、订阅等下游回传，COUPON 奖励在使用优惠券时自动标记）
+	UseRewardGrant(ctx context.Context, req *UseRewardGrantRequest, opts ...http.CallOption) (rsp *RewardGrantReply, err error)
+	// ValidateCoupon ValidateCoupon 验证优惠券 (供 Payment Service 调用)
+	ValidateCoupon(ctx context.Context, req *ValidateCouponRequest, opts ...http.CallOption) (rsp *ValidateCouponReply, err error)
+}
+
+type MarketingHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewMarketingHTTPClient(client *http.Client) MarketingHTTPClient {
+	return &MarketingHTTPClientImpl{client}
+}
+
+// BatchDeleteCoupons BatchDeleteCoupons 批量删除优惠券（指定优惠码或按筛选条件）
+func (c *MarketingHTTPClientImpl) BatchDeleteCoupons(ctx context.Context, in *BatchDeleteCouponsRequest, opts ...http.CallOption) (*BatchCouponsReply, error) {
+	var out BatchCouponsReply
+	pattern := "/marketing/v1/coupons/batch-delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingBatchDeleteCoupons))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchUpdateCouponStatus BatchUpdateCouponStatus 批量修改优惠券状态（指定优惠码或按筛选条件）
+func (c *MarketingHTTPClientImpl) BatchUpdateCouponStatus(ctx context.Context, in *BatchUpdateCouponStatusRequest, opts ...http.CallOption) (*BatchCouponsReply, error) {
+	var out BatchCouponsReply
+	pattern := "/marketing/v1/coupons/batch-update-status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingBatchUpdateCouponStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckAudience CheckAudience 检查用户是否属于受众
+func (c *MarketingHTTPClientImpl) CheckAudience(ctx context.Context, in *CheckAudienceRequest, opts ...http.CallOption) (*CheckAudienceReply, error) {
+	var out CheckAudienceReply
+	pattern := "/marketing/v1/audiences/{audienceId}/check"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCheckAudience))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ClaimCoupon ClaimCoupon 用户领取优惠券到券包（受每用户领取次数和领取总量限制）
+func (c *MarketingHTTPClientImpl) ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...http.CallOption) (*UserCouponReply, error) {
+	var out UserCouponReply
+	pattern := "/marketing/v1/coupons/{couponCode}/claim"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingClaimCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CloneCoupon CloneCoupon 以新优惠码复制已有优惠券（有效期平移到 validFrom，时长不变；不可用日期同步平移，默认不保留绑定用户）
+func (c *MarketingHTTPClientImpl) CloneCoupon(ctx context.Context, in *CloneCouponRequest, opts ...http.CallOption) (*CreateCouponReply, error) {
+	var out CreateCouponReply
+	pattern := "/marketing/v1/coupons/{couponCode}/clone"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCloneCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateAudience CreateAudience 创建受众
+func (c *MarketingHTTPClientImpl) CreateAudience(ctx context.Context, in *CreateAudienceRequest, opts ...http.CallOption) (*AudienceReply, error) {
+	var out AudienceReply
+	pattern := "/marketing/v1/audiences"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateAudience))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCampaign CreateCampaign 创建活动（草稿状态）
+func (c *MarketingHTTPClientImpl) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...http.CallOption) (*CampaignReply, error) {
+	var out CampaignReply
+	pattern := "/marketing/v1/campaigns"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateCampaign))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCoupon ========== Coupon Management API (供开发者控制台使用) ==========
+// CreateCoupon 创建优惠券
+func (c *MarketingHTTPClientImpl) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...http.CallOption) (*CreateCouponReply, error) {
+	var out CreateCouponReply
+	pattern := "/marketing/v1/coupons"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCouponFromTemplate CreateCouponFromTemplate 按模板创建优惠券
+func (c *MarketingHTTPClientImpl) CreateCouponFromTemplate(ctx context.Context, in *CreateCouponFromTemplateRequest, opts ...http.CallOption) (*CreateCouponReply, error) {
+	var out CreateCouponReply
+	pattern := "/marketing/v1/coupon-templates/{templateId}/coupons"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateCouponFromTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCouponTemplate CreateCouponTemplate 创建优惠券模板
+func (c *MarketingHTTPClientImpl) CreateCouponTemplate(ctx context.Context, in *CreateCouponTemplateRequest, opts ...http.CallOption) (*CouponTemplateReply, error) {
+	var out CouponTemplateReply
+	pattern := "/marketing/v1/coupon-templates"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateCouponTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateExportJob CreateExportJob 创建后台导出任务 (大数据量导出，生成文件后通过 /marketing/v1/export-jobs/{jobId}/download 下载)
+func (c *MarketingHTTPClientImpl) CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...http.CallOption) (*CreateExportJobReply, error) {
+	var out CreateExportJobReply
+	pattern := "/marketing/v1/export-jobs"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateExportJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRedeemCodes ========== Redeem Code API ==========
+// CreateRedeemCodes 批量生成兑换码（兑换后发放优惠券、积分或订阅时长）
+func (c *MarketingHTTPClientImpl) CreateRedeemCodes(ctx context.Context, in *CreateRedeemCodesRequest, opts ...http.CallOption) (*CreateRedeemCodesReply, error) {
+	var out CreateRedeemCodesReply
+	pattern := "/marketing/v1/redeem-codes/batch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateRedeemCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateReward ========== Reward API ==========
+// CreateReward 创建奖励模板
+func (c *MarketingHTTPClientImpl) CreateReward(ctx context.Context, in *CreateRewardRequest, opts ...http.CallOption) (*RewardReply, error) {
+	var out RewardReply
+	pattern := "/marketing/v1/rewards"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateReward))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTask ========== Task API ==========
+// CreateTask 创建任务
+func (c *MarketingHTTPClientImpl) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
+	var out TaskReply
+	pattern := "/marketing/v1/tasks"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingCreateTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAudience DeleteAudience 删除受众（仍被优惠券引用时拒绝）
+func (c *MarketingHTTPClientImpl) DeleteAudience(ctx context.Context, in *DeleteAudienceRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/marketing/v1/audiences/{audienceId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingDeleteAudience))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCampaign DeleteCampaign 删除活动
+func (c *MarketingHTTPClientImpl) DeleteCampaign(ctx context.Context, in *DeleteCampaignRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/marketing/v1/campaigns/{campaignId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingDeleteCampaign))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCoupon DeleteCoupon 删除优惠券
+func (c *MarketingHTTPClientImpl) DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/marketing/v1/coupons/{couponCode}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingDeleteCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCouponTemplate DeleteCouponTemplate 删除优惠券模板
+func (c *MarketingHTTPClientImpl) DeleteCouponTemplate(ctx context.Context, in *DeleteCouponTemplateRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/marketing/v1/coupon-templates/{templateId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingDeleteCouponTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteReward DeleteReward 删除奖励模板（仍有未完成的发放记录时拒绝）
+func (c *MarketingHTTPClientImpl) DeleteReward(ctx context.Context, in *DeleteRewardRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/marketing/v1/rewards/{rewardId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingDeleteReward))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTask DeleteTask 删除任务（同时删除用户进度）
+func (c *MarketingHTTPClientImpl) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/marketing/v1/tasks/{taskId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingDeleteTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DistributeRewardGrant DistributeRewardGrant 确认发放（MANUAL 发放器，或自动发放失败后重试）
+func (c *MarketingHTTPClientImpl) DistributeRewardGrant(ctx context.Context, in *DistributeRewardGrantRequest, opts ...http.CallOption) (*RewardGrantReply, error) {
+	var out RewardGrantReply
+	pattern := "/marketing/v1/reward-grants/{grantId}/distribute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingDistributeRewardGrant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// EndCampaign EndCampaign 结束活动（不可恢复）
+func (c *MarketingHTTPClientImpl) EndCampaign(ctx context.Context, in *EndCampaignRequest, opts ...http.CallOption) (*CampaignReply, error) {
+	var out CampaignReply
+	pattern := "/marketing/v1/campaigns/{campaignId}/end"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingEndCampaign))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAudience GetAudience 获取受众
+func (c *MarketingHTTPClientImpl) GetAudience(ctx context.Context, in *GetAudienceRequest, opts ...http.CallOption) (*AudienceReply, error) {
+	var out AudienceReply
+	pattern := "/marketing/v1/audiences/{audienceId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetAudience))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCampaign GetCampaign 获取活动
+func (c *MarketingHTTPClientImpl) GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...http.CallOption) (*CampaignReply, error) {
+	var out CampaignReply
+	pattern := "/marketing/v1/campaigns/{campaignId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetCampaign))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCoupon GetCoupon 获取优惠券
+func (c *MarketingHTTPClientImpl) GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...http.CallOption) (*GetCouponReply, error) {
+	var out GetCouponReply
+	pattern := "/marketing/v1/coupons/{couponCode}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCouponStats GetCouponStats 获取优惠券统计
+func (c *MarketingHTTPClientImpl) GetCouponStats(ctx context.Context, in *GetCouponStatsRequest, opts ...http.CallOption) (*GetCouponStatsReply, error) {
+	var out GetCouponStatsReply
+	pattern := "/marketing/v1/coupons/{couponCode}/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetCouponStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCouponTemplate GetCouponTemplate 获取优惠券模板
+func (c *MarketingHTTPClientImpl) GetCouponTemplate(ctx context.Context, in *GetCouponTemplateRequest, opts ...http.CallOption) (*CouponTemplateReply, error) {
+	var out CouponTemplateReply
+	pattern := "/marketing/v1/coupon-templates/{templateId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetCouponTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCouponUsageTimeSeries GetCouponUsageTimeSeries 获取优惠券使用时间序列统计（供营销效果仪表板使用）
+func (c *MarketingHTTPClientImpl) GetCouponUsageTimeSeries(ctx context.Context, in *GetCouponUsageTimeSeriesRequest, opts ...http.CallOption) (*GetCouponUsageTimeSeriesReply, error) {
+	var out GetCouponUsageTimeSeriesReply
+	pattern := "/marketing/v1/coupon-stats/time-series"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetCouponUsageTimeSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCouponsSummaryStats GetCouponsSummaryStats 获取所有优惠券汇总统计（供营销效果仪表板使用）
+func (c *MarketingHTTPClientImpl) GetCouponsSummaryStats(ctx context.Context, in *GetCouponsSummaryStatsRequest, opts ...http.CallOption) (*GetCouponsSummaryStatsReply, error) {
+	var out GetCouponsSummaryStatsReply
+	pattern := "/marketing/v1/coupons/summary-stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetCouponsSummaryStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetExportJob GetExportJob 获取后台导出任务状态
+func (c *MarketingHTTPClientImpl) GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...http.CallOption) (*GetExportJobReply, error) {
+	var out GetExportJobReply
+	pattern := "/marketing/v1/export-jobs/{jobId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetExportJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRedeemCode GetRedeemCode 获取兑换码
+func (c *MarketingHTTPClientImpl) GetRedeemCode(ctx context.Context, in *GetRedeemCodeRequest, opts ...http.CallOption) (*RedeemCodeReply, error) {
+	var out RedeemCodeReply
+	pattern := "/marketing/v1/redeem-codes/{code}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetRedeemCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetReward GetReward 获取奖励模板
+func (c *MarketingHTTPClientImpl) GetReward(ctx context.Context, in *GetRewardRequest, opts ...http.CallOption) (*RewardReply, error) {
+	var out RewardReply
+	pattern := "/marketing/v1/rewards/{rewardId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetReward))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRewardGrant GetRewardGrant 获取奖励发放记录
+func (c *MarketingHTTPClientImpl) GetRewardGrant(ctx context.Context, in *GetRewardGrantRequest, opts ...http.CallOption) (*RewardGrantReply, error) {
+	var out RewardGrantReply
+	pattern := "/marketing/v1/reward-grants/{grantId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetRewardGrant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTask GetTask 获取任务
+func (c *MarketingHTTPClientImpl) GetTask(ctx context.Context, in *GetTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
+	var out TaskReply
+	pattern := "/marketing/v1/tasks/{taskId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUsageByPaymentId GetUsageByPaymentId 按支付流水号查询优惠券使用记录 (供 Payment Service 调用)
+func (c *MarketingHTTPClientImpl) GetUsageByPaymentId(ctx context.Context, in *GetUsageByPaymentIdRequest, opts ...http.CallOption) (*GetCouponUsageReply, error) {
+	var out GetCouponUsageReply
+	pattern := "/marketing/v1/coupon-usages/payments/{paymentId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetUsageByPaymentId))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUsageByPaymentOrder GetUsageByPaymentOrder 按支付订单查询优惠券使用记录 (供 Payment Service 调用)
+func (c *MarketingHTTPClientImpl) GetUsageByPaymentOrder(ctx context.Context, in *GetUsageByPaymentOrderRequest, opts ...http.CallOption) (*GetCouponUsageReply, error) {
+	var out GetCouponUsageReply
+	pattern := "/marketing/v1/coupon-usages/orders/{paymentOrderId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetUsageByPaymentOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserCoupon GetUserCoupon 获取用户优惠券
+func (c *MarketingHTTPClientImpl) GetUserCoupon(ctx context.Context, in *GetUserCouponRequest, opts ...http.CallOption) (*UserCouponReply, error) {
+	var out UserCouponReply
+	pattern := "/marketing/v1/user-coupons/{userCouponId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingGetUserCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportCoupons ImportCoupons 从 CSV 批量导入优惠券（也可通过 multipart 上传：POST /marketing/v1/imports/coupons）
+func (c *MarketingHTTPClientImpl) ImportCoupons(ctx context.Context, in *ImportCouponsRequest, opts ...http.CallOption) (*ImportCouponsReply, error) {
+	var out ImportCouponsReply
+	pattern := "/marketing/v1/coupons/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingImportCoupons))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// IssueCoupon IssueCoupon 将优惠券发放给用户（生成独立有效期的用户优惠券实例）
+func (c *MarketingHTTPClientImpl) IssueCoupon(ctx context.Context, in *IssueCouponRequest, opts ...http.CallOption) (*UserCouponReply, error) {
+	var out UserCouponReply
+	pattern := "/marketing/v1/coupons/{couponCode}/issue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingIssueCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// IssueReward IssueReward 发放奖励（创建发放记录并生成、发放奖励内容）
+func (c *MarketingHTTPClientImpl) IssueReward(ctx context.Context, in *IssueRewardRequest, opts ...http.CallOption) (*RewardGrantReply, error) {
+	var out RewardGrantReply
+	pattern := "/marketing/v1/rewards/{rewardId}/issue"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingIssueReward))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAudiences ListAudiences 列出受众
+func (c *MarketingHTTPClientImpl) ListAudiences(ctx context.Context, in *ListAudiencesRequest, opts ...http.CallOption) (*ListAudiencesReply, error) {
+	var out ListAudiencesReply
+	pattern := "/marketing/v1/audiences"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListAudiences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCampaigns ListCampaigns 列出活动
+func (c *MarketingHTTPClientImpl) ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...http.CallOption) (*ListCampaignsReply, error) {
+	var out ListCampaignsReply
+	pattern := "/marketing/v1/campaigns"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListCampaigns))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCouponTemplates ListCouponTemplates 列出优惠券模板
+func (c *MarketingHTTPClientImpl) ListCouponTemplates(ctx context.Context, in *ListCouponTemplatesRequest, opts ...http.CallOption) (*ListCouponTemplatesReply, error) {
+	var out ListCouponTemplatesReply
+	pattern := "/marketing/v1/coupon-templates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListCouponTemplates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCouponUsages ListCouponUsages 列出优惠券使用记录
+func (c *MarketingHTTPClientImpl) ListCouponUsages(ctx context.Context, in *ListCouponUsagesRequest, opts ...http.CallOption) (*ListCouponUsagesReply, error) {
+	var out ListCouponUsagesReply
+	pattern := "/marketing/v1/coupons/{couponCode}/usages"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListCouponUsages))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCoupons ListCoupons 列出优惠券
+func (c *MarketingHTTPClientImpl) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...http.CallOption) (*ListCouponsReply, error) {
+	var out ListCouponsReply
+	pattern := "/marketing/v1/coupons"
 	path := binding.EncodeURL(pattern, in, true)
 	opts = append(opts, http.Operation(OperationMarketingListCoupons))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -417,6 +2668,244 @@ func (c *MarketingHTTPClientImpl) ListCoupons(ctx context.Context, in *ListCoupo
 	return &out, nil
 }
 
+// ListRedeemCodeRedemptions ListRedeemCodeRedemptions 列出兑换记录（可按发放状态筛选出积分、订阅时长发放失败的记录）
+func (c *MarketingHTTPClientImpl) ListRedeemCodeRedemptions(ctx context.Context, in *ListRedeemCodeRedemptionsRequest, opts ...http.CallOption) (*ListRedeemCodeRedemptionsReply, error) {
+	var out ListRedeemCodeRedemptionsReply
+	pattern := "/marketing/v1/redeem-code-redemptions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListRedeemCodeRedemptions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRedeemCodes ListRedeemCodes 列出兑换码
+func (c *MarketingHTTPClientImpl) ListRedeemCodes(ctx context.Context, in *ListRedeemCodesRequest, opts ...http.CallOption) (*ListRedeemCodesReply, error) {
+	var out ListRedeemCodesReply
+	pattern := "/marketing/v1/redeem-codes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListRedeemCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRewardGrants ListRewardGrants 列出奖励发放记录
+func (c *MarketingHTTPClientImpl) ListRewardGrants(ctx context.Context, in *ListRewardGrantsRequest, opts ...http.CallOption) (*ListRewardGrantsReply, error) {
+	var out ListRewardGrantsReply
+	pattern := "/marketing/v1/reward-grants"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListRewardGrants))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRewards ListRewards 列出奖励模板
+func (c *MarketingHTTPClientImpl) ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...http.CallOption) (*ListRewardsReply, error) {
+	var out ListRewardsReply
+	pattern := "/marketing/v1/rewards"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListRewards))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaskProgress ListTaskProgress 列出用户任务进度
+func (c *MarketingHTTPClientImpl) ListTaskProgress(ctx context.Context, in *ListTaskProgressRequest, opts ...http.CallOption) (*ListTaskProgressReply, error) {
+	var out ListTaskProgressReply
+	pattern := "/marketing/v1/task-progress"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListTaskProgress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTasks ListTasks 列出任务
+func (c *MarketingHTTPClientImpl) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...http.CallOption) (*ListTasksReply, error) {
+	var out ListTasksReply
+	pattern := "/marketing/v1/tasks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListTasks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUsagesByUser ListUsagesByUser 按用户列出优惠券使用记录 (供客服使用)
+func (c *MarketingHTTPClientImpl) ListUsagesByUser(ctx context.Context, in *ListUsagesByUserRequest, opts ...http.CallOption) (*ListCouponUsagesReply, error) {
+	var out ListCouponUsagesReply
+	pattern := "/marketing/v1/users/{userId}/coupon-usages"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListUsagesByUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUserCoupons ListUserCoupons 列出用户券包（按 available/used/expired 分栏）
+func (c *MarketingHTTPClientImpl) ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...http.CallOption) (*ListUserCouponsReply, error) {
+	var out ListUserCouponsReply
+	pattern := "/marketing/v1/users/{userId}/coupons"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMarketingListUserCoupons))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseCampaign PauseCampaign 暂停活动（其下优惠券立即不可用）
+func (c *MarketingHTTPClientImpl) PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...http.CallOption) (*CampaignReply, error) {
+	var out CampaignReply
+	pattern := "/marketing/v1/campaigns/{campaignId}/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingPauseCampaign))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PublishCampaign PublishCampaign 发布活动（草稿或已暂停 -> 进行中）
+func (c *MarketingHTTPClientImpl) PublishCampaign(ctx context.Context, in *PublishCampaignRequest, opts ...http.CallOption) (*CampaignReply, error) {
+	var out CampaignReply
+	pattern := "/marketing/v1/campaigns/{campaignId}/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingPublishCampaign))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RebuildCouponStats RebuildCouponStats 从使用记录重算每日统计汇总并回填去重用户 HyperLogLog (管理接口，用于修复或初始化汇总数据)
+func (c *MarketingHTTPClientImpl) RebuildCouponStats(ctx context.Context, in *RebuildCouponStatsRequest, opts ...http.CallOption) (*RebuildCouponStatsReply, error) {
+	var out RebuildCouponStatsReply
+	pattern := "/marketing/v1/coupon-stats/rebuild"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingRebuildCouponStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RedeemCode RedeemCode 用户兑换兑换码（每个兑换码只能兑换一次）
+func (c *MarketingHTTPClientImpl) RedeemCode(ctx context.Context, in *RedeemCodeRequest, opts ...http.CallOption) (*RedeemCodeRedemptionReply, error) {
+	var out RedeemCodeRedemptionReply
+	pattern := "/marketing/v1/redeem-codes/{code}/redeem"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingRedeemCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RetryRedeemCodeGrant RetryRedeemCodeGrant 重试兑换记录中未成功发放的积分或订阅时长（已发放时直接返回）
+func (c *MarketingHTTPClientImpl) RetryRedeemCodeGrant(ctx context.Context, in *RetryRedeemCodeGrantRequest, opts ...http.CallOption) (*RedeemCodeRedemptionReply, error) {
+	var out RedeemCodeRedemptionReply
+	pattern := "/marketing/v1/redeem-codes/{code}/retry-grant"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingRetryRedeemCodeGrant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeRedeemCode RevokeRedeemCode 作废兑换码（已兑换的不可作废）
+func (c *MarketingHTTPClientImpl) RevokeRedeemCode(ctx context.Context, in *RevokeRedeemCodeRequest, opts ...http.CallOption) (*RedeemCodeReply, error) {
+	var out RedeemCodeReply
+	pattern := "/marketing/v1/redeem-codes/{code}/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingRevokeRedeemCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TriggerEvent TriggerEvent 上报业务事件：推进匹配任务的用户进度，完成任务时发放奖励（按 eventId 幂等）
+func (c *MarketingHTTPClientImpl) TriggerEvent(ctx context.Context, in *TriggerEventRequest, opts ...http.CallOption) (*TriggerEventReply, error) {
+	var out TriggerEventReply
+	pattern := "/marketing/v1/events"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingTriggerEvent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAudience UpdateAudience 更新受众
+func (c *MarketingHTTPClientImpl) UpdateAudience(ctx context.Context, in *UpdateAudienceRequest, opts ...http.CallOption) (*AudienceReply, error) {
+	var out AudienceReply
+	pattern := "/marketing/v1/audiences/{audienceId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingUpdateAudience))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateCampaign UpdateCampaign 更新活动
+func (c *MarketingHTTPClientImpl) UpdateCampaign(ctx context.Context, in *UpdateCampaignRequest, opts ...http.CallOption) (*CampaignReply, error) {
+	var out CampaignReply
+	pattern := "/marketing/v1/campaigns/{campaignId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingUpdateCampaign))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateCoupon UpdateCoupon 更新优惠券
 func (c *MarketingHTTPClientImpl) UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...http.CallOption) (*UpdateCouponReply, error) {
 	var out UpdateCouponReply
@@ -431,6 +2920,62 @@ func (c *MarketingHTTPClientImpl) UpdateCoupon(ctx context.Context, in *UpdateCo
 	return &out, nil
 }
 
+// UpdateCouponTemplate UpdateCouponTemplate 更新优惠券模板
+func (c *MarketingHTTPClientImpl) UpdateCouponTemplate(ctx context.Context, in *UpdateCouponTemplateRequest, opts ...http.CallOption) (*CouponTemplateReply, error) {
+	var out CouponTemplateReply
+	pattern := "/marketing/v1/coupon-templates/{templateId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingUpdateCouponTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateReward UpdateReward 更新奖励模板（版本号加一，已发放的记录保留原版本快照）
+func (c *MarketingHTTPClientImpl) UpdateReward(ctx context.Context, in *UpdateRewardRequest, opts ...http.CallOption) (*RewardReply, error) {
+	var out RewardReply
+	pattern := "/marketing/v1/rewards/{rewardId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingUpdateReward))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTask UpdateTask 更新任务（已计入的进度保留）
+func (c *MarketingHTTPClientImpl) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
+	var out TaskReply
+	pattern := "/marketing/v1/tasks/{taskId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingUpdateTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadAudienceMembers UploadAudienceMembers 上传 LIST 类型受众的名单
+func (c *MarketingHTTPClientImpl) UploadAudienceMembers(ctx context.Context, in *UploadAudienceMembersRequest, opts ...http.CallOption) (*UploadAudienceMembersReply, error) {
+	var out UploadAudienceMembersReply
+	pattern := "/marketing/v1/audiences/{audienceId}/members"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingUploadAudienceMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UseCoupon UseCoupon 使用优惠券 (供 Payment Service 调用)
 func (c *MarketingHTTPClientImpl) UseCoupon(ctx context.Context, in *UseCouponRequest, opts ...http.CallOption) (*UseCouponReply, error) {
 	var out UseCouponReply
@@ -445,6 +2990,20 @@ func (c *MarketingHTTPClientImpl) UseCoupon(ctx context.Context, in *UseCouponRe
 	return &out, nil
 }
 
+// UseRewardGrant UseRewardGrant 标记奖励已使用（积分、订阅等下游回传，COUPON 奖励在使用优惠券时自动标记）
+func (c *MarketingHTTPClientImpl) UseRewardGrant(ctx context.Context, in *UseRewardGrantRequest, opts ...http.CallOption) (*RewardGrantReply, error) {
+	var out RewardGrantReply
+	pattern := "/marketing/v1/reward-grants/{grantId}/use"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMarketingUseRewardGrant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ValidateCoupon ValidateCoupon 验证优惠券 (供 Payment Service 调用)
 func (c *MarketingHTTPClientImpl) ValidateCoupon(ctx context.Context, in *ValidateCouponRequest, opts ...http.CallOption) (*ValidateCouponReply, error) {
 	var out ValidateCouponReply
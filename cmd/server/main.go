@@ -7,6 +7,7 @@ import (
 
 	"marketing-service/internal/conf"
 	_ "marketing-service/internal/errors" // 初始化错误管理器
+	"marketing-service/internal/server"
 
 	"github.com/gaoyong06/go-pkg/logger"
 	pkgutils "github.com/gaoyong06/go-pkg/utils"
@@ -38,7 +39,7 @@ func init() {
 	flag.StringVar(&runMode, "mode", "debug", "Run mode (debug, release)")
 }
 
-func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server, ec *server.EventConsumer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -48,6 +49,7 @@ func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server) *kratos.App {
 		kratos.Server(
 			hs,
 			gs,
+			ec,
 		),
 	)
 }
@@ -33,12 +33,49 @@ func wireApp(confServer *conf.Server, confData *conf.Data, client *conf.Client,
 		return nil, nil, err
 	}
 	couponRepo := data.NewCouponRepo(dataData, logger)
-	couponUseCase := biz.NewCouponUseCase(couponRepo, logger)
-	marketingService := service.NewMarketingService(couponUseCase, logger)
+	validationAttemptRepo, cleanup2, err := data.NewValidationAttemptRepo(dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userCouponRepo := data.NewUserCouponRepo(dataData, logger)
+	audienceRepo := data.NewAudienceRepo(dataData, logger)
+	campaignRepo := data.NewCampaignRepo(dataData, logger)
+	userHistoryProvider := data.NewUserHistoryProvider()
+	budgetNotifier := data.NewBudgetNotifier(logger)
+	couponUseCase := biz.NewCouponUseCase(couponRepo, validationAttemptRepo, userCouponRepo, audienceRepo, campaignRepo, userHistoryProvider, budgetNotifier, logger)
+	exportJobRepo := data.NewExportJobRepo(dataData, logger)
+	blobStore, err := data.NewBlobStore(confData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	exportUseCase, cleanup3, err := biz.NewExportUseCase(couponRepo, exportJobRepo, blobStore, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	couponTemplateRepo := data.NewCouponTemplateRepo(dataData, logger)
+	couponTemplateUseCase := biz.NewCouponTemplateUseCase(couponTemplateRepo, couponUseCase, logger)
+	audienceUseCase := biz.NewAudienceUseCase(audienceRepo, logger)
+	campaignUseCase := biz.NewCampaignUseCase(campaignRepo, logger)
+	redeemCodeRepo := data.NewRedeemCodeRepo(dataData, logger)
+	rewardGranter := data.NewRewardGranter(logger)
+	redeemCodeUseCase := biz.NewRedeemCodeUseCase(redeemCodeRepo, couponRepo, campaignRepo, rewardGranter, logger)
+	rewardRepo := data.NewRewardRepo(dataData, logger)
+	rewardUseCase := biz.NewRewardUseCase(rewardRepo, couponRepo, audienceRepo, campaignRepo, rewardGranter, logger)
+	taskRepo := data.NewTaskRepo(dataData, logger)
+	taskUseCase := biz.NewTaskUseCase(taskRepo, rewardRepo, campaignRepo, rewardUseCase, logger)
+	marketingService := service.NewMarketingService(couponUseCase, exportUseCase, couponTemplateUseCase, audienceUseCase, campaignUseCase, redeemCodeUseCase, rewardUseCase, taskUseCase, logger)
 	httpServer := server.NewHTTPServer(confServer, marketingService, logger)
 	grpcServer := server.NewGRPCServer(confServer, marketingService, logger)
-	app := newApp(logger, httpServer, grpcServer)
+	eventConsumer := server.NewEventConsumer(confData, redisClient, taskUseCase, logger)
+	app := newApp(logger, httpServer, grpcServer, eventConsumer)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
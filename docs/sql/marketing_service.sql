@@ -33,6 +33,23 @@ CREATE TABLE `coupon` (
   `max_uses` int NOT NULL DEFAULT '1' COMMENT '最大使用次数',
   `used_count` int NOT NULL DEFAULT '0' COMMENT '已使用次数',
   `min_amount` bigint NOT NULL DEFAULT '0' COMMENT '最低消费金额(分)',
+  `valid_days` int NOT NULL DEFAULT '0' COMMENT '相对有效期天数（>0 时按发放给用户的时间起算）',
+  `claim_limit` int NOT NULL DEFAULT '0' COMMENT '每个用户可领取次数（0表示不限）',
+  `claim_stock` int NOT NULL DEFAULT '0' COMMENT '可领取总量（0表示不限）',
+  `claimed_count` int NOT NULL DEFAULT '0' COMMENT '已领取/发放数量',
+  `bound_user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '绑定用户ID（为空表示不限用户）',
+  `audience_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '受众ID（为空表示不限受众）',
+  `eligibility` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '新客限制: FIRST_COUPON_USE(首次使用优惠券)/FIRST_ORDER(首单)，为空表示不限',
+  `timezone` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'IANA 时区，用于求值可用时段（为空表示 UTC）',
+  `schedule` text COLLATE utf8mb4_unicode_ci COMMENT '周期性可用时段和不可用日期（JSON，为空表示不限）',
+  `user_daily_limit` int NOT NULL DEFAULT '0' COMMENT '每个用户每天可使用次数（0表示不限）',
+  `user_weekly_limit` int NOT NULL DEFAULT '0' COMMENT '每个用户每周可使用次数（0表示不限）',
+  `user_monthly_limit` int NOT NULL DEFAULT '0' COMMENT '每个用户每月可使用次数（0表示不限）',
+  `daily_limit` int NOT NULL DEFAULT '0' COMMENT '所有用户每天合计可使用次数（0表示不限）',
+  `campaign_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '所属活动ID（为空表示不属于任何活动）',
+  `budget` bigint NOT NULL DEFAULT '0' COMMENT '折扣预算(分)：折扣金额合计上限（0表示不限），用尽后自动停用',
+  `budget_used` bigint NOT NULL DEFAULT '0' COMMENT '已使用的折扣金额(分)',
+  `pacing` text COLLATE utf8mb4_unicode_ci COMMENT '投放节奏：把最大使用次数和折扣预算分摊到生效期内（JSON，为空表示不限）',
   `status` enum('active','inactive','expired') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'active' COMMENT '优惠券状态: active(激活-可使用)/inactive(停用-不可使用)/expired(已过期-系统自动标记)',
   `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
   `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
@@ -42,6 +59,12 @@ CREATE TABLE `coupon` (
   KEY `idx_app_id` (`app_id`),
   KEY `idx_status` (`status`),
   KEY `idx_valid_time` (`valid_from`,`valid_until`),
+  KEY `idx_app_id_status` (`app_id`,`status`),
+  KEY `idx_app_id_created_at` (`app_id`,`created_at`),
+  KEY `idx_app_id_valid_until` (`app_id`,`valid_until`),
+  KEY `idx_app_id_used_count` (`app_id`,`used_count`),
+  KEY `idx_audience_id` (`audience_id`),
+  KEY `idx_campaign_id` (`campaign_id`),
   KEY `idx_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='优惠券表';
 
@@ -59,10 +82,14 @@ CREATE TABLE `coupon_usage` (
   `original_amount` bigint NOT NULL COMMENT '原价(分)',
   `discount_amount` bigint NOT NULL COMMENT '折扣金额(分)',
   `final_amount` bigint NOT NULL COMMENT '实付金额(分)',
+  `currency` enum('CNY','USD','EUR') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'CNY' COMMENT '货币单位（使用时的快照）: CNY(人民币)/USD(美元)/EUR(欧元)',
   `used_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '使用时间(UTC时间)',
   `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
   PRIMARY KEY (`coupon_usage_id`),
   KEY `idx_coupon_code` (`coupon_code`),
+  KEY `idx_coupon_code_payment_order_id` (`coupon_code`,`payment_order_id`),
+  KEY `idx_coupon_code_used_at` (`coupon_code`,`used_at`),
+  KEY `idx_coupon_code_user_id_used_at` (`coupon_code`,`user_id`,`used_at`),
   KEY `idx_app_id` (`app_id`),
   KEY `idx_app_id_used_at` (`app_id`,`used_at`),
   KEY `idx_user_id` (`user_id`),
@@ -71,4 +98,358 @@ CREATE TABLE `coupon_usage` (
   KEY `idx_used_at` (`used_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='优惠券使用记录表';
 
+-- ----------------------------
+-- Table structure for coupon_validation_attempt
+-- ----------------------------
+DROP TABLE IF EXISTS `coupon_validation_attempt`;
+CREATE TABLE `coupon_validation_attempt` (
+  `attempt_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '验证记录ID（唯一标识）',
+  `coupon_code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '优惠券码',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '用户ID（调用方未传时为空）',
+  `amount` bigint NOT NULL COMMENT '订单金额(分)',
+  `reason` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '验证结果原因: OK/NOT_FOUND/APP_MISMATCH/USER_MISMATCH/INACTIVE/NOT_STARTED/EXPIRED/EXHAUSTED/BELOW_MIN_AMOUNT/NOT_ISSUED/NOT_IN_AUDIENCE/NOT_NEW_CUSTOMER/OUTSIDE_SCHEDULE/LIMIT_EXCEEDED/CAMPAIGN_INACTIVE/BUDGET_EXHAUSTED/PACE_LIMITED',
+  `attempted_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '验证时间(UTC时间)',
+  PRIMARY KEY (`attempt_id`),
+  KEY `idx_coupon_code_attempted_at` (`coupon_code`,`attempted_at`),
+  KEY `idx_app_id_attempted_at` (`app_id`,`attempted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='优惠券验证尝试记录表';
+
+-- ----------------------------
+-- Table structure for coupon_stats_daily
+-- ----------------------------
+DROP TABLE IF EXISTS `coupon_stats_daily`;
+CREATE TABLE `coupon_stats_daily` (
+  `coupon_code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '优惠券码',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `stat_date` date NOT NULL COMMENT '统计日期（DATE(used_at)，数据库连接时区）',
+  `currency` enum('CNY','USD','EUR') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '货币单位（使用记录的币种快照）',
+  `uses` int NOT NULL DEFAULT '0' COMMENT '使用次数',
+  `orders` int NOT NULL DEFAULT '0' COMMENT '订单数（订单首次使用该优惠券时计入）',
+  `revenue` bigint NOT NULL DEFAULT '0' COMMENT '实付金额合计(分)',
+  `discount` bigint NOT NULL DEFAULT '0' COMMENT '折扣金额合计(分)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`coupon_code`,`app_id`,`stat_date`,`currency`),
+  KEY `idx_app_id_stat_date` (`app_id`,`stat_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='优惠券每日统计汇总表';
+
+-- 从历史使用记录回填每日汇总（已有数据库升级时必须在建表后执行，否则回填前统计接口读不到历史使用；口径与 RebuildCouponStats 一致）
+INSERT INTO `coupon_stats_daily` (`stat_date`, `app_id`, `coupon_code`, `currency`, `uses`, `orders`, `revenue`, `discount`, `updated_at`)
+SELECT DATE(cu.used_at), cu.app_id, cu.coupon_code, cu.currency,
+  COUNT(*),
+  SUM(CASE WHEN NOT EXISTS (
+    SELECT 1 FROM `coupon_usage` p
+    WHERE p.coupon_code = cu.coupon_code AND p.payment_order_id = cu.payment_order_id
+      AND (p.used_at < cu.used_at OR (p.used_at = cu.used_at AND p.coupon_usage_id < cu.coupon_usage_id))
+  ) THEN 1 ELSE 0 END),
+  SUM(cu.final_amount),
+  SUM(cu.discount_amount),
+  CURRENT_TIMESTAMP(3)
+FROM `coupon_usage` cu
+GROUP BY DATE(cu.used_at), cu.app_id, cu.coupon_code, cu.currency;
+
+-- ----------------------------
+-- Table structure for export_job
+-- ----------------------------
+DROP TABLE IF EXISTS `export_job`;
+CREATE TABLE `export_job` (
+  `job_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '导出任务ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `resource` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '导出数据类型: coupons/coupon_usages',
+  `format` varchar(8) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '导出格式: csv/xlsx',
+  `language` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '列标题语言',
+  `params` text COLLATE utf8mb4_unicode_ci COMMENT '筛选条件(JSON)',
+  `status` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '任务状态: pending/running/succeeded/failed',
+  `file_key` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '文件存储key',
+  `file_name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '下载文件名',
+  `rows` bigint NOT NULL DEFAULT '0' COMMENT '导出行数',
+  `error` varchar(1024) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '失败原因',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `started_at` datetime(3) DEFAULT NULL COMMENT '开始执行时间(UTC时间)',
+  `finished_at` datetime(3) DEFAULT NULL COMMENT '完成时间(UTC时间)',
+  PRIMARY KEY (`job_id`),
+  KEY `idx_app_id_created_at` (`app_id`,`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='后台导出任务表';
+
+-- ----------------------------
+-- Table structure for coupon_audit_log
+-- ----------------------------
+DROP TABLE IF EXISTS `coupon_audit_log`;
+CREATE TABLE `coupon_audit_log` (
+  `audit_id` bigint NOT NULL AUTO_INCREMENT COMMENT '审计日志ID（自增主键）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `coupon_code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '优惠码',
+  `action` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '操作: update_status/delete',
+  `old_status` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '操作前状态',
+  `new_status` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '操作后状态（删除时为空）',
+  `batch_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '批量操作ID',
+  `operator` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '操作人',
+  `reason` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '操作原因',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  PRIMARY KEY (`audit_id`),
+  KEY `idx_app_id_coupon_code` (`app_id`,`coupon_code`),
+  KEY `idx_batch_id` (`batch_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='优惠券审计日志表';
+
+-- ----------------------------
+-- Table structure for coupon_template
+-- ----------------------------
+DROP TABLE IF EXISTS `coupon_template`;
+CREATE TABLE `coupon_template` (
+  `template_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '模板ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '模板名称（应用内唯一）',
+  `description` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '模板描述',
+  `discount_type` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '折扣类型: percent(百分比)/fixed(固定金额)',
+  `discount_value` bigint NOT NULL COMMENT '折扣值(百分比或分)',
+  `currency` enum('CNY','USD','EUR') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'CNY' COMMENT '货币单位',
+  `max_uses` int NOT NULL DEFAULT '1' COMMENT '最大使用次数',
+  `min_amount` bigint NOT NULL DEFAULT '0' COMMENT '最低消费金额(分)',
+  `valid_days` int NOT NULL COMMENT '有效天数（从优惠券生效时间起算）',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`template_id`),
+  UNIQUE KEY `uk_app_id_name` (`app_id`,`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='优惠券模板表';
+
+-- ----------------------------
+-- Table structure for user_coupon
+-- ----------------------------
+DROP TABLE IF EXISTS `user_coupon`;
+CREATE TABLE `user_coupon` (
+  `user_coupon_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '用户优惠券ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `coupon_code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '优惠码',
+  `user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '用户ID',
+  `status` enum('available','used') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'available' COMMENT '状态: available(可用)/used(已使用)，过期由有效期判断',
+  `valid_from` datetime(3) NOT NULL COMMENT '生效时间(UTC时间)',
+  `valid_until` datetime(3) NOT NULL COMMENT '过期时间(UTC时间)',
+  `issued_at` datetime(3) NOT NULL COMMENT '发放时间(UTC时间)',
+  `used_at` datetime(3) DEFAULT NULL COMMENT '使用时间(UTC时间)',
+  `payment_order_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '使用时的支付订单ID',
+  PRIMARY KEY (`user_coupon_id`),
+  KEY `idx_app_id_user_id_status` (`app_id`,`user_id`,`status`),
+  KEY `idx_coupon_code_user_id` (`coupon_code`,`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户优惠券表（发放给用户的优惠券实例）';
+
+-- ----------------------------
+-- Table structure for audience
+-- ----------------------------
+DROP TABLE IF EXISTS `audience`;
+CREATE TABLE `audience` (
+  `audience_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '受众ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '受众名称',
+  `description` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '受众描述',
+  `type` enum('TAG','SEGMENT','LIST','ALL') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '受众类型: TAG(标签圈选)/SEGMENT(画像分群)/LIST(上传名单)/ALL(全量用户)',
+  `rule` text COLLATE utf8mb4_unicode_ci COMMENT '圈选规则（JSON，TAG/SEGMENT 类型）',
+  `status` enum('ACTIVE','PAUSED','ENDED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'ACTIVE' COMMENT '状态: ACTIVE(激活)/PAUSED(已暂停)/ENDED(已结束)',
+  `member_count` bigint NOT NULL DEFAULT '0' COMMENT '名单人数（LIST 类型）',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`audience_id`),
+  KEY `idx_app_id_type` (`app_id`,`type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='受众表';
+
+-- ----------------------------
+-- Table structure for audience_member
+-- ----------------------------
+DROP TABLE IF EXISTS `audience_member`;
+CREATE TABLE `audience_member` (
+  `audience_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '受众ID',
+  `user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '用户ID',
+  PRIMARY KEY (`audience_id`,`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='受众名单表（LIST 类型）';
+
+-- ----------------------------
+-- Table structure for campaign
+-- ----------------------------
+DROP TABLE IF EXISTS `campaign`;
+CREATE TABLE `campaign` (
+  `campaign_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '活动ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '活动名称',
+  `type` enum('REDEEM_CODE','TASK_REWARD','DIRECT_SEND') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '活动类型: REDEEM_CODE(兑换码)/TASK_REWARD(任务奖励)/DIRECT_SEND(直接发放)',
+  `description` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '活动描述',
+  `start_time` datetime(3) NOT NULL COMMENT '开始时间(UTC时间)',
+  `end_time` datetime(3) NOT NULL COMMENT '结束时间(UTC时间)',
+  `status` enum('DRAFT','ACTIVE','PAUSED','ENDED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'DRAFT' COMMENT '状态: DRAFT(草稿)/ACTIVE(进行中)/PAUSED(已暂停)/ENDED(已结束)',
+  `budget` bigint NOT NULL DEFAULT '0' COMMENT '折扣预算(分)：活动下所有优惠券的折扣金额合计上限（0表示不限），用尽后自动暂停',
+  `budget_used` bigint NOT NULL DEFAULT '0' COMMENT '已使用的折扣金额(分)',
+  `currency` enum('CNY','USD','EUR') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'CNY' COMMENT '折扣预算的货币单位: CNY(人民币)/USD(美元)/EUR(欧元)',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`campaign_id`),
+  KEY `idx_app_id_status` (`app_id`,`status`),
+  KEY `idx_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='营销活动表';
+
+-- ----------------------------
+-- Table structure for redeem_code
+-- ----------------------------
+DROP TABLE IF EXISTS `redeem_code`;
+CREATE TABLE `redeem_code` (
+  `code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '兑换码（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `batch_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '批次ID',
+  `campaign_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '所属活动ID：非空时只有活动进行中才可兑换',
+  `reward_type` enum('COUPON','POINTS','SUBSCRIPTION') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '兑换内容: COUPON(优惠券)/POINTS(积分)/SUBSCRIPTION(订阅时长)',
+  `coupon_code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'COUPON：发放到用户券包的优惠码',
+  `points` bigint NOT NULL DEFAULT '0' COMMENT 'POINTS：积分数',
+  `subscription_plan` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'SUBSCRIPTION：订阅套餐',
+  `subscription_days` int NOT NULL DEFAULT '0' COMMENT 'SUBSCRIPTION：订阅天数',
+  `status` enum('ACTIVE','REDEEMED','EXPIRED','REVOKED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'ACTIVE' COMMENT '状态: ACTIVE(可用)/REDEEMED(已兑换)/EXPIRED(已过期)/REVOKED(已作废)',
+  `expires_at` datetime(3) NOT NULL COMMENT '过期时间(UTC时间)',
+  `redeemed_by` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '兑换用户ID',
+  `redeemed_at` datetime(3) DEFAULT NULL COMMENT '兑换时间(UTC时间)',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`code`),
+  KEY `idx_app_id_status` (`app_id`,`status`),
+  KEY `idx_app_id_batch_id` (`app_id`,`batch_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='兑换码表';
+
+-- ----------------------------
+-- Table structure for redeem_code_redemption
+-- ----------------------------
+DROP TABLE IF EXISTS `redeem_code_redemption`;
+CREATE TABLE `redeem_code_redemption` (
+  `redemption_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '兑换记录ID（唯一标识）',
+  `code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '兑换码',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '兑换用户ID',
+  `reward_type` enum('COUPON','POINTS','SUBSCRIPTION') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '兑换内容: COUPON(优惠券)/POINTS(积分)/SUBSCRIPTION(订阅时长)',
+  `coupon_code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'COUPON：发放的优惠码',
+  `user_coupon_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'COUPON：发放到券包的用户优惠券ID',
+  `points` bigint NOT NULL DEFAULT '0' COMMENT 'POINTS：积分数',
+  `subscription_plan` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'SUBSCRIPTION：订阅套餐',
+  `subscription_days` int NOT NULL DEFAULT '0' COMMENT 'SUBSCRIPTION：订阅天数',
+  `grant_status` enum('PENDING','GRANTED','FAILED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'GRANTED' COMMENT '权益发放状态: PENDING(待发放)/GRANTED(已发放)/FAILED(发放失败)',
+  `grant_error` varchar(1024) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '最近一次发放失败的原因',
+  `grant_attempts` int NOT NULL DEFAULT '0' COMMENT '积分、订阅时长的发放尝试次数',
+  `granted_at` datetime(3) DEFAULT NULL COMMENT '权益发放时间(UTC时间)',
+  `redeemed_at` datetime(3) NOT NULL COMMENT '兑换时间(UTC时间)',
+  PRIMARY KEY (`redemption_id`),
+  UNIQUE KEY `uk_code` (`code`),
+  KEY `idx_app_id_user_id` (`app_id`,`user_id`),
+  KEY `idx_app_id_grant_status` (`app_id`,`grant_status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='兑换码兑换记录表';
+
+-- ----------------------------
+-- Table structure for reward
+-- ----------------------------
+DROP TABLE IF EXISTS `reward`;
+CREATE TABLE `reward` (
+  `reward_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '奖励ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '奖励名称',
+  `description` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '奖励描述',
+  `reward_type` enum('COUPON','POINTS','SUBSCRIPTION') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '奖励类型: COUPON(优惠券)/POINTS(积分)/SUBSCRIPTION(订阅时长)',
+  `content` text COLLATE utf8mb4_unicode_ci COMMENT '奖励内容（JSON）',
+  `generator_config` text COLLATE utf8mb4_unicode_ci COMMENT '生成配置（JSON：优惠码前缀、发放总量）',
+  `validator_config` text COLLATE utf8mb4_unicode_ci COMMENT '校验配置（JSON：可发放时间、受众、每用户次数）',
+  `distributor_config` text COLLATE utf8mb4_unicode_ci COMMENT '发放配置（JSON：AUTO/MANUAL）',
+  `valid_days` int NOT NULL DEFAULT '0' COMMENT '发放后有效天数（0表示不过期，COUPON 必填）',
+  `version` int NOT NULL DEFAULT '1' COMMENT '版本号（每次修改加一）',
+  `status` enum('ACTIVE','PAUSED','ENDED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'ACTIVE' COMMENT '状态: ACTIVE(激活)/PAUSED(已暂停)/ENDED(已结束)',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`reward_id`),
+  KEY `idx_app_id_status` (`app_id`,`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='奖励模板表';
+
+-- ----------------------------
+-- Table structure for reward_grant
+-- ----------------------------
+DROP TABLE IF EXISTS `reward_grant`;
+CREATE TABLE `reward_grant` (
+  `grant_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '发放记录ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `reward_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '奖励ID',
+  `reward_name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '奖励名称（快照）',
+  `reward_type` enum('COUPON','POINTS','SUBSCRIPTION') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '奖励类型（快照）',
+  `reward_version` int NOT NULL COMMENT '发放时的奖励版本',
+  `content_snapshot` text COLLATE utf8mb4_unicode_ci COMMENT '发放时的奖励内容（JSON 快照）',
+  `campaign_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '活动ID',
+  `campaign_name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '活动名称（快照）',
+  `task_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '任务ID',
+  `task_name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '任务名称（快照）',
+  `user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '用户ID',
+  `request_id` varchar(128) COLLATE utf8mb4_unicode_ci DEFAULT NULL COMMENT '调用方幂等键（为空表示不去重）',
+  `status` enum('PENDING','GENERATED','DISTRIBUTED','USED','EXPIRED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'PENDING' COMMENT '状态: PENDING(待处理)/GENERATED(已生成)/DISTRIBUTED(已发放)/USED(已使用)/EXPIRED(已过期)',
+  `coupon_code` varchar(50) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT 'COUPON：生成的用户专属优惠码',
+  `expire_time` datetime(3) DEFAULT NULL COMMENT '过期时间(UTC时间)，为空表示不过期',
+  `generated_at` datetime(3) DEFAULT NULL COMMENT '生成时间(UTC时间)',
+  `distributed_at` datetime(3) DEFAULT NULL COMMENT '发放时间(UTC时间)',
+  `used_at` datetime(3) DEFAULT NULL COMMENT '使用时间(UTC时间)',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`grant_id`),
+  UNIQUE KEY `uk_reward_id_request_id` (`reward_id`,`request_id`),
+  KEY `idx_reward_id_user_id` (`reward_id`,`user_id`),
+  KEY `idx_app_id_user_id` (`app_id`,`user_id`),
+  KEY `idx_coupon_code` (`coupon_code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='奖励发放记录表';
+
+-- ----------------------------
+-- Table structure for task
+-- ----------------------------
+DROP TABLE IF EXISTS `task`;
+CREATE TABLE `task` (
+  `task_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '任务ID（唯一标识）',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `campaign_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '活动ID（为空表示不属于活动）',
+  `name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '任务名称',
+  `description` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '任务描述',
+  `task_type` enum('INVITE','PURCHASE','SHARE','SIGN_IN') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '任务类型: INVITE(邀请好友)/PURCHASE(购买商品)/SHARE(分享内容)/SIGN_IN(签到打卡)',
+  `event_type` enum('USER_REGISTER','ORDER_PAID','USER_SIGN_IN') COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '触发事件类型',
+  `trigger_config` text COLLATE utf8mb4_unicode_ci COMMENT '触发配置（JSON：获得进度的用户属性）',
+  `condition_config` text COLLATE utf8mb4_unicode_ci COMMENT '完成条件（JSON：金额、币种、事件属性、完成次数）',
+  `reward_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '完成后发放的奖励ID',
+  `repeatable` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否可重复完成',
+  `status` enum('ACTIVE','PAUSED','ENDED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'ACTIVE' COMMENT '状态: ACTIVE(激活)/PAUSED(已暂停)/ENDED(已结束)',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`task_id`),
+  KEY `idx_app_id_event_type_status` (`app_id`,`event_type`,`status`),
+  KEY `idx_campaign_id` (`campaign_id`),
+  KEY `idx_reward_id` (`reward_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='任务表';
+
+-- ----------------------------
+-- Table structure for task_progress
+-- ----------------------------
+DROP TABLE IF EXISTS `task_progress`;
+CREATE TABLE `task_progress` (
+  `task_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '任务ID',
+  `user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '用户ID',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `progress` int NOT NULL DEFAULT '0' COMMENT '当前已计入的事件次数',
+  `target` int NOT NULL DEFAULT '1' COMMENT '完成所需次数',
+  `completions` int NOT NULL DEFAULT '0' COMMENT '已完成次数',
+  `status` enum('IN_PROGRESS','COMPLETED') COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'IN_PROGRESS' COMMENT '状态: IN_PROGRESS(进行中)/COMPLETED(已完成)',
+  `last_event_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '最近计入的事件ID',
+  `completed_at` datetime(3) DEFAULT NULL COMMENT '最近完成时间(UTC时间)',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  `updated_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间(UTC时间)',
+  PRIMARY KEY (`task_id`,`user_id`),
+  KEY `idx_app_id_user_id` (`app_id`,`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户任务进度表';
+
+-- ----------------------------
+-- Table structure for task_event_log
+-- ----------------------------
+DROP TABLE IF EXISTS `task_event_log`;
+CREATE TABLE `task_event_log` (
+  `task_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '任务ID',
+  `event_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '事件ID',
+  `app_id` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '应用ID',
+  `user_id` varchar(36) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '获得进度的用户ID',
+  `completed` tinyint(1) NOT NULL DEFAULT '0' COMMENT '该事件是否完成了任务',
+  `grant_id` varchar(32) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '完成任务后发放的奖励记录ID',
+  `created_at` datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间(UTC时间)',
+  PRIMARY KEY (`task_id`,`event_id`),
+  KEY `idx_app_id_user_id` (`app_id`,`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='任务事件记录表';
+
 SET FOREIGN_KEY_CHECKS = 1;
@@ -0,0 +1,375 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+
+	"github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	maxAudienceConditions = 20     // 单个受众规则的最大条件数
+	maxAudienceUploadIDs  = 100000 // 单次上传名单的最大用户数
+	maxAudienceUserIDLen  = 36     // 用户ID最大长度（与 coupon_usage.user_id 一致）
+	maxAudienceBadLines   = 100    // 上传结果中返回的无效行号数量上限
+)
+
+// Audience 受众领域对象（定义优惠券的可用人群）
+type Audience struct {
+	AudienceID  string
+	AppID       string
+	Name        string
+	Description string
+	Type        string        // 见 constants.AudienceType*
+	Rule        *AudienceRule // TAG/SEGMENT 类型的圈选规则
+	Status      string        // 见 constants.AudienceStatus*，非 ACTIVE 时任何用户都不命中
+	MemberCount int64         // LIST 类型的名单人数
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// AudienceRule 受众圈选规则：按调用方传入的用户属性求值
+type AudienceRule struct {
+	Match      string               // 条件组合方式：ALL（全部满足，默认）/ANY（满足任一）
+	Conditions []*AudienceCondition // 条件列表
+}
+
+// AudienceCondition 受众圈选条件
+type AudienceCondition struct {
+	Attribute string   // 用户属性名
+	Operator  string   // 见 constants.AudienceOperator*
+	Values    []string // 比较值（IN/NOT_IN 为多个，EXISTS 不需要）
+}
+
+// AudienceMemberUpload 上传受众名单
+type AudienceMemberUpload struct {
+	AppID      string
+	AudienceID string
+	Mode       string    // 见 constants.AudienceUploadMode*，默认 REPLACE
+	Content    io.Reader // 名单文件：每行一个用户ID（CSV 取第一列，可带标题行）
+}
+
+// AudienceMemberUploadResult 上传受众名单结果
+type AudienceMemberUploadResult struct {
+	Total        int32   // 有效的去重用户数
+	Invalid      int32   // 无效行数（用户ID超长）
+	InvalidLines []int32 // 无效行号（最多返回前 100 个）
+	MemberCount  int64   // 上传后的名单人数
+}
+
+// AudienceRepo 受众仓储接口（查询均限定在 appID 内）
+type AudienceRepo interface {
+	Save(context.Context, *Audience) error
+	Update(context.Context, *Audience) error
+	FindByID(ctx context.Context, appID, audienceID string) (*Audience, error)
+	List(ctx context.Context, appID, audienceType string, page, pageSize int) ([]*Audience, int64, error)
+	// Delete 删除受众及其名单，仍被优惠券引用时返回 ErrCodeAudienceInUse
+	Delete(ctx context.Context, appID, audienceID string) error
+	// ReplaceMembers 写入名单：replace 为 true 时先清空原名单，返回写入后的名单人数
+	ReplaceMembers(ctx context.Context, audienceID string, userIDs []string, replace bool) (int64, error)
+	IsMember(ctx context.Context, audienceID, userID string) (bool, error)
+}
+
+// AudienceUseCase 受众用例
+type AudienceUseCase struct {
+	repo AudienceRepo
+	log  *log.Helper
+}
+
+// NewAudienceUseCase 创建受众用例
+func NewAudienceUseCase(repo AudienceRepo, logger log.Logger) *AudienceUseCase {
+	return &AudienceUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create 创建受众
+func (uc *AudienceUseCase) Create(ctx context.Context, a *Audience) (*Audience, error) {
+	if a.Status == "" {
+		a.Status = constants.AudienceStatusActive
+	}
+	if err := validateAudience(a); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	a.AudienceID = GenerateShortID()
+	a.MemberCount = 0
+	a.CreatedAt = now
+	a.UpdatedAt = now
+	if err := uc.repo.Save(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Get 获取受众
+func (uc *AudienceUseCase) Get(ctx context.Context, appID, audienceID string) (*Audience, error) {
+	return uc.repo.FindByID(ctx, appID, audienceID)
+}
+
+// List 列出受众（audienceType 为空表示全部类型）
+func (uc *AudienceUseCase) List(ctx context.Context, appID, audienceType string, page, pageSize int) ([]*Audience, int64, error) {
+	return uc.repo.List(ctx, appID, audienceType, page, pageSize)
+}
+
+// Update 更新受众（类型不可修改）
+func (uc *AudienceUseCase) Update(ctx context.Context, a *Audience) (*Audience, error) {
+	if err := validateAudience(a); err != nil {
+		return nil, err
+	}
+	a.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Delete 删除受众
+func (uc *AudienceUseCase) Delete(ctx context.Context, appID, audienceID string) error {
+	return uc.repo.Delete(ctx, appID, audienceID)
+}
+
+// UploadMembers 上传 LIST 类型受众的名单（REPLACE 覆盖原名单，APPEND 追加）
+func (uc *AudienceUseCase) UploadMembers(ctx context.Context, in *AudienceMemberUpload) (*AudienceMemberUploadResult, error) {
+	if in.Mode == "" {
+		in.Mode = constants.AudienceUploadModeReplace
+	}
+	if in.Mode != constants.AudienceUploadModeReplace && in.Mode != constants.AudienceUploadModeAppend {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	a, err := uc.repo.FindByID(ctx, in.AppID, in.AudienceID)
+	if err != nil {
+		return nil, err
+	}
+	if a.Type != constants.AudienceTypeList {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeAudienceNotList, "zh-CN")
+	}
+
+	userIDs, result, err := parseAudienceMembers(in.Content)
+	if err != nil {
+		return nil, err
+	}
+	if result.MemberCount, err = uc.repo.ReplaceMembers(ctx, a.AudienceID, userIDs, in.Mode == constants.AudienceUploadModeReplace); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Check 检查用户是否属于受众
+func (uc *AudienceUseCase) Check(ctx context.Context, appID, audienceID, userID string, attrs map[string]string) (bool, error) {
+	a, err := uc.repo.FindByID(ctx, appID, audienceID)
+	if err != nil {
+		return false, err
+	}
+	return audienceMatches(ctx, uc.repo, a, userID, attrs)
+}
+
+// audienceMatches 判断用户是否属于受众：ALL 全部命中，LIST 查名单，TAG/SEGMENT 按规则对用户属性求值
+func audienceMatches(ctx context.Context, repo AudienceRepo, a *Audience, userID string, attrs map[string]string) (bool, error) {
+	if a.Status != constants.AudienceStatusActive {
+		return false, nil
+	}
+	switch a.Type {
+	case constants.AudienceTypeAll:
+		return true, nil
+	case constants.AudienceTypeList:
+		if userID == "" {
+			return false, nil
+		}
+		return repo.IsMember(ctx, a.AudienceID, userID)
+	default:
+		return a.Rule.Matches(attrs), nil
+	}
+}
+
+// Matches 按用户属性对规则求值（没有条件的规则不命中任何用户）
+func (r *AudienceRule) Matches(attrs map[string]string) bool {
+	if r == nil || len(r.Conditions) == 0 {
+		return false
+	}
+	matchAny := r.Match == constants.AudienceMatchAny
+	for _, c := range r.Conditions {
+		if c.Matches(attrs) == matchAny {
+			return matchAny
+		}
+	}
+	return !matchAny
+}
+
+// Matches 按用户属性对单个条件求值（属性缺失时只有 NOT_IN/NE 命中）
+func (c *AudienceCondition) Matches(attrs map[string]string) bool {
+	value, ok := attrs[c.Attribute]
+	switch c.Operator {
+	case constants.AudienceOperatorExists:
+		return ok
+	case constants.AudienceOperatorEq:
+		return ok && value == c.Values[0]
+	case constants.AudienceOperatorNe:
+		return !ok || value != c.Values[0]
+	case constants.AudienceOperatorIn:
+		return ok && containsString(c.Values, value)
+	case constants.AudienceOperatorNotIn:
+		return !ok || !containsString(c.Values, value)
+	case constants.AudienceOperatorGt, constants.AudienceOperatorGte, constants.AudienceOperatorLt, constants.AudienceOperatorLte:
+		if !ok {
+			return false
+		}
+		actual, err1 := strconv.ParseFloat(value, 64)
+		expected, err2 := strconv.ParseFloat(c.Values[0], 64)
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		switch c.Operator {
+		case constants.AudienceOperatorGt:
+			return actual > expected
+		case constants.AudienceOperatorGte:
+			return actual >= expected
+		case constants.AudienceOperatorLt:
+			return actual < expected
+		default:
+			return actual <= expected
+		}
+	}
+	return false
+}
+
+// containsString 判断切片是否包含字符串
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAudience 校验受众类型、状态和规则
+func validateAudience(a *Audience) error {
+	a.Name = strings.TrimSpace(a.Name)
+	if a.Name == "" {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	switch a.Status {
+	case constants.AudienceStatusActive, constants.AudienceStatusPaused, constants.AudienceStatusEnded:
+	default:
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	switch a.Type {
+	case constants.AudienceTypeAll, constants.AudienceTypeList:
+		// 名单和全量用户不使用规则
+		a.Rule = nil
+		return nil
+	case constants.AudienceTypeTag, constants.AudienceTypeSegment:
+		if !validAudienceRule(a.Rule) {
+			return errors.NewBizError(marketingErrors.ErrCodeAudienceInvalidRule, "zh-CN")
+		}
+		if a.Rule.Match == "" {
+			a.Rule.Match = constants.AudienceMatchAll
+		}
+		return nil
+	default:
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+}
+
+// validAudienceRule 规则至少包含一个条件，且每个条件的比较值个数与操作符匹配
+func validAudienceRule(r *AudienceRule) bool {
+	if r == nil || len(r.Conditions) == 0 || len(r.Conditions) > maxAudienceConditions {
+		return false
+	}
+	if r.Match != "" && r.Match != constants.AudienceMatchAll && r.Match != constants.AudienceMatchAny {
+		return false
+	}
+	for _, c := range r.Conditions {
+		if c == nil || strings.TrimSpace(c.Attribute) == "" {
+			return false
+		}
+		switch c.Operator {
+		case constants.AudienceOperatorExists:
+		case constants.AudienceOperatorIn, constants.AudienceOperatorNotIn:
+			if len(c.Values) == 0 {
+				return false
+			}
+		case constants.AudienceOperatorEq, constants.AudienceOperatorNe:
+			if len(c.Values) != 1 {
+				return false
+			}
+		case constants.AudienceOperatorGt, constants.AudienceOperatorGte, constants.AudienceOperatorLt, constants.AudienceOperatorLte:
+			if len(c.Values) != 1 {
+				return false
+			}
+			if _, err := strconv.ParseFloat(c.Values[0], 64); err != nil {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// parseAudienceMembers 解析名单文件：每行一个用户ID，CSV 取第一列；首行为标题（userId/user_id/用户ID）时跳过，
+// 空行跳过，重复的用户ID只保留一个
+func parseAudienceMembers(r io.Reader) ([]string, *AudienceMemberUploadResult, error) {
+	result := &AudienceMemberUploadResult{}
+	seen := make(map[string]bool)
+	userIDs := make([]string, 0)
+
+	scanner := bufio.NewScanner(r)
+	var line int32
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if line == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if i := strings.IndexByte(text, ','); i >= 0 {
+			text = text[:i]
+		}
+		userID := strings.Trim(strings.TrimSpace(text), `"`)
+		if userID == "" {
+			continue
+		}
+		if line == 1 && isAudienceMemberHeader(userID) {
+			continue
+		}
+		if len(userID) > maxAudienceUserIDLen {
+			result.Invalid++
+			if len(result.InvalidLines) < maxAudienceBadLines {
+				result.InvalidLines = append(result.InvalidLines, line)
+			}
+			continue
+		}
+		if seen[userID] {
+			continue
+		}
+		if len(userIDs) >= maxAudienceUploadIDs {
+			return nil, nil, errors.NewBizError(marketingErrors.ErrCodeImportTooManyRows, "zh-CN")
+		}
+		seen[userID] = true
+		userIDs = append(userIDs, userID)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, errors.NewBizError(marketingErrors.ErrCodeImportInvalidFile, "zh-CN")
+	}
+	result.Total = int32(len(userIDs))
+	return userIDs, result, nil
+}
+
+// isAudienceMemberHeader 是否为名单文件的标题行
+func isAudienceMemberHeader(s string) bool {
+	switch strings.ToLower(s) {
+	case "userid", "user_id", "用户id":
+		return true
+	}
+	return false
+}
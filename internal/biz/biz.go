@@ -6,4 +6,11 @@ import "github.com/google/wire"
 // 极简重构：仅保留优惠券功能，移除复杂营销活动系统
 var ProviderSet = wire.NewSet(
 	NewCouponUseCase,
+	NewExportUseCase,
+	NewCouponTemplateUseCase,
+	NewAudienceUseCase,
+	NewCampaignUseCase,
+	NewRedeemCodeUseCase,
+	NewRewardUseCase,
+	NewTaskUseCase,
 )
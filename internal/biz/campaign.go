@@ -0,0 +1,209 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+	"marketing-service/internal/metrics"
+
+	"github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Campaign 营销活动领域对象：把一组优惠券归到同一个活动下，统一控制上下线和起止时间
+type Campaign struct {
+	CampaignID  string
+	AppID       string
+	Name        string
+	Type        string // 见 constants.CampaignType*
+	Description string
+	StartTime   time.Time
+	EndTime     time.Time
+	Status      string // 见 constants.CampaignStatus*
+	Budget      int64  // 折扣预算(分)：活动下所有优惠券的折扣金额合计上限（0 表示不限），用尽后自动暂停
+	BudgetUsed  int64  // 已使用的折扣金额(分)
+	Currency    string // 折扣预算的货币单位（创建后不可修改），设置了预算时活动下的优惠券必须使用该币种
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CampaignFilter 活动查询条件（零值表示不筛选，AppID 必填）
+type CampaignFilter struct {
+	AppID  string
+	Status string
+	Type   string
+}
+
+// IsRunning 活动在 now 是否进行中（已发布且在起止时间内），不在进行中时其下所有优惠券都不可用
+func (c *Campaign) IsRunning(now time.Time) bool {
+	return c.Status == constants.CampaignStatusActive && !now.Before(c.StartTime) && now.Before(c.EndTime)
+}
+
+// CampaignRepo 活动仓储接口（查询均限定在 appID 内）
+type CampaignRepo interface {
+	Save(context.Context, *Campaign) error
+	// Update 更新活动基本信息并回写状态和已使用预算：预算低于已使用金额或已关联优惠券的币种与活动不一致时返回
+	// ErrCodeBusinessRuleViolation，预算不超过已使用金额时暂停进行中的活动
+	Update(context.Context, *Campaign) error
+	FindByID(ctx context.Context, appID, campaignID string) (*Campaign, error)
+	List(ctx context.Context, filter *CampaignFilter, page, pageSize int) ([]*Campaign, int64, error)
+	// Delete 删除活动，仍有优惠券或任务关联时返回 ErrCodeCampaignInUse
+	Delete(ctx context.Context, appID, campaignID string) error
+	// UpdateStatus 仅当活动当前状态在 from 中时改为 to，返回是否修改成功
+	UpdateStatus(ctx context.Context, appID, campaignID string, from []string, to string, now time.Time) (bool, error)
+	// CountByStatus 统计所有应用中处于该状态的活动数
+	CountByStatus(ctx context.Context, status string) (int64, error)
+}
+
+// CampaignUseCase 活动用例
+type CampaignUseCase struct {
+	repo CampaignRepo
+	log  *log.Helper
+}
+
+// NewCampaignUseCase 创建活动用例
+func NewCampaignUseCase(repo CampaignRepo, logger log.Logger) *CampaignUseCase {
+	return &CampaignUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create 创建活动（草稿状态，发布后其下的优惠券才可用）
+func (uc *CampaignUseCase) Create(ctx context.Context, c *Campaign) (*Campaign, error) {
+	if c.Currency == "" {
+		c.Currency = constants.CouponCurrencyCNY
+	}
+	if err := validateCampaign(c); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	c.CampaignID = GenerateShortID()
+	c.Status = constants.CampaignStatusDraft
+	c.BudgetUsed = 0
+	c.CreatedAt = now
+	c.UpdatedAt = now
+	if err := uc.repo.Save(ctx, c); err != nil {
+		return nil, err
+	}
+	metrics.GetMetrics().CampaignCreatedTotal.Inc()
+	return c, nil
+}
+
+// Get 获取活动
+func (uc *CampaignUseCase) Get(ctx context.Context, appID, campaignID string) (*Campaign, error) {
+	return uc.repo.FindByID(ctx, appID, campaignID)
+}
+
+// List 列出活动
+func (uc *CampaignUseCase) List(ctx context.Context, filter *CampaignFilter, page, pageSize int) ([]*Campaign, int64, error) {
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// Update 更新活动（已结束的活动不可修改，状态通过发布/暂停/结束修改）
+// 修改后的预算不能低于已使用金额；设置预算时活动下已有的优惠券必须使用活动币种；预算已用尽时进行中的活动自动暂停
+func (uc *CampaignUseCase) Update(ctx context.Context, c *Campaign) (*Campaign, error) {
+	if c.Status == constants.CampaignStatusEnded {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeCampaignStatusInvalid, "zh-CN")
+	}
+	if err := validateCampaign(c); err != nil {
+		return nil, err
+	}
+	status := c.Status
+	c.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	if c.Status != status {
+		uc.log.Infof("campaign paused by budget update: campaign_id=%s, budget=%d, budget_used=%d", c.CampaignID, c.Budget, c.BudgetUsed)
+		uc.refreshActiveGauge(ctx)
+	}
+	return c, nil
+}
+
+// Delete 删除活动
+func (uc *CampaignUseCase) Delete(ctx context.Context, appID, campaignID string) error {
+	if err := uc.repo.Delete(ctx, appID, campaignID); err != nil {
+		return err
+	}
+	uc.refreshActiveGauge(ctx)
+	return nil
+}
+
+// Publish 发布活动：草稿或已暂停的活动改为进行中（已过结束时间或折扣预算已用尽的活动不能发布）
+func (uc *CampaignUseCase) Publish(ctx context.Context, appID, campaignID string) (*Campaign, error) {
+	c, err := uc.repo.FindByID(ctx, appID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(c.EndTime) {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeCampaignStatusInvalid, "zh-CN")
+	}
+	if c.HasBudget() && c.BudgetUsed >= c.Budget {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeCampaignBudgetExhausted, "zh-CN")
+	}
+	return uc.transition(ctx, appID, campaignID, []string{constants.CampaignStatusDraft, constants.CampaignStatusPaused}, constants.CampaignStatusActive)
+}
+
+// Pause 暂停活动：其下所有优惠券立即不可验证和使用
+func (uc *CampaignUseCase) Pause(ctx context.Context, appID, campaignID string) (*Campaign, error) {
+	return uc.transition(ctx, appID, campaignID, []string{constants.CampaignStatusActive}, constants.CampaignStatusPaused)
+}
+
+// End 结束活动（不可恢复）
+func (uc *CampaignUseCase) End(ctx context.Context, appID, campaignID string) (*Campaign, error) {
+	c, err := uc.transition(ctx, appID, campaignID, []string{constants.CampaignStatusDraft, constants.CampaignStatusActive, constants.CampaignStatusPaused}, constants.CampaignStatusEnded)
+	if err != nil {
+		return nil, err
+	}
+	metrics.GetMetrics().CampaignCompletedTotal.Inc()
+	return c, nil
+}
+
+// transition 按状态机修改活动状态：当前状态不在 from 中时返回 ErrCodeCampaignStatusInvalid
+func (uc *CampaignUseCase) transition(ctx context.Context, appID, campaignID string, from []string, to string) (*Campaign, error) {
+	ok, err := uc.repo.UpdateStatus(ctx, appID, campaignID, from, to, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	c, err := uc.repo.FindByID(ctx, appID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeCampaignStatusInvalid, "zh-CN")
+	}
+	uc.refreshActiveGauge(ctx)
+	return c, nil
+}
+
+// refreshActiveGauge 按数据库中进行中的活动数更新指标（失败只记录日志）
+func (uc *CampaignUseCase) refreshActiveGauge(ctx context.Context) {
+	count, err := uc.repo.CountByStatus(ctx, constants.CampaignStatusActive)
+	if err != nil {
+		uc.log.Warnf("failed to count active campaigns: %v", err)
+		return
+	}
+	metrics.GetMetrics().CampaignActiveTotal.Set(float64(count))
+}
+
+// validateCampaign 校验活动类型、币种、预算和起止时间
+func validateCampaign(c *Campaign) error {
+	switch c.Type {
+	case constants.CampaignTypeRedeemCode, constants.CampaignTypeTaskReward, constants.CampaignTypeDirectSend:
+	default:
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if !isValidCurrency(c.Currency) {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if c.Budget < 0 {
+		return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	if c.StartTime.IsZero() || c.EndTime.IsZero() || !c.StartTime.Before(c.EndTime) {
+		return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
 
 	"github.com/gaoyong06/go-pkg/errors"
 
@@ -13,20 +14,42 @@ import (
 
 // Coupon 优惠券领域对象
 type Coupon struct {
-	CouponID      int64     // 优惠券ID（自增主键）
-	CouponCode    string    // 优惠码（业务唯一标识）
-	AppID         string    // 应用ID
-	DiscountType  string    // 折扣类型
-	DiscountValue int64     // 折扣值
-	Currency      string    // 货币单位: CNY, USD, EUR 等，仅固定金额类型需要
-	ValidFrom     time.Time // 生效时间
-	ValidUntil    time.Time // 过期时间
-	MaxUses       int32     // 最大使用次数
-	UsedCount     int32     // 已使用次数
-	MinAmount     int64     // 最低消费金额
-	Status        string    // 状态
-	CreatedAt     time.Time // 创建时间
-	UpdatedAt     time.Time // 更新时间
+	CouponID         int64           // 优惠券ID（自增主键）
+	CouponCode       string          // 优惠码（业务唯一标识）
+	AppID            string          // 应用ID
+	DiscountType     string          // 折扣类型
+	DiscountValue    int64           // 折扣值
+	Currency         string          // 货币单位: CNY, USD, EUR 等，仅固定金额类型需要
+	ValidFrom        time.Time       // 生效时间
+	ValidUntil       time.Time       // 过期时间
+	MaxUses          int32           // 最大使用次数
+	UsedCount        int32           // 已使用次数
+	MinAmount        int64           // 最低消费金额
+	ValidDays        int32           // 相对有效期天数：>0 时优惠券需先发放给用户，按发放时间起算有效期（ValidFrom/ValidUntil 为发放期）
+	ClaimLimit       int32           // 每个用户可领取次数（0 表示不限）
+	ClaimStock       int32           // 可领取总量（0 表示不限）
+	ClaimedCount     int32           // 已领取/发放数量
+	BoundUserID      string          // 绑定用户ID：非空时只有该用户可以领取、验证通过和使用
+	AudienceID       string          // 受众ID：非空时只有属于该受众的用户可以验证通过
+	Eligibility      string          // 新客限制，见 constants.CouponEligibility*，为空表示不限
+	Timezone         string          // IANA 时区，用于求值 Schedule，为空表示 UTC
+	Schedule         *CouponSchedule // 周期性可用时段和不可用日期（nil 表示不限）
+	UserDailyLimit   int32           // 每个用户每天可使用次数（0 表示不限，下同）
+	UserWeeklyLimit  int32           // 每个用户每周可使用次数
+	UserMonthlyLimit int32           // 每个用户每月可使用次数
+	DailyLimit       int32           // 所有用户每天合计可使用次数
+	CampaignID       string          // 所属活动ID：非空时只有活动进行中才可用
+	Budget           int64           // 折扣预算(分)：所有使用记录的折扣金额合计上限（0 表示不限），用尽后自动停用
+	BudgetUsed       int64           // 已使用的折扣金额(分)
+	Pacing           *CouponPacing   // 投放节奏：把 MaxUses 和 Budget 分摊到生效期内（nil 表示不限）
+	Status           string          // 状态
+	CreatedAt        time.Time       // 创建时间
+	UpdatedAt        time.Time       // 更新时间
+}
+
+// BoundTo 优惠券是否可以由该用户使用（未绑定用户的优惠券任何用户都可以使用）
+func (c *Coupon) BoundTo(userID string) bool {
+	return c.BoundUserID == "" || c.BoundUserID == userID
 }
 
 // CouponUsage 优惠券使用记录领域对象
@@ -40,33 +63,96 @@ type CouponUsage struct {
 	OriginalAmount int64
 	DiscountAmount int64
 	FinalAmount    int64
+	Currency       string    // 货币单位（使用时的快照，之后修改优惠券不影响历史记录）
 	UsedAt         time.Time // 使用时间
 	CreatedAt      time.Time // 创建时间
 }
 
+// CouponFilter 优惠券列表筛选与排序条件（零值表示不筛选）
+type CouponFilter struct {
+	AppID              string    // 应用ID
+	Status             string    // 状态
+	CodePrefix         string    // 优惠码前缀匹配
+	CodeContains       string    // 优惠码子串匹配
+	DiscountType       string    // 折扣类型
+	Currency           string    // 货币单位
+	ActiveFrom         time.Time // 有效期窗口起始：与优惠券有效期有交集即命中
+	ActiveUntil        time.Time // 有效期窗口结束
+	ExpiringWithinDays int32     // N 天内过期（仅未过期的优惠券）
+	Exhausted          *bool     // 是否已用尽：nil 不筛选
+	CreatedFrom        time.Time // 创建时间起始
+	CreatedTo          time.Time // 创建时间结束
+	SortBy             string    // 排序字段，见 constants.CouponSortBy*
+	SortOrder          string    // 排序方向，见 constants.SortOrder*
+	CampaignID         string    // 所属活动ID
+}
+
+// CouponUsageFilter 使用记录查询条件（零值表示不筛选，AppID 必填）
+type CouponUsageFilter struct {
+	AppID          string    // 应用ID
+	CouponCode     string    // 优惠码
+	UserID         string    // 用户ID
+	PaymentOrderID string    // 支付订单ID
+	PaymentID      string    // 支付流水号
+	UsedFrom       time.Time // 使用时间起始
+	UsedTo         time.Time // 使用时间结束
+}
+
 // CouponRepo 优惠券仓储接口
 type CouponRepo interface {
 	Save(context.Context, *Coupon) (*Coupon, error)
 	Update(context.Context, *Coupon) (*Coupon, error)
 	FindByCode(context.Context, string) (*Coupon, error)
-	List(context.Context, string, string, int, int) ([]*Coupon, int64, error) // appID, status, page, pageSize
+	FindByCodes(context.Context, []string) (map[string]*Coupon, error)       // 按优惠码批量查找（含已删除的优惠券），返回 code -> 优惠券
+	ExistingCodes(context.Context, []string) (map[string]bool, error)        // 返回已存在（未删除）的优惠码
+	SaveBatch(context.Context, []*Coupon) error                              // 在一个事务内批量创建
+	List(context.Context, *CouponFilter, int, int) ([]*Coupon, int64, error) // filter, page, pageSize
 	Delete(context.Context, string) error
-	IncrementUsedCount(context.Context, string) error // 原子性增加使用次数
+	UpdateStatusBatch(context.Context, string, []string, string, *CouponAudit) ([]*CouponBatchItem, error) // appID, codes, status, audit：在一个事务内修改状态并写审计日志
+	DeleteBatch(context.Context, string, []string, *CouponAudit) ([]*CouponBatchItem, error)               // appID, codes, audit：在一个事务内软删除并写审计日志
+	IncrementUsedCount(context.Context, string) error                                                      // 原子性增加使用次数
 	CreateUsage(context.Context, *CouponUsage) error
-	UseCoupon(context.Context, string, string, string, string, string, int64, int64, int64) error // 使用优惠券（事务操作）：code, appID, userID, paymentOrderID, paymentID, originalAmount, discountAmount, finalAmount
-	ListUsages(context.Context, string, int, int) ([]*CouponUsage, int64, error)                  // couponCode, page, pageSize
+	UseCoupon(context.Context, string, string, string, string, string, int64, int64, int64, []*CouponLimitWindow) ([]*BudgetEvent, error) // 使用优惠券（事务操作）：code, appID, userID, paymentOrderID, paymentID, originalAmount, discountAmount, finalAmount, limits；返回跨过告警阈值的预算事件
+	CountLimitUsage(ctx context.Context, code string, windows []*CouponLimitWindow) ([]int64, error)                                      // 各频次限制窗口内的已使用次数
+	ListUsages(context.Context, string, int, int) ([]*CouponUsage, int64, error)                                                          // couponCode, page, pageSize
+	ListUsagesByFilter(context.Context, *CouponUsageFilter, int, int) ([]*CouponUsage, int64, error)                                      // filter, page, pageSize
+	FindUsage(context.Context, *CouponUsageFilter) (*CouponUsage, error)                                                                  // 按条件查找最近一条使用记录
+	HasPriorUsage(ctx context.Context, appID, userID, excludePaymentOrderID string) (bool, error)                                         // 用户在应用内是否使用过优惠券（不计同一支付订单）
 	GetStats(context.Context, string) (*CouponStats, error)
-	GetSummaryStats(context.Context, string) (*SummaryStats, error) // appID（可选），获取汇总统计
+	GetSummaryStats(context.Context, *SummaryStatsQuery) (*SummaryStats, error)
+	CountUniqueUsers(context.Context, *UniqueUsersQuery) (int64, bool, error) // 返回去重用户数及是否为精确值
+	RebuildDailyStats(context.Context, *RebuildStatsQuery) (int64, error)     // 返回写入的每日汇总行数
+	RebuildUniqueUsers(context.Context, *RebuildStatsQuery) error             // 从使用记录回填去重用户 HyperLogLog
+	// GetUsageTimeSeries 按时间桶聚合使用记录：appID, couponCode（可选）, from, to, bucketStarts（升序）
+	// 返回与 bucketStarts 一一对应的数据点，无数据的时间桶补零
+	GetUsageTimeSeries(context.Context, string, string, time.Time, time.Time, []time.Time) ([]*UsageTimeSeriesPoint, error)
 }
 
 // CouponStats 优惠券统计信息
 type CouponStats struct {
-	CouponCode     string  // 优惠码
-	TotalUses      int32   // 总使用次数
-	TotalOrders    int32   // 总订单数
-	TotalRevenue   int64   // 总营收
-	TotalDiscount  int64   // 总折扣
-	ConversionRate float32 // 转化率
+	CouponCode         string            // 优惠码
+	TotalUses          int32             // 总使用次数
+	TotalOrders        int32             // 总订单数
+	TotalRevenue       int64             // 总营收
+	TotalDiscount      int64             // 总折扣
+	DistinctUsers      int32             // 去重用户数（仅汇总统计的前N个优惠券）
+	ConversionRate     float32           // 转化率(%)：使用次数 / 验证次数
+	ValidationAttempts int32             // 验证次数
+	ValidAttempts      int32             // 验证通过次数
+	ValidRate          float32           // 验证通过率(%)
+	RedemptionRate     float32           // 核销率(%)：使用次数 / 验证通过次数
+	QuotaUtilization   float32           // 配额使用率(%)：使用次数 / 最大使用次数
+	AmountsByCurrency  []*CurrencyAmount // 按币种拆分的收入与折扣
+	UniqueUsers        int64             // 去重用户数（默认 HyperLogLog 近似值）
+	UniqueUsersExact   bool              // UniqueUsers 是否为精确值
+}
+
+// CurrencyAmount 按币种汇总的金额（不同币种的金额不能直接相加）
+type CurrencyAmount struct {
+	Currency      string
+	TotalUses     int32
+	TotalRevenue  int64
+	TotalDiscount int64
 }
 
 // SummaryStats 汇总统计信息
@@ -78,48 +164,132 @@ type SummaryStats struct {
 	TotalRevenue          int64
 	TotalDiscount         int64
 	AverageConversionRate float32
-	TopCoupons            []*CouponStats // 前N个优惠券的详细统计
+	TopCoupons            []*CouponStats    // 前N个优惠券的详细统计
+	TotalAttempts         int32             // 总验证次数
+	TotalValidAttempts    int32             // 总验证通过次数
+	ConversionRate        float32           // 应用整体转化率(%)
+	ValidRate             float32           // 应用整体验证通过率(%)
+	RedemptionRate        float32           // 应用整体核销率(%)
+	AmountsByCurrency     []*CurrencyAmount // 按币种拆分的总收入与总折扣
+	UniqueUsers           int64             // 去重用户数（默认 HyperLogLog 近似值）
+	UniqueUsersExact      bool              // UniqueUsers 是否为精确值
+}
+
+// maxValidDays 有效天数上限（相对有效期优惠券和优惠券模板）
+const maxValidDays = 3650
+
+const (
+	defaultSummaryTopN = 10  // 汇总统计默认返回的优惠券数量
+	maxSummaryTopN     = 100 // 汇总统计最多返回的优惠券数量
+)
+
+// SummaryStatsQuery 汇总统计查询条件
+type SummaryStatsQuery struct {
+	AppID            string    // 应用ID（可选）
+	TopN             int       // 前N个优惠券，默认 10
+	RankBy           string    // 前N个优惠券的排序依据，见 constants.StatsRankBy*，默认按使用次数
+	From             time.Time // 统计窗口起始（作用于使用时间和验证时间，零值表示不限）
+	To               time.Time // 统计窗口结束
+	ExactUniqueUsers bool      // 去重用户数是否使用精确查询
+}
+
+// UniqueUsersQuery 去重用户数查询条件（CouponCode 与 AppID 二选一，CouponCode 优先）
+type UniqueUsersQuery struct {
+	AppID      string
+	CouponCode string
+	From       time.Time // 使用时间窗口（零值表示不限）
+	To         time.Time
+	Exact      bool // 精确模式：直接在使用记录上 COUNT(DISTINCT user_id)
+}
+
+// ValidateResult 优惠券验证结果
+type ValidateResult struct {
+	Coupon          *Coupon        // 优惠券（不存在时为 nil）
+	DiscountAmount  int64          // 折扣金额(分)，仅验证通过时有效
+	Reason          string         // 验证结果原因，见 constants.ValidateReason*
+	NextAvailableAt time.Time      // 原因为 OUTSIDE_SCHEDULE 或 PACE_LIMITED 时下一个可用时刻（零值表示生效期内不再可用）
+	Quotas          []*CouponQuota // 频次限制的剩余额度（通过前面的检查后才计算）
+	BudgetRemaining int64          // 当前可用的剩余折扣预算(分)：优惠券、所属活动和投放节奏中最小的，-1 表示不限
+	BudgetClamped   bool           // 折扣金额是否因剩余预算不足被削减
+}
+
+// IsRelative 是否为相对有效期优惠券（发放给用户后按天数计算有效期）
+func (c *Coupon) IsRelative() bool {
+	return c.ValidDays > 0
+}
+
+// Valid 是否验证通过
+func (r *ValidateResult) Valid() bool {
+	return r.Reason == constants.ValidateReasonOK
 }
 
 // CouponUseCase 优惠券用例
 type CouponUseCase struct {
-	repo CouponRepo
-	log  *log.Helper
+	repo           CouponRepo
+	attemptRepo    ValidationAttemptRepo
+	userCouponRepo UserCouponRepo
+	audienceRepo   AudienceRepo
+	campaignRepo   CampaignRepo
+	history        UserHistoryProvider
+	notifier       BudgetNotifier
+	log            *log.Helper
 }
 
 // NewCouponUseCase 创建优惠券用例
-func NewCouponUseCase(repo CouponRepo, logger log.Logger) *CouponUseCase {
+func NewCouponUseCase(repo CouponRepo, attemptRepo ValidationAttemptRepo, userCouponRepo UserCouponRepo, audienceRepo AudienceRepo, campaignRepo CampaignRepo, history UserHistoryProvider, notifier BudgetNotifier, logger log.Logger) *CouponUseCase {
 	return &CouponUseCase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:           repo,
+		attemptRepo:    attemptRepo,
+		userCouponRepo: userCouponRepo,
+		audienceRepo:   audienceRepo,
+		campaignRepo:   campaignRepo,
+		history:        history,
+		notifier:       notifier,
+		log:            log.NewHelper(logger),
 	}
 }
 
 // Create 创建优惠券
 func (uc *CouponUseCase) Create(ctx context.Context, c *Coupon) (*Coupon, error) {
+	applyCouponDefaults(c, time.Now())
+	// 验证货币单位是否有效（数据库 enum 会再次验证，但这里可以提前发现问题）
+	if !isValidCurrency(c.Currency) {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if !validValidDays(c.ValidDays) || c.ClaimLimit < 0 || c.ClaimStock < 0 || !validLimits(c) || c.Budget < 0 {
+		return nil, errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	if !validEligibility(c.Eligibility) || scheduleViolation(c.Timezone, c.Schedule) != "" || pacingViolation(c) != "" {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if err := uc.checkAudienceRef(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := uc.checkCampaignRef(ctx, c); err != nil {
+		return nil, err
+	}
+	return uc.repo.Save(ctx, c)
+}
+
+// applyCouponDefaults 补全新建优惠券的默认值（创建和导入共用）
+func applyCouponDefaults(c *Coupon, now time.Time) {
 	if c.Status == "" {
 		c.Status = constants.CouponStatusActive
 	}
-	// 确保创建时 UsedCount 为 0
-	if c.UsedCount == 0 {
-		c.UsedCount = 0
-	}
+	// 确保创建时 UsedCount、ClaimedCount、BudgetUsed 为 0
+	c.UsedCount = 0
+	c.ClaimedCount = 0
+	c.BudgetUsed = 0
 	// 如果货币单位为空，设置默认值为 CNY
 	if c.Currency == "" {
 		c.Currency = constants.CouponCurrencyCNY
 	}
-	// 验证货币单位是否有效（数据库 enum 会再次验证，但这里可以提前发现问题）
-	if !isValidCurrency(c.Currency) {
-		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
-	}
-	now := time.Now()
 	if c.CreatedAt.IsZero() {
 		c.CreatedAt = now
 	}
 	if c.UpdatedAt.IsZero() {
 		c.UpdatedAt = now
 	}
-	return uc.repo.Save(ctx, c)
 }
 
 // isValidCurrency 验证货币单位是否有效
@@ -138,20 +308,35 @@ func (uc *CouponUseCase) Get(ctx context.Context, code string) (*Coupon, error)
 }
 
 // List 列出优惠券
-func (uc *CouponUseCase) List(ctx context.Context, appID, status string, page, pageSize int) ([]*Coupon, int64, error) {
-	return uc.repo.List(ctx, appID, status, page, pageSize)
+func (uc *CouponUseCase) List(ctx context.Context, filter *CouponFilter, page, pageSize int) ([]*Coupon, int64, error) {
+	if err := normalizeCouponFilter(filter); err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// normalizeCouponFilter 补全默认排序并校验筛选条件（列表和导出共用）
+func normalizeCouponFilter(filter *CouponFilter) error {
+	if filter.SortBy == "" {
+		filter.SortBy = constants.CouponSortByCreatedAt
+	}
+	if filter.SortOrder == "" {
+		filter.SortOrder = constants.SortOrderDesc
+	}
+	// 验证货币单位是否有效（如果提供了货币单位）
+	if filter.Currency != "" && !isValidCurrency(filter.Currency) {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if !validTimeRange(filter.ActiveFrom, filter.ActiveUntil) || !validTimeRange(filter.CreatedFrom, filter.CreatedTo) {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	return nil
 }
 
 // Update 更新优惠券
 func (uc *CouponUseCase) Update(ctx context.Context, c *Coupon) (*Coupon, error) {
 	// 业务规则验证
-	if !c.ValidFrom.IsZero() && !c.ValidUntil.IsZero() && !c.ValidFrom.Before(c.ValidUntil) {
-		return nil, errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
-	}
-	if c.DiscountValue <= 0 {
-		return nil, errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
-	}
-	if c.DiscountType == constants.CouponDiscountTypePercent && c.DiscountValue > 100 {
+	if couponRuleViolation(c) != "" {
 		return nil, errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
 	}
 	// 验证货币单位是否有效（如果提供了货币单位）
@@ -159,52 +344,335 @@ func (uc *CouponUseCase) Update(ctx context.Context, c *Coupon) (*Coupon, error)
 		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
 	}
 
+	if err := uc.checkAudienceRef(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := uc.checkCampaignRef(ctx, c); err != nil {
+		return nil, err
+	}
+
 	c.UpdatedAt = time.Now()
 	return uc.repo.Update(ctx, c)
 }
 
+// couponRuleViolation 检查优惠券业务规则（更新和导入共用），返回违反的规则说明，通过时返回空字符串
+func couponRuleViolation(c *Coupon) string {
+	if !c.ValidFrom.IsZero() && !c.ValidUntil.IsZero() && !c.ValidFrom.Before(c.ValidUntil) {
+		return "validFrom must be before validUntil"
+	}
+	if c.DiscountValue <= 0 {
+		return "discountValue must be greater than 0"
+	}
+	if c.DiscountType == constants.CouponDiscountTypePercent && c.DiscountValue > 100 {
+		return "percent discountValue must not exceed 100"
+	}
+	if !validValidDays(c.ValidDays) {
+		return "validDays must be between 0 and 3650"
+	}
+	if c.ClaimLimit < 0 || c.ClaimStock < 0 {
+		return "claimLimit and claimStock must not be negative"
+	}
+	if !validEligibility(c.Eligibility) {
+		return "eligibility must be FIRST_COUPON_USE or FIRST_ORDER"
+	}
+	if v := scheduleViolation(c.Timezone, c.Schedule); v != "" {
+		return v
+	}
+	if !validLimits(c) {
+		return "usage limits must not be negative"
+	}
+	if c.Budget < 0 {
+		return "budget must not be negative"
+	}
+	if v := pacingViolation(c); v != "" {
+		return v
+	}
+	return ""
+}
+
+// checkAudienceRef 检查优惠券引用的受众存在且属于同一应用
+func (uc *CouponUseCase) checkAudienceRef(ctx context.Context, c *Coupon) error {
+	if c.AudienceID == "" {
+		return nil
+	}
+	_, err := uc.audienceRepo.FindByID(ctx, c.AppID, c.AudienceID)
+	return err
+}
+
+// checkCampaignRef 检查优惠券关联的活动存在且属于同一应用；活动设置了折扣预算时优惠券币种必须与活动一致
+func (uc *CouponUseCase) checkCampaignRef(ctx context.Context, c *Coupon) error {
+	if c.CampaignID == "" {
+		return nil
+	}
+	campaign, err := uc.campaignRepo.FindByID(ctx, c.AppID, c.CampaignID)
+	if err != nil {
+		return err
+	}
+	if campaign.HasBudget() && c.Currency != campaign.Currency {
+		return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	return nil
+}
+
+// validLimits 频次限制是否合法（0 表示不限）
+func validLimits(c *Coupon) bool {
+	return c.UserDailyLimit >= 0 && c.UserWeeklyLimit >= 0 && c.UserMonthlyLimit >= 0 && c.DailyLimit >= 0
+}
+
+// validEligibility 新客限制是否合法
+func validEligibility(eligibility string) bool {
+	switch eligibility {
+	case "", constants.CouponEligibilityFirstCouponUse, constants.CouponEligibilityFirstOrder:
+		return true
+	}
+	return false
+}
+
+// validValidDays 相对有效期天数是否合法（0 表示使用优惠券本身的有效期）
+func validValidDays(days int32) bool {
+	return days >= 0 && days <= maxValidDays
+}
+
+// Clone 以新优惠码复制应用内已有的优惠券：折扣规则和使用限制不变，有效期平移到 validFrom（零值表示当前时间），时长不变
+// 不可用日期按相同的天数平移；绑定用户默认不复制，keepBoundUser 为 true 时保留
+func (uc *CouponUseCase) Clone(ctx context.Context, appID, sourceCode, newCode string, validFrom time.Time, keepBoundUser bool) (*Coupon, error) {
+	src, err := uc.repo.FindByCode(ctx, sourceCode)
+	if err != nil {
+		return nil, err
+	}
+	if src == nil || src.AppID != appID {
+		return nil, errors.NewBizError(errors.ErrCodeNotFound, "zh-CN")
+	}
+	if validFrom.IsZero() {
+		validFrom = time.Now()
+	}
+	boundUserID := ""
+	if keepBoundUser {
+		boundUserID = src.BoundUserID
+	}
+	loc := scheduleLocation(src.Timezone)
+	return uc.Create(ctx, &Coupon{
+		CouponCode:       newCode,
+		AppID:            appID,
+		DiscountType:     src.DiscountType,
+		DiscountValue:    src.DiscountValue,
+		Currency:         src.Currency,
+		ValidFrom:        validFrom,
+		ValidUntil:       validFrom.Add(src.ValidUntil.Sub(src.ValidFrom)),
+		MaxUses:          src.MaxUses,
+		MinAmount:        src.MinAmount,
+		ValidDays:        src.ValidDays,
+		ClaimLimit:       src.ClaimLimit,
+		ClaimStock:       src.ClaimStock,
+		BoundUserID:      boundUserID,
+		AudienceID:       src.AudienceID,
+		Eligibility:      src.Eligibility,
+		Timezone:         src.Timezone,
+		Schedule:         src.Schedule.shiftBlackoutDates(calendarDaysBetween(src.ValidFrom, validFrom, loc)),
+		UserDailyLimit:   src.UserDailyLimit,
+		UserWeeklyLimit:  src.UserWeeklyLimit,
+		UserMonthlyLimit: src.UserMonthlyLimit,
+		DailyLimit:       src.DailyLimit,
+		CampaignID:       src.CampaignID,
+		Budget:           src.Budget,
+		Pacing:           src.Pacing,
+	})
+}
+
 // Delete 删除优惠券
 func (uc *CouponUseCase) Delete(ctx context.Context, code string) error {
 	return uc.repo.Delete(ctx, code)
 }
 
 // Validate 验证优惠券（供 Payment Service 调用）
-func (uc *CouponUseCase) Validate(ctx context.Context, code, appID string, amount int64) (*Coupon, int64, error) {
+// attrs 为调用方传入的用户属性，用于 TAG/SEGMENT 受众求值；每次调用都会异步记录一条验证尝试，用于转化漏斗统计
+func (uc *CouponUseCase) Validate(ctx context.Context, code, appID, userID string, amount int64, attrs map[string]string) (*ValidateResult, error) {
 	coupon, err := uc.repo.FindByCode(ctx, code)
 	if err != nil {
-		return nil, 0, err
+		// 查询失败（含优惠券不存在）统一记为 NOT_FOUND
+		uc.recordAttempt(ctx, code, appID, userID, amount, constants.ValidateReasonNotFound)
+		return nil, err
 	}
 	if coupon == nil {
-		return nil, 0, nil
+		uc.recordAttempt(ctx, code, appID, userID, amount, constants.ValidateReasonNotFound)
+		return &ValidateResult{Reason: constants.ValidateReasonNotFound}, nil
 	}
 
+	now := time.Now()
+	result := &ValidateResult{
+		Coupon: coupon,
+		Reason: checkCoupon(coupon, appID, userID, amount, now),
+	}
+	// 关联了活动的优惠券每次都重新读取活动状态，活动暂停后立即不可用
+	var campaign *Campaign
+	if result.Valid() && coupon.CampaignID != "" {
+		if campaign, result.Reason, err = uc.checkCampaign(ctx, coupon, now); err != nil {
+			return nil, err
+		}
+	}
+	// 设置了可用时段的优惠券检查当前时刻，不可用时返回下一个可用时刻
+	if result.Valid() && coupon.HasSchedule() {
+		if next := coupon.NextAvailableAt(now); !next.Equal(now) {
+			result.Reason = constants.ValidateReasonOutsideSchedule
+			result.NextAvailableAt = next
+		}
+	}
+	// 相对有效期优惠券检查该用户持有的实例
+	if result.Valid() && coupon.IsRelative() {
+		if result.Reason, err = uc.checkUserCoupon(ctx, coupon, userID, now); err != nil {
+			return nil, err
+		}
+	}
+	// 引用了受众的优惠券检查用户是否属于受众
+	if result.Valid() && coupon.AudienceID != "" {
+		if result.Reason, err = uc.checkAudience(ctx, coupon, userID, attrs); err != nil {
+			return nil, err
+		}
+	}
+	// 新客优惠券检查用户历史
+	if result.Valid() && coupon.Eligibility != "" {
+		if result.Reason, err = uc.checkEligibility(ctx, coupon, userID, ""); err != nil {
+			return nil, err
+		}
+	}
+	// 计算频次限制的剩余额度，任一窗口用尽时不可用
+	if result.Valid() {
+		if result.Reason, err = uc.checkLimits(ctx, coupon, userID, now, result); err != nil {
+			return nil, err
+		}
+	}
+	// 设置了投放节奏的优惠券检查当前时间片的使用次数额度，用完时返回下一个释放额度的时刻
+	if result.Valid() {
+		result.Reason = checkPacedUses(coupon, now, result)
+	}
+	result.BudgetRemaining = -1
+	if result.Valid() {
+		result.DiscountAmount = calculateDiscount(coupon, amount)
+		// 剩余折扣预算不足时削减折扣金额，预算已用尽时不可用
+		result.Reason = applyBudget(coupon, campaign, now, result)
+		if !result.Valid() {
+			result.DiscountAmount = 0
+		}
+	}
+	uc.recordAttempt(ctx, code, appID, userID, amount, result.Reason)
+
+	return result, nil
+}
+
+// checkCampaign 检查优惠券所属活动是否进行中，返回活动（用于预算检查）和验证结果原因
+func (uc *CouponUseCase) checkCampaign(ctx context.Context, coupon *Coupon, now time.Time) (*Campaign, string, error) {
+	campaign, err := uc.campaignRepo.FindByID(ctx, coupon.AppID, coupon.CampaignID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !campaign.IsRunning(now) {
+		return campaign, constants.ValidateReasonCampaignInactive, nil
+	}
+	return campaign, constants.ValidateReasonOK, nil
+}
+
+// checkLimits 计算频次限制的剩余额度并写入 result.Quotas，返回验证结果原因
+// 未传 userID 时只能计算全局限制，按用户的限制在使用时检查
+func (uc *CouponUseCase) checkLimits(ctx context.Context, coupon *Coupon, userID string, now time.Time, result *ValidateResult) (string, error) {
+	windows := coupon.LimitWindows(userID, now)
+	used := make([]int64, 0)
+	if len(windows) > 0 {
+		var err error
+		if used, err = uc.repo.CountLimitUsage(ctx, coupon.CouponCode, windows); err != nil {
+			return "", err
+		}
+	}
+	quotas, exceeded := buildQuotas(coupon, windows, used)
+	result.Quotas = quotas
+	if exceeded {
+		return constants.ValidateReasonLimitExceeded, nil
+	}
+	return constants.ValidateReasonOK, nil
+}
+
+// checkAudience 检查用户是否属于优惠券的受众，返回验证结果原因
+func (uc *CouponUseCase) checkAudience(ctx context.Context, coupon *Coupon, userID string, attrs map[string]string) (string, error) {
+	audience, err := uc.audienceRepo.FindByID(ctx, coupon.AppID, coupon.AudienceID)
+	if err != nil {
+		return "", err
+	}
+	ok, err := audienceMatches(ctx, uc.audienceRepo, audience, userID, attrs)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return constants.ValidateReasonNotInAudience, nil
+	}
+	return constants.ValidateReasonOK, nil
+}
+
+// checkEligibility 检查用户是否满足新客限制，返回验证结果原因
+// 两种限制都要求用户在应用内没有使用过优惠券（同一支付订单叠加使用的除外）；FIRST_ORDER 还要求用户历史中没有订单
+func (uc *CouponUseCase) checkEligibility(ctx context.Context, coupon *Coupon, userID, paymentOrderID string) (string, error) {
+	if userID == "" {
+		return constants.ValidateReasonNotNewCustomer, nil
+	}
+	used, err := uc.repo.HasPriorUsage(ctx, coupon.AppID, userID, paymentOrderID)
+	if err != nil {
+		return "", err
+	}
+	if used {
+		return constants.ValidateReasonNotNewCustomer, nil
+	}
+	if coupon.Eligibility == constants.CouponEligibilityFirstOrder {
+		ordered, err := uc.history.HasOrdered(ctx, coupon.AppID, userID, paymentOrderID)
+		if err != nil {
+			return "", err
+		}
+		if ordered {
+			return constants.ValidateReasonNotNewCustomer, nil
+		}
+	}
+	return constants.ValidateReasonOK, nil
+}
+
+// checkCoupon 检查优惠券在当前时刻对该订单是否可用，返回验证结果原因
+func checkCoupon(coupon *Coupon, appID, userID string, amount int64, now time.Time) string {
 	// 检查应用ID
 	if coupon.AppID != appID {
-		return nil, 0, nil
+		return constants.ValidateReasonAppMismatch
+	}
+
+	// 检查绑定用户
+	if !coupon.BoundTo(userID) {
+		return constants.ValidateReasonUserMismatch
 	}
 
 	// 检查状态
 	if coupon.Status != constants.CouponStatusActive {
-		return nil, 0, nil
+		return constants.ValidateReasonInactive
 	}
 
-	// 检查有效期
-	now := time.Now()
-	if now.Before(coupon.ValidFrom) || now.After(coupon.ValidUntil) {
-		return nil, 0, nil
+	// 检查有效期（相对有效期优惠券的有效期是发放期，使用时改为检查用户持有的实例）
+	if !coupon.IsRelative() {
+		if now.Before(coupon.ValidFrom) {
+			return constants.ValidateReasonNotStarted
+		}
+		if now.After(coupon.ValidUntil) {
+			return constants.ValidateReasonExpired
+		}
 	}
 
 	// 检查使用次数（MaxUses = 0 表示无限制）
 	if coupon.MaxUses > 0 && coupon.UsedCount >= coupon.MaxUses {
-		return nil, 0, nil
+		return constants.ValidateReasonExhausted
 	}
 
 	// 检查最低消费金额
 	if amount < coupon.MinAmount {
-		return nil, 0, nil
+		return constants.ValidateReasonBelowMinAmount
 	}
 
-	// 计算折扣金额
+	return constants.ValidateReasonOK
+}
+
+// calculateDiscount 计算折扣金额
+func calculateDiscount(coupon *Coupon, amount int64) int64 {
 	var discountAmount int64
 	if coupon.DiscountType == constants.CouponDiscountTypePercent {
 		discountAmount = amount * coupon.DiscountValue / 100
@@ -215,23 +683,80 @@ func (uc *CouponUseCase) Validate(ctx context.Context, code, appID string, amoun
 			discountAmount = amount
 		}
 	}
+	return discountAmount
+}
 
-	return coupon, discountAmount, nil
+// recordAttempt 异步记录验证尝试
+func (uc *CouponUseCase) recordAttempt(ctx context.Context, code, appID, userID string, amount int64, reason string) {
+	uc.attemptRepo.Record(ctx, &ValidationAttempt{
+		AttemptID:   GenerateShortID(),
+		CouponCode:  code,
+		AppID:       appID,
+		UserID:      userID,
+		Amount:      amount,
+		Reason:      reason,
+		AttemptedAt: time.Now(),
+	})
 }
 
 // Use 使用优惠券（供 Payment Service 调用）
 // 注意：需要在事务中执行，确保数据一致性
 // paymentOrderID: payment-service的业务订单号orderId
 func (uc *CouponUseCase) Use(ctx context.Context, code, appID string, userID string, paymentOrderID, paymentID string, originalAmount, discountAmount, finalAmount int64) error {
+	// 检查可用时段；首单优惠券先查询外部订单历史（不能放在数据库事务内）；使用记录历史由 Repository 在事务内再次检查
+	coupon, err := uc.repo.FindByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if coupon.HasSchedule() && !coupon.NextAvailableAt(now).Equal(now) {
+		return errors.NewBizError(marketingErrors.ErrCodeCouponOutsideSchedule, "zh-CN")
+	}
+	// 投放节奏：按已读取的计数提前拒绝，Repository 在事务内按最新计数再次检查
+	if !coupon.WithinPace(coupon.UsedCount+1, coupon.BudgetUsed+discountAmount, now) {
+		return errors.NewBizError(marketingErrors.ErrCodeCouponPaceLimited, "zh-CN")
+	}
+	if coupon.Eligibility == constants.CouponEligibilityFirstOrder {
+		ordered, err := uc.history.HasOrdered(ctx, appID, userID, paymentOrderID)
+		if err != nil {
+			return err
+		}
+		if ordered {
+			return errors.NewBizError(marketingErrors.ErrCodeCouponNotNewCustomer, "zh-CN")
+		}
+	}
+
 	// 使用事务确保原子性：先增加使用次数，再创建使用记录
 	// 如果创建使用记录失败，需要回滚使用次数的增加
 	// 注意：这里依赖 Repository 层的事务支持，如果 Repository 不支持事务，需要在 UseCase 层实现
-	return uc.repo.UseCoupon(ctx, code, appID, userID, paymentOrderID, paymentID, originalAmount, discountAmount, finalAmount)
+	// 频次限制由 Repository 用 Redis 计数预占额度，Redis 不可用时在事务内按使用记录检查
+	// 折扣预算在事务内检查并累加，用尽时自动停用优惠券或暂停活动
+	events, err := uc.repo.UseCoupon(ctx, code, appID, userID, paymentOrderID, paymentID, originalAmount, discountAmount, finalAmount, coupon.LimitWindows(userID, now))
+	if err != nil {
+		return err
+	}
+	// 事务提交后发送预算告警事件
+	for _, event := range events {
+		uc.notifier.Notify(ctx, event)
+	}
+	return nil
 }
 
 // GetStats 获取优惠券统计
-func (uc *CouponUseCase) GetStats(ctx context.Context, code string) (*CouponStats, error) {
-	return uc.repo.GetStats(ctx, code)
+func (uc *CouponUseCase) GetStats(ctx context.Context, code string, exactUniqueUsers bool) (*CouponStats, error) {
+	stats, err := uc.repo.GetStats(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	stats.UniqueUsers, stats.UniqueUsersExact, err = uc.repo.CountUniqueUsers(ctx, &UniqueUsersQuery{
+		CouponCode: code,
+		Exact:      exactUniqueUsers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats.ConversionRate, stats.ValidRate, stats.RedemptionRate = funnelRates(stats.ValidationAttempts, stats.ValidAttempts, stats.TotalUses)
+	return stats, nil
 }
 
 // ListUsages 列出优惠券使用记录
@@ -239,7 +764,96 @@ func (uc *CouponUseCase) ListUsages(ctx context.Context, code string, page, page
 	return uc.repo.ListUsages(ctx, code, page, pageSize)
 }
 
+// ListUsagesByUser 按用户列出使用记录（限定在调用方应用内）
+func (uc *CouponUseCase) ListUsagesByUser(ctx context.Context, appID, userID string, usedFrom, usedTo time.Time, page, pageSize int) ([]*CouponUsage, int64, error) {
+	if !validTimeRange(usedFrom, usedTo) {
+		return nil, 0, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	return uc.repo.ListUsagesByFilter(ctx, &CouponUsageFilter{
+		AppID:    appID,
+		UserID:   userID,
+		UsedFrom: usedFrom,
+		UsedTo:   usedTo,
+	}, page, pageSize)
+}
+
+// GetUsageByPaymentOrder 按支付订单查询使用记录（限定在调用方应用内）
+func (uc *CouponUseCase) GetUsageByPaymentOrder(ctx context.Context, appID, paymentOrderID string, usedFrom, usedTo time.Time) (*CouponUsage, error) {
+	if !validTimeRange(usedFrom, usedTo) {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	return uc.repo.FindUsage(ctx, &CouponUsageFilter{
+		AppID:          appID,
+		PaymentOrderID: paymentOrderID,
+		UsedFrom:       usedFrom,
+		UsedTo:         usedTo,
+	})
+}
+
+// GetUsageByPaymentID 按支付流水号查询使用记录（限定在调用方应用内）
+func (uc *CouponUseCase) GetUsageByPaymentID(ctx context.Context, appID, paymentID string, usedFrom, usedTo time.Time) (*CouponUsage, error) {
+	if !validTimeRange(usedFrom, usedTo) {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	return uc.repo.FindUsage(ctx, &CouponUsageFilter{
+		AppID:     appID,
+		PaymentID: paymentID,
+		UsedFrom:  usedFrom,
+		UsedTo:    usedTo,
+	})
+}
+
+// validTimeRange 检查时间范围（任一端为零值表示不限制）
+func validTimeRange(from, to time.Time) bool {
+	return from.IsZero() || to.IsZero() || !from.After(to)
+}
+
 // GetSummaryStats 获取汇总统计
-func (uc *CouponUseCase) GetSummaryStats(ctx context.Context, appID string) (*SummaryStats, error) {
-	return uc.repo.GetSummaryStats(ctx, appID)
+func (uc *CouponUseCase) GetSummaryStats(ctx context.Context, q *SummaryStatsQuery) (*SummaryStats, error) {
+	if q.TopN <= 0 {
+		q.TopN = defaultSummaryTopN
+	}
+	if q.TopN > maxSummaryTopN {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	switch q.RankBy {
+	case "":
+		q.RankBy = constants.StatsRankByUses
+	case constants.StatsRankByUses, constants.StatsRankByRevenue, constants.StatsRankByDiscount, constants.StatsRankByDistinctUsers:
+	default:
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if !validTimeRange(q.From, q.To) {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+
+	stats, err := uc.repo.GetSummaryStats(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	stats.UniqueUsers, stats.UniqueUsersExact, err = uc.repo.CountUniqueUsers(ctx, &UniqueUsersQuery{
+		AppID: q.AppID,
+		From:  q.From,
+		To:    q.To,
+		Exact: q.ExactUniqueUsers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats.ConversionRate, stats.ValidRate, stats.RedemptionRate = funnelRates(stats.TotalAttempts, stats.TotalValidAttempts, stats.TotalUses)
+
+	// 平均转化率：只统计有验证记录的优惠券
+	var totalConversionRate float32
+	var attemptedCoupons int32
+	for _, cs := range stats.TopCoupons {
+		cs.ConversionRate, cs.ValidRate, cs.RedemptionRate = funnelRates(cs.ValidationAttempts, cs.ValidAttempts, cs.TotalUses)
+		if cs.ValidationAttempts > 0 {
+			totalConversionRate += cs.ConversionRate
+			attemptedCoupons++
+		}
+	}
+	if attemptedCoupons > 0 {
+		stats.AverageConversionRate = totalConversionRate / float32(attemptedCoupons)
+	}
+	return stats, nil
 }
@@ -0,0 +1,35 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// ValidationAttempt 优惠券验证尝试（轻量事件，用于转化漏斗统计）
+type ValidationAttempt struct {
+	AttemptID   string
+	CouponCode  string
+	AppID       string    // 调用方应用ID
+	UserID      string    // 用户ID（调用方未传时为空）
+	Amount      int64     // 订单金额(分)
+	Reason      string    // 验证结果原因，见 constants.ValidateReason*
+	AttemptedAt time.Time // 验证时间
+}
+
+// ValidationAttemptRepo 验证尝试记录仓储接口
+type ValidationAttemptRepo interface {
+	// Record 异步记录一次验证尝试，不阻塞调用方；缓冲区满或写入失败时只记录日志
+	Record(context.Context, *ValidationAttempt)
+}
+
+// funnelRates 计算转化漏斗比率（百分比）：验证 → 验证通过 → 使用
+func funnelRates(attempts, valid, uses int32) (conversionRate, validRate, redemptionRate float32) {
+	if attempts > 0 {
+		conversionRate = float32(uses) / float32(attempts) * 100
+		validRate = float32(valid) / float32(attempts) * 100
+	}
+	if valid > 0 {
+		redemptionRate = float32(uses) / float32(valid) * 100
+	}
+	return conversionRate, validRate, redemptionRate
+}
@@ -0,0 +1,146 @@
+package biz
+
+import (
+	"context"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+
+	"github.com/gaoyong06/go-pkg/errors"
+)
+
+const (
+	maxBatchCoupons = 5000 // 单次批量操作最多涉及的优惠券数量
+	batchChunkSize  = 200  // 每个事务处理的优惠券数量
+)
+
+// CouponBatchOp 批量操作请求（Codes 与 Filter 二选一）
+type CouponBatchOp struct {
+	AppID    string
+	Codes    []string      // 指定优惠码
+	Filter   *CouponFilter // 按列表筛选条件选择优惠券
+	Status   string        // 目标状态（仅批量修改状态）
+	Operator string        // 操作人（写入审计日志）
+	Reason   string        // 操作原因（写入审计日志）
+}
+
+// CouponAudit 审计日志公共字段
+type CouponAudit struct {
+	BatchID  string
+	Operator string
+	Reason   string
+}
+
+// CouponBatchItem 批量操作单个优惠券的结果
+type CouponBatchItem struct {
+	CouponCode string
+	Result     string // 见 constants.BatchItemResult*
+	OldStatus  string // 操作前状态（优惠券不存在时为空）
+	Message    string // 失败原因
+}
+
+// CouponBatchResult 批量操作结果
+type CouponBatchResult struct {
+	BatchID  string // 批量操作ID，对应审计日志的 batch_id
+	Matched  int32  // 选中的优惠券数量
+	Affected int32  // 实际修改或删除的数量
+	Failed   int32  // 失败数量
+	Items    []*CouponBatchItem
+}
+
+// BatchUpdateStatus 批量修改优惠券状态，每批在一个事务内执行，每个状态发生变化的优惠券记录一条审计日志
+func (uc *CouponUseCase) BatchUpdateStatus(ctx context.Context, op *CouponBatchOp) (*CouponBatchResult, error) {
+	if op.Status != constants.CouponStatusActive && op.Status != constants.CouponStatusInactive {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	return uc.runBatch(ctx, op, func(codes []string, audit *CouponAudit) ([]*CouponBatchItem, error) {
+		return uc.repo.UpdateStatusBatch(ctx, op.AppID, codes, op.Status, audit)
+	})
+}
+
+// BatchDelete 批量删除优惠券（软删除），每批在一个事务内执行，每个被删除的优惠券记录一条审计日志
+func (uc *CouponUseCase) BatchDelete(ctx context.Context, op *CouponBatchOp) (*CouponBatchResult, error) {
+	return uc.runBatch(ctx, op, func(codes []string, audit *CouponAudit) ([]*CouponBatchItem, error) {
+		return uc.repo.DeleteBatch(ctx, op.AppID, codes, audit)
+	})
+}
+
+// runBatch 解析要操作的优惠码并分批执行；某批事务失败时该批全部记为失败，继续处理后续批次
+func (uc *CouponUseCase) runBatch(ctx context.Context, op *CouponBatchOp, apply func([]string, *CouponAudit) ([]*CouponBatchItem, error)) (*CouponBatchResult, error) {
+	codes, err := uc.resolveBatchCodes(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	audit := &CouponAudit{BatchID: GenerateShortID(), Operator: op.Operator, Reason: op.Reason}
+	result := &CouponBatchResult{
+		BatchID: audit.BatchID,
+		Matched: int32(len(codes)),
+		Items:   make([]*CouponBatchItem, 0, len(codes)),
+	}
+	for start := 0; start < len(codes); start += batchChunkSize {
+		end := start + batchChunkSize
+		if end > len(codes) {
+			end = len(codes)
+		}
+		chunk := codes[start:end]
+		items, err := apply(chunk, audit)
+		if err != nil {
+			uc.log.Errorf("batch %s failed for %d coupons: %v", audit.BatchID, len(chunk), err)
+			items = make([]*CouponBatchItem, 0, len(chunk))
+			for _, code := range chunk {
+				items = append(items, &CouponBatchItem{CouponCode: code, Result: constants.BatchItemResultFailed, Message: err.Error()})
+			}
+		}
+		for _, item := range items {
+			switch item.Result {
+			case constants.BatchItemResultUpdated, constants.BatchItemResultDeleted:
+				result.Affected++
+			case constants.BatchItemResultFailed:
+				result.Failed++
+			}
+		}
+		result.Items = append(result.Items, items...)
+	}
+	return result, nil
+}
+
+// resolveBatchCodes 返回要操作的优惠码（去重并保持顺序）
+func (uc *CouponUseCase) resolveBatchCodes(ctx context.Context, op *CouponBatchOp) ([]string, error) {
+	if (len(op.Codes) > 0) == (op.Filter != nil) {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+
+	if len(op.Codes) > 0 {
+		if len(op.Codes) > maxBatchCoupons {
+			return nil, errors.NewBizError(marketingErrors.ErrCodeBatchTooManyCoupons, "zh-CN")
+		}
+		seen := make(map[string]bool, len(op.Codes))
+		codes := make([]string, 0, len(op.Codes))
+		for _, code := range op.Codes {
+			if code == "" || seen[code] {
+				continue
+			}
+			seen[code] = true
+			codes = append(codes, code)
+		}
+		return codes, nil
+	}
+
+	op.Filter.AppID = op.AppID
+	if err := normalizeCouponFilter(op.Filter); err != nil {
+		return nil, err
+	}
+	coupons, total, err := uc.repo.List(ctx, op.Filter, 1, maxBatchCoupons)
+	if err != nil {
+		return nil, err
+	}
+	if total > maxBatchCoupons {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeBatchTooManyCoupons, "zh-CN")
+	}
+	codes := make([]string, 0, len(coupons))
+	for _, c := range coupons {
+		codes = append(codes, c.CouponCode)
+	}
+	return codes, nil
+}
@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/constants"
+)
+
+// BudgetEvent 折扣预算告警事件：已使用的折扣金额跨过告警阈值（80%、100%）时产生
+type BudgetEvent struct {
+	Scope     string    // 预算所属对象，见 constants.BudgetScope*
+	ID        string    // 优惠码或活动ID
+	AppID     string    // 应用ID
+	Budget    int64     // 折扣预算(分)
+	Used      int64     // 已使用的折扣金额(分)
+	Threshold int64     // 跨过的阈值（已使用百分比）
+	At        time.Time // 跨过阈值的时间
+}
+
+// BudgetNotifier 折扣预算告警通知（尽力而为，失败不影响使用结果）
+// 未接入通知渠道时使用 data 层的日志实现
+type BudgetNotifier interface {
+	Notify(ctx context.Context, event *BudgetEvent)
+}
+
+// HasBudget 是否设置了折扣预算
+func (c *Coupon) HasBudget() bool {
+	return c.Budget > 0
+}
+
+// HasBudget 是否设置了折扣预算（活动下所有优惠券合计）
+func (c *Campaign) HasBudget() bool {
+	return c.Budget > 0
+}
+
+// budgetRemaining 剩余折扣预算（不会小于 0）
+func budgetRemaining(budget, used int64) int64 {
+	if used >= budget {
+		return 0
+	}
+	return budget - used
+}
+
+// NewBudgetEvents 已使用金额从 before 增加到 after 时，为跨过的每个告警阈值创建预算事件
+func NewBudgetEvents(scope, id, appID string, budget, before, after int64, at time.Time) []*BudgetEvent {
+	if budget <= 0 {
+		return nil
+	}
+	var events []*BudgetEvent
+	for _, t := range constants.BudgetThresholds {
+		if before*100 < budget*t && after*100 >= budget*t {
+			events = append(events, &BudgetEvent{
+				Scope:     scope,
+				ID:        id,
+				AppID:     appID,
+				Budget:    budget,
+				Used:      after,
+				Threshold: t,
+				At:        at,
+			})
+		}
+	}
+	return events
+}
+
+// applyBudget 按优惠券和所属活动的剩余预算限制折扣金额，预算已用尽时返回 BUDGET_EXHAUSTED
+// 预算按投放节奏分摊时，当前时间片的额度用完返回 PACE_LIMITED 并写入下一个释放额度的时刻
+// campaign 为 nil 表示不属于活动
+func applyBudget(coupon *Coupon, campaign *Campaign, now time.Time, result *ValidateResult) string {
+	remaining := int64(-1)
+	if coupon.HasBudget() {
+		remaining = budgetRemaining(coupon.Budget, coupon.BudgetUsed)
+	}
+	if campaign != nil && campaign.HasBudget() {
+		if r := budgetRemaining(campaign.Budget, campaign.BudgetUsed); remaining < 0 || r < remaining {
+			remaining = r
+		}
+	}
+	if remaining == 0 {
+		result.BudgetRemaining = 0
+		return constants.ValidateReasonBudgetExhausted
+	}
+	if paced := pacedBudgetRemaining(coupon, now); paced >= 0 && (remaining < 0 || paced < remaining) {
+		remaining = paced
+		if remaining == 0 {
+			result.BudgetRemaining = 0
+			result.NextAvailableAt = coupon.nextPacedRelease(coupon.Budget, coupon.BudgetUsed, now)
+			return constants.ValidateReasonPaceLimited
+		}
+	}
+	result.BudgetRemaining = remaining
+	if remaining < 0 {
+		return constants.ValidateReasonOK
+	}
+	if result.DiscountAmount > remaining {
+		result.DiscountAmount = remaining
+		result.BudgetClamped = true
+	}
+	return constants.ValidateReasonOK
+}
@@ -0,0 +1,374 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+	"marketing-service/internal/export"
+
+	"github.com/gaoyong06/go-pkg/errors"
+)
+
+const (
+	maxImportRows   = 1000 // 单次导入最多行数（不含标题行）
+	importBatchSize = 200  // 分批写入的每批行数
+)
+
+// CouponImport 批量导入请求
+type CouponImport struct {
+	AppID   string
+	Mode    string    // 导入模式，见 constants.ImportMode*，默认全部或不导入
+	DryRun  bool      // 仅预览校验结果，不写入
+	Content io.Reader // CSV 内容（首行为标题行）
+}
+
+// CouponImportRow 批量导入单行结果
+type CouponImportRow struct {
+	Line       int32   // 文件中的行号（标题行为第 1 行）
+	CouponCode string  // 优惠码
+	Status     string  // 结果，见 constants.ImportRowStatus*
+	Message    string  // 失败原因
+	Coupon     *Coupon // 解析出的优惠券（校验失败时为 nil）
+}
+
+// CouponImportResult 批量导入结果
+type CouponImportResult struct {
+	Total     int32 // 数据行数
+	Valid     int32 // 校验通过行数
+	Created   int32 // 实际创建行数
+	Failed    int32 // 失败行数（校验失败或写入失败）
+	Committed bool  // 是否有数据写入
+	Rows      []*CouponImportRow
+}
+
+// 导入列
+const (
+	importColCouponCode    = "couponCode"
+	importColDiscountType  = "discountType"
+	importColDiscountValue = "discountValue"
+	importColCurrency      = "currency"
+	importColValidFrom     = "validFrom"
+	importColValidUntil    = "validUntil"
+	importColMaxUses       = "maxUses"
+	importColMinAmount     = "minAmount"
+	importColStatus        = "status"
+)
+
+// importRequiredColumns 必填列
+var importRequiredColumns = []string{
+	importColCouponCode, importColDiscountType, importColDiscountValue,
+	importColValidFrom, importColValidUntil, importColMaxUses,
+}
+
+// importColumnAliases 列标题到导入列的映射：接受字段名，以及优惠券导出文件的中英文列标题，
+// 导出的文件可以直接修改后导入（应用ID、已使用次数、创建/更新时间等列会被忽略）
+var importColumnAliases = buildImportColumnAliases()
+
+func buildImportColumnAliases() map[string]string {
+	// 与 export.CouponHeaders 的列顺序对应
+	exportColumns := map[int]string{
+		0: importColCouponCode, 2: importColDiscountType, 3: importColDiscountValue, 4: importColCurrency,
+		5: importColValidFrom, 6: importColValidUntil, 7: importColMaxUses, 9: importColMinAmount, 10: importColStatus,
+	}
+	aliases := make(map[string]string)
+	for _, col := range exportColumns {
+		aliases[normalizeImportHeader(col)] = col
+	}
+	for _, lang := range []string{"zh-CN", "en-US"} {
+		headers := export.CouponHeaders(lang)
+		for i, col := range exportColumns {
+			aliases[normalizeImportHeader(headers[i])] = col
+		}
+	}
+	return aliases
+}
+
+// normalizeImportHeader 列标题忽略大小写、空格和下划线（coupon_code、Coupon Code、couponCode 等价）
+func normalizeImportHeader(h string) string {
+	h = strings.ToLower(strings.TrimSpace(h))
+	return strings.NewReplacer(" ", "", "_", "").Replace(h)
+}
+
+// Import 从 CSV 批量导入优惠券
+// 每行使用与创建、更新相同的规则校验；DryRun 只返回校验结果；
+// 全部或不导入模式下任一行失败则不写入，尽力导入模式下写入所有校验通过的行
+func (uc *CouponUseCase) Import(ctx context.Context, in *CouponImport) (*CouponImportResult, error) {
+	if in.Mode == "" {
+		in.Mode = constants.ImportModeAllOrNothing
+	}
+	if in.Mode != constants.ImportModeAllOrNothing && in.Mode != constants.ImportModeBestEffort {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+
+	rows, err := parseCouponImport(in.AppID, in.Content, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 标记文件内重复的优惠码（保留第一次出现的行）
+	seen := make(map[string]bool, len(rows))
+	codes := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Coupon == nil {
+			continue
+		}
+		if seen[row.CouponCode] {
+			row.Status, row.Message, row.Coupon = constants.ImportRowStatusDuplicate, "duplicate couponCode in file", nil
+			continue
+		}
+		seen[row.CouponCode] = true
+		codes = append(codes, row.CouponCode)
+	}
+
+	existing, err := uc.repo.ExistingCodes(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CouponImportResult{Total: int32(len(rows)), Rows: rows}
+	var valid []*CouponImportRow
+	for _, row := range rows {
+		if row.Coupon != nil && existing[row.CouponCode] {
+			row.Status, row.Message, row.Coupon = constants.ImportRowStatusAlreadyExists, "couponCode already exists", nil
+		}
+		if row.Coupon == nil {
+			result.Failed++
+			continue
+		}
+		row.Status = constants.ImportRowStatusOK
+		valid = append(valid, row)
+	}
+	result.Valid = int32(len(valid))
+
+	if in.DryRun || len(valid) == 0 {
+		return result, nil
+	}
+
+	if in.Mode == constants.ImportModeAllOrNothing {
+		if result.Failed > 0 {
+			for _, row := range valid {
+				row.Status = constants.ImportRowStatusNotImported
+			}
+			return result, nil
+		}
+		if err := uc.repo.SaveBatch(ctx, importRowCoupons(valid)); err != nil {
+			return nil, err
+		}
+		for _, row := range valid {
+			row.Status = constants.ImportRowStatusCreated
+		}
+		result.Created = int32(len(valid))
+		result.Committed = true
+		return result, nil
+	}
+
+	// 尽力导入：按批写入，某批失败时逐行重试以定位失败行
+	for start := 0; start < len(valid); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(valid) {
+			end = len(valid)
+		}
+		batch := valid[start:end]
+		if err := uc.repo.SaveBatch(ctx, importRowCoupons(batch)); err == nil {
+			for _, row := range batch {
+				row.Status = constants.ImportRowStatusCreated
+			}
+			result.Created += int32(len(batch))
+			continue
+		}
+		for _, row := range batch {
+			if _, err := uc.repo.Save(ctx, row.Coupon); err != nil {
+				uc.log.Warnf("failed to import coupon %s: %v", row.CouponCode, err)
+				row.Status, row.Message = constants.ImportRowStatusFailed, err.Error()
+				result.Failed++
+				continue
+			}
+			row.Status = constants.ImportRowStatusCreated
+			result.Created++
+		}
+	}
+	result.Committed = result.Created > 0
+	return result, nil
+}
+
+// importRowCoupons 取出各行的优惠券
+func importRowCoupons(rows []*CouponImportRow) []*Coupon {
+	coupons := make([]*Coupon, 0, len(rows))
+	for _, row := range rows {
+		coupons = append(coupons, row.Coupon)
+	}
+	return coupons
+}
+
+// parseCouponImport 解析 CSV 并逐行校验，文件本身无法解析或缺少必填列时返回错误
+func parseCouponImport(appID string, content io.Reader, now time.Time) ([]*CouponImportRow, error) {
+	br := bufio.NewReader(content)
+	// 跳过 UTF-8 BOM（Excel 导出的 CSV 以及本服务导出的 CSV 都带 BOM）
+	if b, err := br.Peek(3); err == nil && string(b) == "\xEF\xBB\xBF" {
+		_, _ = br.Discard(3)
+	}
+	r := csv.NewReader(br)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeImportInvalidFile, "zh-CN")
+	}
+	columns := make(map[string]int)
+	for i, h := range header {
+		if col, ok := importColumnAliases[normalizeImportHeader(h)]; ok {
+			if _, dup := columns[col]; !dup {
+				columns[col] = i
+			}
+		}
+	}
+	for _, col := range importRequiredColumns {
+		if _, ok := columns[col]; !ok {
+			return nil, errors.NewBizError(marketingErrors.ErrCodeImportInvalidFile, "zh-CN")
+		}
+	}
+
+	var rows []*CouponImportRow
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.NewBizError(marketingErrors.ErrCodeImportInvalidFile, "zh-CN")
+		}
+		line, _ := r.FieldPos(0)
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) >= maxImportRows {
+			return nil, errors.NewBizError(marketingErrors.ErrCodeImportTooManyRows, "zh-CN")
+		}
+
+		get := func(col string) string {
+			i, ok := columns[col]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return unescapeImportCell(strings.TrimSpace(record[i]))
+		}
+		row := &CouponImportRow{Line: int32(line), CouponCode: get(importColCouponCode)}
+		coupon, msg := parseImportCoupon(appID, get, now)
+		if msg != "" {
+			row.Status, row.Message = constants.ImportRowStatusInvalid, msg
+		} else {
+			row.Coupon = coupon
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseImportCoupon 解析并校验一行，返回失败原因（通过时为空字符串）
+func parseImportCoupon(appID string, get func(string) string, now time.Time) (*Coupon, string) {
+	c := &Coupon{
+		CouponCode:   get(importColCouponCode),
+		AppID:        appID,
+		DiscountType: get(importColDiscountType),
+		Currency:     strings.ToUpper(get(importColCurrency)),
+		Status:       get(importColStatus),
+	}
+	if c.CouponCode == "" || len(c.CouponCode) > 50 {
+		return nil, "couponCode must be 1-50 characters"
+	}
+	if c.DiscountType != constants.CouponDiscountTypePercent && c.DiscountType != constants.CouponDiscountTypeFixed {
+		return nil, "discountType must be percent or fixed"
+	}
+
+	var err error
+	if c.DiscountValue, err = parseImportInt(get(importColDiscountValue), true); err != nil {
+		return nil, "discountValue: " + err.Error()
+	}
+	maxUses, err := parseImportInt(get(importColMaxUses), true)
+	if err != nil || maxUses <= 0 || maxUses > 1<<31-1 {
+		return nil, "maxUses must be a positive integer"
+	}
+	c.MaxUses = int32(maxUses)
+	if c.MinAmount, err = parseImportInt(get(importColMinAmount), false); err != nil || c.MinAmount < 0 {
+		return nil, "minAmount must be a non-negative integer"
+	}
+	if c.ValidFrom, err = parseImportTime(get(importColValidFrom)); err != nil {
+		return nil, "validFrom: " + err.Error()
+	}
+	if c.ValidUntil, err = parseImportTime(get(importColValidUntil)); err != nil {
+		return nil, "validUntil: " + err.Error()
+	}
+	if c.Status != "" && c.Status != constants.CouponStatusActive && c.Status != constants.CouponStatusInactive {
+		return nil, "status must be active or inactive"
+	}
+
+	applyCouponDefaults(c, now)
+	if !isValidCurrency(c.Currency) {
+		return nil, "currency is not supported"
+	}
+	if v := couponRuleViolation(c); v != "" {
+		return nil, v
+	}
+	return c, ""
+}
+
+// parseImportInt 解析整数列，required 为 false 时空值视为 0
+func parseImportInt(s string, required bool) (int64, error) {
+	if s == "" {
+		if required {
+			return 0, fmt.Errorf("is required")
+		}
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %q", s)
+	}
+	return v, nil
+}
+
+// importTimeLayouts 支持的时间格式（无时区的格式按 UTC 解析），也接受 Unix 时间戳（秒）
+var importTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"}
+
+// parseImportTime 解析时间列
+func parseImportTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, fmt.Errorf("is required")
+	}
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil && ts > 0 {
+		return time.Unix(ts, 0), nil
+	}
+	for _, layout := range importTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+// unescapeImportCell 去掉导出时为防止公式注入添加的单引号前缀
+func unescapeImportCell(s string) string {
+	if len(s) > 1 && s[0] == '\'' && strings.ContainsRune("=+-@", rune(s[1])) {
+		return s[1:]
+	}
+	return s
+}
+
+// isBlankRecord 是否为空行（所有单元格为空）
+func isBlankRecord(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
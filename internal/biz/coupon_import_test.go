@@ -0,0 +1,157 @@
+package biz
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+
+	"github.com/gaoyong06/go-pkg/errors"
+)
+
+func TestParseCouponImport(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	const header = "couponCode,discountType,discountValue,currency,validFrom,validUntil,maxUses,minAmount,status\n"
+
+	type wantRow struct {
+		line    int32
+		code    string
+		status  string
+		message string
+	}
+	tests := []struct {
+		name    string
+		content string
+		wantErr int
+		want    []wantRow
+	}{
+		{
+			name:    "合法行",
+			content: header + "SAVE10,percent,10,usd,2026-03-01,2026-04-01,100,1000,inactive\n",
+			want:    []wantRow{{line: 2, code: "SAVE10"}},
+		},
+		{
+			name:    "带 BOM 和导出文件的中文列标题",
+			content: "\xEF\xBB\xBF优惠码,应用ID,折扣类型,折扣值,货币单位,生效时间,过期时间,最大使用次数,已使用次数\nSAVE10,app,fixed,500,CNY,2026-03-01 00:00:00,2026-04-01 00:00:00,100,3\n",
+			want:    []wantRow{{line: 2, code: "SAVE10"}},
+		},
+		{
+			name:    "英文列标题忽略大小写和空格",
+			content: "COUPON CODE,discount_type,Discount Value,Valid From,Valid Until,Max Uses\nSAVE10,fixed,500,1772323200,2026-04-01T00:00:00Z,100\n",
+			want:    []wantRow{{line: 2, code: "SAVE10"}},
+		},
+		{
+			name:    "去掉防公式注入的单引号前缀",
+			content: header + "'=SAVE,fixed,500,,2026-03-01,2026-04-01,100,,\n",
+			want:    []wantRow{{line: 2, code: "=SAVE"}},
+		},
+		{
+			name:    "跳过空行并保留文件行号",
+			content: header + "A1,fixed,500,,2026-03-01,2026-04-01,100,,\n,,,,,,,,\n\nA2,fixed,500,,2026-03-01,2026-04-01,100,,\n",
+			want:    []wantRow{{line: 2, code: "A1"}, {line: 5, code: "A2"}},
+		},
+		{
+			name: "逐行校验失败原因",
+			content: header +
+				",fixed,500,,2026-03-01,2026-04-01,100,,\n" +
+				"B1,free,500,,2026-03-01,2026-04-01,100,,\n" +
+				"B2,fixed,abc,,2026-03-01,2026-04-01,100,,\n" +
+				"B3,fixed,500,,2026-03-01,2026-04-01,0,,\n" +
+				"B4,fixed,500,,2026-03-01,2026-04-01,100,-1,\n" +
+				"B5,fixed,500,,03/01/2026,2026-04-01,100,,\n" +
+				"B6,fixed,500,,2026-04-01,2026-03-01,100,,\n" +
+				"B7,percent,120,,2026-03-01,2026-04-01,100,,\n" +
+				"B8,fixed,500,XYZ,2026-03-01,2026-04-01,100,,\n" +
+				"B9,fixed,500,,2026-03-01,2026-04-01,100,,expired\n",
+			want: []wantRow{
+				{line: 2, code: "", status: constants.ImportRowStatusInvalid, message: "couponCode must be 1-50 characters"},
+				{line: 3, code: "B1", status: constants.ImportRowStatusInvalid, message: "discountType must be percent or fixed"},
+				{line: 4, code: "B2", status: constants.ImportRowStatusInvalid, message: `discountValue: invalid integer "abc"`},
+				{line: 5, code: "B3", status: constants.ImportRowStatusInvalid, message: "maxUses must be a positive integer"},
+				{line: 6, code: "B4", status: constants.ImportRowStatusInvalid, message: "minAmount must be a non-negative integer"},
+				{line: 7, code: "B5", status: constants.ImportRowStatusInvalid, message: `validFrom: invalid time "03/01/2026"`},
+				{line: 8, code: "B6", status: constants.ImportRowStatusInvalid, message: "validFrom must be before validUntil"},
+				{line: 9, code: "B7", status: constants.ImportRowStatusInvalid, message: "percent discountValue must not exceed 100"},
+				{line: 10, code: "B8", status: constants.ImportRowStatusInvalid, message: "currency is not supported"},
+				{line: 11, code: "B9", status: constants.ImportRowStatusInvalid, message: "status must be active or inactive"},
+			},
+		},
+		{
+			name:    "空文件",
+			content: "",
+			wantErr: marketingErrors.ErrCodeImportInvalidFile,
+		},
+		{
+			name:    "缺少必填列",
+			content: "couponCode,discountType,discountValue,validFrom,validUntil\nA1,fixed,500,2026-03-01,2026-04-01\n",
+			wantErr: marketingErrors.ErrCodeImportInvalidFile,
+		},
+		{
+			name:    "CSV 格式错误",
+			content: header + "\"A1,fixed,500\n",
+			wantErr: marketingErrors.ErrCodeImportInvalidFile,
+		},
+		{
+			name:    "超过行数上限",
+			content: header + strings.Repeat("A1,fixed,500,,2026-03-01,2026-04-01,100,,\n", maxImportRows+1),
+			wantErr: marketingErrors.ErrCodeImportTooManyRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseCouponImport("app", strings.NewReader(tt.content), now)
+			if tt.wantErr != 0 {
+				var bizErr *errors.BizError
+				if !stderrors.As(err, &bizErr) || bizErr.Code != tt.wantErr {
+					t.Fatalf("err = %v, want code %d", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("got %d rows, want %d", len(rows), len(tt.want))
+			}
+			for i, want := range tt.want {
+				row := rows[i]
+				if row.Line != want.line || row.CouponCode != want.code || row.Status != want.status || row.Message != want.message {
+					t.Errorf("row %d = (%d, %q, %q, %q), want (%d, %q, %q, %q)", i,
+						row.Line, row.CouponCode, row.Status, row.Message, want.line, want.code, want.status, want.message)
+				}
+				if (row.Coupon != nil) != (want.status == "") {
+					t.Errorf("row %d coupon = %v, want parsed only when valid", i, row.Coupon)
+				}
+			}
+		})
+	}
+}
+
+func TestParseImportCouponFields(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	content := "couponCode,discountType,discountValue,currency,validFrom,validUntil,maxUses,minAmount,status\n" +
+		"SAVE10,percent,10,usd,2026-03-01 08:00:00,2026-04-01,100,1000,\n"
+
+	rows, err := parseCouponImport("app", strings.NewReader(content), now)
+	if err != nil || len(rows) != 1 || rows[0].Coupon == nil {
+		t.Fatalf("parseCouponImport = %v, %v", rows, err)
+	}
+	c := rows[0].Coupon
+	if c.AppID != "app" || c.DiscountType != constants.CouponDiscountTypePercent || c.DiscountValue != 10 ||
+		c.Currency != "USD" || c.MaxUses != 100 || c.MinAmount != 1000 || c.Status != constants.CouponStatusActive {
+		t.Errorf("coupon = %+v", c)
+	}
+	if want := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC); !c.ValidFrom.Equal(want) {
+		t.Errorf("ValidFrom = %v, want %v", c.ValidFrom, want)
+	}
+	if want := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC); !c.ValidUntil.Equal(want) {
+		t.Errorf("ValidUntil = %v, want %v", c.ValidUntil, want)
+	}
+	if !c.CreatedAt.Equal(now) {
+		t.Errorf("CreatedAt = %v, want %v", c.CreatedAt, now)
+	}
+}
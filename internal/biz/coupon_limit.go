@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"time"
+
+	"marketing-service/internal/constants"
+)
+
+// CouponLimitWindow 频次限制在某一时刻所处的计数窗口
+type CouponLimitWindow struct {
+	Scope  string    // 限制范围，见 constants.CouponLimitScope*
+	Limit  int32     // 窗口内允许的使用次数
+	UserID string    // 按用户限制时的用户ID，全局限制为空
+	From   time.Time // 窗口开始时间（含）
+	To     time.Time // 窗口结束时间（不含），也是计数重置时间
+}
+
+// CouponQuota 频次限制的剩余额度
+type CouponQuota struct {
+	Scope     string    // 限制范围，见 constants.CouponLimitScope*
+	Limit     int32     // 限制次数
+	Used      int32     // 已使用次数
+	Remaining int32     // 剩余次数
+	ResetAt   time.Time // 额度重置时间（TOTAL 为零值）
+}
+
+// HasLimits 是否设置了按时间窗口的频次限制
+func (c *Coupon) HasLimits() bool {
+	return c.UserDailyLimit > 0 || c.UserWeeklyLimit > 0 || c.UserMonthlyLimit > 0 || c.DailyLimit > 0
+}
+
+// LimitWindows 返回 now 所处的频次限制窗口（按优惠券时区的自然日、自然周（周一开始）和自然月计算）
+// userID 为空时只返回全局限制
+func (c *Coupon) LimitWindows(userID string, now time.Time) []*CouponLimitWindow {
+	local := now.In(scheduleLocation(c.Timezone))
+	y, m, d := local.Date()
+	dayStart := time.Date(y, m, d, 0, 0, 0, 0, local.Location())
+	weekStart := time.Date(y, m, d-(int(local.Weekday())+6)%7, 0, 0, 0, 0, local.Location())
+	monthStart := time.Date(y, m, 1, 0, 0, 0, 0, local.Location())
+
+	windows := make([]*CouponLimitWindow, 0, 4)
+	if userID != "" {
+		if c.UserDailyLimit > 0 {
+			windows = append(windows, &CouponLimitWindow{Scope: constants.CouponLimitScopeUserDay, Limit: c.UserDailyLimit, UserID: userID, From: dayStart, To: dayStart.AddDate(0, 0, 1)})
+		}
+		if c.UserWeeklyLimit > 0 {
+			windows = append(windows, &CouponLimitWindow{Scope: constants.CouponLimitScopeUserWeek, Limit: c.UserWeeklyLimit, UserID: userID, From: weekStart, To: weekStart.AddDate(0, 0, 7)})
+		}
+		if c.UserMonthlyLimit > 0 {
+			windows = append(windows, &CouponLimitWindow{Scope: constants.CouponLimitScopeUserMonth, Limit: c.UserMonthlyLimit, UserID: userID, From: monthStart, To: monthStart.AddDate(0, 1, 0)})
+		}
+	}
+	if c.DailyLimit > 0 {
+		windows = append(windows, &CouponLimitWindow{Scope: constants.CouponLimitScopeDay, Limit: c.DailyLimit, From: dayStart, To: dayStart.AddDate(0, 0, 1)})
+	}
+	return windows
+}
+
+// buildQuotas 根据各窗口的已使用次数计算剩余额度，并附加总使用次数限制；返回额度及是否有限制已用尽
+func buildQuotas(c *Coupon, windows []*CouponLimitWindow, used []int64) ([]*CouponQuota, bool) {
+	quotas := make([]*CouponQuota, 0, len(windows)+1)
+	exceeded := false
+	for i, w := range windows {
+		q := newCouponQuota(w.Scope, w.Limit, used[i])
+		q.ResetAt = w.To
+		quotas = append(quotas, q)
+		if q.Remaining == 0 {
+			exceeded = true
+		}
+	}
+	if c.MaxUses > 0 {
+		quotas = append(quotas, newCouponQuota(constants.CouponLimitScopeTotal, c.MaxUses, int64(c.UsedCount)))
+	}
+	return quotas, exceeded
+}
+
+// newCouponQuota 创建剩余额度
+func newCouponQuota(scope string, limit int32, used int64) *CouponQuota {
+	remaining := int64(limit) - used
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &CouponQuota{
+		Scope:     scope,
+		Limit:     limit,
+		Used:      int32(used),
+		Remaining: int32(remaining),
+	}
+}
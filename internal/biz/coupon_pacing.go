@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"math"
+	"time"
+
+	"marketing-service/internal/constants"
+)
+
+const (
+	maxPacingCurvePoints = 100   // 节奏曲线最多的分段数
+	maxPacingCurveWeight = 10000 // 节奏曲线单个分段的最大权重
+)
+
+// CouponPacing 投放节奏：把总使用次数（MaxUses）和折扣预算（Budget）按时间分摊到生效期内
+// 生效期按 Interval 切成连续的时间片（从 ValidFrom 起算），每个时间片开始时释放一批额度，
+// 累计释放量按曲线计算，最后一个时间片释放全部额度；当前时间片的累计额度用完后要等到下一个时间片
+type CouponPacing struct {
+	Interval string  // 时间片长度，见 constants.PacingInterval*
+	Curve    []int32 // 节奏曲线：把生效期等分为 len(Curve) 段，每段额度与权重成正比（段内均匀），为空表示全程均匀
+}
+
+// HasPacing 是否设置了投放节奏（只有设置了总使用次数或折扣预算时才生效）
+func (c *Coupon) HasPacing() bool {
+	return c.Pacing != nil && (c.MaxUses > 0 || c.Budget > 0)
+}
+
+// pacingIntervalDuration 时间片长度
+func pacingIntervalDuration(interval string) time.Duration {
+	if interval == constants.PacingIntervalDay {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// pacingSlice now 所在时间片的开始和结束时间（结束时间不超过 ValidUntil）
+func (c *Coupon) pacingSlice(now time.Time) (time.Time, time.Time) {
+	d := pacingIntervalDuration(c.Pacing.Interval)
+	start := c.ValidFrom
+	if now.After(c.ValidFrom) {
+		start = c.ValidFrom.Add(now.Sub(c.ValidFrom) / d * d)
+	}
+	end := start.Add(d)
+	if end.After(c.ValidUntil) {
+		end = c.ValidUntil
+	}
+	return start, end
+}
+
+// pacedAllowance total 中截至 now 所在时间片已释放的累计额度
+func (c *Coupon) pacedAllowance(total int64, now time.Time) int64 {
+	_, end := c.pacingSlice(now)
+	span := c.ValidUntil.Sub(c.ValidFrom)
+	if span <= 0 || !end.Before(c.ValidUntil) {
+		return total
+	}
+	x := float64(end.Sub(c.ValidFrom)) / float64(span)
+	allowance := int64(math.Floor(float64(total)*pacingCurveFraction(c.Pacing.Curve, x) + 1e-9))
+	if allowance > total {
+		return total
+	}
+	return allowance
+}
+
+// pacingCurveFraction 生效期过去 x（0~1）时曲线的累计比例
+func pacingCurveFraction(curve []int32, x float64) float64 {
+	var sum int64
+	for _, w := range curve {
+		sum += int64(w)
+	}
+	if sum <= 0 {
+		return x
+	}
+	segments := float64(len(curve))
+	var acc float64
+	for i, w := range curve {
+		from := float64(i) / segments
+		if x <= from {
+			break
+		}
+		covered := math.Min(x-from, 1/segments) * segments
+		acc += float64(w) * covered
+	}
+	return acc / float64(sum)
+}
+
+// nextPacedRelease now 之后累计额度超过 used 的第一个时间片开始时间，不会再超过时返回零值
+func (c *Coupon) nextPacedRelease(total, used int64, now time.Time) time.Time {
+	_, end := c.pacingSlice(now)
+	for t := end; t.Before(c.ValidUntil); {
+		if c.pacedAllowance(total, t) > used {
+			return t
+		}
+		_, t = c.pacingSlice(t)
+	}
+	return time.Time{}
+}
+
+// WithinPace 使用后的已使用次数和已使用折扣金额是否仍在 now 所在时间片的累计额度内（供使用事务内检查）
+func (c *Coupon) WithinPace(usedCount int32, budgetUsed int64, now time.Time) bool {
+	if !c.HasPacing() {
+		return true
+	}
+	if c.MaxUses > 0 && int64(usedCount) > c.pacedAllowance(int64(c.MaxUses), now) {
+		return false
+	}
+	if c.Budget > 0 && budgetUsed > c.pacedAllowance(c.Budget, now) {
+		return false
+	}
+	return true
+}
+
+// checkPacedUses 检查当前时间片的使用次数额度，用完时返回 PACE_LIMITED 并写入下一个释放额度的时刻
+func checkPacedUses(coupon *Coupon, now time.Time, result *ValidateResult) string {
+	if !coupon.HasPacing() || coupon.MaxUses <= 0 {
+		return constants.ValidateReasonOK
+	}
+	used := int64(coupon.UsedCount)
+	if used < coupon.pacedAllowance(int64(coupon.MaxUses), now) {
+		return constants.ValidateReasonOK
+	}
+	result.NextAvailableAt = coupon.nextPacedRelease(int64(coupon.MaxUses), used, now)
+	return constants.ValidateReasonPaceLimited
+}
+
+// pacedBudgetRemaining 当前时间片剩余的折扣预算额度，未按节奏分摊预算时返回 -1
+func pacedBudgetRemaining(coupon *Coupon, now time.Time) int64 {
+	if !coupon.HasPacing() || coupon.Budget <= 0 {
+		return -1
+	}
+	return budgetRemaining(coupon.pacedAllowance(coupon.Budget, now), coupon.BudgetUsed)
+}
+
+// pacingViolation 检查投放节奏配置，返回违反的规则说明，通过时返回空字符串
+func pacingViolation(c *Coupon) string {
+	if c.Pacing == nil {
+		return ""
+	}
+	if c.Pacing.Interval != constants.PacingIntervalHour && c.Pacing.Interval != constants.PacingIntervalDay {
+		return "pacing interval must be HOUR or DAY"
+	}
+	if c.MaxUses <= 0 && c.Budget <= 0 {
+		return "pacing requires maxUses or budget"
+	}
+	if c.IsRelative() {
+		return "pacing is not supported for relative validity coupons"
+	}
+	if len(c.Pacing.Curve) > maxPacingCurvePoints {
+		return "pacing curve must not exceed 100 points"
+	}
+	var sum int64
+	for _, w := range c.Pacing.Curve {
+		if w < 0 || w > maxPacingCurveWeight {
+			return "pacing curve weights must be between 0 and 10000"
+		}
+		sum += int64(w)
+	}
+	if len(c.Pacing.Curve) > 0 && sum == 0 {
+		return "pacing curve must have a positive weight"
+	}
+	return ""
+}
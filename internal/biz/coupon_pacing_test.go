@@ -0,0 +1,177 @@
+package biz
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"marketing-service/internal/constants"
+)
+
+func TestPacingCurveFraction(t *testing.T) {
+	tests := []struct {
+		name  string
+		curve []int32
+		x     float64
+		want  float64
+	}{
+		{name: "无曲线时均匀", curve: nil, x: 0.3, want: 0.3},
+		{name: "权重全为 0 时均匀", curve: []int32{0, 0}, x: 0.3, want: 0.3},
+		{name: "等权重曲线", curve: []int32{1, 1}, x: 0.25, want: 0.25},
+		{name: "前重后轻的段内", curve: []int32{3, 1}, x: 0.25, want: 0.375},
+		{name: "前重后轻的段边界", curve: []int32{3, 1}, x: 0.5, want: 0.75},
+		{name: "前段权重为 0", curve: []int32{0, 1}, x: 0.5, want: 0},
+		{name: "生效期结束", curve: []int32{5, 2, 3}, x: 1, want: 1},
+		{name: "生效期开始", curve: []int32{5, 2, 3}, x: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pacingCurveFraction(tt.curve, tt.x); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("pacingCurveFraction(%v, %v) = %v, want %v", tt.curve, tt.x, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCouponPacedAllowance(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		interval   string
+		curve      []int32
+		validUntil time.Time
+		now        time.Time
+		want       int64
+	}{
+		{name: "第一个时间片", interval: constants.PacingIntervalDay, validUntil: from.AddDate(0, 0, 10), now: from.Add(5 * time.Hour), want: 10},
+		{name: "生效前按第一个时间片", interval: constants.PacingIntervalDay, validUntil: from.AddDate(0, 0, 10), now: from.Add(-time.Hour), want: 10},
+		{name: "时间片开始时释放", interval: constants.PacingIntervalDay, validUntil: from.AddDate(0, 0, 10), now: from.AddDate(0, 0, 3), want: 40},
+		{name: "最后一个时间片释放全部额度", interval: constants.PacingIntervalDay, validUntil: from.AddDate(0, 0, 10), now: from.AddDate(0, 0, 9), want: 100},
+		{name: "最后一个时间片不足一个时间片长度", interval: constants.PacingIntervalHour, validUntil: from.Add(5*time.Hour + 30*time.Minute), now: from.Add(5*time.Hour + 10*time.Minute), want: 100},
+		{name: "按小时分摊", interval: constants.PacingIntervalHour, validUntil: from.Add(8 * time.Hour), now: from.Add(90 * time.Minute), want: 25},
+		{name: "按曲线分摊", interval: constants.PacingIntervalDay, curve: []int32{3, 1}, validUntil: from.AddDate(0, 0, 10), now: from.AddDate(0, 0, 4), want: 75},
+		{name: "结果向下取整", interval: constants.PacingIntervalDay, validUntil: from.AddDate(0, 0, 3), now: from, want: 33},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Coupon{ValidFrom: from, ValidUntil: tt.validUntil, Pacing: &CouponPacing{Interval: tt.interval, Curve: tt.curve}}
+			if got := c.pacedAllowance(100, tt.now); got != tt.want {
+				t.Errorf("pacedAllowance(100, %v) = %d, want %d", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPacedUses(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 0, 10)
+
+	tests := []struct {
+		name       string
+		pacing     *CouponPacing
+		usedCount  int32
+		now        time.Time
+		wantReason string
+		wantNext   time.Time
+	}{
+		{name: "未设置投放节奏", pacing: nil, usedCount: 50, now: from, wantReason: constants.ValidateReasonOK},
+		{name: "时间片额度未用完", pacing: &CouponPacing{Interval: constants.PacingIntervalDay}, usedCount: 9, now: from, wantReason: constants.ValidateReasonOK},
+		{
+			name:       "时间片额度用完等到下一个时间片",
+			pacing:     &CouponPacing{Interval: constants.PacingIntervalDay},
+			usedCount:  10,
+			now:        from.Add(5 * time.Hour),
+			wantReason: constants.ValidateReasonPaceLimited,
+			wantNext:   from.AddDate(0, 0, 1),
+		},
+		{
+			name:       "曲线前段无额度时等到有额度的时间片",
+			pacing:     &CouponPacing{Interval: constants.PacingIntervalDay, Curve: []int32{0, 1}},
+			usedCount:  0,
+			now:        from,
+			wantReason: constants.ValidateReasonPaceLimited,
+			wantNext:   from.AddDate(0, 0, 5),
+		},
+		{
+			name:       "总额度已用完时没有下一个可用时间",
+			pacing:     &CouponPacing{Interval: constants.PacingIntervalDay},
+			usedCount:  100,
+			now:        from.AddDate(0, 0, 9),
+			wantReason: constants.ValidateReasonPaceLimited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Coupon{ValidFrom: from, ValidUntil: until, MaxUses: 100, UsedCount: tt.usedCount, Pacing: tt.pacing}
+			result := &ValidateResult{}
+			if got := checkPacedUses(c, tt.now, result); got != tt.wantReason {
+				t.Errorf("checkPacedUses = %s, want %s", got, tt.wantReason)
+			}
+			if !result.NextAvailableAt.Equal(tt.wantNext) {
+				t.Errorf("NextAvailableAt = %v, want %v", result.NextAvailableAt, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestCouponWithinPace(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := from.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		maxUses    int32
+		budget     int64
+		usedCount  int32
+		budgetUsed int64
+		want       bool
+	}{
+		{name: "次数在额度内", maxUses: 100, usedCount: 10, want: true},
+		{name: "次数超过额度", maxUses: 100, usedCount: 11, want: false},
+		{name: "预算在额度内", budget: 10000, budgetUsed: 1000, want: true},
+		{name: "预算超过额度", budget: 10000, budgetUsed: 1001, want: false},
+		{name: "次数和预算都不限时不生效", usedCount: 1000, budgetUsed: 1000000, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Coupon{
+				ValidFrom: from, ValidUntil: from.AddDate(0, 0, 10), MaxUses: tt.maxUses, Budget: tt.budget,
+				Pacing: &CouponPacing{Interval: constants.PacingIntervalDay},
+			}
+			if got := c.WithinPace(tt.usedCount, tt.budgetUsed, now); got != tt.want {
+				t.Errorf("WithinPace(%d, %d) = %v, want %v", tt.usedCount, tt.budgetUsed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPacingViolation(t *testing.T) {
+	tests := []struct {
+		name   string
+		coupon *Coupon
+		want   string
+	}{
+		{name: "未设置投放节奏", coupon: &Coupon{}, want: ""},
+		{name: "合法配置", coupon: &Coupon{MaxUses: 10, Pacing: &CouponPacing{Interval: constants.PacingIntervalHour, Curve: []int32{1, 0, 2}}}, want: ""},
+		{name: "时间片长度无效", coupon: &Coupon{MaxUses: 10, Pacing: &CouponPacing{Interval: "WEEK"}}, want: "pacing interval must be HOUR or DAY"},
+		{name: "没有总使用次数和预算", coupon: &Coupon{Pacing: &CouponPacing{Interval: constants.PacingIntervalDay}}, want: "pacing requires maxUses or budget"},
+		{name: "相对有效期优惠券", coupon: &Coupon{MaxUses: 10, ValidDays: 7, Pacing: &CouponPacing{Interval: constants.PacingIntervalDay}}, want: "pacing is not supported for relative validity coupons"},
+		{name: "曲线分段过多", coupon: &Coupon{Budget: 100, Pacing: &CouponPacing{Interval: constants.PacingIntervalDay, Curve: make([]int32, maxPacingCurvePoints+1)}}, want: "pacing curve must not exceed 100 points"},
+		{name: "权重为负", coupon: &Coupon{MaxUses: 10, Pacing: &CouponPacing{Interval: constants.PacingIntervalDay, Curve: []int32{1, -1}}}, want: "pacing curve weights must be between 0 and 10000"},
+		{name: "权重过大", coupon: &Coupon{MaxUses: 10, Pacing: &CouponPacing{Interval: constants.PacingIntervalDay, Curve: []int32{maxPacingCurveWeight + 1}}}, want: "pacing curve weights must be between 0 and 10000"},
+		{name: "权重全为 0", coupon: &Coupon{MaxUses: 10, Pacing: &CouponPacing{Interval: constants.PacingIntervalDay, Curve: []int32{0, 0}}}, want: "pacing curve must have a positive weight"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pacingViolation(tt.coupon); got != tt.want {
+				t.Errorf("pacingViolation = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package biz
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxScheduleWindows       = 20  // 每个优惠券最多的时段数
+	maxScheduleBlackoutDates = 366 // 每个优惠券最多的不可用日期数
+	scheduleLookaheadDays    = 400 // 计算下一个可用时间时最多向后查找的天数
+	minutesPerDay            = 24 * 60
+	blackoutDateLayout       = "2006-01-02"
+)
+
+// CouponSchedule 优惠券周期性可用时段（在优惠券时区内求值）
+// 生效期内，只有落在某个时段内且不在不可用日期的时刻才可以使用；没有时段和不可用日期时表示不限
+type CouponSchedule struct {
+	Windows       []*CouponTimeWindow // 可用时段，为空表示全天可用（只受不可用日期限制）
+	BlackoutDates []string            // 不可用日期（YYYY-MM-DD，按优惠券时区的自然日）
+}
+
+// CouponTimeWindow 每周可用时段
+// EndTime 不晚于 StartTime 时表示跨过午夜，结束于次日；Weekdays 指时段开始的那一天
+type CouponTimeWindow struct {
+	Weekdays  []int32 // 星期几（0=周日 ... 6=周六），为空表示每天
+	StartTime string  // 开始时间 HH:MM
+	EndTime   string  // 结束时间 HH:MM（不含），24:00 表示当天结束
+}
+
+// IsEmpty 是否没有任何时段限制
+func (s *CouponSchedule) IsEmpty() bool {
+	return s == nil || (len(s.Windows) == 0 && len(s.BlackoutDates) == 0)
+}
+
+// HasSchedule 是否设置了周期性可用时段或不可用日期
+func (c *Coupon) HasSchedule() bool {
+	return !c.Schedule.IsEmpty()
+}
+
+// NextAvailableAt 返回 now 及之后优惠券按时段可用的最早时刻：now 可用时返回 now，
+// 生效期内（向后最多 scheduleLookaheadDays 天）都不再可用时返回零值
+func (c *Coupon) NextAvailableAt(now time.Time) time.Time {
+	if !c.HasSchedule() {
+		return now
+	}
+	loc := scheduleLocation(c.Timezone)
+	blackout := make(map[string]bool, len(c.Schedule.BlackoutDates))
+	for _, d := range c.Schedule.BlackoutDates {
+		blackout[d] = true
+	}
+	windows := c.Schedule.Windows
+	if len(windows) == 0 {
+		windows = []*CouponTimeWindow{{StartTime: "00:00", EndTime: "24:00"}}
+	}
+
+	local := now.In(loc)
+	y, m, d := local.Date()
+	var best time.Time
+	// 从前一天开始，以覆盖前一天开始、跨过午夜的时段
+	for i := -1; i <= scheduleLookaheadDays; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, loc)
+		if !best.IsZero() && !day.Before(best) {
+			break
+		}
+		if !c.IsRelative() && day.After(c.ValidUntil) {
+			break
+		}
+		for _, w := range windows {
+			if !w.appliesOn(day.Weekday()) {
+				continue
+			}
+			start, end := w.interval(day)
+			at := firstOpenInstant(start, end, now, blackout)
+			if !at.IsZero() && (best.IsZero() || at.Before(best)) {
+				best = at
+			}
+		}
+	}
+	if !best.IsZero() && !c.IsRelative() && best.After(c.ValidUntil) {
+		return time.Time{}
+	}
+	return best
+}
+
+// firstOpenInstant 返回时段 [start, end) 内不早于 now、且不在不可用日期的最早时刻，没有时返回零值
+func firstOpenInstant(start, end, now time.Time, blackout map[string]bool) time.Time {
+	at := start
+	if at.Before(now) {
+		at = now
+	}
+	for at.Before(end) {
+		if !blackout[at.Format(blackoutDateLayout)] {
+			return at
+		}
+		// 跳到下一个自然日的零点
+		y, m, d := at.Date()
+		at = scheduleClockTime(y, m, d+1, 0, at.Location())
+	}
+	return time.Time{}
+}
+
+// appliesOn 时段是否在该星期几开始
+func (w *CouponTimeWindow) appliesOn(weekday time.Weekday) bool {
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+	for _, d := range w.Weekdays {
+		if time.Weekday(d) == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// interval 返回时段在 day（当天零点）开始的绝对时间区间，跨午夜的时段结束于次日
+func (w *CouponTimeWindow) interval(day time.Time) (time.Time, time.Time) {
+	startMin, _ := parseClockMinutes(w.StartTime)
+	endMin, _ := parseClockMinutes(w.EndTime)
+	if endMin <= startMin {
+		endMin += minutesPerDay
+	}
+	y, m, d := day.Date()
+	return scheduleClockTime(y, m, d, startMin, day.Location()), scheduleClockTime(y, m, d, endMin, day.Location())
+}
+
+// scheduleClockTime 返回当地时间 y-m-d 零点后 minutes 分钟的时刻；
+// 该时刻因夏令时开始被跳过时取跳变后的第一个时刻（time.Date 不保证取哪一侧，可能早一小时）
+func scheduleClockTime(y int, m time.Month, d, minutes int, loc *time.Location) time.Time {
+	t := time.Date(y, m, d, 0, minutes, 0, 0, loc)
+	want := time.Date(y, m, d, 0, minutes, 0, 0, time.UTC)
+	got := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC)
+	if got.Before(want) {
+		if _, end := t.ZoneBounds(); !end.IsZero() {
+			return end
+		}
+	}
+	return t
+}
+
+// parseClockMinutes 解析 HH:MM（00:00 ~ 24:00）为当天的分钟数
+func parseClockMinutes(s string) (int, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, false
+	}
+	h, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	mi, err := strconv.Atoi(parts[1])
+	if err != nil || h < 0 || mi < 0 || mi > 59 {
+		return 0, false
+	}
+	minutes := h*60 + mi
+	if minutes > minutesPerDay {
+		return 0, false
+	}
+	return minutes, true
+}
+
+// shiftBlackoutDates 返回不可用日期平移 days 天后的时段副本（不修改原时段），无法解析的日期原样保留
+func (s *CouponSchedule) shiftBlackoutDates(days int) *CouponSchedule {
+	if s == nil {
+		return nil
+	}
+	shifted := &CouponSchedule{
+		Windows:       s.Windows,
+		BlackoutDates: make([]string, 0, len(s.BlackoutDates)),
+	}
+	for _, d := range s.BlackoutDates {
+		t, err := time.Parse(blackoutDateLayout, d)
+		if err != nil {
+			shifted.BlackoutDates = append(shifted.BlackoutDates, d)
+			continue
+		}
+		shifted.BlackoutDates = append(shifted.BlackoutDates, t.AddDate(0, 0, days).Format(blackoutDateLayout))
+	}
+	return shifted
+}
+
+// calendarDaysBetween 返回 from 到 to 在 loc 时区下相差的自然日数（按日期计算，不受夏令时影响）
+func calendarDaysBetween(from, to time.Time, loc *time.Location) int {
+	fy, fm, fd := from.In(loc).Date()
+	ty, tm, td := to.In(loc).Date()
+	a := time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)
+	b := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// scheduleLocation 返回优惠券时区，为空或无法识别时使用 UTC
+func scheduleLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// scheduleViolation 检查时区和时段设置，返回违反的规则说明，通过时返回空字符串
+func scheduleViolation(timezone string, s *CouponSchedule) string {
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return "timezone must be a valid IANA time zone"
+		}
+	}
+	if s == nil {
+		return ""
+	}
+	if len(s.Windows) > maxScheduleWindows {
+		return "schedule allows at most 20 windows"
+	}
+	for _, w := range s.Windows {
+		for _, d := range w.Weekdays {
+			if d < 0 || d > 6 {
+				return "schedule weekdays must be between 0 (Sunday) and 6 (Saturday)"
+			}
+		}
+		start, ok := parseClockMinutes(w.StartTime)
+		if !ok || start == minutesPerDay {
+			return "schedule startTime must be HH:MM"
+		}
+		if _, ok := parseClockMinutes(w.EndTime); !ok {
+			return "schedule endTime must be HH:MM"
+		}
+	}
+	if len(s.BlackoutDates) > maxScheduleBlackoutDates {
+		return "schedule allows at most 366 blackout dates"
+	}
+	for _, d := range s.BlackoutDates {
+		if _, err := time.Parse(blackoutDateLayout, d); err != nil {
+			return "schedule blackoutDates must be YYYY-MM-DD"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,319 @@
+package biz
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s is not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestCouponNextAvailableAt(t *testing.T) {
+	utc := func(day, hour, min int) time.Time {
+		return time.Date(2026, 3, day, hour, min, 0, 0, time.UTC)
+	}
+	farFuture := utc(1, 0, 0).AddDate(1, 0, 0)
+
+	tests := []struct {
+		name       string
+		timezone   string
+		schedule   *CouponSchedule
+		validUntil time.Time
+		validDays  int32
+		now        time.Time
+		want       time.Time
+	}{
+		{
+			name: "没有时段限制时立即可用",
+			now:  utc(10, 8, 0),
+			want: utc(10, 8, 0),
+		},
+		{
+			name:     "时段内立即可用",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}},
+			now:      utc(10, 11, 0),
+			want:     utc(10, 11, 0),
+		},
+		{
+			name:     "时段前等到当天开始",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}},
+			now:      utc(10, 8, 0),
+			want:     utc(10, 10, 0),
+		},
+		{
+			name:     "结束时间不含",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}},
+			now:      utc(10, 12, 0),
+			want:     utc(11, 10, 0),
+		},
+		{
+			name:     "跨午夜时段在次日凌晨仍可用（星期按开始日计算）",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{Weekdays: []int32{5}, StartTime: "22:00", EndTime: "02:00"}}},
+			now:      utc(7, 1, 0), // 周六凌晨，周五开始的时段内
+			want:     utc(7, 1, 0),
+		},
+		{
+			name:     "跨午夜时段结束后等到下周",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{Weekdays: []int32{5}, StartTime: "22:00", EndTime: "02:00"}}},
+			now:      utc(7, 3, 0),
+			want:     utc(13, 22, 0),
+		},
+		{
+			name:     "不可用日期跳到下一个时段",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}, BlackoutDates: []string{"2026-03-10"}},
+			now:      utc(10, 9, 0),
+			want:     utc(11, 10, 0),
+		},
+		{
+			name:     "只有不可用日期时等到次日零点",
+			schedule: &CouponSchedule{BlackoutDates: []string{"2026-03-10", "2026-03-11"}},
+			now:      utc(10, 9, 0),
+			want:     utc(12, 0, 0),
+		},
+		{
+			name:     "跨午夜时段的后半段落在不可用日期",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "22:00", EndTime: "02:00"}}, BlackoutDates: []string{"2026-03-11"}},
+			now:      utc(11, 0, 30),
+			want:     utc(12, 0, 0),
+		},
+		{
+			name:     "24:00 表示当天结束",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "20:00", EndTime: "24:00"}}},
+			now:      utc(10, 23, 59),
+			want:     utc(10, 23, 59),
+		},
+		{
+			name:     "00:00 到 24:00 为全天",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{Weekdays: []int32{3}, StartTime: "00:00", EndTime: "24:00"}}},
+			now:      utc(10, 23, 0), // 周二
+			want:     utc(11, 0, 0),
+		},
+		{
+			name:     "按优惠券时区求值",
+			timezone: "Asia/Shanghai",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}},
+			now:      utc(10, 1, 0), // 北京时间 09:00
+			want:     utc(10, 2, 0),
+		},
+		{
+			name:     "夏令时开始当天按当地时间计算",
+			timezone: "America/New_York",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "09:00", EndTime: "10:00"}}},
+			now:      utc(7, 15, 30), // 3 月 7 日 10:30 EST
+			want:     utc(8, 13, 0),  // 3 月 8 日 09:00 EDT
+		},
+		{
+			name:     "夏令时跳过的开始时间顺延到跳变时刻",
+			timezone: "America/New_York",
+			schedule: &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "02:30", EndTime: "04:00"}}},
+			now:      utc(8, 6, 0), // 3 月 8 日 01:00 EST
+			want:     utc(8, 7, 0), // 03:00 EDT
+		},
+		{
+			name:       "下一个时段晚于过期时间",
+			schedule:   &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}},
+			validUntil: utc(10, 9, 0),
+			now:        utc(10, 8, 0),
+			want:       time.Time{},
+		},
+		{
+			name:       "过期前开始的时段仍可用",
+			schedule:   &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}},
+			validUntil: utc(10, 10, 30),
+			now:        utc(10, 8, 0),
+			want:       utc(10, 10, 0),
+		},
+		{
+			name:       "相对有效期优惠券不受发放期截止时间限制",
+			schedule:   &CouponSchedule{Windows: []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}},
+			validUntil: utc(10, 9, 0),
+			validDays:  7,
+			now:        utc(10, 8, 0),
+			want:       utc(10, 10, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.timezone != "" {
+				mustLoadLocation(t, tt.timezone)
+			}
+			c := &Coupon{Timezone: tt.timezone, Schedule: tt.schedule, ValidUntil: farFuture, ValidDays: tt.validDays}
+			if !tt.validUntil.IsZero() {
+				c.ValidUntil = tt.validUntil
+			}
+			got := c.NextAvailableAt(tt.now)
+			if !got.Equal(tt.want) {
+				t.Errorf("NextAvailableAt(%v) = %v, want %v", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCouponTimeWindowInterval(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name      string
+		window    CouponTimeWindow
+		day       time.Time
+		wantStart time.Time
+		wantSpan  time.Duration
+	}{
+		{
+			name:      "普通时段",
+			window:    CouponTimeWindow{StartTime: "10:00", EndTime: "12:30"},
+			day:       time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC),
+			wantSpan:  150 * time.Minute,
+		},
+		{
+			name:      "跨午夜结束于次日",
+			window:    CouponTimeWindow{StartTime: "22:00", EndTime: "02:00"},
+			day:       time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 3, 10, 22, 0, 0, 0, time.UTC),
+			wantSpan:  4 * time.Hour,
+		},
+		{
+			name:      "开始等于结束表示 24 小时",
+			window:    CouponTimeWindow{StartTime: "08:00", EndTime: "08:00"},
+			day:       time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC),
+			wantSpan:  24 * time.Hour,
+		},
+		{
+			name:      "24:00 结束于次日零点",
+			window:    CouponTimeWindow{StartTime: "00:00", EndTime: "24:00"},
+			day:       time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+			wantSpan:  24 * time.Hour,
+		},
+		{
+			name:      "开始时间被夏令时跳过",
+			window:    CouponTimeWindow{StartTime: "02:30", EndTime: "04:00"},
+			day:       time.Date(2026, 3, 8, 0, 0, 0, 0, ny),
+			wantStart: time.Date(2026, 3, 8, 3, 0, 0, 0, ny),
+			wantSpan:  time.Hour,
+		},
+		{
+			name:      "夏令时开始当天只有 23 小时",
+			window:    CouponTimeWindow{StartTime: "00:00", EndTime: "24:00"},
+			day:       time.Date(2026, 3, 8, 0, 0, 0, 0, ny),
+			wantStart: time.Date(2026, 3, 8, 0, 0, 0, 0, ny),
+			wantSpan:  23 * time.Hour,
+		},
+		{
+			name:      "夏令时结束当天有 25 小时",
+			window:    CouponTimeWindow{StartTime: "00:00", EndTime: "24:00"},
+			day:       time.Date(2026, 11, 1, 0, 0, 0, 0, ny),
+			wantStart: time.Date(2026, 11, 1, 0, 0, 0, 0, ny),
+			wantSpan:  25 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.window.interval(tt.day)
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", start, tt.wantStart)
+			}
+			if span := end.Sub(start); span != tt.wantSpan {
+				t.Errorf("end - start = %v, want %v", span, tt.wantSpan)
+			}
+		})
+	}
+}
+
+func TestFirstOpenInstant(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		now      time.Time
+		blackout []string
+		want     time.Time
+	}{
+		{name: "未开始返回开始时间", start: at(10, 10), end: at(10, 12), now: at(10, 8), want: at(10, 10)},
+		{name: "进行中返回 now", start: at(10, 10), end: at(10, 12), now: at(10, 11), want: at(10, 11)},
+		{name: "已结束返回零值", start: at(10, 10), end: at(10, 12), now: at(10, 12), want: time.Time{}},
+		{name: "开始日为不可用日期时跳到次日零点", start: at(10, 22), end: at(11, 2), now: at(10, 8), blackout: []string{"2026-03-10"}, want: at(11, 0)},
+		{name: "整个时段都在不可用日期", start: at(10, 10), end: at(10, 12), now: at(10, 8), blackout: []string{"2026-03-10"}, want: time.Time{}},
+		{name: "跨多日时段跳过连续的不可用日期", start: at(10, 0), end: at(13, 0), now: at(9, 0), blackout: []string{"2026-03-10", "2026-03-11"}, want: at(12, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blackout := make(map[string]bool)
+			for _, d := range tt.blackout {
+				blackout[d] = true
+			}
+			got := firstOpenInstant(tt.start, tt.end, tt.now, blackout)
+			if !got.Equal(tt.want) {
+				t.Errorf("firstOpenInstant = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarDaysBetween(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	shanghai := mustLoadLocation(t, "Asia/Shanghai")
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		loc  *time.Location
+		want int
+	}{
+		{name: "同一天", from: time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC), to: time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC), loc: time.UTC, want: 0},
+		{name: "不足 24 小时但跨自然日", from: time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC), to: time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC), loc: time.UTC, want: 1},
+		{name: "向前平移", from: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), to: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), loc: time.UTC, want: -9},
+		{name: "按时区的自然日计算", from: time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC), to: time.Date(2026, 3, 1, 17, 0, 0, 0, time.UTC), loc: shanghai, want: 1},
+		{name: "跨夏令时开始", from: time.Date(2026, 3, 7, 0, 0, 0, 0, ny), to: time.Date(2026, 3, 9, 0, 0, 0, 0, ny), loc: ny, want: 2},
+		{name: "跨夏令时结束", from: time.Date(2026, 10, 31, 0, 0, 0, 0, ny), to: time.Date(2026, 11, 2, 0, 0, 0, 0, ny), loc: ny, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendarDaysBetween(tt.from, tt.to, tt.loc); got != tt.want {
+				t.Errorf("calendarDaysBetween = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCouponScheduleShiftBlackoutDates(t *testing.T) {
+	windows := []*CouponTimeWindow{{StartTime: "10:00", EndTime: "12:00"}}
+	src := &CouponSchedule{Windows: windows, BlackoutDates: []string{"2026-02-27", "2026-12-31", "bad"}}
+
+	got := src.shiftBlackoutDates(2)
+	want := []string{"2026-03-01", "2027-01-02", "bad"}
+	if len(got.BlackoutDates) != len(want) {
+		t.Fatalf("BlackoutDates = %v, want %v", got.BlackoutDates, want)
+	}
+	for i := range want {
+		if got.BlackoutDates[i] != want[i] {
+			t.Errorf("BlackoutDates[%d] = %s, want %s", i, got.BlackoutDates[i], want[i])
+		}
+	}
+	if src.BlackoutDates[0] != "2026-02-27" {
+		t.Errorf("source schedule was modified: %v", src.BlackoutDates)
+	}
+	if len(got.Windows) != 1 || got.Windows[0] != windows[0] {
+		t.Errorf("Windows = %v, want %v", got.Windows, windows)
+	}
+	if (*CouponSchedule)(nil).shiftBlackoutDates(2) != nil {
+		t.Errorf("nil schedule should stay nil")
+	}
+}
@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/constants"
+
+	"github.com/gaoyong06/go-pkg/errors"
+)
+
+// maxTimeSeriesBuckets 单次时间序列查询允许的最大时间桶数量
+const maxTimeSeriesBuckets = 1000
+
+// UsageTimeSeriesPoint 使用时间序列数据点
+type UsageTimeSeriesPoint struct {
+	BucketStart    time.Time // 时间桶起始时间（按请求时区对齐）
+	Uses           int32     // 使用次数
+	DistinctOrders int32     // 去重订单数
+	DistinctUsers  int32     // 去重用户数
+	Revenue        int64     // 产生收入(分)
+	Discount       int64     // 折扣金额(分)
+}
+
+// GetUsageTimeSeries 获取使用时间序列统计
+// 时间桶在请求时区内对齐（天/周/月的起点随夏令时正确变化），统计区间为 [from, to)
+func (uc *CouponUseCase) GetUsageTimeSeries(ctx context.Context, appID, couponCode string, from, to time.Time, granularity, timezone string) ([]*UsageTimeSeriesPoint, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if !from.Before(to) {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+
+	bucketStarts := make([]time.Time, 0)
+	for t := truncateToBucket(from.In(loc), granularity); t.Before(to); t = nextBucket(t, granularity) {
+		if len(bucketStarts) >= maxTimeSeriesBuckets {
+			return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+		}
+		bucketStarts = append(bucketStarts, t)
+	}
+
+	return uc.repo.GetUsageTimeSeries(ctx, appID, couponCode, from, to, bucketStarts)
+}
+
+// truncateToBucket 将时间截断到所在时间桶的起点（使用 t 自身的时区）
+func truncateToBucket(t time.Time, granularity string) time.Time {
+	y, m, d := t.Date()
+	switch granularity {
+	case constants.TimeGranularityHour:
+		return time.Date(y, m, d, t.Hour(), 0, 0, 0, t.Location())
+	case constants.TimeGranularityWeek:
+		// 以周一为一周的起点
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, t.Location())
+	case constants.TimeGranularityMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// nextBucket 返回下一个时间桶的起点
+func nextBucket(t time.Time, granularity string) time.Time {
+	y, m, d := t.Date()
+	switch granularity {
+	case constants.TimeGranularityHour:
+		// 直接按绝对时长推进，避免夏令时回拨时 time.Date 取到重复的本地小时
+		return t.Add(time.Hour)
+	case constants.TimeGranularityWeek:
+		return time.Date(y, m, d+7, 0, 0, 0, 0, t.Location())
+	case constants.TimeGranularityMonth:
+		return time.Date(y, m+1, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// RebuildStatsQuery 重算每日统计汇总的范围
+type RebuildStatsQuery struct {
+	AppID      string
+	CouponCode string    // 可选
+	From       time.Time // 可选，按数据库连接时区的自然日对齐
+	To         time.Time // 可选，按数据库连接时区的自然日对齐
+}
+
+// RebuildStats 从使用记录重算每日统计汇总，并回填去重用户 HyperLogLog（回填不受 From/To 限制）
+func (uc *CouponUseCase) RebuildStats(ctx context.Context, q *RebuildStatsQuery) (int64, error) {
+	if !validTimeRange(q.From, q.To) {
+		return 0, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	rows, err := uc.repo.RebuildDailyStats(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+	if err := uc.repo.RebuildUniqueUsers(ctx, q); err != nil {
+		return 0, err
+	}
+	uc.log.Infof("rebuilt coupon daily stats: app_id=%s, coupon_code=%s, rows=%d", q.AppID, q.CouponCode, rows)
+	return rows, nil
+}
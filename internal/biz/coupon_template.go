@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"marketing-service/internal/constants"
+
+	"github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CouponTemplate 优惠券模板领域对象（折扣规则、使用限制和相对有效期）
+type CouponTemplate struct {
+	TemplateID    string
+	AppID         string
+	Name          string // 模板名称（应用内唯一）
+	Description   string
+	DiscountType  string
+	DiscountValue int64
+	Currency      string
+	MaxUses       int32
+	MinAmount     int64
+	ValidDays     int32 // 有效天数：优惠券过期时间 = 生效时间 + ValidDays 天
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// CouponTemplateRepo 优惠券模板仓储接口（查询均限定在 appID 内）
+type CouponTemplateRepo interface {
+	Save(context.Context, *CouponTemplate) error
+	Update(context.Context, *CouponTemplate) error
+	FindByID(ctx context.Context, appID, templateID string) (*CouponTemplate, error)
+	List(ctx context.Context, appID string, page, pageSize int) ([]*CouponTemplate, int64, error)
+	Delete(ctx context.Context, appID, templateID string) error
+}
+
+// CouponTemplateUseCase 优惠券模板用例
+type CouponTemplateUseCase struct {
+	repo CouponTemplateRepo
+	cuc  *CouponUseCase
+	log  *log.Helper
+}
+
+// NewCouponTemplateUseCase 创建优惠券模板用例
+func NewCouponTemplateUseCase(repo CouponTemplateRepo, cuc *CouponUseCase, logger log.Logger) *CouponTemplateUseCase {
+	return &CouponTemplateUseCase{
+		repo: repo,
+		cuc:  cuc,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create 创建优惠券模板
+func (uc *CouponTemplateUseCase) Create(ctx context.Context, t *CouponTemplate) (*CouponTemplate, error) {
+	if err := validateCouponTemplate(t); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	t.TemplateID = GenerateShortID()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	if err := uc.repo.Save(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Get 获取优惠券模板
+func (uc *CouponTemplateUseCase) Get(ctx context.Context, appID, templateID string) (*CouponTemplate, error) {
+	return uc.repo.FindByID(ctx, appID, templateID)
+}
+
+// List 列出优惠券模板
+func (uc *CouponTemplateUseCase) List(ctx context.Context, appID string, page, pageSize int) ([]*CouponTemplate, int64, error) {
+	return uc.repo.List(ctx, appID, page, pageSize)
+}
+
+// Update 更新优惠券模板（已从模板创建的优惠券不受影响）
+func (uc *CouponTemplateUseCase) Update(ctx context.Context, t *CouponTemplate) (*CouponTemplate, error) {
+	if err := validateCouponTemplate(t); err != nil {
+		return nil, err
+	}
+	t.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Delete 删除优惠券模板
+func (uc *CouponTemplateUseCase) Delete(ctx context.Context, appID, templateID string) error {
+	return uc.repo.Delete(ctx, appID, templateID)
+}
+
+// CreateCoupon 按模板创建优惠券：有效期从 validFrom（零值表示当前时间）起算 ValidDays 天
+func (uc *CouponTemplateUseCase) CreateCoupon(ctx context.Context, appID, templateID, code string, validFrom time.Time) (*Coupon, error) {
+	t, err := uc.repo.FindByID(ctx, appID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if validFrom.IsZero() {
+		validFrom = time.Now()
+	}
+	return uc.cuc.Create(ctx, &Coupon{
+		CouponCode:    code,
+		AppID:         appID,
+		DiscountType:  t.DiscountType,
+		DiscountValue: t.DiscountValue,
+		Currency:      t.Currency,
+		ValidFrom:     validFrom,
+		ValidUntil:    validFrom.AddDate(0, 0, int(t.ValidDays)),
+		MaxUses:       t.MaxUses,
+		MinAmount:     t.MinAmount,
+	})
+}
+
+// validateCouponTemplate 校验模板（折扣规则与优惠券相同）
+func validateCouponTemplate(t *CouponTemplate) error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Currency == "" {
+		t.Currency = constants.CouponCurrencyCNY
+	}
+	if t.Name == "" || !isValidCurrency(t.Currency) {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if t.DiscountType != constants.CouponDiscountTypePercent && t.DiscountType != constants.CouponDiscountTypeFixed {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if t.MaxUses <= 0 || t.MinAmount < 0 || t.ValidDays <= 0 || t.ValidDays > maxValidDays {
+		return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	if couponRuleViolation(&Coupon{DiscountType: t.DiscountType, DiscountValue: t.DiscountValue}) != "" {
+		return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	return nil
+}
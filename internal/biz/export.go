@@ -0,0 +1,385 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+	"marketing-service/internal/export"
+
+	"github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	exportBatchSize         = 1000             // 导出时每批查询的行数
+	maxSyncExportRows       = 10000            // 同步流式导出的最大行数，超过需使用后台导出任务
+	maxConcurrentExportJobs = 2                // 同时执行的后台导出任务数
+	exportJobTimeout        = 30 * time.Minute // 单个后台导出任务的超时时间
+)
+
+// BlobStore 导出文件存储（默认本地磁盘，可替换为对象存储等实现）
+type BlobStore interface {
+	// Create 创建文件，写入完成后调用方负责 Close
+	Create(ctx context.Context, key string) (io.WriteCloser, error)
+	// Open 打开文件用于下载
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// ExportJob 后台导出任务
+type ExportJob struct {
+	JobID      string
+	AppID      string
+	Resource   string // 导出数据类型，见 constants.ExportResource*
+	Format     string // 导出格式，见 constants.ExportFormat*
+	Language   string // 列标题语言
+	Params     string // 筛选条件（JSON，仅用于追溯）
+	Status     string // 任务状态，见 constants.ExportJobStatus*
+	FileKey    string // 文件在 BlobStore 中的 key
+	FileName   string // 下载文件名
+	Rows       int64  // 导出行数（不含标题行）
+	Error      string // 失败原因
+	CreatedAt  time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	CouponFilter *CouponFilter      // Resource=coupons 时的筛选条件
+	UsageFilter  *CouponUsageFilter // Resource=coupon_usages 时的筛选条件
+}
+
+// ExportJobRepo 导出任务仓储接口
+type ExportJobRepo interface {
+	Save(context.Context, *ExportJob) error
+	Update(context.Context, *ExportJob) error
+	FindByID(ctx context.Context, appID, jobID string) (*ExportJob, error)
+}
+
+// ExportUseCase 导出用例（同步流式导出 + 后台导出任务）
+type ExportUseCase struct {
+	repo    CouponRepo
+	jobRepo ExportJobRepo
+	store   BlobStore
+	log     *log.Helper
+
+	ctx    context.Context // 后台任务的根 context，服务退出时取消
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	sem    chan struct{}
+}
+
+// NewExportUseCase 创建导出用例，返回的 cleanup 会取消并等待执行中的后台任务
+func NewExportUseCase(repo CouponRepo, jobRepo ExportJobRepo, store BlobStore, logger log.Logger) (*ExportUseCase, func(), error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	uc := &ExportUseCase{
+		repo:    repo,
+		jobRepo: jobRepo,
+		store:   store,
+		log:     log.NewHelper(logger),
+		ctx:     ctx,
+		cancel:  cancel,
+		sem:     make(chan struct{}, maxConcurrentExportJobs),
+	}
+	cleanup := func() {
+		cancel()
+		uc.wg.Wait()
+	}
+	return uc, cleanup, nil
+}
+
+// ExportCoupons 同步流式导出优惠券（超过 maxSyncExportRows 时返回错误，需使用后台导出任务）
+func (uc *ExportUseCase) ExportCoupons(ctx context.Context, filter *CouponFilter, format, lang string, w io.Writer) error {
+	if !export.IsValidFormat(format) {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if err := normalizeCouponFilter(filter); err != nil {
+		return err
+	}
+	// 固定导出截止时间，避免分页查询期间新写入的数据导致行偏移
+	if filter.CreatedTo.IsZero() {
+		filter.CreatedTo = time.Now()
+	}
+	_, total, err := uc.repo.List(ctx, filter, 1, 1)
+	if err != nil {
+		return err
+	}
+	if total > maxSyncExportRows {
+		return errors.NewBizError(marketingErrors.ErrCodeExportTooLarge, "zh-CN")
+	}
+	_, err = uc.writeCoupons(ctx, filter, format, lang, w)
+	return err
+}
+
+// ExportUsages 同步流式导出使用记录（超过 maxSyncExportRows 时返回错误，需使用后台导出任务）
+func (uc *ExportUseCase) ExportUsages(ctx context.Context, filter *CouponUsageFilter, format, lang string, w io.Writer) error {
+	if !export.IsValidFormat(format) {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if !validTimeRange(filter.UsedFrom, filter.UsedTo) {
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	// 固定导出截止时间，避免分页查询期间新写入的数据导致行偏移
+	if filter.UsedTo.IsZero() {
+		filter.UsedTo = time.Now()
+	}
+	_, total, err := uc.repo.ListUsagesByFilter(ctx, filter, 1, 1)
+	if err != nil {
+		return err
+	}
+	if total > maxSyncExportRows {
+		return errors.NewBizError(marketingErrors.ErrCodeExportTooLarge, "zh-CN")
+	}
+	_, err = uc.writeUsages(ctx, filter, format, lang, w)
+	return err
+}
+
+// CreateJob 创建后台导出任务并异步执行
+func (uc *ExportUseCase) CreateJob(ctx context.Context, job *ExportJob) (*ExportJob, error) {
+	if !export.IsValidFormat(job.Format) {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+
+	// 固定导出截止时间，避免分批查询期间新写入的数据导致行偏移
+	now := time.Now()
+	var params interface{}
+	switch job.Resource {
+	case constants.ExportResourceCoupons:
+		if job.CouponFilter == nil {
+			job.CouponFilter = &CouponFilter{}
+		}
+		job.CouponFilter.AppID = job.AppID
+		if err := normalizeCouponFilter(job.CouponFilter); err != nil {
+			return nil, err
+		}
+		if job.CouponFilter.CreatedTo.IsZero() {
+			job.CouponFilter.CreatedTo = now
+		}
+		params = job.CouponFilter
+	case constants.ExportResourceCouponUsages:
+		if job.UsageFilter == nil {
+			job.UsageFilter = &CouponUsageFilter{}
+		}
+		job.UsageFilter.AppID = job.AppID
+		if !validTimeRange(job.UsageFilter.UsedFrom, job.UsageFilter.UsedTo) {
+			return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+		}
+		if job.UsageFilter.UsedTo.IsZero() {
+			job.UsageFilter.UsedTo = now
+		}
+		params = job.UsageFilter
+	default:
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, errors.WrapErrorWithLang(ctx, err, errors.ErrCodeInternalError)
+	}
+	job.JobID = GenerateShortID()
+	job.Params = string(paramsJSON)
+	job.Status = constants.ExportJobStatusPending
+	job.FileName = export.FileName(job.Resource, job.Format, now.UTC().Format("20060102150405"))
+	job.FileKey = fmt.Sprintf("%s/%s.%s", job.AppID, job.JobID, job.Format)
+	job.CreatedAt = now
+	if err := uc.jobRepo.Save(ctx, job); err != nil {
+		return nil, err
+	}
+
+	// 返回副本，后台任务会继续修改 job 的状态
+	created := *job
+	uc.wg.Add(1)
+	go uc.runJob(job)
+
+	return &created, nil
+}
+
+// GetJob 获取导出任务（限定在调用方应用内）
+func (uc *ExportUseCase) GetJob(ctx context.Context, appID, jobID string) (*ExportJob, error) {
+	return uc.jobRepo.FindByID(ctx, appID, jobID)
+}
+
+// OpenJobFile 打开已完成导出任务的文件用于下载
+func (uc *ExportUseCase) OpenJobFile(ctx context.Context, appID, jobID string) (*ExportJob, io.ReadCloser, error) {
+	job, err := uc.jobRepo.FindByID(ctx, appID, jobID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.Status != constants.ExportJobStatusSucceeded {
+		return nil, nil, errors.NewBizError(marketingErrors.ErrCodeExportJobNotReady, "zh-CN")
+	}
+	rc, err := uc.store.Open(ctx, job.FileKey)
+	if err != nil {
+		return nil, nil, errors.WrapErrorWithLang(ctx, err, marketingErrors.ErrCodeExportFailed)
+	}
+	return job, rc, nil
+}
+
+// runJob 执行后台导出任务（并发数受 sem 限制）
+func (uc *ExportUseCase) runJob(job *ExportJob) {
+	defer uc.wg.Done()
+
+	select {
+	case uc.sem <- struct{}{}:
+		defer func() { <-uc.sem }()
+	case <-uc.ctx.Done():
+		uc.finishJob(job, 0, uc.ctx.Err())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(uc.ctx, exportJobTimeout)
+	defer cancel()
+
+	job.Status = constants.ExportJobStatusRunning
+	job.StartedAt = time.Now()
+	if err := uc.jobRepo.Update(ctx, job); err != nil {
+		uc.log.Errorf("failed to update export job %s: %v", job.JobID, err)
+	}
+
+	rows, err := uc.writeJobFile(ctx, job)
+	uc.finishJob(job, rows, err)
+}
+
+// writeJobFile 将导出数据写入 BlobStore
+func (uc *ExportUseCase) writeJobFile(ctx context.Context, job *ExportJob) (int64, error) {
+	f, err := uc.store.Create(ctx, job.FileKey)
+	if err != nil {
+		return 0, err
+	}
+
+	var rows int64
+	if job.Resource == constants.ExportResourceCoupons {
+		rows, err = uc.writeCoupons(ctx, job.CouponFilter, job.Format, job.Language, f)
+	} else {
+		rows, err = uc.writeUsages(ctx, job.UsageFilter, job.Format, job.Language, f)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return rows, err
+}
+
+// finishJob 记录任务结果（使用独立 context，服务退出时也能写回失败状态）
+func (uc *ExportUseCase) finishJob(job *ExportJob, rows int64, err error) {
+	job.Rows = rows
+	job.FinishedAt = time.Now()
+	if err != nil {
+		job.Status = constants.ExportJobStatusFailed
+		job.Error = err.Error()
+		uc.log.Errorf("export job %s failed: %v", job.JobID, err)
+	} else {
+		job.Status = constants.ExportJobStatusSucceeded
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := uc.jobRepo.Update(ctx, job); err != nil {
+		uc.log.Errorf("failed to update export job %s: %v", job.JobID, err)
+	}
+}
+
+// writeCoupons 分批查询并写入优惠券，返回写入行数
+func (uc *ExportUseCase) writeCoupons(ctx context.Context, filter *CouponFilter, format, lang string, w io.Writer) (int64, error) {
+	tw, err := export.NewWriter(w, format)
+	if err != nil {
+		return 0, err
+	}
+	if err := tw.WriteRow(export.CouponHeaders(lang)); err != nil {
+		return 0, err
+	}
+
+	var rows int64
+	for page := 1; ; page++ {
+		coupons, _, err := uc.repo.List(ctx, filter, page, exportBatchSize)
+		if err != nil {
+			return rows, err
+		}
+		for _, c := range coupons {
+			if err := tw.WriteRow(couponExportRow(c)); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+		if len(coupons) < exportBatchSize {
+			break
+		}
+	}
+	return rows, tw.Close()
+}
+
+// writeUsages 分批查询并写入使用记录，返回写入行数
+func (uc *ExportUseCase) writeUsages(ctx context.Context, filter *CouponUsageFilter, format, lang string, w io.Writer) (int64, error) {
+	tw, err := export.NewWriter(w, format)
+	if err != nil {
+		return 0, err
+	}
+	if err := tw.WriteRow(export.CouponUsageHeaders(lang)); err != nil {
+		return 0, err
+	}
+
+	var rows int64
+	for page := 1; ; page++ {
+		usages, _, err := uc.repo.ListUsagesByFilter(ctx, filter, page, exportBatchSize)
+		if err != nil {
+			return rows, err
+		}
+		for _, u := range usages {
+			if err := tw.WriteRow(usageExportRow(u)); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+		if len(usages) < exportBatchSize {
+			break
+		}
+	}
+	return rows, tw.Close()
+}
+
+// couponExportRow 优惠券导出行（列顺序与 export.CouponHeaders 一致）
+func couponExportRow(c *Coupon) []string {
+	return []string{
+		c.CouponCode,
+		c.AppID,
+		c.DiscountType,
+		strconv.FormatInt(c.DiscountValue, 10),
+		c.Currency,
+		formatExportTime(c.ValidFrom),
+		formatExportTime(c.ValidUntil),
+		strconv.Itoa(int(c.MaxUses)),
+		strconv.Itoa(int(c.UsedCount)),
+		strconv.FormatInt(c.MinAmount, 10),
+		c.Status,
+		formatExportTime(c.CreatedAt),
+		formatExportTime(c.UpdatedAt),
+	}
+}
+
+// usageExportRow 使用记录导出行（列顺序与 export.CouponUsageHeaders 一致）
+func usageExportRow(u *CouponUsage) []string {
+	return []string{
+		u.CouponUsageID,
+		u.CouponCode,
+		u.AppID,
+		u.UserID,
+		u.PaymentOrderID,
+		u.PaymentID,
+		strconv.FormatInt(u.OriginalAmount, 10),
+		strconv.FormatInt(u.DiscountAmount, 10),
+		strconv.FormatInt(u.FinalAmount, 10),
+		u.Currency,
+		formatExportTime(u.UsedAt),
+	}
+}
+
+// formatExportTime 导出时间统一使用 UTC RFC3339 格式
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,417 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+	"marketing-service/internal/metrics"
+
+	"github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	maxRedeemCodeBatchSize    = 10000                              // 单次最多生成的兑换码数量
+	defaultRedeemCodeLength   = 12                                 // 兑换码随机部分的默认长度
+	minRedeemCodeLength       = 8                                  // 随机部分最短长度（保证不可猜测）
+	maxRedeemCodeLength       = 32                                 // 随机部分最长长度
+	maxRedeemCodePrefixLength = 16                                 // 前缀最长长度（前缀 + 随机部分不超过 varchar(50)）
+	redeemCodeAlphabet        = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // 去掉易混淆的 0/O、1/I
+)
+
+// RedeemCode 兑换码领域对象：一次性礼品码/激活码，兑换后给用户发放优惠券、积分或订阅时长（不用于订单抵扣）
+type RedeemCode struct {
+	Code             string    // 兑换码（唯一标识）
+	AppID            string    // 应用ID
+	BatchID          string    // 批次ID（同一次生成的兑换码相同）
+	CampaignID       string    // 所属活动ID：非空时只有活动进行中才可兑换
+	RewardType       string    // 兑换内容，见 constants.RewardType*（COUPON/POINTS/SUBSCRIPTION）
+	CouponCode       string    // COUPON：发放到用户券包的优惠码
+	Points           int64     // POINTS：积分数
+	SubscriptionPlan string    // SUBSCRIPTION：订阅套餐
+	SubscriptionDays int32     // SUBSCRIPTION：订阅天数
+	Status           string    // 状态，见 constants.RedeemCodeStatus*
+	ExpiresAt        time.Time // 过期时间（不含）
+	RedeemedBy       string    // 兑换用户ID
+	RedeemedAt       time.Time // 兑换时间
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// RedeemCodeRedemption 兑换记录：兑换码被哪个用户兑换、发放了什么
+// 优惠券与兑换在同一事务内发放（GRANTED）；积分和订阅时长在兑换提交后发放，失败时为 FAILED，可按兑换记录重试
+type RedeemCodeRedemption struct {
+	RedemptionID     string
+	Code             string
+	AppID            string
+	UserID           string
+	RewardType       string
+	CouponCode       string // COUPON：发放的优惠码
+	UserCouponID     string // COUPON：发放到券包的用户优惠券ID
+	Points           int64
+	SubscriptionPlan string
+	SubscriptionDays int32
+	GrantStatus      string    // 见 constants.RedeemGrantStatus*
+	GrantError       string    // 最近一次发放失败的原因
+	GrantAttempts    int32     // 积分、订阅时长的发放尝试次数
+	GrantedAt        time.Time // 权益发放时间
+	RedeemedAt       time.Time
+}
+
+// RedemptionFilter 兑换记录查询条件（零值表示不筛选，AppID 必填）
+type RedemptionFilter struct {
+	AppID       string
+	UserID      string
+	GrantStatus string
+}
+
+// RedeemCodeFilter 兑换码查询条件（零值表示不筛选，AppID 必填）
+type RedeemCodeFilter struct {
+	AppID      string
+	BatchID    string
+	Status     string    // ACTIVE 不含已过期，EXPIRED 含已过期但未标记的兑换码
+	RedeemedBy string    // 兑换用户ID
+	Now        time.Time // 判断过期的时间
+}
+
+// Expired 兑换码在 now 时是否已过期（未兑换且已过过期时间）
+func (c *RedeemCode) Expired(now time.Time) bool {
+	return c.Status == constants.RedeemCodeStatusActive && !now.Before(c.ExpiresAt)
+}
+
+// DisplayStatus 展示状态：未兑换且已过过期时间的兑换码为 EXPIRED
+func (c *RedeemCode) DisplayStatus(now time.Time) string {
+	if c.Expired(now) {
+		return constants.RedeemCodeStatusExpired
+	}
+	return c.Status
+}
+
+// RedeemCodeRepo 兑换码仓储接口（查询均限定在 appID 内）
+type RedeemCodeRepo interface {
+	// SaveBatch 在一个事务内批量创建兑换码
+	SaveBatch(ctx context.Context, codes []*RedeemCode) error
+	FindByCode(ctx context.Context, appID, code string) (*RedeemCode, error)
+	List(ctx context.Context, filter *RedeemCodeFilter, page, pageSize int) ([]*RedeemCode, int64, error)
+	// Redeem 在一个事务内把未过期的 ACTIVE 兑换码改为 REDEEMED、发放用户优惠券（userCoupon 非空时）并写入兑换记录
+	// 兑换码已被兑换或状态已变化时返回 ErrCodeRedeemCodeAlreadyRedeemed
+	Redeem(ctx context.Context, redemption *RedeemCodeRedemption, userCoupon *UserCoupon) error
+	// UpdateStatus 仅当兑换码当前状态为 from 时改为 to，返回是否修改成功
+	UpdateStatus(ctx context.Context, appID, code, from, to string, now time.Time) (bool, error)
+	// FindRedemption 查找兑换码的兑换记录
+	FindRedemption(ctx context.Context, appID, code string) (*RedeemCodeRedemption, error)
+	ListRedemptions(ctx context.Context, filter *RedemptionFilter, page, pageSize int) ([]*RedeemCodeRedemption, int64, error)
+	// UpdateGrant 记录一次权益发放的结果（发放次数加一），已发放的记录不再修改，返回是否修改成功
+	UpdateGrant(ctx context.Context, r *RedeemCodeRedemption) (bool, error)
+}
+
+// RewardGranter 发放本服务之外的权益（积分、订阅时长），可接入积分服务或订阅服务
+// ref 为发放来源的唯一ID（如兑换记录ID），接入方应以此做幂等；未接入时使用 data 层的本地实现
+type RewardGranter interface {
+	GrantPoints(ctx context.Context, appID, userID string, points int64, ref string) error
+	GrantSubscription(ctx context.Context, appID, userID, plan string, days int32, ref string) error
+}
+
+// RedeemCodeUseCase 兑换码用例
+type RedeemCodeUseCase struct {
+	repo         RedeemCodeRepo
+	couponRepo   CouponRepo
+	campaignRepo CampaignRepo
+	granter      RewardGranter
+	log          *log.Helper
+}
+
+// NewRedeemCodeUseCase 创建兑换码用例
+func NewRedeemCodeUseCase(repo RedeemCodeRepo, couponRepo CouponRepo, campaignRepo CampaignRepo, granter RewardGranter, logger log.Logger) *RedeemCodeUseCase {
+	return &RedeemCodeUseCase{
+		repo:         repo,
+		couponRepo:   couponRepo,
+		campaignRepo: campaignRepo,
+		granter:      granter,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// CreateBatch 按模板批量生成兑换码（同一批次的兑换内容、过期时间和所属活动相同）
+// prefix 为可选前缀，length 为随机部分长度（0 表示默认 12 位）
+func (uc *RedeemCodeUseCase) CreateBatch(ctx context.Context, tmpl *RedeemCode, count int, prefix string, length int) ([]*RedeemCode, error) {
+	if length == 0 {
+		length = defaultRedeemCodeLength
+	}
+	prefix = strings.ToUpper(prefix)
+	if count <= 0 || count > maxRedeemCodeBatchSize || length < minRedeemCodeLength || length > maxRedeemCodeLength ||
+		len(prefix) > maxRedeemCodePrefixLength {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	now := time.Now()
+	if err := uc.validateTemplate(ctx, tmpl, now); err != nil {
+		return nil, err
+	}
+
+	batchID := GenerateShortID()
+	codes := make([]*RedeemCode, 0, count)
+	seen := make(map[string]bool, count)
+	for len(codes) < count {
+		code, err := randomRedeemCode(prefix, length)
+		if err != nil {
+			uc.log.Errorf("failed to generate redeem code: %v", err)
+			return nil, errors.NewBizError(marketingErrors.ErrCodeRedeemCodeCreateFailed, "zh-CN")
+		}
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		codes = append(codes, &RedeemCode{
+			Code:             code,
+			AppID:            tmpl.AppID,
+			BatchID:          batchID,
+			CampaignID:       tmpl.CampaignID,
+			RewardType:       tmpl.RewardType,
+			CouponCode:       tmpl.CouponCode,
+			Points:           tmpl.Points,
+			SubscriptionPlan: tmpl.SubscriptionPlan,
+			SubscriptionDays: tmpl.SubscriptionDays,
+			Status:           constants.RedeemCodeStatusActive,
+			ExpiresAt:        tmpl.ExpiresAt,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		})
+	}
+	if err := uc.repo.SaveBatch(ctx, codes); err != nil {
+		return nil, err
+	}
+	metrics.GetMetrics().RedeemCodeGeneratedTotal.Add(float64(len(codes)))
+	return codes, nil
+}
+
+// validateTemplate 校验兑换内容、过期时间和所属活动
+func (uc *RedeemCodeUseCase) validateTemplate(ctx context.Context, tmpl *RedeemCode, now time.Time) error {
+	if !tmpl.ExpiresAt.After(now) {
+		return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	switch tmpl.RewardType {
+	case constants.RewardTypeCoupon:
+		coupon, err := uc.couponRepo.FindByCode(ctx, tmpl.CouponCode)
+		if err != nil {
+			return err
+		}
+		if coupon == nil || coupon.AppID != tmpl.AppID {
+			return errors.NewBizError(errors.ErrCodeNotFound, "zh-CN")
+		}
+	case constants.RewardTypePoints:
+		if tmpl.Points <= 0 {
+			return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+		}
+	case constants.RewardTypeSubscription:
+		if tmpl.SubscriptionPlan == "" || tmpl.SubscriptionDays <= 0 {
+			return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+		}
+	default:
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if tmpl.CampaignID != "" {
+		campaign, err := uc.campaignRepo.FindByID(ctx, tmpl.AppID, tmpl.CampaignID)
+		if err != nil {
+			return err
+		}
+		if campaign.Type != constants.CampaignTypeRedeemCode {
+			return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+		}
+	}
+	return nil
+}
+
+// Get 获取兑换码
+func (uc *RedeemCodeUseCase) Get(ctx context.Context, appID, code string) (*RedeemCode, error) {
+	return uc.repo.FindByCode(ctx, appID, strings.ToUpper(code))
+}
+
+// List 列出兑换码
+func (uc *RedeemCodeUseCase) List(ctx context.Context, filter *RedeemCodeFilter, page, pageSize int) ([]*RedeemCode, int64, error) {
+	switch filter.Status {
+	case "", constants.RedeemCodeStatusActive, constants.RedeemCodeStatusRedeemed, constants.RedeemCodeStatusExpired, constants.RedeemCodeStatusRevoked:
+	default:
+		return nil, 0, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if filter.Now.IsZero() {
+		filter.Now = time.Now()
+	}
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// Redeem 用户兑换兑换码：校验状态、过期时间和所属活动后，在一个事务内标记已兑换、发放优惠券并写入兑换记录
+// 积分和订阅时长在事务提交后通过 RewardGranter 发放（以兑换记录ID作为幂等键），发放结果记录在兑换记录上，失败时可调用 RetryGrant 重试
+func (uc *RedeemCodeUseCase) Redeem(ctx context.Context, appID, code, userID string) (*RedeemCodeRedemption, error) {
+	rc, err := uc.repo.FindByCode(ctx, appID, strings.ToUpper(code))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := uc.checkRedeemable(ctx, rc, now); err != nil {
+		return nil, err
+	}
+
+	redemption := &RedeemCodeRedemption{
+		RedemptionID:     GenerateShortID(),
+		Code:             rc.Code,
+		AppID:            appID,
+		UserID:           userID,
+		RewardType:       rc.RewardType,
+		CouponCode:       rc.CouponCode,
+		Points:           rc.Points,
+		SubscriptionPlan: rc.SubscriptionPlan,
+		SubscriptionDays: rc.SubscriptionDays,
+		GrantStatus:      constants.RedeemGrantStatusPending,
+		RedeemedAt:       now,
+	}
+	var userCoupon *UserCoupon
+	if rc.RewardType == constants.RewardTypeCoupon {
+		coupon, err := uc.couponRepo.FindByCode(ctx, rc.CouponCode)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkGrantable(coupon, appID, userID, now); err != nil {
+			return nil, err
+		}
+		userCoupon = newUserCoupon(coupon, userID, now)
+		redemption.UserCouponID = userCoupon.UserCouponID
+		redemption.GrantStatus = constants.RedeemGrantStatusGranted
+		redemption.GrantedAt = now
+	}
+	if err := uc.repo.Redeem(ctx, redemption, userCoupon); err != nil {
+		return nil, err
+	}
+	metrics.GetMetrics().RedeemCodeRedeemedTotal.Inc()
+
+	uc.grantExternal(ctx, redemption)
+	return redemption, nil
+}
+
+// checkRedeemable 检查兑换码当前可以兑换；已过期但未标记的兑换码顺便标记为 EXPIRED
+func (uc *RedeemCodeUseCase) checkRedeemable(ctx context.Context, rc *RedeemCode, now time.Time) error {
+	switch rc.Status {
+	case constants.RedeemCodeStatusRedeemed:
+		return errors.NewBizError(marketingErrors.ErrCodeRedeemCodeAlreadyRedeemed, "zh-CN")
+	case constants.RedeemCodeStatusRevoked:
+		return errors.NewBizError(marketingErrors.ErrCodeRedeemCodeRevoked, "zh-CN")
+	case constants.RedeemCodeStatusExpired:
+		return errors.NewBizError(marketingErrors.ErrCodeRedeemCodeExpired, "zh-CN")
+	}
+	if rc.Expired(now) {
+		ok, err := uc.repo.UpdateStatus(ctx, rc.AppID, rc.Code, constants.RedeemCodeStatusActive, constants.RedeemCodeStatusExpired, now)
+		if err != nil {
+			uc.log.Warnf("failed to mark redeem code expired: code=%s, err=%v", rc.Code, err)
+		} else if ok {
+			metrics.GetMetrics().RedeemCodeExpiredTotal.Inc()
+		}
+		return errors.NewBizError(marketingErrors.ErrCodeRedeemCodeExpired, "zh-CN")
+	}
+	if rc.CampaignID != "" {
+		campaign, err := uc.campaignRepo.FindByID(ctx, rc.AppID, rc.CampaignID)
+		if err != nil {
+			return err
+		}
+		if !campaign.IsRunning(now) {
+			return errors.NewBizError(marketingErrors.ErrCodeCampaignNotRunning, "zh-CN")
+		}
+	}
+	return nil
+}
+
+// grantExternal 发放积分或订阅时长并把结果（GRANTED/FAILED）记录到兑换记录上
+// 兑换已提交，发放失败不影响兑换结果，记录为 FAILED 后可调用 RetryGrant 重试；记录结果失败时保持 PENDING，同样可以重试
+func (uc *RedeemCodeUseCase) grantExternal(ctx context.Context, r *RedeemCodeRedemption) {
+	var err error
+	switch r.RewardType {
+	case constants.RewardTypePoints:
+		err = uc.granter.GrantPoints(ctx, r.AppID, r.UserID, r.Points, r.RedemptionID)
+	case constants.RewardTypeSubscription:
+		err = uc.granter.GrantSubscription(ctx, r.AppID, r.UserID, r.SubscriptionPlan, r.SubscriptionDays, r.RedemptionID)
+	default:
+		return
+	}
+
+	r.GrantAttempts++
+	if err != nil {
+		uc.log.Errorf("failed to grant redeem code reward: redemption_id=%s, code=%s, reward_type=%s, err=%v", r.RedemptionID, r.Code, r.RewardType, err)
+		r.GrantStatus = constants.RedeemGrantStatusFailed
+		r.GrantError = err.Error()
+		metrics.GetMetrics().RedeemCodeGrantFailedTotal.Inc()
+	} else {
+		r.GrantStatus = constants.RedeemGrantStatusGranted
+		r.GrantError = ""
+		r.GrantedAt = time.Now()
+	}
+	if _, err := uc.repo.UpdateGrant(ctx, r); err != nil {
+		uc.log.Errorf("failed to record redeem code grant: redemption_id=%s, status=%s, err=%v", r.RedemptionID, r.GrantStatus, err)
+	}
+}
+
+// RetryGrant 重试兑换记录中未成功发放的积分或订阅时长（已发放时直接返回兑换记录）
+// RewardGranter 以兑换记录ID作为幂等键，重复发放不会多发
+func (uc *RedeemCodeUseCase) RetryGrant(ctx context.Context, appID, code string) (*RedeemCodeRedemption, error) {
+	r, err := uc.repo.FindRedemption(ctx, appID, strings.ToUpper(code))
+	if err != nil {
+		return nil, err
+	}
+	if r.GrantStatus == constants.RedeemGrantStatusGranted {
+		return r, nil
+	}
+	uc.grantExternal(ctx, r)
+	return r, nil
+}
+
+// ListRedemptions 列出兑换记录（可按发放状态筛选出需要重试的记录）
+func (uc *RedeemCodeUseCase) ListRedemptions(ctx context.Context, filter *RedemptionFilter, page, pageSize int) ([]*RedeemCodeRedemption, int64, error) {
+	switch filter.GrantStatus {
+	case "", constants.RedeemGrantStatusPending, constants.RedeemGrantStatusGranted, constants.RedeemGrantStatusFailed:
+	default:
+		return nil, 0, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	return uc.repo.ListRedemptions(ctx, filter, page, pageSize)
+}
+
+// Revoke 作废兑换码（只能作废未兑换的兑换码，已过期的也可以作废）
+func (uc *RedeemCodeUseCase) Revoke(ctx context.Context, appID, code string) (*RedeemCode, error) {
+	code = strings.ToUpper(code)
+	rc, err := uc.repo.FindByCode(ctx, appID, code)
+	if err != nil {
+		return nil, err
+	}
+	if rc.Status == constants.RedeemCodeStatusRedeemed {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeRedeemCodeAlreadyRedeemed, "zh-CN")
+	}
+	if rc.Status == constants.RedeemCodeStatusRevoked {
+		return rc, nil
+	}
+	ok, err := uc.repo.UpdateStatus(ctx, appID, code, rc.Status, constants.RedeemCodeStatusRevoked, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// 并发兑换或作废，按最新状态重新判断
+		return uc.Revoke(ctx, appID, code)
+	}
+	return uc.repo.FindByCode(ctx, appID, code)
+}
+
+// randomRedeemCode 生成 prefix + length 位随机字符的兑换码
+func randomRedeemCode(prefix string, length int) (string, error) {
+	var b strings.Builder
+	b.Grow(len(prefix) + length)
+	b.WriteString(prefix)
+	max := big.NewInt(int64(len(redeemCodeAlphabet)))
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(redeemCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
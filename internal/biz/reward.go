@@ -0,0 +1,574 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+	"marketing-service/internal/metrics"
+
+	"github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const rewardCouponCodeLength = 12 // 奖励生成的优惠码随机部分长度
+
+var rewardCodePrefixPattern = regexp.MustCompile(`^[A-Z0-9]{0,16}$`)
+
+// Reward 奖励模板：定义"给什么"，生成、校验、发放逻辑以 JSON 配置存储在奖励上
+// 每次修改版本号加一，发放记录保存发放时的版本和内容快照
+type Reward struct {
+	RewardID    string
+	AppID       string
+	Name        string
+	Description string
+	RewardType  string // 见 constants.RewardType*（COUPON/POINTS/SUBSCRIPTION）
+	Content     RewardContent
+	Generator   RewardGeneratorConfig
+	Validator   RewardValidatorConfig
+	Distributor RewardDistributorConfig
+	ValidDays   int32  // 发放后有效天数：COUPON 为生成的优惠券有效期（必填），其他类型为发放记录的过期时间（0 表示不过期）
+	Version     int32  // 版本号（从 1 开始，每次修改加一）
+	Status      string // 见 constants.RewardStatus*，只有 ACTIVE 的奖励可以发放
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RewardContent 奖励内容（按奖励类型只保留对应字段）
+type RewardContent struct {
+	DiscountType     string // COUPON：折扣类型 percent/fixed
+	DiscountValue    int64  // COUPON：折扣值
+	Currency         string // COUPON：货币单位（固定金额折扣）
+	MinAmount        int64  // COUPON：最低消费金额
+	Points           int64  // POINTS：积分数
+	SubscriptionPlan string // SUBSCRIPTION：订阅套餐
+	SubscriptionDays int32  // SUBSCRIPTION：订阅天数
+}
+
+// RewardGeneratorConfig 生成配置：定义"怎么生成"（发放总量是配置项，已发放数量由发放记录统计）
+type RewardGeneratorConfig struct {
+	CodePrefix string // COUPON：生成的优惠码前缀
+	TotalCount int64  // 发放总量（0 表示不限）
+}
+
+// RewardValidatorConfig 校验配置：定义"谁、什么时候可以领"
+type RewardValidatorConfig struct {
+	StartTime    time.Time // 可发放开始时间（零值表示不限）
+	EndTime      time.Time // 可发放结束时间（不含，零值表示不限）
+	AudienceID   string    // 受众ID：非空时只发放给属于该受众的用户
+	PerUserLimit int32     // 每个用户可获得次数（0 表示不限）
+}
+
+// RewardDistributorConfig 发放配置：定义"怎么发放"
+type RewardDistributorConfig struct {
+	Type string // 见 constants.DistributorType*，AUTO 生成后立即发放，MANUAL 等待调用方确认发放
+}
+
+// RewardFilter 奖励查询条件（零值表示不筛选，AppID 必填）
+type RewardFilter struct {
+	AppID      string
+	RewardType string
+	Status     string
+}
+
+// RewardGrant 奖励发放记录：每发放一次奖励一条记录，保存发放时的奖励版本和内容快照
+// 状态 PENDING → GENERATED → DISTRIBUTED → USED，未使用且已过过期时间的为 EXPIRED
+type RewardGrant struct {
+	GrantID         string
+	AppID           string
+	RewardID        string
+	RewardName      string // 快照：发放时的奖励名称
+	RewardType      string // 快照：发放时的奖励类型
+	RewardVersion   int32  // 快照：发放时的奖励版本
+	ContentSnapshot RewardContent
+	CampaignID      string
+	CampaignName    string // 快照：发放时的活动名称
+	TaskID          string
+	TaskName        string // 快照：发放时的任务名称
+	UserID          string
+	RequestID       string    // 调用方的幂等键：同一奖励下相同的 RequestID 只发放一次
+	Status          string    // 见 constants.RewardGrantStatus*
+	CouponCode      string    // COUPON：生成的用户专属优惠码
+	ExpireTime      time.Time // 过期时间（零值表示不过期）
+	GeneratedAt     time.Time
+	DistributedAt   time.Time
+	UsedAt          time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// RewardGrantFilter 发放记录查询条件（零值表示不筛选，AppID 必填）
+type RewardGrantFilter struct {
+	AppID      string
+	RewardID   string
+	UserID     string
+	CampaignID string
+	TaskID     string
+	Status     string    // EXPIRED 含未使用且已过过期时间的记录，其他状态不含
+	Now        time.Time // 判断过期的时间
+}
+
+// Expired 发放记录在 now 时是否已过期（未使用且已过过期时间）
+func (g *RewardGrant) Expired(now time.Time) bool {
+	if g.Status == constants.RewardGrantStatusExpired {
+		return true
+	}
+	return g.Status != constants.RewardGrantStatusUsed && !g.ExpireTime.IsZero() && !now.Before(g.ExpireTime)
+}
+
+// DisplayStatus 展示状态：未使用且已过过期时间的发放记录为 EXPIRED
+func (g *RewardGrant) DisplayStatus(now time.Time) string {
+	if g.Expired(now) {
+		return constants.RewardGrantStatusExpired
+	}
+	return g.Status
+}
+
+// RewardIssue 发放奖励请求
+type RewardIssue struct {
+	AppID      string
+	RewardID   string
+	UserID     string
+	CampaignID string            // 所属活动（可选），活动须在进行中
+	TaskID     string            // 触发发放的任务（任务引擎发放时填写）
+	TaskName   string            // 任务名称快照
+	RequestID  string            // 幂等键（可选）
+	Attrs      map[string]string // 用户属性（TAG/SEGMENT 受众求值）
+}
+
+// RewardRepo 奖励仓储接口（查询均限定在 appID 内）
+type RewardRepo interface {
+	Save(context.Context, *Reward) error
+	// Update 乐观锁更新：仅当库中版本为 r.Version-1 时写入，否则返回 ErrCodeRewardVersionConflict
+	Update(context.Context, *Reward) error
+	FindByID(ctx context.Context, appID, rewardID string) (*Reward, error)
+	List(ctx context.Context, filter *RewardFilter, page, pageSize int) ([]*Reward, int64, error)
+	// Delete 删除奖励，仍有未完成（PENDING/GENERATED）的发放记录或被任务引用时返回 ErrCodeRewardInUse
+	Delete(ctx context.Context, appID, rewardID string) error
+	// CreateGrant 在事务内锁定奖励，检查发放总量和每用户次数后写入发放记录，返回记录和是否新建
+	// RequestID 非空且已存在时直接返回已有记录（不检查限制）
+	CreateGrant(ctx context.Context, g *RewardGrant, totalCount int64, perUserLimit int32) (*RewardGrant, bool, error)
+	FindGrant(ctx context.Context, appID, grantID string) (*RewardGrant, error)
+	ListGrants(ctx context.Context, filter *RewardGrantFilter, page, pageSize int) ([]*RewardGrant, int64, error)
+	// UpdateGrantStatus 仅当发放记录当前状态为 from 时改为 g.Status，并写入 g 的优惠码和各阶段时间，返回是否修改成功
+	UpdateGrantStatus(ctx context.Context, g *RewardGrant, from string) (bool, error)
+	// GenerateGrant 在一个事务内把 PENDING 发放记录改为 GENERATED（写入 g 的优惠码和生成时间）并创建生成的优惠券（coupon 非空时），
+	// 记录已不是 PENDING 时不创建优惠券并返回 false
+	GenerateGrant(ctx context.Context, g *RewardGrant, coupon *Coupon) (bool, error)
+}
+
+// RewardUseCase 奖励用例
+type RewardUseCase struct {
+	repo         RewardRepo
+	couponRepo   CouponRepo
+	audienceRepo AudienceRepo
+	campaignRepo CampaignRepo
+	granter      RewardGranter
+	log          *log.Helper
+}
+
+// NewRewardUseCase 创建奖励用例
+func NewRewardUseCase(repo RewardRepo, couponRepo CouponRepo, audienceRepo AudienceRepo, campaignRepo CampaignRepo, granter RewardGranter, logger log.Logger) *RewardUseCase {
+	return &RewardUseCase{
+		repo:         repo,
+		couponRepo:   couponRepo,
+		audienceRepo: audienceRepo,
+		campaignRepo: campaignRepo,
+		granter:      granter,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Create 创建奖励（版本 1，状态 ACTIVE）
+func (uc *RewardUseCase) Create(ctx context.Context, r *Reward) (*Reward, error) {
+	if err := uc.validateReward(ctx, r); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	r.RewardID = GenerateShortID()
+	r.Version = 1
+	if r.Status == "" {
+		r.Status = constants.RewardStatusActive
+	}
+	r.CreatedAt = now
+	r.UpdatedAt = now
+	if err := uc.repo.Save(ctx, r); err != nil {
+		return nil, err
+	}
+	metrics.GetMetrics().RewardCreatedTotal.Inc()
+	return r, nil
+}
+
+// Get 获取奖励
+func (uc *RewardUseCase) Get(ctx context.Context, appID, rewardID string) (*Reward, error) {
+	return uc.repo.FindByID(ctx, appID, rewardID)
+}
+
+// List 列出奖励
+func (uc *RewardUseCase) List(ctx context.Context, filter *RewardFilter, page, pageSize int) ([]*Reward, int64, error) {
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// Update 更新奖励：r 为修改后的奖励（Version 为读取时的版本），写入时版本加一，期间被其他请求修改时返回版本冲突
+func (uc *RewardUseCase) Update(ctx context.Context, r *Reward) (*Reward, error) {
+	if err := uc.validateReward(ctx, r); err != nil {
+		return nil, err
+	}
+	r.Version++
+	r.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Delete 删除奖励（已完成的发放记录保留快照）
+func (uc *RewardUseCase) Delete(ctx context.Context, appID, rewardID string) error {
+	return uc.repo.Delete(ctx, appID, rewardID)
+}
+
+// validateReward 校验奖励类型、内容和配置，并清理与奖励类型无关的内容字段
+func (uc *RewardUseCase) validateReward(ctx context.Context, r *Reward) error {
+	switch r.Status {
+	case "", constants.RewardStatusActive, constants.RewardStatusPaused, constants.RewardStatusEnded:
+	default:
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if r.Distributor.Type == "" {
+		r.Distributor.Type = constants.DistributorTypeAuto
+	}
+	if v := rewardViolation(r); v != "" {
+		uc.log.Warnf("invalid reward: %s", v)
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if r.Validator.AudienceID != "" {
+		if _, err := uc.audienceRepo.FindByID(ctx, r.AppID, r.Validator.AudienceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rewardViolation 检查奖励规则，返回违反的规则说明，通过时返回空字符串
+func rewardViolation(r *Reward) string {
+	c := &r.Content
+	switch r.RewardType {
+	case constants.RewardTypeCoupon:
+		if c.Currency == "" {
+			c.Currency = constants.CouponCurrencyCNY
+		}
+		if c.DiscountType != constants.CouponDiscountTypePercent && c.DiscountType != constants.CouponDiscountTypeFixed {
+			return "discountType must be percent or fixed"
+		}
+		if c.DiscountValue <= 0 || (c.DiscountType == constants.CouponDiscountTypePercent && c.DiscountValue > 100) {
+			return "discountValue must be greater than 0 and percent must not exceed 100"
+		}
+		if !isValidCurrency(c.Currency) || c.MinAmount < 0 {
+			return "invalid currency or minAmount"
+		}
+		if r.ValidDays <= 0 {
+			return "validDays is required for coupon rewards"
+		}
+		*c = RewardContent{DiscountType: c.DiscountType, DiscountValue: c.DiscountValue, Currency: c.Currency, MinAmount: c.MinAmount}
+	case constants.RewardTypePoints:
+		if c.Points <= 0 {
+			return "points must be greater than 0"
+		}
+		*c = RewardContent{Points: c.Points}
+	case constants.RewardTypeSubscription:
+		if c.SubscriptionPlan == "" || c.SubscriptionDays <= 0 {
+			return "subscriptionPlan and subscriptionDays are required"
+		}
+		*c = RewardContent{SubscriptionPlan: c.SubscriptionPlan, SubscriptionDays: c.SubscriptionDays}
+	default:
+		return "rewardType must be COUPON, POINTS or SUBSCRIPTION"
+	}
+	if !validValidDays(r.ValidDays) {
+		return "validDays must be between 0 and 3650"
+	}
+	if !rewardCodePrefixPattern.MatchString(r.Generator.CodePrefix) || r.Generator.TotalCount < 0 {
+		return "codePrefix must be up to 16 uppercase letters or digits and totalCount must not be negative"
+	}
+	v := r.Validator
+	if !v.StartTime.IsZero() && !v.EndTime.IsZero() && !v.StartTime.Before(v.EndTime) {
+		return "validator startTime must be before endTime"
+	}
+	if v.PerUserLimit < 0 {
+		return "perUserLimit must not be negative"
+	}
+	if r.Distributor.Type != constants.DistributorTypeAuto && r.Distributor.Type != constants.DistributorTypeManual {
+		return "distributor type must be AUTO or MANUAL"
+	}
+	return ""
+}
+
+// Issue 发放奖励：校验 → 写入发放记录（PENDING，预占库存）→ 生成内容（GENERATED）→ 发放（DISTRIBUTED，MANUAL 时等待确认）
+// 相同 RequestID 重复调用时返回已有记录，未完成的记录继续生成和发放
+func (uc *RewardUseCase) Issue(ctx context.Context, req *RewardIssue) (*RewardGrant, error) {
+	reward, err := uc.repo.FindByID(ctx, req.AppID, req.RewardID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := uc.checkIssuable(ctx, reward, req, now); err != nil {
+		return nil, err
+	}
+
+	grant := &RewardGrant{
+		GrantID:         GenerateShortID(),
+		AppID:           req.AppID,
+		RewardID:        reward.RewardID,
+		RewardName:      reward.Name,
+		RewardType:      reward.RewardType,
+		RewardVersion:   reward.Version,
+		ContentSnapshot: reward.Content,
+		CampaignID:      req.CampaignID,
+		TaskID:          req.TaskID,
+		TaskName:        req.TaskName,
+		UserID:          req.UserID,
+		RequestID:       req.RequestID,
+		Status:          constants.RewardGrantStatusPending,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if reward.ValidDays > 0 {
+		grant.ExpireTime = now.AddDate(0, 0, int(reward.ValidDays))
+	}
+	if req.CampaignID != "" {
+		campaign, err := uc.campaignRepo.FindByID(ctx, req.AppID, req.CampaignID)
+		if err != nil {
+			return nil, err
+		}
+		if !campaign.IsRunning(now) {
+			return nil, errors.NewBizError(marketingErrors.ErrCodeCampaignNotRunning, "zh-CN")
+		}
+		grant.CampaignName = campaign.Name
+	}
+
+	grant, _, err = uc.repo.CreateGrant(ctx, grant, reward.Generator.TotalCount, reward.Validator.PerUserLimit)
+	if err != nil {
+		return nil, err
+	}
+	if grant.Status == constants.RewardGrantStatusPending {
+		if err := uc.generate(ctx, reward, grant); err != nil {
+			return nil, err
+		}
+	}
+	if grant.Status == constants.RewardGrantStatusGenerated && reward.Distributor.Type == constants.DistributorTypeAuto {
+		if err := uc.distribute(ctx, grant); err != nil {
+			return nil, err
+		}
+	}
+	return grant, nil
+}
+
+// checkIssuable 执行校验链：奖励状态、可发放时间、受众
+func (uc *RewardUseCase) checkIssuable(ctx context.Context, r *Reward, req *RewardIssue, now time.Time) error {
+	v := r.Validator
+	if r.Status != constants.RewardStatusActive ||
+		(!v.StartTime.IsZero() && now.Before(v.StartTime)) || (!v.EndTime.IsZero() && !now.Before(v.EndTime)) {
+		return errors.NewBizError(marketingErrors.ErrCodeRewardNotActive, "zh-CN")
+	}
+	if v.AudienceID == "" {
+		return nil
+	}
+	audience, err := uc.audienceRepo.FindByID(ctx, r.AppID, v.AudienceID)
+	if err != nil {
+		return err
+	}
+	ok, err := audienceMatches(ctx, uc.audienceRepo, audience, req.UserID, req.Attrs)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.NewBizError(marketingErrors.ErrCodeRewardNotEligible, "zh-CN")
+	}
+	return nil
+}
+
+// generate 生成奖励内容（PENDING → GENERATED）：COUPON 生成绑定用户的一次性优惠券（发放前未激活），其他类型直接使用内容快照
+// 优惠券与状态迁移在同一事务内写入，并发生成或迁移失败时不会留下孤立的优惠券
+func (uc *RewardUseCase) generate(ctx context.Context, reward *Reward, g *RewardGrant) error {
+	start := time.Now()
+	var coupon *Coupon
+	if g.RewardType == constants.RewardTypeCoupon {
+		code, err := randomRedeemCode(reward.Generator.CodePrefix, rewardCouponCodeLength)
+		if err != nil {
+			uc.log.Errorf("failed to generate reward coupon code: %v", err)
+			return errors.NewBizError(marketingErrors.ErrCodeRewardGenerateFailed, "zh-CN")
+		}
+		c := g.ContentSnapshot
+		coupon = &Coupon{
+			CouponCode:    code,
+			AppID:         g.AppID,
+			DiscountType:  c.DiscountType,
+			DiscountValue: c.DiscountValue,
+			Currency:      c.Currency,
+			MinAmount:     c.MinAmount,
+			ValidFrom:     g.CreatedAt,
+			ValidUntil:    g.ExpireTime,
+			MaxUses:       1,
+			BoundUserID:   g.UserID,
+			Status:        constants.CouponStatusInactive,
+		}
+		applyCouponDefaults(coupon, start)
+		g.CouponCode = code
+	}
+	g.Status = constants.RewardGrantStatusGenerated
+	g.GeneratedAt = time.Now()
+	g.UpdatedAt = g.GeneratedAt
+	ok, err := uc.repo.GenerateGrant(ctx, g, coupon)
+	if err != nil {
+		return errors.NewBizError(marketingErrors.ErrCodeRewardGenerateFailed, "zh-CN")
+	}
+	if !ok {
+		return errors.NewBizError(marketingErrors.ErrCodeRewardGrantStatusInvalid, "zh-CN")
+	}
+	metrics.GetMetrics().RewardGenerationDuration.WithLabelValues(g.RewardType).Observe(time.Since(start).Seconds())
+	return nil
+}
+
+// distribute 发放奖励（GENERATED → DISTRIBUTED）：COUPON 激活生成的优惠券，POINTS/SUBSCRIPTION 通过 RewardGranter 发放（以发放记录ID作为幂等键）
+// 发放失败时记录保持 GENERATED，可调用 Distribute 重试
+func (uc *RewardUseCase) distribute(ctx context.Context, g *RewardGrant) error {
+	start := time.Now()
+	err := uc.deliver(ctx, g)
+	status := "success"
+	if err != nil {
+		status = "failed"
+	}
+	metrics.GetMetrics().RewardDistributionDuration.WithLabelValues(g.RewardType, status).Observe(time.Since(start).Seconds())
+	if err != nil {
+		uc.log.Errorf("failed to distribute reward: grant_id=%s, reward_type=%s, err=%v", g.GrantID, g.RewardType, err)
+		return errors.NewBizError(marketingErrors.ErrCodeRewardDistributeFailed, "zh-CN")
+	}
+
+	g.Status = constants.RewardGrantStatusDistributed
+	g.DistributedAt = time.Now()
+	g.UpdatedAt = g.DistributedAt
+	if err := uc.transitionGrant(ctx, g, constants.RewardGrantStatusGenerated); err != nil {
+		return err
+	}
+	metrics.GetMetrics().RewardGrantedTotal.Inc()
+	metrics.GetMetrics().RewardGrantedByType.WithLabelValues(g.RewardType).Inc()
+	return nil
+}
+
+// deliver 把奖励内容交付给用户
+func (uc *RewardUseCase) deliver(ctx context.Context, g *RewardGrant) error {
+	c := g.ContentSnapshot
+	switch g.RewardType {
+	case constants.RewardTypeCoupon:
+		coupon, err := uc.couponRepo.FindByCode(ctx, g.CouponCode)
+		if err != nil {
+			return err
+		}
+		if coupon == nil {
+			return errors.NewBizError(errors.ErrCodeNotFound, "zh-CN")
+		}
+		if coupon.Status == constants.CouponStatusActive {
+			return nil
+		}
+		coupon.Status = constants.CouponStatusActive
+		coupon.UpdatedAt = time.Now()
+		_, err = uc.couponRepo.Update(ctx, coupon)
+		return err
+	case constants.RewardTypePoints:
+		return uc.granter.GrantPoints(ctx, g.AppID, g.UserID, c.Points, g.GrantID)
+	case constants.RewardTypeSubscription:
+		return uc.granter.GrantSubscription(ctx, g.AppID, g.UserID, c.SubscriptionPlan, c.SubscriptionDays, g.GrantID)
+	}
+	return nil
+}
+
+// transitionGrant 条件更新发放记录状态，状态已被并发修改时返回状态无效
+func (uc *RewardUseCase) transitionGrant(ctx context.Context, g *RewardGrant, from string) error {
+	ok, err := uc.repo.UpdateGrantStatus(ctx, g, from)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.NewBizError(marketingErrors.ErrCodeRewardGrantStatusInvalid, "zh-CN")
+	}
+	return nil
+}
+
+// GetGrant 获取发放记录
+func (uc *RewardUseCase) GetGrant(ctx context.Context, appID, grantID string) (*RewardGrant, error) {
+	return uc.repo.FindGrant(ctx, appID, grantID)
+}
+
+// ListGrants 列出发放记录
+func (uc *RewardUseCase) ListGrants(ctx context.Context, filter *RewardGrantFilter, page, pageSize int) ([]*RewardGrant, int64, error) {
+	if filter.Now.IsZero() {
+		filter.Now = time.Now()
+	}
+	return uc.repo.ListGrants(ctx, filter, page, pageSize)
+}
+
+// Distribute 确认发放：MANUAL 发放器生成后的记录，或自动发放失败后重试（PENDING 的记录先重新生成）
+func (uc *RewardUseCase) Distribute(ctx context.Context, appID, grantID string) (*RewardGrant, error) {
+	g, err := uc.repo.FindGrant(ctx, appID, grantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkNotExpired(ctx, g); err != nil {
+		return nil, err
+	}
+	if g.Status == constants.RewardGrantStatusPending {
+		reward, err := uc.repo.FindByID(ctx, appID, g.RewardID)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.generate(ctx, reward, g); err != nil {
+			return nil, err
+		}
+	}
+	if g.Status != constants.RewardGrantStatusGenerated {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeRewardGrantStatusInvalid, "zh-CN")
+	}
+	if err := uc.distribute(ctx, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Use 标记发放记录已使用（DISTRIBUTED → USED），供积分、订阅等下游回传使用结果
+// COUPON 奖励在使用生成的优惠券时自动标记，不能手动标记
+func (uc *RewardUseCase) Use(ctx context.Context, appID, grantID string) (*RewardGrant, error) {
+	g, err := uc.repo.FindGrant(ctx, appID, grantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkNotExpired(ctx, g); err != nil {
+		return nil, err
+	}
+	if g.RewardType == constants.RewardTypeCoupon || g.Status != constants.RewardGrantStatusDistributed {
+		return nil, errors.NewBizError(marketingErrors.ErrCodeRewardGrantStatusInvalid, "zh-CN")
+	}
+	g.Status = constants.RewardGrantStatusUsed
+	g.UsedAt = time.Now()
+	g.UpdatedAt = g.UsedAt
+	if err := uc.transitionGrant(ctx, g, constants.RewardGrantStatusDistributed); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// checkNotExpired 已过期的记录返回状态无效，未标记的顺便标记为 EXPIRED
+func (uc *RewardUseCase) checkNotExpired(ctx context.Context, g *RewardGrant) error {
+	if !g.Expired(time.Now()) {
+		return nil
+	}
+	if g.Status != constants.RewardGrantStatusExpired {
+		from := g.Status
+		g.Status = constants.RewardGrantStatusExpired
+		g.UpdatedAt = time.Now()
+		if _, err := uc.repo.UpdateGrantStatus(ctx, g, from); err != nil {
+			uc.log.Warnf("failed to mark reward grant expired: grant_id=%s, err=%v", g.GrantID, err)
+		}
+	}
+	return errors.NewBizError(marketingErrors.ErrCodeRewardGrantStatusInvalid, "zh-CN")
+}
@@ -0,0 +1,432 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+	"marketing-service/internal/metrics"
+
+	"github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	taskEventIDMaxLength       = 64          // 事件ID最大长度（与发放记录的幂等键拼接后不超过 128）
+	taskUserIDMaxLength        = 36          // 用户ID最大长度（与进度、事件记录的 user_id 列一致）
+	defaultInviteUserAttribute = "inviterId" // 邀请任务默认从事件属性中读取邀请人
+)
+
+// taskTypeEvents 各任务类型的触发事件（SHARE 没有对应的事件，须在触发配置中指定）
+var taskTypeEvents = map[string]string{
+	constants.TaskTypeInvite:   constants.EventTypeUserRegister,
+	constants.TaskTypePurchase: constants.EventTypeOrderPaid,
+	constants.TaskTypeSignIn:   constants.EventTypeUserSignIn,
+}
+
+// Task 任务领域对象：用户完成触发条件后发放关联的奖励
+type Task struct {
+	TaskID      string
+	AppID       string
+	CampaignID  string // 所属活动（可选，须为 TASK_REWARD 类型），活动不在进行中时不处理事件
+	Name        string
+	Description string
+	TaskType    string // 见 constants.TaskType*
+	Trigger     TaskTriggerConfig
+	Condition   TaskConditionConfig
+	RewardID    string // 完成任务后发放的奖励
+	Repeatable  bool   // 是否可重复完成：是则每完成一次进度归零，否则完成后不再计入事件
+	Status      string // 见 constants.TaskStatus*，只有 ACTIVE 的任务处理事件
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TaskTriggerConfig 触发配置：哪种事件推进任务、推进谁的进度
+type TaskTriggerConfig struct {
+	EventType     string // 见 constants.EventType*
+	UserAttribute string // 非空时事件属性中该字段的值为获得进度的用户（如邀请任务的邀请人），否则为事件用户
+}
+
+// TaskConditionConfig 完成条件：事件须满足的条件和完成所需次数
+type TaskConditionConfig struct {
+	MinAmount   int64             // ORDER_PAID：订单金额下限（0 表示不限）
+	Currency    string            // ORDER_PAID：订单币种（为空表示不限）
+	Attributes  map[string]string // 事件属性须与这些键值全部相等
+	TargetCount int32             // 完成所需的事件次数（默认 1）
+}
+
+// TaskFilter 任务查询条件（零值表示不筛选，AppID 必填）
+type TaskFilter struct {
+	AppID      string
+	CampaignID string
+	TaskType   string
+	Status     string
+}
+
+// TaskEvent 业务事件（用户注册、订单支付、签到等），EventID 由事件方生成，同一事件重复上报只处理一次
+type TaskEvent struct {
+	EventID    string
+	AppID      string
+	EventType  string // 见 constants.EventType*
+	UserID     string
+	Amount     int64  // ORDER_PAID：订单金额(分)
+	Currency   string // ORDER_PAID：订单币种
+	Attributes map[string]string
+}
+
+// Valid 事件类型是否支持、事件ID和用户ID是否有效
+func (e *TaskEvent) Valid() bool {
+	switch e.EventType {
+	case constants.EventTypeUserRegister, constants.EventTypeOrderPaid, constants.EventTypeUserSignIn:
+	default:
+		return false
+	}
+	return e.EventID != "" && len(e.EventID) <= taskEventIDMaxLength &&
+		e.UserID != "" && len(e.UserID) <= taskUserIDMaxLength
+}
+
+// TaskProgress 用户任务进度
+type TaskProgress struct {
+	TaskID      string
+	AppID       string
+	UserID      string
+	Progress    int32  // 当前已计入的事件次数
+	Target      int32  // 完成所需次数（最近一次计入时的任务配置）
+	Completions int32  // 已完成次数
+	Status      string // 见 constants.TaskProgressStatus*
+	LastEventID string
+	CompletedAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TaskProgressFilter 任务进度查询条件（零值表示不筛选，AppID 必填）
+type TaskProgressFilter struct {
+	AppID  string
+	TaskID string
+	UserID string
+	Status string
+}
+
+// TaskEventRecord 任务事件记录：每个任务对每个事件ID只计入一次，完成任务的记录关联发放的奖励
+type TaskEventRecord struct {
+	TaskID    string
+	EventID   string
+	AppID     string
+	UserID    string // 获得进度的用户
+	Completed bool   // 该事件完成了任务
+	GrantID   string // 完成任务后发放的奖励记录（发放失败时为空，重复上报事件时重试发放）
+	CreatedAt time.Time
+}
+
+// TaskTriggerResult 事件对单个任务的处理结果
+type TaskTriggerResult struct {
+	TaskID      string
+	TaskName    string
+	UserID      string // 获得进度的用户
+	Progress    int32
+	Target      int32
+	Completions int32
+	Completed   bool   // 该事件完成了任务
+	Duplicate   bool   // 该事件已处理过
+	Ignored     bool   // 任务已完成且不可重复完成，事件不再计入
+	GrantID     string // 发放的奖励记录
+	RewardError string // 奖励发放失败的原因（重复上报该事件时重试发放）
+}
+
+// TaskRepo 任务仓储接口（查询均限定在 appID 内）
+type TaskRepo interface {
+	Save(context.Context, *Task) error
+	Update(context.Context, *Task) error
+	FindByID(ctx context.Context, appID, taskID string) (*Task, error)
+	List(ctx context.Context, filter *TaskFilter, page, pageSize int) ([]*Task, int64, error)
+	// Delete 删除任务及其进度和事件记录
+	Delete(ctx context.Context, appID, taskID string) error
+	// ListActive 列出应用内由该事件类型触发的 ACTIVE 任务
+	ListActive(ctx context.Context, appID, eventType string) ([]*Task, error)
+	// RecordEvent 在事务内锁定用户进度并计入事件：
+	// 事件已计入过时返回已有记录和 duplicate=true；任务已完成且不可重复完成时返回 nil 记录；否则写入 rec 并返回更新后的进度
+	RecordEvent(ctx context.Context, t *Task, rec *TaskEventRecord) (progress *TaskProgress, record *TaskEventRecord, duplicate bool, err error)
+	// SetEventGrant 关联事件记录发放的奖励
+	SetEventGrant(ctx context.Context, taskID, eventID, grantID string) error
+	ListProgress(ctx context.Context, filter *TaskProgressFilter, page, pageSize int) ([]*TaskProgress, int64, error)
+}
+
+// TaskUseCase 任务用例：维护任务配置，并按事件推进用户进度、发放奖励
+type TaskUseCase struct {
+	repo         TaskRepo
+	rewardRepo   RewardRepo
+	campaignRepo CampaignRepo
+	rewards      *RewardUseCase
+	log          *log.Helper
+}
+
+// NewTaskUseCase 创建任务用例
+func NewTaskUseCase(repo TaskRepo, rewardRepo RewardRepo, campaignRepo CampaignRepo, rewards *RewardUseCase, logger log.Logger) *TaskUseCase {
+	return &TaskUseCase{
+		repo:         repo,
+		rewardRepo:   rewardRepo,
+		campaignRepo: campaignRepo,
+		rewards:      rewards,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Create 创建任务（默认 ACTIVE）
+func (uc *TaskUseCase) Create(ctx context.Context, t *Task) (*Task, error) {
+	if t.Status == "" {
+		t.Status = constants.TaskStatusActive
+	}
+	if err := uc.validateTask(ctx, t); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	t.TaskID = GenerateShortID()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	if err := uc.repo.Save(ctx, t); err != nil {
+		return nil, err
+	}
+	metrics.GetMetrics().TaskCreatedTotal.Inc()
+	return t, nil
+}
+
+// Get 获取任务
+func (uc *TaskUseCase) Get(ctx context.Context, appID, taskID string) (*Task, error) {
+	return uc.repo.FindByID(ctx, appID, taskID)
+}
+
+// List 列出任务
+func (uc *TaskUseCase) List(ctx context.Context, filter *TaskFilter, page, pageSize int) ([]*Task, int64, error) {
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// Update 更新任务（已计入的进度保留，之后的事件按新配置处理）
+func (uc *TaskUseCase) Update(ctx context.Context, t *Task) (*Task, error) {
+	if err := uc.validateTask(ctx, t); err != nil {
+		return nil, err
+	}
+	t.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Delete 删除任务
+func (uc *TaskUseCase) Delete(ctx context.Context, appID, taskID string) error {
+	return uc.repo.Delete(ctx, appID, taskID)
+}
+
+// ListProgress 列出用户任务进度
+func (uc *TaskUseCase) ListProgress(ctx context.Context, filter *TaskProgressFilter, page, pageSize int) ([]*TaskProgress, int64, error) {
+	return uc.repo.ListProgress(ctx, filter, page, pageSize)
+}
+
+// validateTask 校验任务类型、触发配置和完成条件，并检查关联的奖励和活动
+func (uc *TaskUseCase) validateTask(ctx context.Context, t *Task) error {
+	if v := taskViolation(t); v != "" {
+		uc.log.Warnf("invalid task: %s", v)
+		return errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if _, err := uc.rewardRepo.FindByID(ctx, t.AppID, t.RewardID); err != nil {
+		return err
+	}
+	if t.CampaignID != "" {
+		campaign, err := uc.campaignRepo.FindByID(ctx, t.AppID, t.CampaignID)
+		if err != nil {
+			return err
+		}
+		if campaign.Type != constants.CampaignTypeTaskReward {
+			return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+		}
+	}
+	return nil
+}
+
+// taskViolation 检查任务规则（补全默认触发事件和完成次数），返回违反的规则说明，通过时返回空字符串
+func taskViolation(t *Task) string {
+	switch t.Status {
+	case constants.TaskStatusActive, constants.TaskStatusPaused, constants.TaskStatusEnded:
+	default:
+		return "status must be ACTIVE, PAUSED or ENDED"
+	}
+	if t.TaskType != constants.TaskTypeShare {
+		event, ok := taskTypeEvents[t.TaskType]
+		if !ok {
+			return "taskType must be INVITE, PURCHASE, SHARE or SIGN_IN"
+		}
+		if t.Trigger.EventType != "" && t.Trigger.EventType != event {
+			return "trigger eventType does not match taskType"
+		}
+		t.Trigger.EventType = event
+	}
+	switch t.Trigger.EventType {
+	case constants.EventTypeUserRegister, constants.EventTypeOrderPaid, constants.EventTypeUserSignIn:
+	default:
+		return "trigger eventType must be USER_REGISTER, ORDER_PAID or USER_SIGN_IN"
+	}
+	if t.TaskType == constants.TaskTypeInvite && t.Trigger.UserAttribute == "" {
+		t.Trigger.UserAttribute = defaultInviteUserAttribute
+	}
+	c := &t.Condition
+	if c.TargetCount == 0 {
+		c.TargetCount = 1
+	}
+	if c.TargetCount < 0 || c.MinAmount < 0 {
+		return "targetCount and minAmount must not be negative"
+	}
+	if c.Currency != "" && !isValidCurrency(c.Currency) {
+		return "invalid condition currency"
+	}
+	if t.RewardID == "" {
+		return "rewardId is required"
+	}
+	return ""
+}
+
+// Matches 事件是否满足完成条件（事件类型由 ListActive 筛选）
+func (c *TaskConditionConfig) Matches(e *TaskEvent) bool {
+	if c.MinAmount > 0 && e.Amount < c.MinAmount {
+		return false
+	}
+	if c.Currency != "" && e.Currency != c.Currency {
+		return false
+	}
+	for k, v := range c.Attributes {
+		if e.Attributes[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// creditedUser 获得进度的用户：配置了 UserAttribute 时取事件属性（不能是事件用户本人，超长视为无效），否则为事件用户
+func (t *Task) creditedUser(e *TaskEvent) string {
+	if t.Trigger.UserAttribute == "" {
+		return e.UserID
+	}
+	userID := e.Attributes[t.Trigger.UserAttribute]
+	if userID == e.UserID || len(userID) > taskUserIDMaxLength {
+		return ""
+	}
+	return userID
+}
+
+// Trigger 处理业务事件：匹配 ACTIVE 任务的触发配置和完成条件，按事件ID幂等地计入用户进度，完成任务时发放关联的奖励
+// 奖励发放失败不影响进度，结果中返回失败原因（GrantID 为空），重复上报同一事件时以相同的幂等键重试发放（Stream 消费时该事件不 ACK，接管后自动重试）；
+// 写入进度失败时返回错误，可重试整个事件
+func (uc *TaskUseCase) Trigger(ctx context.Context, e *TaskEvent) ([]*TaskTriggerResult, error) {
+	if !e.Valid() {
+		return nil, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+
+	tasks, err := uc.repo.ListActive(ctx, e.AppID, e.EventType)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	running := make(map[string]*Campaign)
+	results := make([]*TaskTriggerResult, 0, len(tasks))
+	for _, t := range tasks {
+		if t.CampaignID != "" {
+			campaign, ok := running[t.CampaignID]
+			if !ok {
+				if campaign, err = uc.campaignRepo.FindByID(ctx, e.AppID, t.CampaignID); err != nil {
+					uc.log.Warnf("failed to find task campaign: task_id=%s, campaign_id=%s, err=%v", t.TaskID, t.CampaignID, err)
+					campaign = nil
+				}
+				running[t.CampaignID] = campaign
+			}
+			if campaign == nil || !campaign.IsRunning(now) {
+				continue
+			}
+		}
+		if !t.Condition.Matches(e) {
+			continue
+		}
+		userID := t.creditedUser(e)
+		if userID == "" {
+			continue
+		}
+
+		result, err := uc.apply(ctx, t, e, userID)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// apply 计入任务进度，完成任务时发放奖励
+func (uc *TaskUseCase) apply(ctx context.Context, t *Task, e *TaskEvent, userID string) (*TaskTriggerResult, error) {
+	start := time.Now()
+	metrics.GetMetrics().TaskTriggeredTotal.Inc()
+	progress, rec, duplicate, err := uc.repo.RecordEvent(ctx, t, &TaskEventRecord{
+		TaskID:    t.TaskID,
+		EventID:   e.EventID,
+		AppID:     e.AppID,
+		UserID:    userID,
+		CreatedAt: start,
+	})
+	if err != nil {
+		metrics.GetMetrics().TaskTriggerDuration.WithLabelValues(t.TaskType, "failed").Observe(time.Since(start).Seconds())
+		uc.log.Errorf("failed to record task event: task_id=%s, event_id=%s, err=%v", t.TaskID, e.EventID, err)
+		return nil, errors.NewBizError(marketingErrors.ErrCodeTaskTriggerFailed, "zh-CN")
+	}
+
+	result := &TaskTriggerResult{
+		TaskID:      t.TaskID,
+		TaskName:    t.Name,
+		UserID:      userID,
+		Progress:    progress.Progress,
+		Target:      progress.Target,
+		Completions: progress.Completions,
+		Duplicate:   duplicate,
+		Ignored:     rec == nil,
+	}
+	status := "progressed"
+	switch {
+	case rec == nil:
+		status = "ignored"
+	case duplicate:
+		status = "duplicate"
+	case rec.Completed:
+		status = "completed"
+		metrics.GetMetrics().TaskCompletedTotal.Inc()
+	}
+	if rec != nil && rec.Completed {
+		result.Completed = true
+		result.GrantID = rec.GrantID
+		if rec.GrantID == "" {
+			uc.issueReward(ctx, t, e, rec, result)
+		}
+	}
+	metrics.GetMetrics().TaskTriggerDuration.WithLabelValues(t.TaskType, status).Observe(time.Since(start).Seconds())
+	return result, nil
+}
+
+// issueReward 发放任务奖励：幂等键由任务ID和事件ID组成，重复发放返回同一条发放记录
+func (uc *TaskUseCase) issueReward(ctx context.Context, t *Task, e *TaskEvent, rec *TaskEventRecord, result *TaskTriggerResult) {
+	grant, err := uc.rewards.Issue(ctx, &RewardIssue{
+		AppID:      rec.AppID,
+		RewardID:   t.RewardID,
+		UserID:     rec.UserID,
+		CampaignID: t.CampaignID,
+		TaskID:     t.TaskID,
+		TaskName:   t.Name,
+		RequestID:  "task:" + t.TaskID + ":" + rec.EventID,
+		Attrs:      e.Attributes,
+	})
+	if err != nil {
+		uc.log.Warnf("failed to issue task reward: task_id=%s, event_id=%s, user_id=%s, err=%v", t.TaskID, rec.EventID, rec.UserID, err)
+		result.RewardError = err.Error()
+		return
+	}
+	result.GrantID = grant.GrantID
+	if err := uc.repo.SetEventGrant(ctx, t.TaskID, rec.EventID, grant.GrantID); err != nil {
+		uc.log.Warnf("failed to link task reward grant: task_id=%s, event_id=%s, grant_id=%s, err=%v", t.TaskID, rec.EventID, grant.GrantID, err)
+	}
+}
@@ -0,0 +1,199 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/constants"
+	marketingErrors "marketing-service/internal/errors"
+
+	"github.com/gaoyong06/go-pkg/errors"
+)
+
+// UserCoupon 用户优惠券（发放给用户的优惠券实例，有效期独立于优惠券本身）
+type UserCoupon struct {
+	UserCouponID   string
+	AppID          string
+	CouponCode     string
+	UserID         string
+	Status         string    // 见 constants.UserCouponStatus*
+	ValidFrom      time.Time // 实例生效时间
+	ValidUntil     time.Time // 实例过期时间
+	IssuedAt       time.Time // 发放时间
+	UsedAt         time.Time // 使用时间（未使用时为零值）
+	PaymentOrderID string    // 使用时的支付订单ID
+	Coupon         *Coupon   // 优惠券详情（查询时填充，优惠券已删除时仍返回）
+}
+
+// UserCouponFilter 用户优惠券列表查询条件
+type UserCouponFilter struct {
+	AppID  string    // 应用ID
+	UserID string    // 用户ID
+	Status string    // 状态：available/used/expired，空表示全部
+	Now    time.Time // 判断是否过期的时间点
+}
+
+// Usable 实例在 now 时刻是否可用
+func (u *UserCoupon) Usable(now time.Time) bool {
+	return u.Status == constants.UserCouponStatusAvailable && !now.Before(u.ValidFrom) && now.Before(u.ValidUntil)
+}
+
+// DisplayStatus 展示状态：未使用且已过有效期的实例为 expired
+func (u *UserCoupon) DisplayStatus(now time.Time) string {
+	if u.Status == constants.UserCouponStatusAvailable && !now.Before(u.ValidUntil) {
+		return constants.UserCouponStatusExpired
+	}
+	return u.Status
+}
+
+// UserCouponRepo 用户优惠券仓储接口
+type UserCouponRepo interface {
+	// Issue 发放优惠券实例：锁定优惠券后检查用户是否已持有未过期的可用实例，已持有时返回 ErrCodeUserCouponAlreadyIssued
+	Issue(context.Context, *UserCoupon) error
+	// Claim 用户领取优惠券实例：锁定优惠券后检查领取总量和用户领取次数，
+	// 超出时返回 ErrCodeCouponClaimStockExhausted / ErrCodeCouponClaimLimitReached
+	Claim(context.Context, *UserCoupon) error
+	FindByID(ctx context.Context, appID, userCouponID string) (*UserCoupon, error)
+	List(ctx context.Context, filter *UserCouponFilter, page, pageSize int) ([]*UserCoupon, int64, error)
+	// ListAvailable 列出用户持有的某优惠券的未使用实例（含已过期），按过期时间升序
+	ListAvailable(ctx context.Context, appID, code, userID string) ([]*UserCoupon, error)
+}
+
+// Issue 将优惠券发放给用户，生成独立有效期的实例（后台发放，不受领取限制，但计入已领取数量）
+// 相对有效期优惠券的实例有效期为 [发放时间, 发放时间 + ValidDays 天)，其他优惠券沿用优惠券本身的有效期；
+// 只能在优惠券的有效期（相对有效期优惠券即发放期）内发放
+func (uc *CouponUseCase) Issue(ctx context.Context, appID, code, userID string) (*UserCoupon, error) {
+	return uc.grant(ctx, appID, code, userID, uc.userCouponRepo.Issue)
+}
+
+// Claim 用户领取优惠券到券包，受每用户领取次数和领取总量限制，有效期规则同 Issue
+func (uc *CouponUseCase) Claim(ctx context.Context, appID, code, userID string) (*UserCoupon, error) {
+	return uc.grant(ctx, appID, code, userID, uc.userCouponRepo.Claim)
+}
+
+// grant 校验优惠券可发放后生成实例并写入（发放和领取共用）
+func (uc *CouponUseCase) grant(ctx context.Context, appID, code, userID string, save func(context.Context, *UserCoupon) error) (*UserCoupon, error) {
+	coupon, err := uc.repo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := checkGrantable(coupon, appID, userID, now); err != nil {
+		return nil, err
+	}
+
+	instance := newUserCoupon(coupon, userID, now)
+	if err := save(ctx, instance); err != nil {
+		return nil, err
+	}
+	instance.Coupon = coupon
+	return instance, nil
+}
+
+// GetUserCoupon 获取用户优惠券（含优惠券详情）
+func (uc *CouponUseCase) GetUserCoupon(ctx context.Context, appID, userCouponID string) (*UserCoupon, error) {
+	u, err := uc.userCouponRepo.FindByID(ctx, appID, userCouponID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.attachCoupons(ctx, []*UserCoupon{u}); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// ListUserCoupons 列出用户券包（按状态分栏：available/used/expired，空表示全部），含优惠券详情
+func (uc *CouponUseCase) ListUserCoupons(ctx context.Context, filter *UserCouponFilter, page, pageSize int) ([]*UserCoupon, int64, error) {
+	switch filter.Status {
+	case "", constants.UserCouponStatusAvailable, constants.UserCouponStatusUsed, constants.UserCouponStatusExpired:
+	default:
+		return nil, 0, errors.NewBizError(errors.ErrCodeInvalidArgument, "zh-CN")
+	}
+	if filter.Now.IsZero() {
+		filter.Now = time.Now()
+	}
+	list, total, err := uc.userCouponRepo.List(ctx, filter, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.attachCoupons(ctx, list); err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// attachCoupons 为用户优惠券填充优惠券详情
+func (uc *CouponUseCase) attachCoupons(ctx context.Context, list []*UserCoupon) error {
+	if len(list) == 0 {
+		return nil
+	}
+	codes := make([]string, 0, len(list))
+	for _, u := range list {
+		codes = append(codes, u.CouponCode)
+	}
+	coupons, err := uc.repo.FindByCodes(ctx, codes)
+	if err != nil {
+		return err
+	}
+	for _, u := range list {
+		u.Coupon = coupons[u.CouponCode]
+	}
+	return nil
+}
+
+// checkGrantable 检查优惠券在 now 时可以发放给该用户（发放、领取和兑换码兑换共用）
+func checkGrantable(coupon *Coupon, appID, userID string, now time.Time) error {
+	if coupon == nil || coupon.AppID != appID {
+		return errors.NewBizError(errors.ErrCodeNotFound, "zh-CN")
+	}
+	if !coupon.BoundTo(userID) {
+		return errors.NewBizError(marketingErrors.ErrCodeCouponUserMismatch, "zh-CN")
+	}
+	if coupon.Status != constants.CouponStatusActive || now.Before(coupon.ValidFrom) || now.After(coupon.ValidUntil) {
+		return errors.NewBizError(errors.ErrCodeBusinessRuleViolation, "zh-CN")
+	}
+	return nil
+}
+
+// newUserCoupon 按优惠券规则生成用户优惠券实例
+func newUserCoupon(coupon *Coupon, userID string, now time.Time) *UserCoupon {
+	u := &UserCoupon{
+		UserCouponID: GenerateShortID(),
+		AppID:        coupon.AppID,
+		CouponCode:   coupon.CouponCode,
+		UserID:       userID,
+		Status:       constants.UserCouponStatusAvailable,
+		ValidFrom:    coupon.ValidFrom,
+		ValidUntil:   coupon.ValidUntil,
+		IssuedAt:     now,
+	}
+	if coupon.IsRelative() {
+		u.ValidFrom = now
+		u.ValidUntil = now.AddDate(0, 0, int(coupon.ValidDays))
+	}
+	return u
+}
+
+// checkUserCoupon 检查相对有效期优惠券对该用户是否可用，返回验证结果原因
+func (uc *CouponUseCase) checkUserCoupon(ctx context.Context, coupon *Coupon, userID string, now time.Time) (string, error) {
+	if userID == "" {
+		return constants.ValidateReasonNotIssued, nil
+	}
+	instances, err := uc.userCouponRepo.ListAvailable(ctx, coupon.AppID, coupon.CouponCode, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(instances) == 0 {
+		return constants.ValidateReasonNotIssued, nil
+	}
+	reason := constants.ValidateReasonExpired
+	for _, u := range instances {
+		if u.Usable(now) {
+			return constants.ValidateReasonOK, nil
+		}
+		if now.Before(u.ValidFrom) {
+			reason = constants.ValidateReasonNotStarted
+		}
+	}
+	return reason, nil
+}
@@ -0,0 +1,10 @@
+package biz
+
+import "context"
+
+// UserHistoryProvider 用户历史查询（首单优惠券资格判断），可接入用户服务或订单服务
+// 未接入时使用 data 层的本地实现
+type UserHistoryProvider interface {
+	// HasOrdered 用户在应用内是否有过订单（不计 excludePaymentOrderID，即正在支付的订单）
+	HasOrdered(ctx context.Context, appID, userID, excludePaymentOrderID string) (bool, error)
+}
@@ -141,6 +141,8 @@ type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Export        *Data_Export           `protobuf:"bytes,3,opt,name=export,proto3" json:"export,omitempty"`
+	EventStream   *Data_EventStream      `protobuf:"bytes,4,opt,name=event_stream,json=eventStream,proto3" json:"event_stream,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,6 +191,20 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetExport() *Data_Export {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+func (x *Data) GetEventStream() *Data_EventStream {
+	if x != nil {
+		return x.EventStream
+	}
+	return nil
+}
+
 // 客户端配置
 type Client struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -538,6 +554,158 @@ func (x *Data_Redis) GetMinIdleConns() int32 {
 	return 0
 }
 
+type Data_Export struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocalDir      string                 `protobuf:"bytes,1,opt,name=local_dir,json=localDir,proto3" json:"local_dir,omitempty"` // 导出文件本地存储目录（默认 ./data/exports）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Export) Reset() {
+	*x = Data_Export{}
+	mi := &file_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Export) ProtoMessage() {}
+
+func (x *Data_Export) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Export.ProtoReflect.Descriptor instead.
+func (*Data_Export) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Export) GetLocalDir() string {
+	if x != nil {
+		return x.LocalDir
+	}
+	return ""
+}
+
+type Data_EventStream struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Enabled          bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                            // 是否从 Redis Stream 消费任务事件（默认关闭，事件也可以通过 TriggerEvent 接口上报）
+	Stream           string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`                                               // Stream 名称（默认 marketing:events）
+	Group            string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`                                                 // 消费者组（默认 marketing-service）
+	Consumer         string                 `protobuf:"bytes,4,opt,name=consumer,proto3" json:"consumer,omitempty"`                                           // 消费者名称（默认主机名）
+	BatchSize        int32                  `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                       // 每次读取的最大事件数（默认 32）
+	Block            *durationpb.Duration   `protobuf:"bytes,6,opt,name=block,proto3" json:"block,omitempty"`                                                 // 没有新事件时的阻塞等待时间（默认 5s）
+	MaxDeliveries    int32                  `protobuf:"varint,7,opt,name=max_deliveries,json=maxDeliveries,proto3" json:"max_deliveries,omitempty"`           // 单个事件的最大投递次数，超过后转入死信 Stream（默认 5）
+	DeadLetterStream string                 `protobuf:"bytes,8,opt,name=dead_letter_stream,json=deadLetterStream,proto3" json:"dead_letter_stream,omitempty"` // 死信 Stream 名称（默认 {stream}:dead）
+	ClaimIdle        *durationpb.Duration   `protobuf:"bytes,9,opt,name=claim_idle,json=claimIdle,proto3" json:"claim_idle,omitempty"`                        // pending 事件空闲超过该时间后由任一消费者接管重试（默认 1m）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Data_EventStream) Reset() {
+	*x = Data_EventStream{}
+	mi := &file_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_EventStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_EventStream) ProtoMessage() {}
+
+func (x *Data_EventStream) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_EventStream.ProtoReflect.Descriptor instead.
+func (*Data_EventStream) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_EventStream) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Data_EventStream) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *Data_EventStream) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Data_EventStream) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *Data_EventStream) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_EventStream) GetBlock() *durationpb.Duration {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *Data_EventStream) GetMaxDeliveries() int32 {
+	if x != nil {
+		return x.MaxDeliveries
+	}
+	return 0
+}
+
+func (x *Data_EventStream) GetDeadLetterStream() string {
+	if x != nil {
+		return x.DeadLetterStream
+	}
+	return ""
+}
+
+func (x *Data_EventStream) GetClaimIdle() *durationpb.Duration {
+	if x != nil {
+		return x.ClaimIdle
+	}
+	return nil
+}
+
 type Client_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
@@ -548,7 +716,7 @@ type Client_GRPC struct {
 
 func (x *Client_GRPC) Reset() {
 	*x = Client_GRPC{}
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -560,7 +728,7 @@ func (x *Client_GRPC) String() string {
 func (*Client_GRPC) ProtoMessage() {}
 
 func (x *Client_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,10 +778,12 @@ const file_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xa6\x05\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x9a\t\n" +
 	"\x04Data\x129\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x1d.marketing.conf.Data.DatabaseR\bdatabase\x120\n" +
-	"\x05redis\x18\x02 \x01(\v2\x1a.marketing.conf.Data.RedisR\x05redis\x1a\xcd\x01\n" +
+	"\x05redis\x18\x02 \x01(\v2\x1a.marketing.conf.Data.RedisR\x05redis\x123\n" +
+	"\x06export\x18\x03 \x01(\v2\x1b.marketing.conf.Data.ExportR\x06export\x12C\n" +
+	"\fevent_stream\x18\x04 \x01(\v2 .marketing.conf.Data.EventStreamR\veventStream\x1a\xcd\x01\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
@@ -629,7 +799,21 @@ const file_conf_proto_rawDesc = "" +
 	"\fread_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1b\n" +
 	"\tpool_size\x18\b \x01(\x05R\bpoolSize\x12$\n" +
-	"\x0emin_idle_conns\x18\t \x01(\x05R\fminIdleConns\"\x9e\x01\n" +
+	"\x0emin_idle_conns\x18\t \x01(\x05R\fminIdleConns\x1a%\n" +
+	"\x06Export\x12\x1b\n" +
+	"\tlocal_dir\x18\x01 \x01(\tR\blocalDir\x1a\xd0\x02\n" +
+	"\vEventStream\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06stream\x18\x02 \x01(\tR\x06stream\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\x12\x1a\n" +
+	"\bconsumer\x18\x04 \x01(\tR\bconsumer\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\x12/\n" +
+	"\x05block\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x05block\x12%\n" +
+	"\x0emax_deliveries\x18\a \x01(\x05R\rmaxDeliveries\x12,\n" +
+	"\x12dead_letter_stream\x18\b \x01(\tR\x10deadLetterStream\x128\n" +
+	"\n" +
+	"claim_idle\x18\t \x01(\v2\x19.google.protobuf.DurationR\tclaimIdle\"\x9e\x01\n" +
 	"\x06Client\x12?\n" +
 	"\fnotification\x18\x01 \x01(\v2\x1b.marketing.conf.Client.GRPCR\fnotification\x1aS\n" +
 	"\x04GRPC\x12\x16\n" +
@@ -648,7 +832,7 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: marketing.conf.Bootstrap
 	(*Server)(nil),              // 1: marketing.conf.Server
@@ -658,8 +842,10 @@ var file_conf_proto_goTypes = []any{
 	(*Server_GRPC)(nil),         // 5: marketing.conf.Server.GRPC
 	(*Data_Database)(nil),       // 6: marketing.conf.Data.Database
 	(*Data_Redis)(nil),          // 7: marketing.conf.Data.Redis
-	(*Client_GRPC)(nil),         // 8: marketing.conf.Client.GRPC
-	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
+	(*Data_Export)(nil),         // 8: marketing.conf.Data.Export
+	(*Data_EventStream)(nil),    // 9: marketing.conf.Data.EventStream
+	(*Client_GRPC)(nil),         // 10: marketing.conf.Client.GRPC
+	(*durationpb.Duration)(nil), // 11: google.protobuf.Duration
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: marketing.conf.Bootstrap.server:type_name -> marketing.conf.Server
@@ -669,19 +855,23 @@ var file_conf_proto_depIdxs = []int32{
 	5,  // 4: marketing.conf.Server.grpc:type_name -> marketing.conf.Server.GRPC
 	6,  // 5: marketing.conf.Data.database:type_name -> marketing.conf.Data.Database
 	7,  // 6: marketing.conf.Data.redis:type_name -> marketing.conf.Data.Redis
-	8,  // 7: marketing.conf.Client.notification:type_name -> marketing.conf.Client.GRPC
-	9,  // 8: marketing.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	9,  // 9: marketing.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	9,  // 10: marketing.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	9,  // 11: marketing.conf.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	9,  // 12: marketing.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	9,  // 13: marketing.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	9,  // 14: marketing.conf.Client.GRPC.timeout:type_name -> google.protobuf.Duration
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	8,  // 7: marketing.conf.Data.export:type_name -> marketing.conf.Data.Export
+	9,  // 8: marketing.conf.Data.event_stream:type_name -> marketing.conf.Data.EventStream
+	10, // 9: marketing.conf.Client.notification:type_name -> marketing.conf.Client.GRPC
+	11, // 10: marketing.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	11, // 11: marketing.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	11, // 12: marketing.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	11, // 13: marketing.conf.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	11, // 14: marketing.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	11, // 15: marketing.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	11, // 16: marketing.conf.Data.EventStream.block:type_name -> google.protobuf.Duration
+	11, // 17: marketing.conf.Data.EventStream.claim_idle:type_name -> google.protobuf.Duration
+	11, // 18: marketing.conf.Client.GRPC.timeout:type_name -> google.protobuf.Duration
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -695,7 +885,7 @@ func file_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
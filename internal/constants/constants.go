@@ -21,6 +21,7 @@ const (
 
 // CampaignStatus 活动状态
 const (
+	CampaignStatusDraft  = "DRAFT"  // 草稿（未发布）
 	CampaignStatusActive = "ACTIVE" // 活动中
 	CampaignStatusPaused = "PAUSED" // 已暂停
 	CampaignStatusEnded  = "ENDED"  // 已结束
@@ -60,6 +61,12 @@ const (
 	TaskStatusEnded  = "ENDED"  // 已结束
 )
 
+// TaskProgressStatus 用户任务进度状态
+const (
+	TaskProgressStatusInProgress = "IN_PROGRESS" // 进行中
+	TaskProgressStatusCompleted  = "COMPLETED"   // 已完成（不可重复完成的任务）
+)
+
 // ========== Audience 相关常量 ==========
 
 // AudienceType 受众类型
@@ -77,6 +84,31 @@ const (
 	AudienceStatusEnded  = "ENDED"  // 已结束
 )
 
+// AudienceMatch 受众规则条件组合方式
+const (
+	AudienceMatchAll = "ALL" // 全部条件满足
+	AudienceMatchAny = "ANY" // 任一条件满足
+)
+
+// AudienceOperator 受众规则条件操作符（GT/GTE/LT/LTE 按数值比较）
+const (
+	AudienceOperatorEq     = "EQ"     // 等于
+	AudienceOperatorNe     = "NE"     // 不等于
+	AudienceOperatorIn     = "IN"     // 属于
+	AudienceOperatorNotIn  = "NOT_IN" // 不属于
+	AudienceOperatorGt     = "GT"     // 大于
+	AudienceOperatorGte    = "GTE"    // 大于等于
+	AudienceOperatorLt     = "LT"     // 小于
+	AudienceOperatorLte    = "LTE"    // 小于等于
+	AudienceOperatorExists = "EXISTS" // 属性存在
+)
+
+// AudienceUploadMode 受众名单上传模式
+const (
+	AudienceUploadModeReplace = "REPLACE" // 覆盖原名单
+	AudienceUploadModeAppend  = "APPEND"  // 追加到原名单
+)
+
 // ========== RedeemCode 相关常量 ==========
 
 // RedeemCodeStatus 兑换码状态
@@ -87,6 +119,13 @@ const (
 	RedeemCodeStatusRevoked  = "REVOKED"  // 已作废
 )
 
+// RedeemGrantStatus 兑换记录的权益发放状态
+const (
+	RedeemGrantStatusPending = "PENDING" // 待发放（积分、订阅时长在兑换提交后发放）
+	RedeemGrantStatusGranted = "GRANTED" // 已发放
+	RedeemGrantStatusFailed  = "FAILED"  // 发放失败，可重试
+)
+
 // CodeType 码类型
 const (
 	CodeTypeCoupon   = "COUPON"   // 优惠券
@@ -125,6 +164,14 @@ const (
 	GeneratorTypePoints = "POINTS" // 积分生成器
 )
 
+// ========== Distributor 相关常量 ==========
+
+// DistributorType 发放器类型
+const (
+	DistributorTypeAuto   = "AUTO"   // 生成后立即发放
+	DistributorTypeManual = "MANUAL" // 生成后等待调用方确认发放
+)
+
 // ========== Discount 相关常量 ==========
 
 // DiscountType 折扣类型
@@ -170,3 +217,143 @@ var ValidCouponCurrencies = []string{
 	CouponCurrencyUSD,
 	CouponCurrencyEUR,
 }
+
+// CouponSortBy 优惠券列表排序字段
+const (
+	CouponSortByCreatedAt     = "created_at"     // 创建时间
+	CouponSortByValidUntil    = "valid_until"    // 过期时间
+	CouponSortByUsedCount     = "used_count"     // 已使用次数
+	CouponSortByRemainingUses = "remaining_uses" // 剩余可用次数（max_uses = 0 表示无限制，视为最大）
+)
+
+// SortOrder 排序方向
+const (
+	SortOrderAsc  = "asc"  // 升序
+	SortOrderDesc = "desc" // 降序
+)
+
+// TimeGranularity 时间序列统计粒度
+const (
+	TimeGranularityHour  = "hour"  // 小时
+	TimeGranularityDay   = "day"   // 天
+	TimeGranularityWeek  = "week"  // 周（周一为起始）
+	TimeGranularityMonth = "month" // 月
+)
+
+// StatsRankBy 汇总统计前N个优惠券的排序依据
+const (
+	StatsRankByUses          = "uses"           // 使用次数
+	StatsRankByRevenue       = "revenue"        // 产生收入（跨币种按最小货币单位累加，仅用于排名）
+	StatsRankByDiscount      = "discount"       // 折扣金额（同上）
+	StatsRankByDistinctUsers = "distinct_users" // 去重用户数
+)
+
+// ValidateReason 优惠券验证结果原因（用于验证响应和转化漏斗统计）
+const (
+	ValidateReasonOK               = "OK"                // 验证通过
+	ValidateReasonNotFound         = "NOT_FOUND"         // 优惠券不存在
+	ValidateReasonAppMismatch      = "APP_MISMATCH"      // 不属于当前应用
+	ValidateReasonUserMismatch     = "USER_MISMATCH"     // 优惠券绑定了其他用户
+	ValidateReasonNotInAudience    = "NOT_IN_AUDIENCE"   // 用户不属于优惠券的受众
+	ValidateReasonNotNewCustomer   = "NOT_NEW_CUSTOMER"  // 新客优惠券：用户已使用过优惠券或已有订单
+	ValidateReasonInactive         = "INACTIVE"          // 优惠券未激活
+	ValidateReasonNotStarted       = "NOT_STARTED"       // 未到生效时间
+	ValidateReasonExpired          = "EXPIRED"           // 已过期
+	ValidateReasonExhausted        = "EXHAUSTED"         // 使用次数已用尽
+	ValidateReasonBelowMinAmount   = "BELOW_MIN_AMOUNT"  // 未达到最低消费金额
+	ValidateReasonNotIssued        = "NOT_ISSUED"        // 相对有效期优惠券未发放给该用户
+	ValidateReasonOutsideSchedule  = "OUTSIDE_SCHEDULE"  // 不在可用时段内或当天为不可用日期
+	ValidateReasonLimitExceeded    = "LIMIT_EXCEEDED"    // 频次限制已用尽（每人每天/周/月或每天合计）
+	ValidateReasonCampaignInactive = "CAMPAIGN_INACTIVE" // 所属活动未发布、已暂停、已结束或不在活动时间内
+	ValidateReasonBudgetExhausted  = "BUDGET_EXHAUSTED"  // 优惠券或所属活动的折扣预算已用尽
+	ValidateReasonPaceLimited      = "PACE_LIMITED"      // 投放节奏：当前时间片的额度已用完
+)
+
+// ExportFormat 导出文件格式
+const (
+	ExportFormatCSV  = "csv"  // CSV（UTF-8 BOM，兼容 Excel）
+	ExportFormatXLSX = "xlsx" // Excel 工作簿
+)
+
+// ExportResource 导出数据类型
+const (
+	ExportResourceCoupons      = "coupons"       // 优惠券
+	ExportResourceCouponUsages = "coupon_usages" // 优惠券使用记录
+)
+
+// ExportJobStatus 后台导出任务状态
+const (
+	ExportJobStatusPending   = "pending"   // 等待执行
+	ExportJobStatusRunning   = "running"   // 执行中
+	ExportJobStatusSucceeded = "succeeded" // 已完成，可下载
+	ExportJobStatusFailed    = "failed"    // 失败
+)
+
+// ImportMode 批量导入模式
+const (
+	ImportModeAllOrNothing = "all_or_nothing" // 任一行校验失败则全部不写入
+	ImportModeBestEffort   = "best_effort"    // 写入校验通过的行，跳过失败行
+)
+
+// ImportRowStatus 批量导入单行结果
+const (
+	ImportRowStatusOK            = "OK"             // 校验通过（预览模式）
+	ImportRowStatusCreated       = "CREATED"        // 已创建
+	ImportRowStatusInvalid       = "INVALID"        // 字段格式或业务规则校验失败
+	ImportRowStatusDuplicate     = "DUPLICATE"      // 优惠码在文件中重复
+	ImportRowStatusAlreadyExists = "ALREADY_EXISTS" // 优惠码已存在
+	ImportRowStatusFailed        = "FAILED"         // 写入失败
+	ImportRowStatusNotImported   = "NOT_IMPORTED"   // 全部或不导入模式下因其他行失败未写入
+)
+
+// CouponAuditAction 优惠券审计操作
+const (
+	CouponAuditActionUpdateStatus = "update_status" // 修改状态
+	CouponAuditActionDelete       = "delete"        // 删除
+)
+
+// BatchItemResult 批量操作单个优惠券的结果
+const (
+	BatchItemResultUpdated   = "UPDATED"   // 已修改
+	BatchItemResultDeleted   = "DELETED"   // 已删除
+	BatchItemResultUnchanged = "UNCHANGED" // 状态未变化，未修改
+	BatchItemResultNotFound  = "NOT_FOUND" // 优惠券不存在或不属于当前应用
+	BatchItemResultFailed    = "FAILED"    // 所在批次事务失败
+)
+
+// CouponEligibility 优惠券新客限制
+const (
+	CouponEligibilityFirstCouponUse = "FIRST_COUPON_USE" // 用户在应用内首次使用优惠券
+	CouponEligibilityFirstOrder     = "FIRST_ORDER"      // 用户在应用内的首单（同时检查使用记录和订单历史）
+)
+
+// CouponLimitScope 优惠券频次限制范围
+const (
+	CouponLimitScopeUserDay   = "USER_DAY"   // 每个用户每天
+	CouponLimitScopeUserWeek  = "USER_WEEK"  // 每个用户每周（周一开始）
+	CouponLimitScopeUserMonth = "USER_MONTH" // 每个用户每月
+	CouponLimitScopeDay       = "DAY"        // 所有用户每天合计
+	CouponLimitScopeTotal     = "TOTAL"      // 总使用次数（maxUses）
+)
+
+// PacingInterval 投放节奏的时间片长度
+const (
+	PacingIntervalHour = "HOUR" // 每小时释放一批额度
+	PacingIntervalDay  = "DAY"  // 每天释放一批额度（从生效时间起每 24 小时）
+)
+
+// BudgetScope 折扣预算所属对象
+const (
+	BudgetScopeCoupon   = "COUPON"   // 优惠券预算
+	BudgetScopeCampaign = "CAMPAIGN" // 活动预算（活动下所有优惠券合计）
+)
+
+// BudgetThresholds 折扣预算告警阈值（已使用百分比），跨过时发送预算事件
+var BudgetThresholds = []int64{80, 100}
+
+// UserCouponStatus 用户优惠券状态（过期由有效期判断，不单独存储）
+const (
+	UserCouponStatusAvailable = "available" // 可用
+	UserCouponStatusUsed      = "used"      // 已使用
+	UserCouponStatusExpired   = "expired"   // 已过期（未使用且已过有效期，仅用于展示和列表筛选）
+)
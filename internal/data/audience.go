@@ -0,0 +1,256 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// audienceMemberBatchSize 写入名单的每批行数
+const audienceMemberBatchSize = 1000
+
+// audienceRuleJSON 受众规则的存储格式
+type audienceRuleJSON struct {
+	Match      string                  `json:"match"`
+	Conditions []audienceConditionJSON `json:"conditions"`
+}
+
+// audienceConditionJSON 受众规则条件的存储格式
+type audienceConditionJSON struct {
+	Attribute string   `json:"attribute"`
+	Operator  string   `json:"operator"`
+	Values    []string `json:"values,omitempty"`
+}
+
+// audienceRepo 实现 biz.AudienceRepo 接口
+type audienceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAudienceRepo 创建受众 Repository
+func NewAudienceRepo(data *Data, logger log.Logger) biz.AudienceRepo {
+	return &audienceRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/audience")),
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *audienceRepo) toBizModel(m *model.Audience) *biz.Audience {
+	a := &biz.Audience{
+		AudienceID:  m.AudienceID,
+		AppID:       m.AppID,
+		Name:        m.Name,
+		Description: m.Description,
+		Type:        m.Type,
+		Status:      m.Status,
+		MemberCount: m.MemberCount,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.Rule != "" {
+		var rule audienceRuleJSON
+		if err := json.Unmarshal([]byte(m.Rule), &rule); err != nil {
+			r.log.Warnf("failed to unmarshal audience rule: audience_id=%s, err=%v", m.AudienceID, err)
+			return a
+		}
+		a.Rule = &biz.AudienceRule{Match: rule.Match}
+		for _, c := range rule.Conditions {
+			a.Rule.Conditions = append(a.Rule.Conditions, &biz.AudienceCondition{
+				Attribute: c.Attribute,
+				Operator:  c.Operator,
+				Values:    c.Values,
+			})
+		}
+	}
+	return a
+}
+
+// toDataModel 将业务模型转换为数据模型
+func (r *audienceRepo) toDataModel(b *biz.Audience) (*model.Audience, error) {
+	m := &model.Audience{
+		AudienceID:  b.AudienceID,
+		AppID:       b.AppID,
+		Name:        b.Name,
+		Description: b.Description,
+		Type:        b.Type,
+		Status:      b.Status,
+		MemberCount: b.MemberCount,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+	if b.Rule != nil {
+		rule := audienceRuleJSON{Match: b.Rule.Match}
+		for _, c := range b.Rule.Conditions {
+			rule.Conditions = append(rule.Conditions, audienceConditionJSON{
+				Attribute: c.Attribute,
+				Operator:  c.Operator,
+				Values:    c.Values,
+			})
+		}
+		data, err := json.Marshal(rule)
+		if err != nil {
+			return nil, err
+		}
+		m.Rule = string(data)
+	}
+	return m, nil
+}
+
+// Save 创建受众
+func (r *audienceRepo) Save(ctx context.Context, a *biz.Audience) error {
+	m, err := r.toDataModel(a)
+	if err != nil {
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
+		r.log.Errorf("failed to create audience: %v", err)
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// Update 更新受众（类型和名单人数不修改）
+func (r *audienceRepo) Update(ctx context.Context, a *biz.Audience) error {
+	m, err := r.toDataModel(a)
+	if err != nil {
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := r.data.db.WithContext(ctx).Model(&model.Audience{}).
+		Where("audience_id = ? AND app_id = ?", m.AudienceID, m.AppID).
+		Updates(map[string]interface{}{
+			"name":        m.Name,
+			"description": m.Description,
+			"rule":        m.Rule,
+			"status":      m.Status,
+			"updated_at":  m.UpdatedAt,
+		}).Error; err != nil {
+		r.log.Errorf("failed to update audience: %v", err)
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// FindByID 查找受众（限定在应用内）
+func (r *audienceRepo) FindByID(ctx context.Context, appID, audienceID string) (*biz.Audience, error) {
+	var m model.Audience
+	if err := r.data.db.WithContext(ctx).
+		Where("audience_id = ? AND app_id = ?", audienceID, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeAudienceNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find audience: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// List 列出应用内的受众（按创建时间倒序）
+func (r *audienceRepo) List(ctx context.Context, appID, audienceType string, page, pageSize int) ([]*biz.Audience, int64, error) {
+	var (
+		models []model.Audience
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.Audience{}).Where("app_id = ?", appID)
+	if audienceType != "" {
+		query = query.Where("type = ?", audienceType)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count audiences: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("created_at DESC").Order("audience_id").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list audiences: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.Audience, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// Delete 删除受众及其名单（物理删除），仍被未删除的优惠券引用时拒绝
+func (r *audienceRepo) Delete(ctx context.Context, appID, audienceID string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var refs int64
+		if err := tx.Model(&model.Coupon{}).
+			Where("audience_id = ? AND app_id = ?", audienceID, appID).
+			Count(&refs).Error; err != nil {
+			return err
+		}
+		if refs > 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeAudienceInUse, "zh-CN")
+		}
+
+		result := tx.Where("audience_id = ? AND app_id = ?", audienceID, appID).Delete(&model.Audience{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeAudienceNotFound, "zh-CN")
+		}
+		return tx.Where("audience_id = ?", audienceID).Delete(&model.AudienceMember{}).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to delete audience: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ReplaceMembers 在一个事务内写入名单并更新名单人数：replace 为 true 时先清空原名单，已存在的用户忽略
+func (r *audienceRepo) ReplaceMembers(ctx context.Context, audienceID string, userIDs []string, replace bool) (int64, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if replace {
+			if err := tx.Where("audience_id = ?", audienceID).Delete(&model.AudienceMember{}).Error; err != nil {
+				return err
+			}
+		}
+		if len(userIDs) > 0 {
+			members := make([]model.AudienceMember, 0, len(userIDs))
+			for _, userID := range userIDs {
+				members = append(members, model.AudienceMember{AudienceID: audienceID, UserID: userID})
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+				CreateInBatches(members, audienceMemberBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&model.AudienceMember{}).Where("audience_id = ?", audienceID).Count(&count).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Audience{}).Where("audience_id = ?", audienceID).
+			Update("member_count", count).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to replace audience members: %v", err)
+		return 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return count, nil
+}
+
+// IsMember 用户是否在受众名单中（主键查找）
+func (r *audienceRepo) IsMember(ctx context.Context, audienceID, userID string) (bool, error) {
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&model.AudienceMember{}).
+		Where("audience_id = ? AND user_id = ?", audienceID, userID).
+		Limit(1).Count(&count).Error; err != nil {
+		r.log.Errorf("failed to check audience member: %v", err)
+		return false, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/conf"
+)
+
+// defaultExportDir 未配置 data.export.local_dir 时的导出文件目录
+const defaultExportDir = "./data/exports"
+
+// localBlobStore 本地磁盘实现的 biz.BlobStore
+type localBlobStore struct {
+	dir string
+}
+
+// NewBlobStore 创建导出文件存储（当前为本地磁盘，替换为对象存储时只需提供新的 biz.BlobStore 实现）
+func NewBlobStore(c *conf.Data) (biz.BlobStore, error) {
+	dir := defaultExportDir
+	if c.Export != nil && c.Export.LocalDir != "" {
+		dir = c.Export.LocalDir
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create export dir %s: %w", dir, err)
+	}
+	return &localBlobStore{dir: dir}, nil
+}
+
+// path 将 key 映射为存储目录内的文件路径（拒绝跳出存储目录的 key）
+func (s *localBlobStore) path(key string) (string, error) {
+	p := filepath.Join(s.dir, filepath.FromSlash(key))
+	rel, err := filepath.Rel(s.dir, p)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key: %s", key)
+	}
+	return p, nil
+}
+
+// Create 创建文件（先写入临时文件，Close 时重命名，避免下载到未写完的文件）
+func (s *localBlobStore) Create(ctx context.Context, key string) (io.WriteCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp(filepath.Dir(p), filepath.Base(p)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &localBlobFile{File: f, target: p}, nil
+}
+
+// Open 打开文件
+func (s *localBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
+
+// localBlobFile 写入完成后原子重命名为目标文件
+type localBlobFile struct {
+	*os.File
+	target string
+}
+
+// Close 关闭临时文件并重命名为目标文件
+func (f *localBlobFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		os.Remove(f.File.Name())
+		return err
+	}
+	return os.Rename(f.File.Name(), f.target)
+}
@@ -0,0 +1,36 @@
+package data
+
+import (
+	"context"
+
+	"marketing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// logBudgetNotifier 日志实现的折扣预算告警：以结构化日志输出预算事件，由日志采集侧配置告警
+// 接入通知服务或 Webhook 时替换 NewBudgetNotifier 的实现即可
+type logBudgetNotifier struct {
+	log *log.Helper
+}
+
+// NewBudgetNotifier 创建折扣预算告警通知
+func NewBudgetNotifier(logger log.Logger) biz.BudgetNotifier {
+	return &logBudgetNotifier{
+		log: log.NewHelper(log.With(logger, "module", "data/budget_notifier")),
+	}
+}
+
+// Notify 输出预算事件日志
+func (n *logBudgetNotifier) Notify(ctx context.Context, event *biz.BudgetEvent) {
+	n.log.WithContext(ctx).Warnw(
+		"event", "budget_threshold_crossed",
+		"scope", event.Scope,
+		"id", event.ID,
+		"app_id", event.AppID,
+		"budget", event.Budget,
+		"used", event.Used,
+		"threshold", event.Threshold,
+		"at", event.At.Unix(),
+	)
+}
@@ -0,0 +1,299 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// campaignRepo 实现 biz.CampaignRepo 接口
+type campaignRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCampaignRepo 创建活动 Repository
+func NewCampaignRepo(data *Data, logger log.Logger) biz.CampaignRepo {
+	return &campaignRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/campaign")),
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *campaignRepo) toBizModel(m *model.Campaign) *biz.Campaign {
+	return &biz.Campaign{
+		CampaignID:  m.CampaignID,
+		AppID:       m.AppID,
+		Name:        m.Name,
+		Type:        m.Type,
+		Description: m.Description,
+		StartTime:   m.StartTime,
+		EndTime:     m.EndTime,
+		Status:      m.Status,
+		Budget:      m.Budget,
+		BudgetUsed:  m.BudgetUsed,
+		Currency:    m.Currency,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+// toDataModel 将业务模型转换为数据模型
+func (r *campaignRepo) toDataModel(b *biz.Campaign) *model.Campaign {
+	return &model.Campaign{
+		CampaignID:  b.CampaignID,
+		AppID:       b.AppID,
+		Name:        b.Name,
+		Type:        b.Type,
+		Description: b.Description,
+		StartTime:   b.StartTime,
+		EndTime:     b.EndTime,
+		Status:      b.Status,
+		Budget:      b.Budget,
+		BudgetUsed:  b.BudgetUsed,
+		Currency:    b.Currency,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+}
+
+// Save 创建活动
+func (r *campaignRepo) Save(ctx context.Context, c *biz.Campaign) error {
+	if err := r.data.db.WithContext(ctx).Create(r.toDataModel(c)).Error; err != nil {
+		r.log.Errorf("failed to create campaign: %v", err)
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// Update 更新活动基本信息（状态通过 UpdateStatus 修改，已使用预算只在使用优惠券时累加），已结束的活动不修改
+// 在事务内锁定活动行后校验预算：修改后的预算不能低于已使用金额，设置预算时活动下的优惠券必须使用活动币种；
+// 预算不超过已使用金额时暂停进行中的活动（与 useCampaign 的自动暂停一致），并回写 c 的状态和已使用预算
+func (r *campaignRepo) Update(ctx context.Context, c *biz.Campaign) error {
+	m := r.toDataModel(c)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cur model.Campaign
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("status", "budget", "budget_used", "currency").
+			Where("campaign_id = ? AND app_id = ?", m.CampaignID, m.AppID).
+			Take(&cur).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return pkgErrors.NewBizError(marketingErrors.ErrCodeCampaignNotFound, "zh-CN")
+			}
+			return err
+		}
+		if cur.Status == constants.CampaignStatusEnded {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCampaignStatusInvalid, "zh-CN")
+		}
+
+		status := cur.Status
+		if m.Budget > 0 {
+			if m.Budget != cur.Budget && m.Budget < cur.BudgetUsed {
+				return pkgErrors.NewBizError(pkgErrors.ErrCodeBusinessRuleViolation, "zh-CN")
+			}
+			// 预算按活动币种累加，已关联的优惠券必须使用该币种（与 checkCampaignRef 一致）
+			var mismatched int64
+			if err := tx.Model(&model.Coupon{}).
+				Where("campaign_id = ? AND app_id = ? AND currency <> ?", m.CampaignID, m.AppID, cur.Currency).
+				Count(&mismatched).Error; err != nil {
+				return err
+			}
+			if mismatched > 0 {
+				return pkgErrors.NewBizError(pkgErrors.ErrCodeBusinessRuleViolation, "zh-CN")
+			}
+			if cur.BudgetUsed >= m.Budget && status == constants.CampaignStatusActive {
+				status = constants.CampaignStatusPaused
+			}
+		}
+
+		if err := tx.Model(&model.Campaign{}).
+			Where("campaign_id = ? AND app_id = ?", m.CampaignID, m.AppID).
+			Updates(map[string]interface{}{
+				"name":        m.Name,
+				"type":        m.Type,
+				"description": m.Description,
+				"start_time":  m.StartTime,
+				"end_time":    m.EndTime,
+				"budget":      m.Budget,
+				"status":      status,
+				"updated_at":  m.UpdatedAt,
+			}).Error; err != nil {
+			return err
+		}
+		c.Status, c.BudgetUsed = status, cur.BudgetUsed
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("failed to update campaign: %v", err)
+		return err
+	}
+	return nil
+}
+
+// FindByID 查找活动（限定在应用内）
+func (r *campaignRepo) FindByID(ctx context.Context, appID, campaignID string) (*biz.Campaign, error) {
+	var m model.Campaign
+	if err := r.data.db.WithContext(ctx).
+		Where("campaign_id = ? AND app_id = ?", campaignID, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeCampaignNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find campaign: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// List 列出应用内的活动（按创建时间倒序）
+func (r *campaignRepo) List(ctx context.Context, filter *biz.CampaignFilter, page, pageSize int) ([]*biz.Campaign, int64, error) {
+	var (
+		models []model.Campaign
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.Campaign{}).Where("app_id = ?", filter.AppID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count campaigns: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("created_at DESC").Order("campaign_id").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list campaigns: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.Campaign, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// Delete 删除活动（物理删除），仍有未删除的优惠券或任务关联时拒绝
+func (r *campaignRepo) Delete(ctx context.Context, appID, campaignID string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var refs int64
+		if err := tx.Model(&model.Coupon{}).
+			Where("campaign_id = ? AND app_id = ?", campaignID, appID).
+			Count(&refs).Error; err != nil {
+			return err
+		}
+		if refs == 0 {
+			if err := tx.Model(&model.Task{}).
+				Where("campaign_id = ? AND app_id = ?", campaignID, appID).
+				Count(&refs).Error; err != nil {
+				return err
+			}
+		}
+		if refs > 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCampaignInUse, "zh-CN")
+		}
+
+		result := tx.Where("campaign_id = ? AND app_id = ?", campaignID, appID).Delete(&model.Campaign{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCampaignNotFound, "zh-CN")
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("failed to delete campaign: %v", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateStatus 条件更新活动状态（当前状态在 from 中才修改），并发修改时只有一个成功
+func (r *campaignRepo) UpdateStatus(ctx context.Context, appID, campaignID string, from []string, to string, now time.Time) (bool, error) {
+	result := r.data.db.WithContext(ctx).Model(&model.Campaign{}).
+		Where("campaign_id = ? AND app_id = ? AND status IN ?", campaignID, appID, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		r.log.Errorf("failed to update campaign status: %v", result.Error)
+		return false, pkgErrors.WrapErrorWithLang(ctx, result.Error, pkgErrors.ErrCodeInternalError)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CountByStatus 统计所有应用中处于该状态的活动数
+func (r *campaignRepo) CountByStatus(ctx context.Context, status string) (int64, error) {
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&model.Campaign{}).
+		Where("status = ?", status).Count(&count).Error; err != nil {
+		r.log.Errorf("failed to count campaigns: %v", err)
+		return 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return count, nil
+}
+
+// useCampaign 在使用优惠券的事务内检查所属活动是否进行中，并累加活动的已使用折扣预算
+// 以共享锁读取活动行：暂停或结束活动的更新会等待进行中的使用事务提交，之后的使用立即被拒绝
+// 预算用尽时自动暂停活动，返回跨过告警阈值的预算事件
+func useCampaign(tx *gorm.DB, appID, campaignID string, discountAmount int64, now time.Time) ([]*biz.BudgetEvent, error) {
+	// 先按条件累加预算：UPDATE 直接持有排他锁，避免先加共享锁再升级为排他锁时的死锁；未设置预算或剩余预算不足时不修改
+	charged := false
+	if discountAmount > 0 {
+		result := tx.Model(&model.Campaign{}).
+			Where("campaign_id = ? AND app_id = ? AND budget > 0 AND budget_used + ? <= budget", campaignID, appID, discountAmount).
+			Update("budget_used", gorm.Expr("budget_used + ?", discountAmount))
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		charged = result.RowsAffected > 0
+	}
+
+	var m model.Campaign
+	if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).
+		Select("status", "start_time", "end_time", "budget", "budget_used").
+		Where("campaign_id = ? AND app_id = ?", campaignID, appID).
+		Take(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeCampaignNotFound, "zh-CN")
+		}
+		return nil, err
+	}
+	campaign := &biz.Campaign{Status: m.Status, StartTime: m.StartTime, EndTime: m.EndTime, Budget: m.Budget}
+	if !campaign.IsRunning(now) {
+		return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeCampaignNotRunning, "zh-CN")
+	}
+	if !campaign.HasBudget() || discountAmount <= 0 {
+		return nil, nil
+	}
+	if !charged {
+		return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeCouponBudgetExhausted, "zh-CN")
+	}
+
+	// 预算用尽时暂停活动（与使用记录在同一事务内提交），补充预算后可重新发布
+	if m.BudgetUsed >= m.Budget {
+		if err := tx.Model(&model.Campaign{}).
+			Where("campaign_id = ? AND app_id = ? AND status = ?", campaignID, appID, constants.CampaignStatusActive).
+			Updates(map[string]interface{}{
+				"status":     constants.CampaignStatusPaused,
+				"updated_at": now,
+			}).Error; err != nil {
+			return nil, err
+		}
+	}
+	return biz.NewBudgetEvents(constants.BudgetScopeCampaign, campaignID, appID, m.Budget, m.BudgetUsed-discountAmount, m.BudgetUsed, now), nil
+}
@@ -2,9 +2,12 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
 	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
 	"strings"
 	"time"
 
@@ -12,6 +15,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // couponRepo 实现 biz.CouponRepo 接口
@@ -34,20 +38,37 @@ func (r *couponRepo) toBizModel(m *model.Coupon) *biz.Coupon {
 		return nil
 	}
 	return &biz.Coupon{
-		CouponID:      m.CouponID,
-		CouponCode:    m.CouponCode,
-		AppID:         m.AppID,
-		DiscountType:  m.DiscountType,
-		DiscountValue: m.DiscountValue,
-		Currency:      m.Currency,
-		ValidFrom:     m.ValidFrom,
-		ValidUntil:    m.ValidUntil,
-		MaxUses:       m.MaxUses,
-		UsedCount:     m.UsedCount,
-		MinAmount:     m.MinAmount,
-		Status:        m.Status,
-		CreatedAt:     m.CreatedAt,
-		UpdatedAt:     m.UpdatedAt,
+		CouponID:         m.CouponID,
+		CouponCode:       m.CouponCode,
+		AppID:            m.AppID,
+		DiscountType:     m.DiscountType,
+		DiscountValue:    m.DiscountValue,
+		Currency:         m.Currency,
+		ValidFrom:        m.ValidFrom,
+		ValidUntil:       m.ValidUntil,
+		MaxUses:          m.MaxUses,
+		UsedCount:        m.UsedCount,
+		MinAmount:        m.MinAmount,
+		ValidDays:        m.ValidDays,
+		ClaimLimit:       m.ClaimLimit,
+		ClaimStock:       m.ClaimStock,
+		ClaimedCount:     m.ClaimedCount,
+		BoundUserID:      m.BoundUserID,
+		AudienceID:       m.AudienceID,
+		Eligibility:      m.Eligibility,
+		Timezone:         m.Timezone,
+		Schedule:         r.toBizSchedule(m),
+		UserDailyLimit:   m.UserDailyLimit,
+		UserWeeklyLimit:  m.UserWeeklyLimit,
+		UserMonthlyLimit: m.UserMonthlyLimit,
+		DailyLimit:       m.DailyLimit,
+		CampaignID:       m.CampaignID,
+		Budget:           m.Budget,
+		BudgetUsed:       m.BudgetUsed,
+		Pacing:           r.toBizPacing(m),
+		Status:           m.Status,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
 	}
 }
 
@@ -62,23 +83,121 @@ func (r *couponRepo) toDataModel(b *biz.Coupon) *model.Coupon {
 		currency = "CNY"
 	}
 	return &model.Coupon{
-		CouponID:      b.CouponID,
-		CouponCode:    b.CouponCode,
-		AppID:         b.AppID,
-		DiscountType:  b.DiscountType,
-		DiscountValue: b.DiscountValue,
-		Currency:      currency,
-		ValidFrom:     b.ValidFrom,
-		ValidUntil:    b.ValidUntil,
-		MaxUses:       b.MaxUses,
-		UsedCount:     b.UsedCount,
-		MinAmount:     b.MinAmount,
-		Status:        b.Status,
-		CreatedAt:     b.CreatedAt,
-		UpdatedAt:     b.UpdatedAt,
+		CouponID:         b.CouponID,
+		CouponCode:       b.CouponCode,
+		AppID:            b.AppID,
+		DiscountType:     b.DiscountType,
+		DiscountValue:    b.DiscountValue,
+		Currency:         currency,
+		ValidFrom:        b.ValidFrom,
+		ValidUntil:       b.ValidUntil,
+		MaxUses:          b.MaxUses,
+		UsedCount:        b.UsedCount,
+		MinAmount:        b.MinAmount,
+		ValidDays:        b.ValidDays,
+		ClaimLimit:       b.ClaimLimit,
+		ClaimStock:       b.ClaimStock,
+		ClaimedCount:     b.ClaimedCount,
+		BoundUserID:      b.BoundUserID,
+		AudienceID:       b.AudienceID,
+		Eligibility:      b.Eligibility,
+		Timezone:         b.Timezone,
+		Schedule:         toScheduleJSON(b.Schedule),
+		UserDailyLimit:   b.UserDailyLimit,
+		UserWeeklyLimit:  b.UserWeeklyLimit,
+		UserMonthlyLimit: b.UserMonthlyLimit,
+		DailyLimit:       b.DailyLimit,
+		CampaignID:       b.CampaignID,
+		Budget:           b.Budget,
+		BudgetUsed:       b.BudgetUsed,
+		Pacing:           toPacingJSON(b.Pacing),
+		Status:           b.Status,
+		CreatedAt:        b.CreatedAt,
+		UpdatedAt:        b.UpdatedAt,
 	}
 }
 
+// couponScheduleJSON 优惠券可用时段的存储格式
+type couponScheduleJSON struct {
+	Windows       []couponTimeWindowJSON `json:"windows,omitempty"`
+	BlackoutDates []string               `json:"blackoutDates,omitempty"`
+}
+
+// couponTimeWindowJSON 可用时段的存储格式
+type couponTimeWindowJSON struct {
+	Weekdays  []int32 `json:"weekdays,omitempty"`
+	StartTime string  `json:"startTime"`
+	EndTime   string  `json:"endTime"`
+}
+
+// toBizSchedule 解析可用时段，未设置或格式错误时返回 nil（不限时段）
+func (r *couponRepo) toBizSchedule(m *model.Coupon) *biz.CouponSchedule {
+	if m.Schedule == "" {
+		return nil
+	}
+	var s couponScheduleJSON
+	if err := json.Unmarshal([]byte(m.Schedule), &s); err != nil {
+		r.log.Warnf("failed to unmarshal coupon schedule: coupon_code=%s, err=%v", m.CouponCode, err)
+		return nil
+	}
+	schedule := &biz.CouponSchedule{BlackoutDates: s.BlackoutDates}
+	for _, w := range s.Windows {
+		schedule.Windows = append(schedule.Windows, &biz.CouponTimeWindow{
+			Weekdays:  w.Weekdays,
+			StartTime: w.StartTime,
+			EndTime:   w.EndTime,
+		})
+	}
+	return schedule
+}
+
+// toScheduleJSON 序列化可用时段，不限时段时为空字符串
+func toScheduleJSON(s *biz.CouponSchedule) string {
+	if s.IsEmpty() {
+		return ""
+	}
+	v := couponScheduleJSON{BlackoutDates: s.BlackoutDates}
+	for _, w := range s.Windows {
+		v.Windows = append(v.Windows, couponTimeWindowJSON{
+			Weekdays:  w.Weekdays,
+			StartTime: w.StartTime,
+			EndTime:   w.EndTime,
+		})
+	}
+	// 只含字符串和整数，序列化不会失败
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// couponPacingJSON 投放节奏的存储格式
+type couponPacingJSON struct {
+	Interval string  `json:"interval"`
+	Curve    []int32 `json:"curve,omitempty"`
+}
+
+// toBizPacing 解析投放节奏，未设置或格式错误时返回 nil（不限节奏）
+func (r *couponRepo) toBizPacing(m *model.Coupon) *biz.CouponPacing {
+	if m.Pacing == "" {
+		return nil
+	}
+	var p couponPacingJSON
+	if err := json.Unmarshal([]byte(m.Pacing), &p); err != nil {
+		r.log.Warnf("failed to unmarshal coupon pacing: coupon_code=%s, err=%v", m.CouponCode, err)
+		return nil
+	}
+	return &biz.CouponPacing{Interval: p.Interval, Curve: p.Curve}
+}
+
+// toPacingJSON 序列化投放节奏，不限节奏时为空字符串
+func toPacingJSON(p *biz.CouponPacing) string {
+	if p == nil {
+		return ""
+	}
+	// 只含字符串和整数，序列化不会失败
+	data, _ := json.Marshal(couponPacingJSON{Interval: p.Interval, Curve: p.Curve})
+	return string(data)
+}
+
 // toBizUsageModel 将使用记录数据模型转换为业务模型
 func (r *couponRepo) toBizUsageModel(m *model.CouponUsage) *biz.CouponUsage {
 	if m == nil {
@@ -94,6 +213,7 @@ func (r *couponRepo) toBizUsageModel(m *model.CouponUsage) *biz.CouponUsage {
 		OriginalAmount: m.OriginalAmount,
 		DiscountAmount: m.DiscountAmount,
 		FinalAmount:    m.FinalAmount,
+		Currency:       m.Currency,
 		UsedAt:         m.UsedAt,
 		CreatedAt:      m.CreatedAt,
 	}
@@ -114,6 +234,7 @@ func (r *couponRepo) toDataUsageModel(b *biz.CouponUsage) *model.CouponUsage {
 		OriginalAmount: b.OriginalAmount,
 		DiscountAmount: b.DiscountAmount,
 		FinalAmount:    b.FinalAmount,
+		Currency:       b.Currency,
 		UsedAt:         b.UsedAt,
 		CreatedAt:      b.CreatedAt,
 	}
@@ -173,14 +294,29 @@ func (r *couponRepo) Save(ctx context.Context, coupon *biz.Coupon) (*biz.Coupon,
 func (r *couponRepo) Update(ctx context.Context, coupon *biz.Coupon) (*biz.Coupon, error) {
 	m := r.toDataModel(coupon)
 	updateFields := map[string]interface{}{
-		"discount_type":  m.DiscountType,
-		"discount_value": m.DiscountValue,
-		"valid_from":     m.ValidFrom,
-		"valid_until":    m.ValidUntil,
-		"max_uses":       m.MaxUses,
-		"min_amount":     m.MinAmount,
-		"status":         m.Status,
-		"updated_at":     m.UpdatedAt,
+		"discount_type":      m.DiscountType,
+		"discount_value":     m.DiscountValue,
+		"valid_from":         m.ValidFrom,
+		"valid_until":        m.ValidUntil,
+		"max_uses":           m.MaxUses,
+		"min_amount":         m.MinAmount,
+		"valid_days":         m.ValidDays,
+		"claim_limit":        m.ClaimLimit,
+		"claim_stock":        m.ClaimStock,
+		"bound_user_id":      m.BoundUserID,
+		"audience_id":        m.AudienceID,
+		"eligibility":        m.Eligibility,
+		"timezone":           m.Timezone,
+		"schedule":           m.Schedule,
+		"user_daily_limit":   m.UserDailyLimit,
+		"user_weekly_limit":  m.UserWeeklyLimit,
+		"user_monthly_limit": m.UserMonthlyLimit,
+		"daily_limit":        m.DailyLimit,
+		"campaign_id":        m.CampaignID,
+		"budget":             m.Budget,
+		"pacing":             m.Pacing,
+		"status":             m.Status,
+		"updated_at":         m.UpdatedAt,
 	}
 	if err := r.data.db.WithContext(ctx).Model(&model.Coupon{}).
 		Where("coupon_code = ?", m.CouponCode).Updates(updateFields).Error; err != nil {
@@ -204,21 +340,83 @@ func (r *couponRepo) FindByCode(ctx context.Context, code string) (*biz.Coupon,
 	return r.toBizModel(&m), nil
 }
 
-// List 列出优惠券（分页）
-func (r *couponRepo) List(ctx context.Context, appID, status string, page, pageSize int) ([]*biz.Coupon, int64, error) {
+// FindByCodes 按优惠码批量查找优惠券（含已删除的优惠券，用于展示用户已持有的优惠券）
+func (r *couponRepo) FindByCodes(ctx context.Context, codes []string) (map[string]*biz.Coupon, error) {
+	result := make(map[string]*biz.Coupon, len(codes))
+	if len(codes) == 0 {
+		return result, nil
+	}
+	var models []model.Coupon
+	if err := r.data.db.WithContext(ctx).Unscoped().Where("coupon_code IN ?", codes).Find(&models).Error; err != nil {
+		r.log.Errorf("failed to find coupons by codes: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	for i := range models {
+		result[models[i].CouponCode] = r.toBizModel(&models[i])
+	}
+	return result, nil
+}
+
+// ExistingCodes 返回已存在（未删除）的优惠码
+func (r *couponRepo) ExistingCodes(ctx context.Context, codes []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	const chunkSize = 500
+	for start := 0; start < len(codes); start += chunkSize {
+		end := start + chunkSize
+		if end > len(codes) {
+			end = len(codes)
+		}
+		var found []string
+		if err := r.data.db.WithContext(ctx).Model(&model.Coupon{}).
+			Where("coupon_code IN ?", codes[start:end]).
+			Pluck("coupon_code", &found).Error; err != nil {
+			r.log.Errorf("failed to find existing coupon codes: %v", err)
+			return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+		}
+		for _, code := range found {
+			existing[code] = true
+		}
+	}
+	return existing, nil
+}
+
+// SaveBatch 在一个事务内批量创建优惠券（任一条失败则全部回滚）
+func (r *couponRepo) SaveBatch(ctx context.Context, coupons []*biz.Coupon) error {
+	if len(coupons) == 0 {
+		return nil
+	}
+	models := make([]*model.Coupon, 0, len(coupons))
+	codes := make([]string, 0, len(coupons))
+	for _, c := range coupons {
+		models = append(models, r.toDataModel(c))
+		codes = append(codes, c.CouponCode)
+	}
+
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 与 Save 一致：已软删除的相同优惠码先永久删除，再重新创建
+		if err := tx.Unscoped().Where("coupon_code IN ? AND deleted_at IS NOT NULL", codes).Delete(&model.Coupon{}).Error; err != nil {
+			return err
+		}
+		return tx.CreateInBatches(models, 100).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to save coupons in batch: %v", err)
+		if isDuplicateEntryError(err) {
+			return pkgErrors.NewBizError(pkgErrors.ErrCodeAlreadyExists, "zh-CN")
+		}
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// List 列出优惠券（分页，支持筛选与排序）
+func (r *couponRepo) List(ctx context.Context, filter *biz.CouponFilter, page, pageSize int) ([]*biz.Coupon, int64, error) {
 	var (
 		models []model.Coupon
 		total  int64
 	)
 
-	query := r.data.db.WithContext(ctx).Model(&model.Coupon{})
-
-	if appID != "" {
-		query = query.Where("app_id = ?", appID)
-	}
-	if status != "" {
-		query = query.Where("status = ?", status)
-	}
+	query := r.applyCouponFilter(r.data.db.WithContext(ctx).Model(&model.Coupon{}), filter)
 
 	// 统计总数
 	if err := query.Count(&total).Error; err != nil {
@@ -229,7 +427,7 @@ func (r *couponRepo) List(ctx context.Context, appID, status string, page, pageS
 	// 分页查询
 	offset := (page - 1) * pageSize
 	if err := query.Offset(offset).Limit(pageSize).
-		Order("created_at DESC, coupon_code DESC").
+		Order(couponOrderClause(filter.SortBy, filter.SortOrder)).
 		Find(&models).Error; err != nil {
 		r.log.Errorf("failed to list coupons: %v", err)
 		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
@@ -244,6 +442,81 @@ func (r *couponRepo) List(ctx context.Context, appID, status string, page, pageS
 	return result, total, nil
 }
 
+// applyCouponFilter 将筛选条件应用到优惠券查询
+func (r *couponRepo) applyCouponFilter(query *gorm.DB, filter *biz.CouponFilter) *gorm.DB {
+	if filter.AppID != "" {
+		query = query.Where("app_id = ?", filter.AppID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	// 前缀匹配可以使用 uk_coupon_code 索引，子串匹配只能扫描 app_id 范围内的记录
+	if filter.CodePrefix != "" {
+		query = query.Where("coupon_code LIKE ?", escapeLike(filter.CodePrefix)+"%")
+	}
+	if filter.CodeContains != "" {
+		query = query.Where("coupon_code LIKE ?", "%"+escapeLike(filter.CodeContains)+"%")
+	}
+	if filter.DiscountType != "" {
+		query = query.Where("discount_type = ?", filter.DiscountType)
+	}
+	if filter.Currency != "" {
+		query = query.Where("currency = ?", filter.Currency)
+	}
+	if filter.CampaignID != "" {
+		query = query.Where("campaign_id = ?", filter.CampaignID)
+	}
+	// 有效期窗口交集：valid_from <= 窗口结束 AND valid_until >= 窗口起始
+	if !filter.ActiveUntil.IsZero() {
+		query = query.Where("valid_from <= ?", filter.ActiveUntil)
+	}
+	if !filter.ActiveFrom.IsZero() {
+		query = query.Where("valid_until >= ?", filter.ActiveFrom)
+	}
+	if filter.ExpiringWithinDays > 0 {
+		now := time.Now()
+		query = query.Where("valid_until BETWEEN ? AND ?", now, now.AddDate(0, 0, int(filter.ExpiringWithinDays)))
+	}
+	if filter.Exhausted != nil {
+		if *filter.Exhausted {
+			query = query.Where("max_uses > 0 AND used_count >= max_uses")
+		} else {
+			query = query.Where("(max_uses = 0 OR used_count < max_uses)")
+		}
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		query = query.Where("created_at <= ?", filter.CreatedTo)
+	}
+	return query
+}
+
+// couponOrderClause 构建优惠券列表排序子句（字段已在 API 层校验，这里只做白名单映射）
+func couponOrderClause(sortBy, sortOrder string) string {
+	dir := "DESC"
+	if sortOrder == constants.SortOrderAsc {
+		dir = "ASC"
+	}
+	switch sortBy {
+	case constants.CouponSortByValidUntil:
+		return "valid_until " + dir + ", coupon_code " + dir
+	case constants.CouponSortByUsedCount:
+		return "used_count " + dir + ", coupon_code " + dir
+	case constants.CouponSortByRemainingUses:
+		// max_uses = 0 表示无限制，视为剩余次数最多
+		return "(max_uses = 0) " + dir + ", (max_uses - used_count) " + dir + ", coupon_code " + dir
+	default:
+		return "created_at " + dir + ", coupon_code " + dir
+	}
+}
+
+// escapeLike 转义 LIKE 模式中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
 // Delete 删除优惠券（软删除）
 func (r *couponRepo) Delete(ctx context.Context, code string) error {
 	// GORM 的软删除：使用 Delete 方法会自动设置 deleted_at 字段
@@ -279,17 +552,32 @@ func (r *couponRepo) IncrementUsedCount(ctx context.Context, code string) error
 // CreateUsage 创建使用记录
 func (r *couponRepo) CreateUsage(ctx context.Context, usage *biz.CouponUsage) error {
 	m := r.toDataUsageModel(usage)
-	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
-		r.log.Errorf("failed to create coupon usage: %v", err)
-		return err
-	}
-	return nil
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			r.log.Errorf("failed to create coupon usage: %v", err)
+			return err
+		}
+		// 同一事务内更新每日汇总
+		if err := r.incrementDailyStats(tx, m); err != nil {
+			r.log.Errorf("failed to update coupon daily stats: %v", err)
+			return err
+		}
+		return nil
+	})
 }
 
-// UseCoupon 使用优惠券（事务操作：原子性增加使用次数 + 创建使用记录）
-func (r *couponRepo) UseCoupon(ctx context.Context, code, appID string, userID string, paymentOrderID, paymentID string, originalAmount, discountAmount, finalAmount int64) error {
+// UseCoupon 使用优惠券（事务操作：原子性增加使用次数 + 累加折扣预算 + 创建使用记录）
+func (r *couponRepo) UseCoupon(ctx context.Context, code, appID string, userID string, paymentOrderID, paymentID string, originalAmount, discountAmount, finalAmount int64, limits []*biz.CouponLimitWindow) ([]*biz.BudgetEvent, error) {
+	now := time.Now()
+	// 频次限制先在 Redis 中预占额度，事务失败时释放
+	reserved, err := r.reserveLimits(ctx, code, limits, now)
+	if err != nil {
+		return nil, err
+	}
+
 	// 使用事务确保原子性
-	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	var events []*biz.BudgetEvent
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 1. 原子性增加使用次数
 		result := tx.Model(&model.Coupon{}).
 			Where("coupon_code = ? AND (max_uses = 0 OR used_count < max_uses)", code).
@@ -304,8 +592,84 @@ func (r *couponRepo) UseCoupon(ctx context.Context, code, appID string, userID s
 			return pkgErrors.NewBizError(pkgErrors.ErrCodeBusinessRuleViolation, "zh-CN")
 		}
 
-		// 2. 创建使用记录
-		now := time.Now()
+		// 2. 读取优惠券当前币种作为快照（之后修改优惠券不影响历史记录）
+		var coupon model.Coupon
+		if err := tx.Select("coupon_code", "currency", "valid_days", "bound_user_id", "eligibility", "campaign_id", "budget", "budget_used",
+			"used_count", "max_uses", "valid_from", "valid_until", "pacing").
+			Where("coupon_code = ?", code).
+			Take(&coupon).Error; err != nil {
+			r.log.Errorf("failed to get coupon currency: %v", err)
+			return err
+		}
+		currency := coupon.Currency
+
+		// 绑定了用户的优惠券只能由该用户使用（返回错误时回滚使用次数）
+		if coupon.BoundUserID != "" && coupon.BoundUserID != userID {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponUserMismatch, "zh-CN")
+		}
+
+		// 投放节奏：按使用后的计数检查当前时间片的累计额度（优惠券行已被上面的更新锁定）
+		if pacing := r.toBizPacing(&coupon); pacing != nil {
+			budgetUsed := coupon.BudgetUsed
+			if coupon.Budget > 0 {
+				budgetUsed += discountAmount
+			}
+			paced := &biz.Coupon{
+				MaxUses:    coupon.MaxUses,
+				Budget:     coupon.Budget,
+				ValidFrom:  coupon.ValidFrom,
+				ValidUntil: coupon.ValidUntil,
+				Pacing:     pacing,
+			}
+			if !paced.WithinPace(coupon.UsedCount, budgetUsed, now) {
+				return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponPaceLimited, "zh-CN")
+			}
+		}
+
+		// 优惠券折扣预算（优惠券行已被上面的更新锁定）：剩余预算不足时拒绝，用尽时停用优惠券
+		if coupon.Budget > 0 && discountAmount > 0 {
+			couponEvents, err := consumeCouponBudget(tx, code, appID, coupon.Budget, coupon.BudgetUsed, discountAmount, now)
+			if err != nil {
+				return err
+			}
+			events = append(events, couponEvents...)
+		}
+
+		// 所属活动必须进行中（共享锁读取活动，与暂停/结束活动的更新互斥），并累加活动折扣预算
+		if coupon.CampaignID != "" {
+			campaignEvents, err := useCampaign(tx, appID, coupon.CampaignID, discountAmount, now)
+			if err != nil {
+				return err
+			}
+			events = append(events, campaignEvents...)
+		}
+
+		// Redis 不可用时按使用记录检查频次限制
+		if len(limits) > 0 && !reserved {
+			if err := checkLimitsInTx(tx, code, limits); err != nil {
+				return err
+			}
+		}
+
+		// 新客优惠券：锁定该用户的使用记录后检查历史（同一支付订单叠加使用的除外），避免并发首单重复使用
+		if coupon.Eligibility != "" {
+			used, err := hasPriorUsage(tx.Clauses(clause.Locking{Strength: "UPDATE"}), appID, userID, paymentOrderID)
+			if err != nil {
+				r.log.Errorf("failed to check coupon usage history: %v", err)
+				return err
+			}
+			if used {
+				return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponNotNewCustomer, "zh-CN")
+			}
+		}
+
+		// 核销用户券包中该优惠券在有效期内的实例：相对有效期优惠券必须持有实例，其他优惠券持有时一并标记为已使用
+		if err := consumeUserCoupon(tx, appID, code, userID, paymentOrderID, now, coupon.ValidDays > 0); err != nil {
+			r.log.Errorf("failed to consume user coupon: %v", err)
+			return err
+		}
+
+		// 3. 创建使用记录
 		usage := &model.CouponUsage{
 			CouponUsageID:  biz.GenerateShortID(),
 			CouponCode:     code,
@@ -316,6 +680,7 @@ func (r *couponRepo) UseCoupon(ctx context.Context, code, appID string, userID s
 			OriginalAmount: originalAmount,
 			DiscountAmount: discountAmount,
 			FinalAmount:    finalAmount,
+			Currency:       currency,
 			UsedAt:         now,
 			CreatedAt:      now,
 		}
@@ -325,8 +690,50 @@ func (r *couponRepo) UseCoupon(ctx context.Context, code, appID string, userID s
 			return err
 		}
 
+		// 4. 同一事务内更新每日汇总
+		if err := r.incrementDailyStats(tx, usage); err != nil {
+			r.log.Errorf("failed to update coupon daily stats: %v", err)
+			return err
+		}
+
+		// 奖励生成的优惠券都绑定了用户：同一事务内把对应的奖励发放记录标记为已使用
+		if coupon.BoundUserID != "" {
+			if err := useRewardGrant(tx, code, now); err != nil {
+				r.log.Errorf("failed to mark reward grant used: %v", err)
+				return err
+			}
+		}
+
 		return nil
 	})
+	if err != nil {
+		if reserved {
+			r.releaseLimits(ctx, code, limits)
+		}
+		return nil, err
+	}
+
+	// 5. 事务提交后更新去重用户 HyperLogLog（尽力而为，失败不影响使用结果）
+	r.addUniqueUser(ctx, code, appID, userID, now)
+	return events, nil
+}
+
+// consumeCouponBudget 在使用优惠券的事务内累加优惠券的已使用折扣预算，预算用尽时停用优惠券，返回跨过告警阈值的预算事件
+func consumeCouponBudget(tx *gorm.DB, code, appID string, budget, used, discountAmount int64, now time.Time) ([]*biz.BudgetEvent, error) {
+	if used+discountAmount > budget {
+		return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeCouponBudgetExhausted, "zh-CN")
+	}
+	updates := map[string]interface{}{
+		"budget_used": gorm.Expr("budget_used + ?", discountAmount),
+	}
+	if used+discountAmount >= budget {
+		updates["status"] = constants.CouponStatusInactive
+		updates["updated_at"] = now
+	}
+	if err := tx.Model(&model.Coupon{}).Where("coupon_code = ?", code).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	return biz.NewBudgetEvents(constants.BudgetScopeCoupon, code, appID, budget, used, used+discountAmount, now), nil
 }
 
 // ListUsages 列出使用记录（分页）
@@ -363,184 +770,99 @@ func (r *couponRepo) ListUsages(ctx context.Context, couponCode string, page, pa
 	return result, total, nil
 }
 
-// GetStats 获取优惠券统计
-func (r *couponRepo) GetStats(ctx context.Context, code string) (*biz.CouponStats, error) {
-	var stats biz.CouponStats
-	stats.CouponCode = code
+// ListUsagesByFilter 按条件列出使用记录（分页）
+func (r *couponRepo) ListUsagesByFilter(ctx context.Context, filter *biz.CouponUsageFilter, page, pageSize int) ([]*biz.CouponUsage, int64, error) {
+	var (
+		models []model.CouponUsage
+		total  int64
+	)
+
+	query := r.applyUsageFilter(r.data.db.WithContext(ctx).Model(&model.CouponUsage{}), filter)
 
-	// 统计使用次数和订单数
-	var countResult struct {
-		TotalUses   int32
-		TotalOrders int32
-	}
-	if err := r.data.db.WithContext(ctx).Model(&model.CouponUsage{}).
-		Select("COUNT(*) as total_uses, COUNT(DISTINCT payment_order_id) as total_orders").
-		Where("coupon_code = ?", code).
-		Scan(&countResult).Error; err != nil {
-		r.log.Errorf("failed to count coupon stats: %v", err)
-		return nil, err
+	// 统计总数
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count coupon usages: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
 	}
-	stats.TotalUses = countResult.TotalUses
-	stats.TotalOrders = countResult.TotalOrders
 
-	// 统计收入和折扣金额
-	var amountResult struct {
-		TotalRevenue  int64
-		TotalDiscount int64
-	}
-	if err := r.data.db.WithContext(ctx).Model(&model.CouponUsage{}).
-		Select("SUM(final_amount) as total_revenue, SUM(discount_amount) as total_discount").
-		Where("coupon_code = ?", code).
-		Scan(&amountResult).Error; err != nil {
-		r.log.Errorf("failed to sum coupon amounts: %v", err)
-		return nil, err
+	// 分页查询
+	offset := (page - 1) * pageSize
+	if err := query.Offset(offset).Limit(pageSize).
+		Order("used_at DESC, coupon_usage_id DESC").
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list coupon usages: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
 	}
-	stats.TotalRevenue = amountResult.TotalRevenue
-	stats.TotalDiscount = amountResult.TotalDiscount
 
-	// 计算转化率（如果有优惠券信息）
-	var coupon model.Coupon
-	if err := r.data.db.WithContext(ctx).Where("coupon_code = ?", code).First(&coupon).Error; err == nil {
-		if coupon.MaxUses > 0 {
-			stats.ConversionRate = float32(stats.TotalUses) / float32(coupon.MaxUses) * 100
-		}
+	// 转换为业务模型
+	result := make([]*biz.CouponUsage, 0, len(models))
+	for _, m := range models {
+		result = append(result, r.toBizUsageModel(&m))
 	}
 
-	return &stats, nil
+	return result, total, nil
 }
 
-// GetSummaryStats 获取汇总统计
-func (r *couponRepo) GetSummaryStats(ctx context.Context, appID string) (*biz.SummaryStats, error) {
-	var stats biz.SummaryStats
-
-	// 构建查询条件
-	couponQuery := r.data.db.WithContext(ctx).Model(&model.Coupon{})
-	usageQuery := r.data.db.WithContext(ctx).Model(&model.CouponUsage{})
-
-	if appID != "" {
-		couponQuery = couponQuery.Where("app_id = ?", appID)
-		usageQuery = usageQuery.Where("app_id = ?", appID)
+// FindUsage 按条件查找最近一条使用记录
+func (r *couponRepo) FindUsage(ctx context.Context, filter *biz.CouponUsageFilter) (*biz.CouponUsage, error) {
+	var m model.CouponUsage
+	query := r.applyUsageFilter(r.data.db.WithContext(ctx).Model(&model.CouponUsage{}), filter)
+	if err := query.Order("used_at DESC, coupon_usage_id DESC").First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(pkgErrors.ErrCodeNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find coupon usage: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
 	}
+	return r.toBizUsageModel(&m), nil
+}
 
-	// 统计优惠券总数和激活数
-	var couponCounts struct {
-		Total  int64
-		Active int64
-	}
-	if err := couponQuery.Select("COUNT(*) as total, SUM(CASE WHEN status = 'active' THEN 1 ELSE 0 END) as active").
-		Scan(&couponCounts).Error; err != nil {
-		r.log.Errorf("failed to count coupons: %v", err)
-		return nil, err
+// HasPriorUsage 用户在应用内是否使用过优惠券（不计 excludePaymentOrderID 对应的订单）
+func (r *couponRepo) HasPriorUsage(ctx context.Context, appID, userID, excludePaymentOrderID string) (bool, error) {
+	used, err := hasPriorUsage(r.data.db.WithContext(ctx), appID, userID, excludePaymentOrderID)
+	if err != nil {
+		r.log.Errorf("failed to check coupon usage history: %v", err)
+		return false, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
 	}
-	stats.TotalCoupons = int32(couponCounts.Total)
-	stats.ActiveCoupons = int32(couponCounts.Active)
+	return used, nil
+}
 
-	// 统计总使用次数和订单数（使用 app_id 字段直接查询，避免 JOIN）
-	usageCountsQuery := r.data.db.WithContext(ctx).Model(&model.CouponUsage{})
-	if appID != "" {
-		usageCountsQuery = usageCountsQuery.Where("app_id = ?", appID)
+// hasPriorUsage 查询用户在应用内的使用记录（走 idx_user_id 索引，事务内加锁时锁住该用户的索引范围）
+func hasPriorUsage(db *gorm.DB, appID, userID, excludePaymentOrderID string) (bool, error) {
+	var ids []string
+	query := db.Model(&model.CouponUsage{}).Where("user_id = ? AND app_id = ?", userID, appID)
+	if excludePaymentOrderID != "" {
+		query = query.Where("payment_order_id <> ?", excludePaymentOrderID)
 	}
-	var usageCounts struct {
-		TotalUses   int32
-		TotalOrders int32
+	if err := query.Limit(1).Pluck("coupon_usage_id", &ids).Error; err != nil {
+		return false, err
 	}
-	if err := usageCountsQuery.Select("COUNT(*) as total_uses, COUNT(DISTINCT payment_order_id) as total_orders").
-		Scan(&usageCounts).Error; err != nil {
-		r.log.Errorf("failed to count usages: %v", err)
-		return nil, err
-	}
-	stats.TotalUses = usageCounts.TotalUses
-	stats.TotalOrders = usageCounts.TotalOrders
+	return len(ids) > 0, nil
+}
 
-	// 统计总收入和总折扣（需要重新构建查询）
-	amountsQuery := r.data.db.WithContext(ctx).Model(&model.CouponUsage{})
-	if appID != "" {
-		amountsQuery = amountsQuery.Where("coupon_code IN (SELECT coupon_code FROM coupon WHERE app_id = ? AND deleted_at IS NULL)", appID)
+// applyUsageFilter 将筛选条件应用到使用记录查询
+// user_id / payment_order_id / payment_id 均有独立索引，app_id + used_at 使用 idx_app_id_used_at
+func (r *couponRepo) applyUsageFilter(query *gorm.DB, filter *biz.CouponUsageFilter) *gorm.DB {
+	if filter.AppID != "" {
+		query = query.Where("app_id = ?", filter.AppID)
 	}
-	var amounts struct {
-		TotalRevenue  int64
-		TotalDiscount int64
+	if filter.CouponCode != "" {
+		query = query.Where("coupon_code = ?", filter.CouponCode)
 	}
-	if err := amountsQuery.Select("COALESCE(SUM(final_amount), 0) as total_revenue, COALESCE(SUM(discount_amount), 0) as total_discount").
-		Scan(&amounts).Error; err != nil {
-		r.log.Errorf("failed to sum amounts: %v", err)
-		return nil, err
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
 	}
-	stats.TotalRevenue = amounts.TotalRevenue
-	stats.TotalDiscount = amounts.TotalDiscount
-
-	// 批量获取所有优惠券的统计信息（优化：避免 N+1 查询）
-	// 使用 JOIN 查询一次性获取所有优惠券的统计
-	type CouponStatsResult struct {
-		CouponCode     string
-		MaxUses        int32
-		TotalUses      int32
-		TotalOrders    int32
-		TotalRevenue   int64
-		TotalDiscount  int64
-		ConversionRate float32
-	}
-
-	var statsResults []CouponStatsResult
-	statsQuery := r.data.db.WithContext(ctx).
-		Table("coupon c").
-		Select(`
-			c.coupon_code,
-			c.max_uses,
-			COALESCE(COUNT(cu.id), 0) as total_uses,
-			COALESCE(COUNT(DISTINCT cu.payment_order_id), 0) as total_orders,
-			COALESCE(SUM(cu.final_amount), 0) as total_revenue,
-			COALESCE(SUM(cu.discount_amount), 0) as total_discount,
-			CASE 
-				WHEN c.max_uses > 0 THEN (COALESCE(COUNT(cu.id), 0) * 100.0 / c.max_uses)
-				ELSE 0
-			END as conversion_rate
-		`).
-		Joins("LEFT JOIN coupon_usage cu ON c.coupon_code = cu.coupon_code")
-
-	if appID != "" {
-		statsQuery = statsQuery.Where("c.app_id = ? AND c.deleted_at IS NULL", appID)
-	} else {
-		statsQuery = statsQuery.Where("c.deleted_at IS NULL")
-	}
-
-	statsQuery = statsQuery.Group("c.coupon_code, c.max_uses").
-		Order("total_uses DESC").
-		Limit(10) // 只取前10个
-
-	if err := statsQuery.Scan(&statsResults).Error; err != nil {
-		r.log.Errorf("failed to get coupon stats: %v", err)
-		return nil, err
+	if filter.PaymentOrderID != "" {
+		query = query.Where("payment_order_id = ?", filter.PaymentOrderID)
 	}
-
-	// 转换为业务模型
-	topCoupons := make([]*biz.CouponStats, 0, len(statsResults))
-	var totalConversionRate float32
-	var validCouponsCount int32
-
-	for _, sr := range statsResults {
-		couponStats := &biz.CouponStats{
-			CouponCode:     sr.CouponCode,
-			TotalUses:      sr.TotalUses,
-			TotalOrders:    sr.TotalOrders,
-			TotalRevenue:   sr.TotalRevenue,
-			TotalDiscount:  sr.TotalDiscount,
-			ConversionRate: sr.ConversionRate,
-		}
-		topCoupons = append(topCoupons, couponStats)
-
-		if sr.MaxUses > 0 {
-			totalConversionRate += sr.ConversionRate
-			validCouponsCount++
-		}
+	if filter.PaymentID != "" {
+		query = query.Where("payment_id = ?", filter.PaymentID)
 	}
-
-	// 计算平均转化率
-	if validCouponsCount > 0 {
-		stats.AverageConversionRate = totalConversionRate / float32(validCouponsCount)
+	if !filter.UsedFrom.IsZero() {
+		query = query.Where("used_at >= ?", filter.UsedFrom)
 	}
-
-	stats.TopCoupons = topCoupons
-
-	return &stats, nil
+	if !filter.UsedTo.IsZero() {
+		query = query.Where("used_at <= ?", filter.UsedTo)
+	}
+	return query
 }
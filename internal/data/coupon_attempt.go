@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	attemptBufferSize    = 10000       // 内存缓冲区大小，写满后丢弃新记录，保证验证接口不被阻塞
+	attemptBatchSize     = 200         // 单次批量写入条数
+	attemptFlushInterval = time.Second // 定时刷新间隔
+	attemptWriteTimeout  = 5 * time.Second
+)
+
+// validationAttemptRepo 实现 biz.ValidationAttemptRepo 接口（内存缓冲 + 后台批量写入）
+type validationAttemptRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mu     sync.RWMutex
+	closed bool
+	ch     chan *model.CouponValidationAttempt
+	done   chan struct{}
+}
+
+// NewValidationAttemptRepo 创建验证尝试记录 Repository，返回的 cleanup 会在退出前刷新缓冲区
+func NewValidationAttemptRepo(data *Data, logger log.Logger) (biz.ValidationAttemptRepo, func(), error) {
+	r := &validationAttemptRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/validation_attempt")),
+		ch:   make(chan *model.CouponValidationAttempt, attemptBufferSize),
+		done: make(chan struct{}),
+	}
+	go r.run()
+
+	cleanup := func() {
+		r.mu.Lock()
+		r.closed = true
+		close(r.ch)
+		r.mu.Unlock()
+		<-r.done
+	}
+	return r, cleanup, nil
+}
+
+// Record 异步记录一次验证尝试
+func (r *validationAttemptRepo) Record(ctx context.Context, a *biz.ValidationAttempt) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return
+	}
+
+	select {
+	case r.ch <- &model.CouponValidationAttempt{
+		AttemptID:   a.AttemptID,
+		CouponCode:  a.CouponCode,
+		AppID:       a.AppID,
+		UserID:      a.UserID,
+		Amount:      a.Amount,
+		Reason:      a.Reason,
+		AttemptedAt: a.AttemptedAt,
+	}:
+	default:
+		r.log.Warnf("validation attempt buffer is full, dropping attempt: coupon_code=%s, app_id=%s", a.CouponCode, a.AppID)
+	}
+}
+
+// run 后台批量写入，达到批量大小或定时器到期时刷新
+func (r *validationAttemptRepo) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(attemptFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*model.CouponValidationAttempt, 0, attemptBatchSize)
+	for {
+		select {
+		case a, ok := <-r.ch:
+			if !ok {
+				r.flush(batch)
+				return
+			}
+			batch = append(batch, a)
+			if len(batch) >= attemptBatchSize {
+				r.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			r.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+// flush 批量写入数据库（统计数据允许少量丢失，失败只记录日志）
+func (r *validationAttemptRepo) flush(batch []*model.CouponValidationAttempt) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), attemptWriteTimeout)
+	defer cancel()
+	if err := r.data.db.WithContext(ctx).CreateInBatches(batch, attemptBatchSize).Error; err != nil {
+		r.log.Errorf("failed to write %d validation attempts: %v", len(batch), err)
+	}
+}
@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpdateStatusBatch 在一个事务内批量修改优惠券状态，每个状态发生变化的优惠券写一条审计日志
+func (r *couponRepo) UpdateStatusBatch(ctx context.Context, appID string, codes []string, status string, audit *biz.CouponAudit) ([]*biz.CouponBatchItem, error) {
+	var items []*biz.CouponBatchItem
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := lockCouponStatuses(tx, appID, codes)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var changed []string
+		var logs []*model.CouponAuditLog
+		items = make([]*biz.CouponBatchItem, 0, len(codes))
+		for _, code := range codes {
+			oldStatus, ok := current[code]
+			item := &biz.CouponBatchItem{CouponCode: code, OldStatus: oldStatus}
+			switch {
+			case !ok:
+				item.Result = constants.BatchItemResultNotFound
+			case oldStatus == status:
+				item.Result = constants.BatchItemResultUnchanged
+			default:
+				item.Result = constants.BatchItemResultUpdated
+				changed = append(changed, code)
+				logs = append(logs, newCouponAuditLog(appID, code, constants.CouponAuditActionUpdateStatus, oldStatus, status, audit, now))
+			}
+			items = append(items, item)
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&model.Coupon{}).
+			Where("app_id = ? AND coupon_code IN ?", appID, changed).
+			Updates(map[string]interface{}{"status": status, "updated_at": now}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&logs).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to update coupon status in batch: %v", err)
+		return nil, err
+	}
+	return items, nil
+}
+
+// DeleteBatch 在一个事务内批量软删除优惠券，每个被删除的优惠券写一条审计日志
+func (r *couponRepo) DeleteBatch(ctx context.Context, appID string, codes []string, audit *biz.CouponAudit) ([]*biz.CouponBatchItem, error) {
+	var items []*biz.CouponBatchItem
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current, err := lockCouponStatuses(tx, appID, codes)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var deleted []string
+		var logs []*model.CouponAuditLog
+		items = make([]*biz.CouponBatchItem, 0, len(codes))
+		for _, code := range codes {
+			oldStatus, ok := current[code]
+			item := &biz.CouponBatchItem{CouponCode: code, OldStatus: oldStatus}
+			if !ok {
+				item.Result = constants.BatchItemResultNotFound
+			} else {
+				item.Result = constants.BatchItemResultDeleted
+				deleted = append(deleted, code)
+				logs = append(logs, newCouponAuditLog(appID, code, constants.CouponAuditActionDelete, oldStatus, "", audit, now))
+			}
+			items = append(items, item)
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+
+		if err := tx.Where("app_id = ? AND coupon_code IN ?", appID, deleted).Delete(&model.Coupon{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&logs).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to delete coupons in batch: %v", err)
+		return nil, err
+	}
+	return items, nil
+}
+
+// lockCouponStatuses 锁定并返回应用内（未删除）优惠券的当前状态：coupon_code -> status
+func lockCouponStatuses(tx *gorm.DB, appID string, codes []string) (map[string]string, error) {
+	var rows []struct {
+		CouponCode string
+		Status     string
+	}
+	if err := tx.Model(&model.Coupon{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("coupon_code, status").
+		Where("app_id = ? AND coupon_code IN ?", appID, codes).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]string, len(rows))
+	for _, row := range rows {
+		statuses[row.CouponCode] = row.Status
+	}
+	return statuses, nil
+}
+
+// newCouponAuditLog 创建审计日志
+func newCouponAuditLog(appID, code, action, oldStatus, newStatus string, audit *biz.CouponAudit, now time.Time) *model.CouponAuditLog {
+	return &model.CouponAuditLog{
+		AppID:      appID,
+		CouponCode: code,
+		Action:     action,
+		OldStatus:  oldStatus,
+		NewStatus:  newStatus,
+		BatchID:    audit.BatchID,
+		Operator:   audit.Operator,
+		Reason:     audit.Reason,
+		CreatedAt:  now,
+	}
+}
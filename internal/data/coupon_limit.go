@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// couponLimitKeyGrace 频次计数 key 在窗口结束后的保留时间
+const couponLimitKeyGrace = time.Hour
+
+// reserveCouponLimitScript 原子检查并预占所有频次限制窗口的额度
+// KEYS: 计数 key；ARGV: 每个 key 依次为 限制次数、过期时间(毫秒)
+// 返回 0 表示预占成功，i>0 表示第 i 个窗口额度已用尽（此时不修改任何计数）
+var reserveCouponLimitScript = redis.NewScript(`
+for i, key in ipairs(KEYS) do
+	local used = tonumber(redis.call('GET', key) or '0')
+	if used >= tonumber(ARGV[2 * i - 1]) then
+		return i
+	end
+end
+for i, key in ipairs(KEYS) do
+	redis.call('INCR', key)
+	if redis.call('PTTL', key) < 0 then
+		redis.call('PEXPIRE', key, ARGV[2 * i])
+	end
+end
+return 0
+`)
+
+// couponLimitKey 频次限制窗口的计数 key（同一优惠券的 key 使用相同的 hash tag，便于在 Lua 脚本中一起操作）
+func couponLimitKey(code string, w *biz.CouponLimitWindow) string {
+	return fmt.Sprintf("marketing:limit:{%s}:%s:%s:%d", code, w.Scope, w.UserID, w.From.Unix())
+}
+
+// couponLimitKeyTTL 计数 key 的过期时间
+func couponLimitKeyTTL(w *biz.CouponLimitWindow, now time.Time) time.Duration {
+	return w.To.Sub(now) + couponLimitKeyGrace
+}
+
+// countLimitUsage 从使用记录统计窗口内的使用次数
+func countLimitUsage(db *gorm.DB, code string, w *biz.CouponLimitWindow) (int64, error) {
+	var count int64
+	query := db.Model(&model.CouponUsage{}).
+		Where("coupon_code = ? AND used_at >= ? AND used_at < ?", code, w.From, w.To)
+	if w.UserID != "" {
+		query = query.Where("user_id = ?", w.UserID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountLimitUsage 各频次限制窗口内的已使用次数：优先读取 Redis 计数，计数不存在或 Redis 不可用时统计使用记录
+func (r *couponRepo) CountLimitUsage(ctx context.Context, code string, windows []*biz.CouponLimitWindow) ([]int64, error) {
+	used := make([]int64, len(windows))
+	cached := make([]bool, len(windows))
+	if r.data.rdb != nil {
+		keys := make([]string, 0, len(windows))
+		for _, w := range windows {
+			keys = append(keys, couponLimitKey(code, w))
+		}
+		values, err := r.data.rdb.MGet(ctx, keys...).Result()
+		if err != nil {
+			r.log.Warnf("failed to get coupon limit counters, falling back to database: %v", err)
+		} else {
+			for i, v := range values {
+				if s, ok := v.(string); ok {
+					if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+						used[i], cached[i] = n, true
+					}
+				}
+			}
+		}
+	}
+
+	db := r.data.db.WithContext(ctx)
+	for i, w := range windows {
+		if cached[i] {
+			continue
+		}
+		count, err := countLimitUsage(db, code, w)
+		if err != nil {
+			r.log.Errorf("failed to count coupon limit usage: %v", err)
+			return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+		}
+		used[i] = count
+	}
+	return used, nil
+}
+
+// reserveLimits 在 Redis 中预占频次限制额度
+// 不存在的计数先用使用记录初始化；额度用尽时返回错误；Redis 不可用时返回 reserved=false，由调用方在事务内检查
+func (r *couponRepo) reserveLimits(ctx context.Context, code string, windows []*biz.CouponLimitWindow, now time.Time) (bool, error) {
+	if len(windows) == 0 || r.data.rdb == nil {
+		return false, nil
+	}
+	keys := make([]string, 0, len(windows))
+	args := make([]interface{}, 0, 2*len(windows))
+	for _, w := range windows {
+		keys = append(keys, couponLimitKey(code, w))
+		args = append(args, w.Limit, couponLimitKeyTTL(w, now).Milliseconds())
+	}
+
+	if err := r.seedLimitCounters(ctx, code, windows, keys, now); err != nil {
+		r.log.Warnf("failed to seed coupon limit counters, falling back to database: %v", err)
+		return false, nil
+	}
+	index, err := reserveCouponLimitScript.Run(ctx, r.data.rdb, keys, args...).Int()
+	if err != nil {
+		r.log.Warnf("failed to reserve coupon limits, falling back to database: %v", err)
+		return false, nil
+	}
+	if index > 0 {
+		return false, pkgErrors.NewBizError(marketingErrors.ErrCodeCouponLimitExceeded, "zh-CN")
+	}
+	return true, nil
+}
+
+// seedLimitCounters 用使用记录初始化不存在的计数 key（Redis 重启或 key 过期后保持计数准确）
+func (r *couponRepo) seedLimitCounters(ctx context.Context, code string, windows []*biz.CouponLimitWindow, keys []string, now time.Time) error {
+	pipe := r.data.rdb.Pipeline()
+	exists := make([]*redis.IntCmd, len(keys))
+	for i, key := range keys {
+		exists[i] = pipe.Exists(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	db := r.data.db.WithContext(ctx)
+	for i, w := range windows {
+		if exists[i].Val() > 0 {
+			continue
+		}
+		count, err := countLimitUsage(db, code, w)
+		if err != nil {
+			return err
+		}
+		// 并发初始化时只保留第一个写入的值
+		if err := r.data.rdb.SetNX(ctx, keys[i], count, couponLimitKeyTTL(w, now)).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseLimits 使用失败时释放 Redis 中预占的额度（尽力而为，失败只记录日志）
+func (r *couponRepo) releaseLimits(ctx context.Context, code string, windows []*biz.CouponLimitWindow) {
+	pipe := r.data.rdb.Pipeline()
+	for _, w := range windows {
+		pipe.Decr(ctx, couponLimitKey(code, w))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Warnf("failed to release coupon limits: coupon_code=%s, err=%v", code, err)
+	}
+}
+
+// checkLimitsInTx Redis 不可用时在事务内按使用记录检查频次限制（调用前已通过更新 used_count 锁定优惠券行，同一优惠券的使用串行执行）
+func checkLimitsInTx(tx *gorm.DB, code string, windows []*biz.CouponLimitWindow) error {
+	for _, w := range windows {
+		count, err := countLimitUsage(tx, code, w)
+		if err != nil {
+			return err
+		}
+		if count >= int64(w.Limit) {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponLimitExceeded, "zh-CN")
+		}
+	}
+	return nil
+}
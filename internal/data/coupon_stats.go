@@ -0,0 +1,464 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"gorm.io/gorm"
+)
+
+// GetUsageTimeSeries 按时间桶聚合使用记录
+// 时间桶边界由 biz 层按请求时区计算，这里用 CASE 表达式把 used_at 映射到桶序号，
+// 因此不依赖 MySQL 时区表，且 WHERE 条件可以走 idx_app_id_used_at 索引
+func (r *couponRepo) GetUsageTimeSeries(ctx context.Context, appID, couponCode string, from, to time.Time, bucketStarts []time.Time) ([]*biz.UsageTimeSeriesPoint, error) {
+	points := make([]*biz.UsageTimeSeriesPoint, len(bucketStarts))
+	for i, start := range bucketStarts {
+		points[i] = &biz.UsageTimeSeriesPoint{BucketStart: start}
+	}
+	if len(bucketStarts) == 0 {
+		return points, nil
+	}
+
+	// CASE WHEN used_at < b1 THEN 0 WHEN used_at < b2 THEN 1 ... ELSE n-1 END
+	var (
+		bucketExpr strings.Builder
+		args       = make([]interface{}, 0, len(bucketStarts))
+	)
+	bucketExpr.WriteString("CASE")
+	for i := 1; i < len(bucketStarts); i++ {
+		bucketExpr.WriteString(" WHEN used_at < ? THEN ")
+		bucketExpr.WriteString(strconv.Itoa(i - 1))
+		args = append(args, bucketStarts[i])
+	}
+	bucketExpr.WriteString(" ELSE ")
+	bucketExpr.WriteString(strconv.Itoa(len(bucketStarts) - 1))
+	bucketExpr.WriteString(" END")
+
+	query := r.data.db.WithContext(ctx).Model(&model.CouponUsage{}).
+		Select(bucketExpr.String()+` AS bucket,
+			COUNT(*) AS uses,
+			COUNT(DISTINCT payment_order_id) AS distinct_orders,
+			COUNT(DISTINCT user_id) AS distinct_users,
+			COALESCE(SUM(final_amount), 0) AS revenue,
+			COALESCE(SUM(discount_amount), 0) AS discount`, args...).
+		Where("app_id = ? AND used_at >= ? AND used_at < ?", appID, from, to)
+	if couponCode != "" {
+		query = query.Where("coupon_code = ?", couponCode)
+	}
+
+	var rows []struct {
+		Bucket         int
+		Uses           int32
+		DistinctOrders int32
+		DistinctUsers  int32
+		Revenue        int64
+		Discount       int64
+	}
+	if err := query.Group("bucket").Scan(&rows).Error; err != nil {
+		r.log.Errorf("failed to aggregate coupon usage time series: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	for _, row := range rows {
+		if row.Bucket < 0 || row.Bucket >= len(points) {
+			continue
+		}
+		p := points[row.Bucket]
+		p.Uses = row.Uses
+		p.DistinctOrders = row.DistinctOrders
+		p.DistinctUsers = row.DistinctUsers
+		p.Revenue = row.Revenue
+		p.Discount = row.Discount
+	}
+
+	return points, nil
+}
+
+// usageAggSource 使用记录聚合数据源：每日汇总表或原始使用记录，两者提供同名的聚合表达式
+type usageAggSource struct {
+	newQuery func() *gorm.DB // 每次调用返回新的查询（gorm 链式查询不能复用）
+	uses     string          // 使用次数聚合表达式
+	orders   string          // 订单数聚合表达式
+	revenue  string          // 收入聚合表达式
+	discount string          // 折扣聚合表达式
+}
+
+// dailyUsageSource 基于 coupon_stats_daily 的聚合数据源
+func (r *couponRepo) dailyUsageSource(db *gorm.DB, appID, couponCode, fromDate, toDate string) *usageAggSource {
+	return &usageAggSource{
+		newQuery: func() *gorm.DB {
+			query := db.Model(&model.CouponStatsDaily{})
+			if appID != "" {
+				query = query.Where("app_id = ?", appID)
+			}
+			if couponCode != "" {
+				query = query.Where("coupon_code = ?", couponCode)
+			}
+			if fromDate != "" {
+				query = query.Where("stat_date >= ?", fromDate)
+			}
+			if toDate != "" {
+				query = query.Where("stat_date <= ?", toDate)
+			}
+			return query
+		},
+		uses:     "SUM(uses)",
+		orders:   "SUM(orders)",
+		revenue:  "SUM(revenue)",
+		discount: "SUM(discount)",
+	}
+}
+
+// rawUsageSource 基于 coupon_usage 原始记录的聚合数据源（时间窗口不是整天时使用）
+func (r *couponRepo) rawUsageSource(db *gorm.DB, filter *biz.CouponUsageFilter) *usageAggSource {
+	return &usageAggSource{
+		newQuery: func() *gorm.DB {
+			return r.applyUsageFilter(db.Model(&model.CouponUsage{}), filter)
+		},
+		uses:     "COUNT(*)",
+		orders:   "COUNT(DISTINCT payment_order_id)",
+		revenue:  "SUM(final_amount)",
+		discount: "SUM(discount_amount)",
+	}
+}
+
+// summaryUsageSource 选择汇总统计的数据源：窗口按整天（数据库连接时区）对齐时读每日汇总表，否则扫描原始使用记录
+func (r *couponRepo) summaryUsageSource(db *gorm.DB, q *biz.SummaryStatsQuery) (*usageAggSource, bool) {
+	if fromDate, toDate, ok := dailyStatsWindow(q.From, q.To, r.data.loc); ok {
+		return r.dailyUsageSource(db, q.AppID, "", fromDate, toDate), true
+	}
+	return r.rawUsageSource(db, summaryUsageFilter(q)), false
+}
+
+// summaryUsageFilter 汇总统计时间窗口对应的使用记录筛选条件
+func summaryUsageFilter(q *biz.SummaryStatsQuery) *biz.CouponUsageFilter {
+	return &biz.CouponUsageFilter{
+		AppID:    q.AppID,
+		UsedFrom: q.From,
+		UsedTo:   q.To,
+	}
+}
+
+// GetStats 获取优惠券统计（使用次数、订单数和金额读取每日汇总表）
+func (r *couponRepo) GetStats(ctx context.Context, code string) (*biz.CouponStats, error) {
+	var stats biz.CouponStats
+	stats.CouponCode = code
+
+	src := r.dailyUsageSource(r.data.db.WithContext(ctx), "", code, "", "")
+
+	// 统计使用次数和订单数
+	var countResult struct {
+		TotalUses   int32
+		TotalOrders int32
+	}
+	if err := src.newQuery().
+		Select(fmt.Sprintf("COALESCE(%s, 0) as total_uses, COALESCE(%s, 0) as total_orders", src.uses, src.orders)).
+		Scan(&countResult).Error; err != nil {
+		r.log.Errorf("failed to count coupon stats: %v", err)
+		return nil, err
+	}
+	stats.TotalUses = countResult.TotalUses
+	stats.TotalOrders = countResult.TotalOrders
+
+	// 按币种统计收入和折扣金额
+	amounts, err := r.sumAmountsByCurrency(src)
+	if err != nil {
+		r.log.Errorf("failed to sum coupon amounts: %v", err)
+		return nil, err
+	}
+	stats.AmountsByCurrency = amounts
+	stats.TotalRevenue, stats.TotalDiscount = totalAmounts(amounts)
+
+	// 计算配额使用率并统计验证次数（如果有优惠券信息）
+	var coupon model.Coupon
+	if err := r.data.db.WithContext(ctx).Where("coupon_code = ?", code).First(&coupon).Error; err == nil {
+		if coupon.MaxUses > 0 {
+			stats.QuotaUtilization = float32(stats.TotalUses) / float32(coupon.MaxUses) * 100
+		}
+
+		// 只统计优惠券所属应用的验证记录，避免其他应用的误用污染漏斗
+		var attemptResult struct {
+			ValidationAttempts int32
+			ValidAttempts      int32
+		}
+		if err := r.data.db.WithContext(ctx).Model(&model.CouponValidationAttempt{}).
+			Select("COUNT(*) as validation_attempts, COALESCE(SUM(CASE WHEN reason = ? THEN 1 ELSE 0 END), 0) as valid_attempts", constants.ValidateReasonOK).
+			Where("coupon_code = ? AND app_id = ?", code, coupon.AppID).
+			Scan(&attemptResult).Error; err != nil {
+			r.log.Errorf("failed to count coupon validation attempts: %v", err)
+			return nil, err
+		}
+		stats.ValidationAttempts = attemptResult.ValidationAttempts
+		stats.ValidAttempts = attemptResult.ValidAttempts
+	}
+
+	return &stats, nil
+}
+
+// GetSummaryStats 获取汇总统计
+// 使用记录与验证记录统一按各自表中的 app_id 过滤（写入时冗余的应用ID），时间窗口同时作用于使用记录和验证记录，
+// 保证汇总值与前N个优惠券明细口径一致；窗口按整天对齐时使用次数和金额读取每日汇总表，订单数始终按原始使用记录去重
+func (r *couponRepo) GetSummaryStats(ctx context.Context, q *biz.SummaryStatsQuery) (*biz.SummaryStats, error) {
+	var stats biz.SummaryStats
+
+	// 统计优惠券总数和激活数（不受时间窗口影响）
+	couponQuery := r.data.db.WithContext(ctx).Model(&model.Coupon{})
+	if q.AppID != "" {
+		couponQuery = couponQuery.Where("app_id = ?", q.AppID)
+	}
+	var couponCounts struct {
+		Total  int64
+		Active int64
+	}
+	if err := couponQuery.Select("COUNT(*) as total, SUM(CASE WHEN status = 'active' THEN 1 ELSE 0 END) as active").
+		Scan(&couponCounts).Error; err != nil {
+		r.log.Errorf("failed to count coupons: %v", err)
+		return nil, err
+	}
+	stats.TotalCoupons = int32(couponCounts.Total)
+	stats.ActiveCoupons = int32(couponCounts.Active)
+
+	src, daily := r.summaryUsageSource(r.data.db.WithContext(ctx), q)
+
+	// 统计总使用次数
+	var usageCounts struct {
+		TotalUses int32
+	}
+	if err := src.newQuery().
+		Select(fmt.Sprintf("COALESCE(%s, 0) as total_uses", src.uses)).
+		Scan(&usageCounts).Error; err != nil {
+		r.log.Errorf("failed to count usages: %v", err)
+		return nil, err
+	}
+	stats.TotalUses = usageCounts.TotalUses
+
+	// 统计订单数：应用内按订单去重（同一订单使用多张优惠券只计一次），每日汇总表按优惠券累加无法表达，
+	// 因此无论窗口是否按整天对齐都扫描原始使用记录，保证两种窗口口径一致
+	orderSrc := r.rawUsageSource(r.data.db.WithContext(ctx), summaryUsageFilter(q))
+	var orderCounts struct {
+		TotalOrders int32
+	}
+	if err := orderSrc.newQuery().
+		Select(fmt.Sprintf("COALESCE(%s, 0) as total_orders", orderSrc.orders)).
+		Scan(&orderCounts).Error; err != nil {
+		r.log.Errorf("failed to count orders: %v", err)
+		return nil, err
+	}
+	stats.TotalOrders = orderCounts.TotalOrders
+
+	// 按币种统计总收入和总折扣（与使用次数同一数据源）
+	amounts, err := r.sumAmountsByCurrency(src)
+	if err != nil {
+		r.log.Errorf("failed to sum amounts: %v", err)
+		return nil, err
+	}
+	stats.AmountsByCurrency = amounts
+	stats.TotalRevenue, stats.TotalDiscount = totalAmounts(amounts)
+
+	// 统计验证次数（转化漏斗顶部）
+	var attempts struct {
+		TotalAttempts      int32
+		TotalValidAttempts int32
+	}
+	if err := r.summaryAttemptQuery(r.data.db.WithContext(ctx), q).
+		Select("COUNT(*) as total_attempts, COALESCE(SUM(CASE WHEN reason = ? THEN 1 ELSE 0 END), 0) as total_valid_attempts", constants.ValidateReasonOK).
+		Scan(&attempts).Error; err != nil {
+		r.log.Errorf("failed to count validation attempts: %v", err)
+		return nil, err
+	}
+	stats.TotalAttempts = attempts.TotalAttempts
+	stats.TotalValidAttempts = attempts.TotalValidAttempts
+
+	// 批量获取前N个优惠券的统计信息（优化：避免 N+1 查询）
+	// 使用记录和验证记录先按优惠券聚合再关联，避免行数膨胀；LEFT JOIN 保证窗口内无使用的优惠券也参与排名
+	type CouponStatsResult struct {
+		CouponCode         string
+		TotalUses          int32
+		TotalOrders        int32
+		DistinctUsers      int32
+		TotalRevenue       int64
+		TotalDiscount      int64
+		QuotaUtilization   float32
+		ValidationAttempts int32
+		ValidAttempts      int32
+	}
+
+	// 去重用户数不能按天累加：按去重用户数排名时改为扫描原始使用记录，否则只为前N个优惠券单独补查
+	subSrc, subDaily := src, daily
+	if daily && q.RankBy == constants.StatsRankByDistinctUsers {
+		subSrc, subDaily = r.rawUsageSource(r.data.db.WithContext(ctx), summaryUsageFilter(q)), false
+	}
+	distinctUsersExpr := "COUNT(DISTINCT user_id)"
+	if subDaily {
+		distinctUsersExpr = "0"
+	}
+	usageSubQuery := subSrc.newQuery().
+		Select(fmt.Sprintf("coupon_code, %s as total_uses, %s as total_orders, %s as distinct_users, %s as total_revenue, %s as total_discount",
+			subSrc.uses, subSrc.orders, distinctUsersExpr, subSrc.revenue, subSrc.discount)).
+		Group("coupon_code")
+	attemptsSubQuery := r.summaryAttemptQuery(r.data.db.WithContext(ctx), q).
+		Select("coupon_code, COUNT(*) as validation_attempts, SUM(CASE WHEN reason = ? THEN 1 ELSE 0 END) as valid_attempts", constants.ValidateReasonOK).
+		Group("coupon_code")
+
+	var statsResults []CouponStatsResult
+	statsQuery := r.data.db.WithContext(ctx).
+		Table("coupon c").
+		Select(`
+			c.coupon_code,
+			COALESCE(cu.total_uses, 0) as total_uses,
+			COALESCE(cu.total_orders, 0) as total_orders,
+			COALESCE(cu.distinct_users, 0) as distinct_users,
+			COALESCE(cu.total_revenue, 0) as total_revenue,
+			COALESCE(cu.total_discount, 0) as total_discount,
+			CASE 
+				WHEN c.max_uses > 0 THEN (COALESCE(cu.total_uses, 0) * 100.0 / c.max_uses)
+				ELSE 0
+			END as quota_utilization,
+			COALESCE(va.validation_attempts, 0) as validation_attempts,
+			COALESCE(va.valid_attempts, 0) as valid_attempts
+		`).
+		Joins("LEFT JOIN (?) cu ON c.coupon_code = cu.coupon_code", usageSubQuery).
+		Joins("LEFT JOIN (?) va ON c.coupon_code = va.coupon_code", attemptsSubQuery).
+		Where("c.deleted_at IS NULL")
+	if q.AppID != "" {
+		statsQuery = statsQuery.Where("c.app_id = ?", q.AppID)
+	}
+
+	if err := statsQuery.Order(summaryRankClause(q.RankBy)).
+		Limit(q.TopN).
+		Scan(&statsResults).Error; err != nil {
+		r.log.Errorf("failed to get coupon stats: %v", err)
+		return nil, err
+	}
+
+	codes := make([]string, 0, len(statsResults))
+	for _, sr := range statsResults {
+		codes = append(codes, sr.CouponCode)
+	}
+	amountsByCoupon := make(map[string][]*biz.CurrencyAmount, len(codes))
+	distinctUsersByCoupon := make(map[string]int32, len(codes))
+	if len(codes) > 0 {
+		// 批量按币种统计前N个优惠券的金额（同一优惠券修改币种后可能存在多个币种的使用记录）
+		var rows []struct {
+			CouponCode string
+			biz.CurrencyAmount
+		}
+		if err := src.newQuery().
+			Select(fmt.Sprintf("coupon_code, currency, COALESCE(%s, 0) as total_uses, COALESCE(%s, 0) as total_revenue, COALESCE(%s, 0) as total_discount", src.uses, src.revenue, src.discount)).
+			Where("coupon_code IN ?", codes).
+			Group("coupon_code, currency").
+			Order("coupon_code, currency").
+			Scan(&rows).Error; err != nil {
+			r.log.Errorf("failed to sum top coupon amounts: %v", err)
+			return nil, err
+		}
+		for i := range rows {
+			amount := rows[i].CurrencyAmount
+			amountsByCoupon[rows[i].CouponCode] = append(amountsByCoupon[rows[i].CouponCode], &amount)
+		}
+
+		// 读取每日汇总表时，去重用户数只为前N个优惠券补查
+		if subDaily {
+			var userRows []struct {
+				CouponCode    string
+				DistinctUsers int32
+			}
+			if err := r.applyUsageFilter(r.data.db.WithContext(ctx).Model(&model.CouponUsage{}), summaryUsageFilter(q)).
+				Select("coupon_code, COUNT(DISTINCT user_id) as distinct_users").
+				Where("coupon_code IN ?", codes).
+				Group("coupon_code").
+				Scan(&userRows).Error; err != nil {
+				r.log.Errorf("failed to count top coupon distinct users: %v", err)
+				return nil, err
+			}
+			for _, ur := range userRows {
+				distinctUsersByCoupon[ur.CouponCode] = ur.DistinctUsers
+			}
+		}
+	}
+
+	// 转换为业务模型（转化漏斗比率由 biz 层计算）
+	topCoupons := make([]*biz.CouponStats, 0, len(statsResults))
+	for _, sr := range statsResults {
+		distinctUsers := sr.DistinctUsers
+		if subDaily {
+			distinctUsers = distinctUsersByCoupon[sr.CouponCode]
+		}
+		topCoupons = append(topCoupons, &biz.CouponStats{
+			CouponCode:         sr.CouponCode,
+			TotalUses:          sr.TotalUses,
+			TotalOrders:        sr.TotalOrders,
+			DistinctUsers:      distinctUsers,
+			TotalRevenue:       sr.TotalRevenue,
+			TotalDiscount:      sr.TotalDiscount,
+			QuotaUtilization:   sr.QuotaUtilization,
+			ValidationAttempts: sr.ValidationAttempts,
+			ValidAttempts:      sr.ValidAttempts,
+			AmountsByCurrency:  amountsByCoupon[sr.CouponCode],
+		})
+	}
+
+	stats.TopCoupons = topCoupons
+
+	return &stats, nil
+}
+
+// summaryAttemptQuery 构建汇总统计的验证记录查询
+func (r *couponRepo) summaryAttemptQuery(db *gorm.DB, q *biz.SummaryStatsQuery) *gorm.DB {
+	query := db.Model(&model.CouponValidationAttempt{})
+	if q.AppID != "" {
+		query = query.Where("app_id = ?", q.AppID)
+	}
+	if !q.From.IsZero() {
+		query = query.Where("attempted_at >= ?", q.From)
+	}
+	if !q.To.IsZero() {
+		query = query.Where("attempted_at <= ?", q.To)
+	}
+	return query
+}
+
+// summaryRankClause 前N个优惠券的排序子句（排序字段已在 biz 层校验，这里只做白名单映射）
+// 收入/折扣按最小货币单位跨币种累加排序，仅用于排名
+func summaryRankClause(rankBy string) string {
+	switch rankBy {
+	case constants.StatsRankByRevenue:
+		return "total_revenue DESC, c.coupon_code"
+	case constants.StatsRankByDiscount:
+		return "total_discount DESC, c.coupon_code"
+	case constants.StatsRankByDistinctUsers:
+		return "distinct_users DESC, c.coupon_code"
+	default:
+		return "total_uses DESC, c.coupon_code"
+	}
+}
+
+// sumAmountsByCurrency 按币种汇总收入和折扣金额
+func (r *couponRepo) sumAmountsByCurrency(src *usageAggSource) ([]*biz.CurrencyAmount, error) {
+	var amounts []*biz.CurrencyAmount
+	if err := src.newQuery().
+		Select(fmt.Sprintf("currency, COALESCE(%s, 0) as total_uses, COALESCE(%s, 0) as total_revenue, COALESCE(%s, 0) as total_discount", src.uses, src.revenue, src.discount)).
+		Group("currency").
+		Order("currency").
+		Scan(&amounts).Error; err != nil {
+		return nil, err
+	}
+	return amounts, nil
+}
+
+// totalAmounts 跨币种累加金额（仅用于兼容已废弃的 totalRevenue / totalDiscount 字段）
+func totalAmounts(amounts []*biz.CurrencyAmount) (revenue, discount int64) {
+	for _, a := range amounts {
+		revenue += a.TotalRevenue
+		discount += a.TotalDiscount
+	}
+	return revenue, discount
+}
@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/data/model"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"gorm.io/gorm"
+)
+
+const statDateLayout = "2006-01-02"
+
+// incrementDailyStats 在使用记录所在事务内把一次使用计入每日汇总
+// stat_date 取 used_at 在数据库连接时区的日期，与 RebuildDailyStats 的 DATE(used_at) 口径一致；订单数只在该订单首次使用此优惠券时计入
+func (r *couponRepo) incrementDailyStats(tx *gorm.DB, usage *model.CouponUsage) error {
+	var others int64
+	if err := tx.Model(&model.CouponUsage{}).
+		Where("coupon_code = ? AND payment_order_id = ? AND coupon_usage_id <> ?", usage.CouponCode, usage.PaymentOrderID, usage.CouponUsageID).
+		Count(&others).Error; err != nil {
+		return err
+	}
+	orders := 0
+	if others == 0 {
+		orders = 1
+	}
+
+	return tx.Exec(`INSERT INTO coupon_stats_daily (stat_date, app_id, coupon_code, currency, uses, orders, revenue, discount, updated_at)
+		VALUES (DATE(?), ?, ?, ?, 1, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			uses = uses + VALUES(uses),
+			orders = orders + VALUES(orders),
+			revenue = revenue + VALUES(revenue),
+			discount = discount + VALUES(discount),
+			updated_at = VALUES(updated_at)`,
+		statDate(usage.UsedAt, r.data.loc), usage.AppID, usage.CouponCode, usage.Currency,
+		orders, usage.FinalAmount, usage.DiscountAmount, time.Now(),
+	).Error
+}
+
+// statDate t 所在的统计日期：used_at 按数据库连接时区存取，DATE(used_at) 即该时区的自然日
+func statDate(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(statDateLayout)
+}
+
+// RebuildDailyStats 从原始使用记录重算每日汇总（先删除范围内的汇总行，再 INSERT ... SELECT，整体在一个事务内）
+// 范围按数据库连接时区的自然日对齐
+func (r *couponRepo) RebuildDailyStats(ctx context.Context, q *biz.RebuildStatsQuery) (int64, error) {
+	var (
+		deleteConds = []string{"app_id = ?"}
+		deleteArgs  = []interface{}{q.AppID}
+		usageConds  = []string{"cu.app_id = ?"}
+		usageArgs   = []interface{}{q.AppID}
+	)
+	if q.CouponCode != "" {
+		deleteConds = append(deleteConds, "coupon_code = ?")
+		deleteArgs = append(deleteArgs, q.CouponCode)
+		usageConds = append(usageConds, "cu.coupon_code = ?")
+		usageArgs = append(usageArgs, q.CouponCode)
+	}
+	if !q.From.IsZero() {
+		fromDate := statDate(q.From, r.data.loc)
+		deleteConds = append(deleteConds, "stat_date >= ?")
+		deleteArgs = append(deleteArgs, fromDate)
+		usageConds = append(usageConds, "cu.used_at >= ?")
+		usageArgs = append(usageArgs, fromDate)
+	}
+	if !q.To.IsZero() {
+		toDate := statDate(q.To, r.data.loc)
+		deleteConds = append(deleteConds, "stat_date <= ?")
+		deleteArgs = append(deleteArgs, toDate)
+		usageConds = append(usageConds, "cu.used_at < DATE_ADD(?, INTERVAL 1 DAY)")
+		usageArgs = append(usageArgs, toDate)
+	}
+
+	var rows int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(strings.Join(deleteConds, " AND "), deleteArgs...).
+			Delete(&model.CouponStatsDaily{}).Error; err != nil {
+			r.log.Errorf("failed to delete coupon daily stats: %v", err)
+			return err
+		}
+
+		// 订单数：同一优惠券 + 订单的最早一条使用记录计入
+		args := append([]interface{}{time.Now()}, usageArgs...)
+		result := tx.Exec(`INSERT INTO coupon_stats_daily (stat_date, app_id, coupon_code, currency, uses, orders, revenue, discount, updated_at)
+			SELECT DATE(cu.used_at), cu.app_id, cu.coupon_code, cu.currency,
+				COUNT(*),
+				SUM(CASE WHEN NOT EXISTS (
+					SELECT 1 FROM coupon_usage p
+					WHERE p.coupon_code = cu.coupon_code AND p.payment_order_id = cu.payment_order_id
+						AND (p.used_at < cu.used_at OR (p.used_at = cu.used_at AND p.coupon_usage_id < cu.coupon_usage_id))
+				) THEN 1 ELSE 0 END),
+				SUM(cu.final_amount),
+				SUM(cu.discount_amount),
+				?
+			FROM coupon_usage cu
+			WHERE `+strings.Join(usageConds, " AND ")+`
+			GROUP BY DATE(cu.used_at), cu.app_id, cu.coupon_code, cu.currency`, args...)
+		if result.Error != nil {
+			r.log.Errorf("failed to rebuild coupon daily stats: %v", result.Error)
+			return result.Error
+		}
+		rows = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return rows, nil
+}
+
+// dailyStatsWindow 判断统计窗口能否用每日汇总表表达（起点为 loc 零点、终点为 loc 23:59:59，或不限），
+// loc 为数据库连接时区（与 stat_date 的口径一致），返回 stat_date 的起止日期（空字符串表示不限）
+func dailyStatsWindow(from, to time.Time, loc *time.Location) (string, string, bool) {
+	var fromDate, toDate string
+	if !from.IsZero() {
+		if !isLocalMidnight(from, loc) {
+			return "", "", false
+		}
+		fromDate = statDate(from, loc)
+	}
+	if !to.IsZero() {
+		if !isLocalMidnight(to.Add(time.Second), loc) {
+			return "", "", false
+		}
+		toDate = statDate(to, loc)
+	}
+	return fromDate, toDate, true
+}
+
+// isLocalMidnight t 是否为 loc 时区的零点
+func isLocalMidnight(t time.Time, loc *time.Location) bool {
+	local := t.In(loc)
+	y, m, d := local.Date()
+	return local.Equal(time.Date(y, m, d, 0, 0, 0, 0, loc))
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStatDate(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skipf("time zone Asia/Shanghai is not available: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		usedAt time.Time
+		loc    *time.Location
+		want   string
+	}{
+		{name: "UTC 连接", usedAt: time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC), loc: time.UTC, want: "2026-03-01"},
+		{name: "东八区零点后（UTC 仍是前一天）", usedAt: time.Date(2026, 2, 28, 16, 30, 0, 0, time.UTC), loc: shanghai, want: "2026-03-01"},
+		{name: "东八区零点前", usedAt: time.Date(2026, 3, 1, 15, 59, 59, 0, time.UTC), loc: shanghai, want: "2026-03-01"},
+		{name: "东八区零点", usedAt: time.Date(2026, 3, 1, 16, 0, 0, 0, time.UTC), loc: shanghai, want: "2026-03-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statDate(tt.usedAt, tt.loc); got != tt.want {
+				t.Errorf("statDate(%v) = %s, want %s", tt.usedAt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDailyStatsWindow(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skipf("time zone Asia/Shanghai is not available: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		loc      *time.Location
+		wantFrom string
+		wantTo   string
+		wantOK   bool
+	}{
+		{name: "不限", loc: shanghai, wantOK: true},
+		{
+			name: "UTC 整天", loc: time.UTC,
+			from: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), to: time.Date(2026, 3, 7, 23, 59, 59, 0, time.UTC),
+			wantFrom: "2026-03-01", wantTo: "2026-03-07", wantOK: true,
+		},
+		{
+			name: "连接时区的整天（以 UTC 表示）", loc: shanghai,
+			from: time.Date(2026, 2, 28, 16, 0, 0, 0, time.UTC), to: time.Date(2026, 3, 7, 15, 59, 59, 0, time.UTC),
+			wantFrom: "2026-03-01", wantTo: "2026-03-07", wantOK: true,
+		},
+		{
+			name: "UTC 整天在东八区连接下不是整天", loc: shanghai,
+			from: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), to: time.Date(2026, 3, 7, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name: "只有终点不对齐", loc: shanghai,
+			from: time.Date(2026, 3, 1, 0, 0, 0, 0, shanghai), to: time.Date(2026, 3, 7, 12, 0, 0, 0, shanghai),
+		},
+		{
+			name: "单边窗口", loc: shanghai,
+			from:     time.Date(2026, 3, 1, 0, 0, 0, 0, shanghai),
+			wantFrom: "2026-03-01", wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, ok := dailyStatsWindow(tt.from, tt.to, tt.loc)
+			if ok != tt.wantOK || from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf("dailyStatsWindow = (%q, %q, %v), want (%q, %q, %v)", from, to, ok, tt.wantFrom, tt.wantTo, tt.wantOK)
+			}
+		})
+	}
+}
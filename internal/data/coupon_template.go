@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// couponTemplateRepo 实现 biz.CouponTemplateRepo 接口
+type couponTemplateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCouponTemplateRepo 创建优惠券模板 Repository
+func NewCouponTemplateRepo(data *Data, logger log.Logger) biz.CouponTemplateRepo {
+	return &couponTemplateRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/coupon_template")),
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *couponTemplateRepo) toBizModel(m *model.CouponTemplate) *biz.CouponTemplate {
+	return &biz.CouponTemplate{
+		TemplateID:    m.TemplateID,
+		AppID:         m.AppID,
+		Name:          m.Name,
+		Description:   m.Description,
+		DiscountType:  m.DiscountType,
+		DiscountValue: m.DiscountValue,
+		Currency:      m.Currency,
+		MaxUses:       m.MaxUses,
+		MinAmount:     m.MinAmount,
+		ValidDays:     m.ValidDays,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+// toDataModel 将业务模型转换为数据模型
+func (r *couponTemplateRepo) toDataModel(b *biz.CouponTemplate) *model.CouponTemplate {
+	return &model.CouponTemplate{
+		TemplateID:    b.TemplateID,
+		AppID:         b.AppID,
+		Name:          b.Name,
+		Description:   b.Description,
+		DiscountType:  b.DiscountType,
+		DiscountValue: b.DiscountValue,
+		Currency:      b.Currency,
+		MaxUses:       b.MaxUses,
+		MinAmount:     b.MinAmount,
+		ValidDays:     b.ValidDays,
+		CreatedAt:     b.CreatedAt,
+		UpdatedAt:     b.UpdatedAt,
+	}
+}
+
+// Save 创建优惠券模板
+func (r *couponTemplateRepo) Save(ctx context.Context, t *biz.CouponTemplate) error {
+	if err := r.data.db.WithContext(ctx).Create(r.toDataModel(t)).Error; err != nil {
+		r.log.Errorf("failed to create coupon template: %v", err)
+		if isDuplicateEntryError(err) {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponTemplateNameExists, "zh-CN")
+		}
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// Update 更新优惠券模板
+func (r *couponTemplateRepo) Update(ctx context.Context, t *biz.CouponTemplate) error {
+	m := r.toDataModel(t)
+	result := r.data.db.WithContext(ctx).Model(&model.CouponTemplate{}).
+		Where("template_id = ? AND app_id = ?", m.TemplateID, m.AppID).
+		Updates(map[string]interface{}{
+			"name":           m.Name,
+			"description":    m.Description,
+			"discount_type":  m.DiscountType,
+			"discount_value": m.DiscountValue,
+			"currency":       m.Currency,
+			"max_uses":       m.MaxUses,
+			"min_amount":     m.MinAmount,
+			"valid_days":     m.ValidDays,
+			"updated_at":     m.UpdatedAt,
+		})
+	if result.Error != nil {
+		r.log.Errorf("failed to update coupon template: %v", result.Error)
+		if isDuplicateEntryError(result.Error) {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponTemplateNameExists, "zh-CN")
+		}
+		return pkgErrors.WrapErrorWithLang(ctx, result.Error, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// FindByID 查找优惠券模板（限定在应用内）
+func (r *couponTemplateRepo) FindByID(ctx context.Context, appID, templateID string) (*biz.CouponTemplate, error) {
+	var m model.CouponTemplate
+	if err := r.data.db.WithContext(ctx).
+		Where("template_id = ? AND app_id = ?", templateID, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeCouponTemplateNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find coupon template: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// List 列出应用内的优惠券模板（按名称排序）
+func (r *couponTemplateRepo) List(ctx context.Context, appID string, page, pageSize int) ([]*biz.CouponTemplate, int64, error) {
+	var (
+		models []model.CouponTemplate
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.CouponTemplate{}).Where("app_id = ?", appID)
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count coupon templates: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("name ASC").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list coupon templates: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.CouponTemplate, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// Delete 删除优惠券模板（物理删除，删除后可重新使用同名模板）
+func (r *couponTemplateRepo) Delete(ctx context.Context, appID, templateID string) error {
+	result := r.data.db.WithContext(ctx).
+		Where("template_id = ? AND app_id = ?", templateID, appID).
+		Delete(&model.CouponTemplate{})
+	if result.Error != nil {
+		r.log.Errorf("failed to delete coupon template: %v", result.Error)
+		return pkgErrors.WrapErrorWithLang(ctx, result.Error, pkgErrors.ErrCodeInternalError)
+	}
+	if result.RowsAffected == 0 {
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponTemplateNotFound, "zh-CN")
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/data/model"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+const (
+	uniqueUsersDayLayout  = "20060102"
+	uniqueUsersDayTTL     = 400 * 24 * time.Hour // 应用按天去重 key 的保留时间（覆盖一年的查询窗口）
+	maxUniqueUsersHLLDays = 366                  // 按天合并 HyperLogLog 的最大天数，超过则回退到精确查询
+	uniqueUsersSeedBatch  = 1000                 // 回填时每批 PFADD 的用户数
+)
+
+// couponUniqueUsersKey 优惠券去重用户 HyperLogLog key（全部时间）
+func couponUniqueUsersKey(code string) string {
+	return fmt.Sprintf("marketing:hll:coupon_users:%s", code)
+}
+
+// appUniqueUsersKey 应用去重用户 HyperLogLog key（全部时间）
+func appUniqueUsersKey(appID string) string {
+	return fmt.Sprintf("marketing:hll:app_users:%s", appID)
+}
+
+// appDayUniqueUsersKey 应用按天去重用户 HyperLogLog key，day 为 uniqueUsersDayLayout 格式的日期
+func appDayUniqueUsersKey(appID, day string) string {
+	return fmt.Sprintf("marketing:hll:app_users:%s:%s", appID, day)
+}
+
+// uniqueUsersDay t 在 loc 时区（数据库连接时区，与每日汇总的 stat_date 口径一致）的日期
+func uniqueUsersDay(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(uniqueUsersDayLayout)
+}
+
+// couponUniqueUsersSeededKey 优惠券的 HyperLogLog 已从使用记录回填的标记
+func couponUniqueUsersSeededKey(code string) string {
+	return fmt.Sprintf("marketing:hll:seeded:coupon:%s", code)
+}
+
+// appUniqueUsersSeededKey 应用的 HyperLogLog 已从使用记录回填的标记
+func appUniqueUsersSeededKey(appID string) string {
+	return fmt.Sprintf("marketing:hll:seeded:app:%s", appID)
+}
+
+// addUniqueUser 使用优惠券后把用户加入去重 HyperLogLog（尽力而为，失败只记录日志）
+func (r *couponRepo) addUniqueUser(ctx context.Context, code, appID, userID string, usedAt time.Time) {
+	if r.data.rdb == nil || userID == "" {
+		return
+	}
+	dayKey := appDayUniqueUsersKey(appID, uniqueUsersDay(usedAt, r.data.loc))
+	pipe := r.data.rdb.Pipeline()
+	pipe.PFAdd(ctx, couponUniqueUsersKey(code), userID)
+	pipe.PFAdd(ctx, appUniqueUsersKey(appID), userID)
+	pipe.PFAdd(ctx, dayKey, userID)
+	pipe.Expire(ctx, dayKey, uniqueUsersDayTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Warnf("failed to add unique user to hyperloglog: coupon_code=%s, app_id=%s, err=%v", code, appID, err)
+	}
+}
+
+// CountUniqueUsers 统计去重用户数
+// 默认使用 Redis HyperLogLog 近似计数（误差约 0.81%），以下情况回退到 coupon_usage 上的 COUNT(DISTINCT user_id)：
+// 请求精确模式、窗口无法用 HyperLogLog 表达、HyperLogLog 尚未从使用记录回填（见 RebuildUniqueUsers）或缺少 key、Redis 不可用；
+// 返回值 exact 表示结果是否为精确值
+func (r *couponRepo) CountUniqueUsers(ctx context.Context, q *biz.UniqueUsersQuery) (int64, bool, error) {
+	if !q.Exact && r.data.rdb != nil {
+		if seededKey, keys, ok := uniqueUsersHLLKeys(q, r.data.loc); ok {
+			count, ok, err := r.countUniqueUsersHLL(ctx, seededKey, keys)
+			if err != nil {
+				r.log.Warnf("failed to count unique users from hyperloglog, falling back to exact count: %v", err)
+			} else if ok {
+				return count, false, nil
+			}
+		}
+	}
+
+	var count int64
+	query := r.applyUsageFilter(r.data.db.WithContext(ctx).Model(&model.CouponUsage{}), &biz.CouponUsageFilter{
+		AppID:      q.AppID,
+		CouponCode: q.CouponCode,
+		UsedFrom:   q.From,
+		UsedTo:     q.To,
+	})
+	if err := query.Distinct("user_id").Count(&count).Error; err != nil {
+		r.log.Errorf("failed to count unique users: %v", err)
+		return 0, true, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return count, true, nil
+}
+
+// countUniqueUsersHLL 合并 keys 计数，回填标记或任一 key 不存在时返回 ok=false（PFCOUNT 对不存在的 key 按 0 计）
+func (r *couponRepo) countUniqueUsersHLL(ctx context.Context, seededKey string, keys []string) (int64, bool, error) {
+	pipe := r.data.rdb.Pipeline()
+	seeded := pipe.Exists(ctx, seededKey)
+	existing := pipe.Exists(ctx, keys...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, false, err
+	}
+	if seeded.Val() == 0 || existing.Val() < int64(len(keys)) {
+		return 0, false, nil
+	}
+	count, err := r.data.rdb.PFCount(ctx, keys...).Result()
+	if err != nil {
+		return 0, false, err
+	}
+	return count, true, nil
+}
+
+// uniqueUsersHLLKeys 计算查询对应的回填标记和 HyperLogLog key 列表，ok=false 表示需要回退到精确查询
+// 优惠券只维护全部时间的 key；应用按 loc 时区的自然日合并，窗口须为整天（00:00:00 起、23:59:59 止），与每日汇总的口径一致
+func uniqueUsersHLLKeys(q *biz.UniqueUsersQuery, loc *time.Location) (string, []string, bool) {
+	hasWindow := !q.From.IsZero() || !q.To.IsZero()
+	if q.CouponCode != "" {
+		if hasWindow {
+			return "", nil, false
+		}
+		return couponUniqueUsersSeededKey(q.CouponCode), []string{couponUniqueUsersKey(q.CouponCode)}, true
+	}
+	if q.AppID == "" {
+		return "", nil, false
+	}
+	seededKey := appUniqueUsersSeededKey(q.AppID)
+	if !hasWindow {
+		return seededKey, []string{appUniqueUsersKey(q.AppID)}, true
+	}
+	if q.From.IsZero() || q.To.IsZero() || !isLocalMidnight(q.From, loc) || !isLocalMidnight(q.To.Add(time.Second), loc) {
+		return "", nil, false
+	}
+
+	y, m, d := q.From.In(loc).Date()
+	keys := make([]string, 0)
+	for i := 0; ; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, loc)
+		if day.After(q.To) {
+			break
+		}
+		if len(keys) >= maxUniqueUsersHLLDays {
+			return "", nil, false
+		}
+		keys = append(keys, appDayUniqueUsersKey(q.AppID, uniqueUsersDay(day, loc)))
+	}
+	return seededKey, keys, true
+}
+
+// RebuildUniqueUsers 从使用记录回填去重用户 HyperLogLog 并写入回填标记，之后默认模式才读取 HyperLogLog
+// 指定优惠券时只回填该优惠券的 key；否则回填应用内所有优惠券、应用全部时间以及保留期内每天的 key（与 From/To 无关，
+// HyperLogLog 不能按范围扣减）。先删除标记和旧 key 再读取使用记录：删除前已提交的使用记录都会被读到，
+// 之后提交的使用记录由 addUniqueUser 自行加入，回填期间的查询因缺少标记回退到精确查询
+func (r *couponRepo) RebuildUniqueUsers(ctx context.Context, q *biz.RebuildStatsQuery) error {
+	if r.data.rdb == nil {
+		return nil
+	}
+	if err := r.seedUniqueUsers(ctx, q); err != nil {
+		r.log.Errorf("failed to rebuild unique users hyperloglog: app_id=%s, coupon_code=%s, err=%v", q.AppID, q.CouponCode, err)
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+func (r *couponRepo) seedUniqueUsers(ctx context.Context, q *biz.RebuildStatsQuery) error {
+	db := r.data.db.WithContext(ctx)
+	// 只回填属于该应用的优惠券
+	var codes []string
+	couponQuery := db.Model(&model.Coupon{}).Where("app_id = ?", q.AppID)
+	if q.CouponCode != "" {
+		couponQuery = couponQuery.Where("coupon_code = ?", q.CouponCode)
+	}
+	if err := couponQuery.Pluck("coupon_code", &codes).Error; err != nil {
+		return err
+	}
+	if q.CouponCode != "" && len(codes) == 0 {
+		return nil
+	}
+
+	// 应用保留期内每天的 key（含今天），保留期外的使用记录不回填
+	var days []time.Time
+	if q.CouponCode == "" {
+		y, m, d := time.Now().In(r.data.loc).Date()
+		for i := int(uniqueUsersDayTTL/(24*time.Hour)) - 1; i >= 0; i-- {
+			days = append(days, time.Date(y, m, d-i, 0, 0, 0, 0, r.data.loc))
+		}
+	}
+	dayKeys := make(map[string]string, len(days))
+	for _, day := range days {
+		dayKeys[uniqueUsersDay(day, r.data.loc)] = appDayUniqueUsersKey(q.AppID, uniqueUsersDay(day, r.data.loc))
+	}
+
+	// 1. 删除标记和旧 key
+	var stale []string
+	for _, code := range codes {
+		stale = append(stale, couponUniqueUsersSeededKey(code), couponUniqueUsersKey(code))
+	}
+	if q.CouponCode == "" {
+		stale = append(stale, appUniqueUsersSeededKey(q.AppID), appUniqueUsersKey(q.AppID))
+		for _, key := range dayKeys {
+			stale = append(stale, key)
+		}
+	}
+	if err := r.data.rdb.Del(ctx, stale...).Err(); err != nil {
+		return err
+	}
+
+	// 2. 按优惠券 + 用户 + 日期去重读取使用记录，分批加入对应的 key
+	query := db.Model(&model.CouponUsage{}).
+		Select("DISTINCT coupon_code, user_id, DATE_FORMAT(used_at, '%Y%m%d') AS day").
+		Where("app_id = ? AND user_id <> ''", q.AppID)
+	if q.CouponCode != "" {
+		query = query.Where("coupon_code = ?", q.CouponCode)
+	}
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	pending := make(map[string][]interface{})
+	var size int
+	flush := func() error {
+		if size == 0 {
+			return nil
+		}
+		pipe := r.data.rdb.Pipeline()
+		for key, users := range pending {
+			pipe.PFAdd(ctx, key, users...)
+		}
+		pending, size = make(map[string][]interface{}), 0
+		_, err := pipe.Exec(ctx)
+		return err
+	}
+	for rows.Next() {
+		var row struct {
+			CouponCode string
+			UserID     string
+			Day        string
+		}
+		if err := db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		pending[couponUniqueUsersKey(row.CouponCode)] = append(pending[couponUniqueUsersKey(row.CouponCode)], row.UserID)
+		size++
+		if q.CouponCode == "" {
+			pending[appUniqueUsersKey(q.AppID)] = append(pending[appUniqueUsersKey(q.AppID)], row.UserID)
+			if key, ok := dayKeys[row.Day]; ok {
+				pending[key] = append(pending[key], row.UserID)
+			}
+		}
+		if size >= uniqueUsersSeedBatch {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	// 3. 没有使用记录的 key 也创建为空 HyperLogLog（查询时缺少 key 会回退到精确查询），最后写入回填标记
+	pipe := r.data.rdb.Pipeline()
+	for _, code := range codes {
+		pipe.PFAdd(ctx, couponUniqueUsersKey(code))
+		pipe.Set(ctx, couponUniqueUsersSeededKey(code), 1, 0)
+	}
+	if q.CouponCode == "" {
+		pipe.PFAdd(ctx, appUniqueUsersKey(q.AppID))
+		for _, day := range days {
+			key := dayKeys[uniqueUsersDay(day, r.data.loc)]
+			pipe.PFAdd(ctx, key)
+			pipe.ExpireAt(ctx, key, day.Add(uniqueUsersDayTTL))
+		}
+		pipe.Set(ctx, appUniqueUsersSeededKey(q.AppID), 1, 0)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"marketing-service/internal/biz"
+)
+
+func TestUniqueUsersHLLKeys(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skipf("time zone Asia/Shanghai is not available: %v", err)
+	}
+	day := func(d int) time.Time {
+		return time.Date(2026, 3, d, 0, 0, 0, 0, shanghai)
+	}
+
+	tests := []struct {
+		name       string
+		q          *biz.UniqueUsersQuery
+		wantSeeded string
+		wantKeys   []string
+		wantOK     bool
+	}{
+		{
+			name:       "优惠券全部时间",
+			q:          &biz.UniqueUsersQuery{CouponCode: "SAVE10"},
+			wantSeeded: "marketing:hll:seeded:coupon:SAVE10",
+			wantKeys:   []string{"marketing:hll:coupon_users:SAVE10"},
+			wantOK:     true,
+		},
+		{
+			name: "优惠券带时间窗口",
+			q:    &biz.UniqueUsersQuery{CouponCode: "SAVE10", From: day(1), To: day(2).Add(-time.Second)},
+		},
+		{
+			name:       "应用全部时间",
+			q:          &biz.UniqueUsersQuery{AppID: "app"},
+			wantSeeded: "marketing:hll:seeded:app:app",
+			wantKeys:   []string{"marketing:hll:app_users:app"},
+			wantOK:     true,
+		},
+		{
+			name:       "应用按连接时区的整天合并",
+			q:          &biz.UniqueUsersQuery{AppID: "app", From: day(1).UTC(), To: day(3).Add(-time.Second).UTC()},
+			wantSeeded: "marketing:hll:seeded:app:app",
+			wantKeys:   []string{"marketing:hll:app_users:app:20260301", "marketing:hll:app_users:app:20260302"},
+			wantOK:     true,
+		},
+		{
+			name: "窗口起点不是整天",
+			q:    &biz.UniqueUsersQuery{AppID: "app", From: day(1).Add(time.Hour), To: day(3).Add(-time.Second)},
+		},
+		{
+			name: "UTC 整天在东八区连接下不是整天",
+			q:    &biz.UniqueUsersQuery{AppID: "app", From: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 3, 2, 23, 59, 59, 0, time.UTC)},
+		},
+		{
+			name: "单边窗口",
+			q:    &biz.UniqueUsersQuery{AppID: "app", From: day(1)},
+		},
+		{
+			name: "窗口超过 366 天",
+			q:    &biz.UniqueUsersQuery{AppID: "app", From: day(1), To: day(1).AddDate(1, 0, 2).Add(-time.Second)},
+		},
+		{
+			name: "没有应用和优惠券",
+			q:    &biz.UniqueUsersQuery{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seeded, keys, ok := uniqueUsersHLLKeys(tt.q, shanghai)
+			if ok != tt.wantOK || seeded != tt.wantSeeded {
+				t.Fatalf("uniqueUsersHLLKeys = (%q, %v, %v), want (%q, %v, %v)", seeded, keys, ok, tt.wantSeeded, tt.wantKeys, tt.wantOK)
+			}
+			if len(keys) != len(tt.wantKeys) {
+				t.Fatalf("keys = %v, want %v", keys, tt.wantKeys)
+			}
+			for i := range keys {
+				if keys[i] != tt.wantKeys[i] {
+					t.Errorf("keys[%d] = %s, want %s", i, keys[i], tt.wantKeys[i])
+				}
+			}
+		})
+	}
+}
+
+func TestUniqueUsersDay(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skipf("time zone Asia/Shanghai is not available: %v", err)
+	}
+	// 东八区零点后半小时，UTC 仍是前一天
+	usedAt := time.Date(2026, 2, 28, 16, 30, 0, 0, time.UTC)
+	if got := uniqueUsersDay(usedAt, shanghai); got != "20260301" {
+		t.Errorf("uniqueUsersDay = %s, want 20260301", got)
+	}
+	if got := uniqueUsersDay(usedAt, time.UTC); got != "20260228" {
+		t.Errorf("uniqueUsersDay = %s, want 20260228", got)
+	}
+}
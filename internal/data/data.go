@@ -21,6 +21,19 @@ var ProviderSet = wire.NewSet(
 	NewDB,
 	NewRedis,
 	NewCouponRepo,
+	NewValidationAttemptRepo,
+	NewExportJobRepo,
+	NewBlobStore,
+	NewCouponTemplateRepo,
+	NewUserCouponRepo,
+	NewAudienceRepo,
+	NewCampaignRepo,
+	NewUserHistoryProvider,
+	NewBudgetNotifier,
+	NewRedeemCodeRepo,
+	NewRewardGranter,
+	NewRewardRepo,
+	NewTaskRepo,
 	// 移除 RocketMQ、Cache、Notification 等复杂依赖
 	// 如需缓存，可在 CouponRepo 中直接使用 Redis
 )
@@ -29,6 +42,7 @@ var ProviderSet = wire.NewSet(
 type Data struct {
 	db  *gorm.DB
 	rdb *redis.Client
+	loc *time.Location // 数据库连接时区（DSN 的 loc 参数）：DATETIME 列按该时区的本地时间存取
 	log *log.Helper
 }
 
@@ -49,10 +63,19 @@ func NewData(db *gorm.DB, rdb *redis.Client, logger log.Logger) (*Data, func(),
 	return &Data{
 		db:  db,
 		rdb: rdb,
+		loc: dbLocation(db),
 		log: l,
 	}, cleanup, nil
 }
 
+// dbLocation 返回数据库连接时区，DSN 未设置 loc 时驱动按 UTC 处理
+func dbLocation(db *gorm.DB) *time.Location {
+	if d, ok := db.Dialector.(*mysql.Dialector); ok && d.DSNConfig != nil && d.DSNConfig.Loc != nil {
+		return d.DSNConfig.Loc
+	}
+	return time.UTC
+}
+
 // NewDB .
 func NewDB(c *conf.Data, logger log.Logger) *gorm.DB {
 	l := log.NewHelper(log.With(logger, "module", "data/db"))
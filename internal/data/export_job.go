@@ -0,0 +1,125 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// exportJobRepo 实现 biz.ExportJobRepo 接口
+type exportJobRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewExportJobRepo 创建导出任务 Repository
+func NewExportJobRepo(data *Data, logger log.Logger) biz.ExportJobRepo {
+	return &exportJobRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/export_job")),
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *exportJobRepo) toBizModel(m *model.ExportJob) *biz.ExportJob {
+	job := &biz.ExportJob{
+		JobID:     m.JobID,
+		AppID:     m.AppID,
+		Resource:  m.Resource,
+		Format:    m.Format,
+		Language:  m.Language,
+		Params:    m.Params,
+		Status:    m.Status,
+		FileKey:   m.FileKey,
+		FileName:  m.FileName,
+		Rows:      m.Rows,
+		Error:     m.Error,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.StartedAt != nil {
+		job.StartedAt = *m.StartedAt
+	}
+	if m.FinishedAt != nil {
+		job.FinishedAt = *m.FinishedAt
+	}
+	return job
+}
+
+// toDataModel 将业务模型转换为数据模型
+func (r *exportJobRepo) toDataModel(b *biz.ExportJob) *model.ExportJob {
+	m := &model.ExportJob{
+		JobID:     b.JobID,
+		AppID:     b.AppID,
+		Resource:  b.Resource,
+		Format:    b.Format,
+		Language:  b.Language,
+		Params:    b.Params,
+		Status:    b.Status,
+		FileKey:   b.FileKey,
+		FileName:  b.FileName,
+		Rows:      b.Rows,
+		Error:     b.Error,
+		CreatedAt: b.CreatedAt,
+	}
+	if !b.StartedAt.IsZero() {
+		m.StartedAt = timePtr(b.StartedAt)
+	}
+	if !b.FinishedAt.IsZero() {
+		m.FinishedAt = timePtr(b.FinishedAt)
+	}
+	return m
+}
+
+// Save 创建导出任务
+func (r *exportJobRepo) Save(ctx context.Context, job *biz.ExportJob) error {
+	if err := r.data.db.WithContext(ctx).Create(r.toDataModel(job)).Error; err != nil {
+		r.log.Errorf("failed to create export job: %v", err)
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// Update 更新导出任务状态
+func (r *exportJobRepo) Update(ctx context.Context, job *biz.ExportJob) error {
+	m := r.toDataModel(job)
+	if err := r.data.db.WithContext(ctx).Model(&model.ExportJob{}).
+		Where("job_id = ?", job.JobID).
+		Updates(map[string]interface{}{
+			"status":      m.Status,
+			"rows":        m.Rows,
+			"error":       m.Error,
+			"started_at":  m.StartedAt,
+			"finished_at": m.FinishedAt,
+		}).Error; err != nil {
+		r.log.Errorf("failed to update export job: %v", err)
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// FindByID 查找导出任务（限定在应用内）
+func (r *exportJobRepo) FindByID(ctx context.Context, appID, jobID string) (*biz.ExportJob, error) {
+	var m model.ExportJob
+	if err := r.data.db.WithContext(ctx).
+		Where("job_id = ? AND app_id = ?", jobID, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeExportJobNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find export job: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// timePtr 返回时间指针（用于可空的时间列）
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -8,21 +8,38 @@ import (
 
 // Coupon 优惠券表
 type Coupon struct {
-	CouponID      int64          `gorm:"column:coupon_id;primaryKey;autoIncrement;comment:优惠券ID（自增主键）"`
-	CouponCode    string         `gorm:"column:coupon_code;primaryKey;type:varchar(50);comment:优惠码（唯一标识）"`
-	AppID         string         `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id;comment:应用ID"`
-	DiscountType  string         `gorm:"column:discount_type;type:varchar(16);not null;comment:折扣类型: percent(百分比)/fixed(固定金额)"`
-	DiscountValue int64          `gorm:"column:discount_value;type:bigint(20);not null;comment:折扣值(百分比或分)"`
-	Currency      string         `gorm:"column:currency;type:enum('CNY','USD','EUR');not null;default:'CNY';comment:货币单位: CNY(人民币)/USD(美元)/EUR(欧元)，仅固定金额类型需要"`
-	ValidFrom     time.Time      `gorm:"column:valid_from;type:datetime;not null;index:idx_valid_time;comment:生效时间"`
-	ValidUntil    time.Time      `gorm:"column:valid_until;type:datetime;not null;index:idx_valid_time;comment:过期时间"`
-	MaxUses       int32          `gorm:"column:max_uses;type:int(11);not null;default:1;comment:最大使用次数"`
-	UsedCount     int32          `gorm:"column:used_count;type:int(11);not null;default:0;comment:已使用次数"`
-	MinAmount     int64          `gorm:"column:min_amount;type:bigint(20);not null;default:0;comment:最低消费金额(分)"`
-	Status        string         `gorm:"column:status;type:enum('active','inactive','expired');not null;default:'active';index:idx_status;comment:优惠券状态: active(激活-可使用)/inactive(禁用-不可使用)/expired(已过期-系统自动标记)"`
-	CreatedAt     time.Time      `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
-	UpdatedAt     time.Time      `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
-	DeletedAt     gorm.DeletedAt `gorm:"column:deleted_at;type:datetime;index:idx_deleted_at;comment:删除时间（软删除）"`
+	CouponID         int64          `gorm:"column:coupon_id;primaryKey;autoIncrement;comment:优惠券ID（自增主键）"`
+	CouponCode       string         `gorm:"column:coupon_code;primaryKey;type:varchar(50);comment:优惠码（唯一标识）"`
+	AppID            string         `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id;index:idx_app_id_status;index:idx_app_id_created_at;index:idx_app_id_valid_until;index:idx_app_id_used_count;comment:应用ID"`
+	DiscountType     string         `gorm:"column:discount_type;type:varchar(16);not null;comment:折扣类型: percent(百分比)/fixed(固定金额)"`
+	DiscountValue    int64          `gorm:"column:discount_value;type:bigint(20);not null;comment:折扣值(百分比或分)"`
+	Currency         string         `gorm:"column:currency;type:enum('CNY','USD','EUR');not null;default:'CNY';comment:货币单位: CNY(人民币)/USD(美元)/EUR(欧元)，仅固定金额类型需要"`
+	ValidFrom        time.Time      `gorm:"column:valid_from;type:datetime;not null;index:idx_valid_time;comment:生效时间"`
+	ValidUntil       time.Time      `gorm:"column:valid_until;type:datetime;not null;index:idx_valid_time;index:idx_app_id_valid_until;comment:过期时间"`
+	MaxUses          int32          `gorm:"column:max_uses;type:int(11);not null;default:1;comment:最大使用次数"`
+	UsedCount        int32          `gorm:"column:used_count;type:int(11);not null;default:0;index:idx_app_id_used_count;comment:已使用次数"`
+	MinAmount        int64          `gorm:"column:min_amount;type:bigint(20);not null;default:0;comment:最低消费金额(分)"`
+	ValidDays        int32          `gorm:"column:valid_days;type:int(11);not null;default:0;comment:相对有效期天数（>0 时按发放给用户的时间起算）"`
+	ClaimLimit       int32          `gorm:"column:claim_limit;type:int(11);not null;default:0;comment:每个用户可领取次数（0表示不限）"`
+	ClaimStock       int32          `gorm:"column:claim_stock;type:int(11);not null;default:0;comment:可领取总量（0表示不限）"`
+	ClaimedCount     int32          `gorm:"column:claimed_count;type:int(11);not null;default:0;comment:已领取/发放数量"`
+	BoundUserID      string         `gorm:"column:bound_user_id;type:varchar(36);not null;default:'';comment:绑定用户ID（为空表示不限用户）"`
+	AudienceID       string         `gorm:"column:audience_id;type:varchar(32);not null;default:'';index:idx_audience_id;comment:受众ID（为空表示不限受众）"`
+	Eligibility      string         `gorm:"column:eligibility;type:varchar(16);not null;default:'';comment:新客限制: FIRST_COUPON_USE(首次使用优惠券)/FIRST_ORDER(首单)，为空表示不限"`
+	Timezone         string         `gorm:"column:timezone;type:varchar(64);not null;default:'';comment:IANA 时区，用于求值可用时段（为空表示 UTC）"`
+	Schedule         string         `gorm:"column:schedule;type:text;comment:周期性可用时段和不可用日期（JSON，为空表示不限）"`
+	UserDailyLimit   int32          `gorm:"column:user_daily_limit;type:int(11);not null;default:0;comment:每个用户每天可使用次数（0表示不限）"`
+	UserWeeklyLimit  int32          `gorm:"column:user_weekly_limit;type:int(11);not null;default:0;comment:每个用户每周可使用次数（0表示不限）"`
+	UserMonthlyLimit int32          `gorm:"column:user_monthly_limit;type:int(11);not null;default:0;comment:每个用户每月可使用次数（0表示不限）"`
+	DailyLimit       int32          `gorm:"column:daily_limit;type:int(11);not null;default:0;comment:所有用户每天合计可使用次数（0表示不限）"`
+	CampaignID       string         `gorm:"column:campaign_id;type:varchar(32);not null;default:'';index:idx_campaign_id;comment:所属活动ID（为空表示不属于任何活动）"`
+	Budget           int64          `gorm:"column:budget;type:bigint(20);not null;default:0;comment:折扣预算(分)：折扣金额合计上限（0表示不限），用尽后自动停用"`
+	BudgetUsed       int64          `gorm:"column:budget_used;type:bigint(20);not null;default:0;comment:已使用的折扣金额(分)"`
+	Pacing           string         `gorm:"column:pacing;type:text;comment:投放节奏：把最大使用次数和折扣预算分摊到生效期内（JSON，为空表示不限）"`
+	Status           string         `gorm:"column:status;type:enum('active','inactive','expired');not null;default:'active';index:idx_status;index:idx_app_id_status;comment:优惠券状态: active(激活-可使用)/inactive(禁用-不可使用)/expired(已过期-系统自动标记)"`
+	CreatedAt        time.Time      `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_app_id_created_at;comment:创建时间"`
+	UpdatedAt        time.Time      `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;type:datetime;index:idx_deleted_at;comment:删除时间（软删除）"`
 }
 
 // TableName 指定表名
@@ -33,15 +50,16 @@ func (Coupon) TableName() string {
 // CouponUsage 优惠券使用记录表
 type CouponUsage struct {
 	CouponUsageID  string    `gorm:"column:coupon_usage_id;primaryKey;type:varchar(32);comment:使用记录ID（唯一标识）"`
-	CouponCode     string    `gorm:"column:coupon_code;type:varchar(50);not null;index:idx_coupon_code;comment:优惠券码"`
+	CouponCode     string    `gorm:"column:coupon_code;type:varchar(50);not null;index:idx_coupon_code;index:idx_coupon_code_payment_order_id;index:idx_coupon_code_used_at;index:idx_coupon_code_user_id_used_at;comment:优惠券码"`
 	AppID          string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id;index:idx_app_id_used_at;comment:应用ID"`
-	UserID         string    `gorm:"column:user_id;type:varchar(36);not null;index:idx_user_id;comment:用户ID"`
-	PaymentOrderID string    `gorm:"column:payment_order_id;type:varchar(64);not null;index:idx_payment_order_id;comment:支付订单ID（payment-service的业务订单号orderId）"`
+	UserID         string    `gorm:"column:user_id;type:varchar(36);not null;index:idx_user_id;index:idx_coupon_code_user_id_used_at;comment:用户ID"`
+	PaymentOrderID string    `gorm:"column:payment_order_id;type:varchar(64);not null;index:idx_payment_order_id;index:idx_coupon_code_payment_order_id;comment:支付订单ID（payment-service的业务订单号orderId）"`
 	PaymentID      string    `gorm:"column:payment_id;type:varchar(64);not null;index:idx_payment_id;comment:支付ID"`
 	OriginalAmount int64     `gorm:"column:original_amount;type:bigint(20);not null;comment:原价(分)"`
 	DiscountAmount int64     `gorm:"column:discount_amount;type:bigint(20);not null;comment:折扣金额(分)"`
 	FinalAmount    int64     `gorm:"column:final_amount;type:bigint(20);not null;comment:实付金额(分)"`
-	UsedAt         time.Time `gorm:"column:used_at;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_used_at;index:idx_app_id_used_at;comment:使用时间"`
+	Currency       string    `gorm:"column:currency;type:enum('CNY','USD','EUR');not null;default:'CNY';comment:货币单位（使用时的快照）: CNY(人民币)/USD(美元)/EUR(欧元)"`
+	UsedAt         time.Time `gorm:"column:used_at;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_used_at;index:idx_app_id_used_at;index:idx_coupon_code_used_at;index:idx_coupon_code_user_id_used_at;comment:使用时间"`
 	CreatedAt      time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
 }
 
@@ -49,3 +67,331 @@ type CouponUsage struct {
 func (CouponUsage) TableName() string {
 	return "coupon_usage"
 }
+
+// CouponValidationAttempt 优惠券验证尝试记录表（轻量事件，用于转化漏斗统计）
+type CouponValidationAttempt struct {
+	AttemptID   string    `gorm:"column:attempt_id;primaryKey;type:varchar(32);comment:验证记录ID（唯一标识）"`
+	CouponCode  string    `gorm:"column:coupon_code;type:varchar(50);not null;index:idx_coupon_code_attempted_at;comment:优惠券码"`
+	AppID       string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_attempted_at;comment:应用ID"`
+	UserID      string    `gorm:"column:user_id;type:varchar(36);not null;default:'';comment:用户ID（调用方未传时为空）"`
+	Amount      int64     `gorm:"column:amount;type:bigint(20);not null;comment:订单金额(分)"`
+	Reason      string    `gorm:"column:reason;type:varchar(32);not null;comment:验证结果原因: OK/NOT_FOUND/APP_MISMATCH/USER_MISMATCH/INACTIVE/NOT_STARTED/EXPIRED/EXHAUSTED/BELOW_MIN_AMOUNT/NOT_ISSUED/NOT_IN_AUDIENCE/NOT_NEW_CUSTOMER/OUTSIDE_SCHEDULE/LIMIT_EXCEEDED/CAMPAIGN_INACTIVE/BUDGET_EXHAUSTED/PACE_LIMITED"`
+	AttemptedAt time.Time `gorm:"column:attempted_at;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_coupon_code_attempted_at;index:idx_app_id_attempted_at;comment:验证时间"`
+}
+
+// TableName 指定表名
+func (CouponValidationAttempt) TableName() string {
+	return "coupon_validation_attempt"
+}
+
+// CouponStatsDaily 优惠券每日统计汇总表（使用优惠券时在同一事务内增量更新，可通过 RebuildCouponStats 从使用记录重算）
+type CouponStatsDaily struct {
+	CouponCode string    `gorm:"column:coupon_code;primaryKey;type:varchar(50);comment:优惠券码"`
+	AppID      string    `gorm:"column:app_id;primaryKey;type:varchar(64);index:idx_app_id_stat_date;comment:应用ID"`
+	StatDate   time.Time `gorm:"column:stat_date;primaryKey;type:date;index:idx_app_id_stat_date;comment:统计日期（DATE(used_at)，数据库连接时区）"`
+	Currency   string    `gorm:"column:currency;primaryKey;type:enum('CNY','USD','EUR');comment:货币单位（使用记录的币种快照）"`
+	Uses       int32     `gorm:"column:uses;type:int(11);not null;default:0;comment:使用次数"`
+	Orders     int32     `gorm:"column:orders;type:int(11);not null;default:0;comment:订单数（订单首次使用该优惠券时计入）"`
+	Revenue    int64     `gorm:"column:revenue;type:bigint(20);not null;default:0;comment:实付金额合计(分)"`
+	Discount   int64     `gorm:"column:discount;type:bigint(20);not null;default:0;comment:折扣金额合计(分)"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (CouponStatsDaily) TableName() string {
+	return "coupon_stats_daily"
+}
+
+// ExportJob 后台导出任务表
+type ExportJob struct {
+	JobID      string     `gorm:"column:job_id;primaryKey;type:varchar(32);comment:导出任务ID（唯一标识）"`
+	AppID      string     `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_created_at;comment:应用ID"`
+	Resource   string     `gorm:"column:resource;type:varchar(32);not null;comment:导出数据类型: coupons/coupon_usages"`
+	Format     string     `gorm:"column:format;type:varchar(8);not null;comment:导出格式: csv/xlsx"`
+	Language   string     `gorm:"column:language;type:varchar(16);not null;default:'';comment:列标题语言"`
+	Params     string     `gorm:"column:params;type:text;comment:筛选条件(JSON)"`
+	Status     string     `gorm:"column:status;type:varchar(16);not null;comment:任务状态: pending/running/succeeded/failed"`
+	FileKey    string     `gorm:"column:file_key;type:varchar(255);not null;default:'';comment:文件存储key"`
+	FileName   string     `gorm:"column:file_name;type:varchar(255);not null;default:'';comment:下载文件名"`
+	Rows       int64      `gorm:"column:rows;type:bigint(20);not null;default:0;comment:导出行数"`
+	Error      string     `gorm:"column:error;type:varchar(1024);not null;default:'';comment:失败原因"`
+	CreatedAt  time.Time  `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_app_id_created_at;comment:创建时间"`
+	StartedAt  *time.Time `gorm:"column:started_at;type:datetime;comment:开始执行时间"`
+	FinishedAt *time.Time `gorm:"column:finished_at;type:datetime;comment:完成时间"`
+}
+
+// TableName 指定表名
+func (ExportJob) TableName() string {
+	return "export_job"
+}
+
+// CouponAuditLog 优惠券审计日志表（批量操作每个受影响的优惠券记录一条）
+type CouponAuditLog struct {
+	AuditID    int64     `gorm:"column:audit_id;primaryKey;autoIncrement;comment:审计日志ID（自增主键）"`
+	AppID      string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_coupon_code;comment:应用ID"`
+	CouponCode string    `gorm:"column:coupon_code;type:varchar(50);not null;index:idx_app_id_coupon_code;comment:优惠码"`
+	Action     string    `gorm:"column:action;type:varchar(32);not null;comment:操作: update_status/delete"`
+	OldStatus  string    `gorm:"column:old_status;type:varchar(16);not null;default:'';comment:操作前状态"`
+	NewStatus  string    `gorm:"column:new_status;type:varchar(16);not null;default:'';comment:操作后状态（删除时为空）"`
+	BatchID    string    `gorm:"column:batch_id;type:varchar(32);not null;index:idx_batch_id;comment:批量操作ID"`
+	Operator   string    `gorm:"column:operator;type:varchar(64);not null;default:'';comment:操作人"`
+	Reason     string    `gorm:"column:reason;type:varchar(255);not null;default:'';comment:操作原因"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+}
+
+// TableName 指定表名
+func (CouponAuditLog) TableName() string {
+	return "coupon_audit_log"
+}
+
+// CouponTemplate 优惠券模板表（按模板批量创建相同规则的优惠券）
+type CouponTemplate struct {
+	TemplateID    string    `gorm:"column:template_id;primaryKey;type:varchar(32);comment:模板ID（唯一标识）"`
+	AppID         string    `gorm:"column:app_id;type:varchar(64);not null;uniqueIndex:uk_app_id_name;comment:应用ID"`
+	Name          string    `gorm:"column:name;type:varchar(64);not null;uniqueIndex:uk_app_id_name;comment:模板名称（应用内唯一）"`
+	Description   string    `gorm:"column:description;type:varchar(255);not null;default:'';comment:模板描述"`
+	DiscountType  string    `gorm:"column:discount_type;type:varchar(16);not null;comment:折扣类型: percent(百分比)/fixed(固定金额)"`
+	DiscountValue int64     `gorm:"column:discount_value;type:bigint(20);not null;comment:折扣值(百分比或分)"`
+	Currency      string    `gorm:"column:currency;type:enum('CNY','USD','EUR');not null;default:'CNY';comment:货币单位"`
+	MaxUses       int32     `gorm:"column:max_uses;type:int(11);not null;default:1;comment:最大使用次数"`
+	MinAmount     int64     `gorm:"column:min_amount;type:bigint(20);not null;default:0;comment:最低消费金额(分)"`
+	ValidDays     int32     `gorm:"column:valid_days;type:int(11);not null;comment:有效天数（从优惠券生效时间起算）"`
+	CreatedAt     time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (CouponTemplate) TableName() string {
+	return "coupon_template"
+}
+
+// UserCoupon 用户优惠券表（发放给用户的优惠券实例，有效期独立计算）
+type UserCoupon struct {
+	UserCouponID   string     `gorm:"column:user_coupon_id;primaryKey;type:varchar(32);comment:用户优惠券ID（唯一标识）"`
+	AppID          string     `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_user_id_status;comment:应用ID"`
+	CouponCode     string     `gorm:"column:coupon_code;type:varchar(50);not null;index:idx_coupon_code_user_id;comment:优惠码"`
+	UserID         string     `gorm:"column:user_id;type:varchar(36);not null;index:idx_app_id_user_id_status;index:idx_coupon_code_user_id;comment:用户ID"`
+	Status         string     `gorm:"column:status;type:enum('available','used');not null;default:'available';index:idx_app_id_user_id_status;comment:状态: available(可用)/used(已使用)，过期由有效期判断"`
+	ValidFrom      time.Time  `gorm:"column:valid_from;type:datetime;not null;comment:生效时间"`
+	ValidUntil     time.Time  `gorm:"column:valid_until;type:datetime;not null;comment:过期时间"`
+	IssuedAt       time.Time  `gorm:"column:issued_at;type:datetime;not null;comment:发放时间"`
+	UsedAt         *time.Time `gorm:"column:used_at;type:datetime;comment:使用时间"`
+	PaymentOrderID string     `gorm:"column:payment_order_id;type:varchar(64);not null;default:'';comment:使用时的支付订单ID"`
+}
+
+// TableName 指定表名
+func (UserCoupon) TableName() string {
+	return "user_coupon"
+}
+
+// Audience 受众表（TAG/SEGMENT 规则以 JSON 存储，LIST 名单存储在 audience_member）
+type Audience struct {
+	AudienceID  string    `gorm:"column:audience_id;primaryKey;type:varchar(32);comment:受众ID（唯一标识）"`
+	AppID       string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_type;comment:应用ID"`
+	Name        string    `gorm:"column:name;type:varchar(64);not null;comment:受众名称"`
+	Description string    `gorm:"column:description;type:varchar(255);not null;default:'';comment:受众描述"`
+	Type        string    `gorm:"column:type;type:enum('TAG','SEGMENT','LIST','ALL');not null;index:idx_app_id_type;comment:受众类型: TAG(标签圈选)/SEGMENT(画像分群)/LIST(上传名单)/ALL(全量用户)"`
+	Rule        string    `gorm:"column:rule;type:text;comment:圈选规则（JSON，TAG/SEGMENT 类型）"`
+	Status      string    `gorm:"column:status;type:enum('ACTIVE','PAUSED','ENDED');not null;default:'ACTIVE';comment:状态: ACTIVE(激活)/PAUSED(已暂停)/ENDED(已结束)"`
+	MemberCount int64     `gorm:"column:member_count;type:bigint(20);not null;default:0;comment:名单人数（LIST 类型）"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (Audience) TableName() string {
+	return "audience"
+}
+
+// AudienceMember 受众名单表（LIST 类型，按受众ID + 用户ID 主键查找）
+type AudienceMember struct {
+	AudienceID string `gorm:"column:audience_id;primaryKey;type:varchar(32);comment:受众ID"`
+	UserID     string `gorm:"column:user_id;primaryKey;type:varchar(36);comment:用户ID"`
+}
+
+// TableName 指定表名
+func (AudienceMember) TableName() string {
+	return "audience_member"
+}
+
+// Campaign 营销活动表（活动下的优惠券只有活动进行中才可用）
+type Campaign struct {
+	CampaignID  string    `gorm:"column:campaign_id;primaryKey;type:varchar(32);comment:活动ID（唯一标识）"`
+	AppID       string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_status;comment:应用ID"`
+	Name        string    `gorm:"column:name;type:varchar(64);not null;comment:活动名称"`
+	Type        string    `gorm:"column:type;type:enum('REDEEM_CODE','TASK_REWARD','DIRECT_SEND');not null;comment:活动类型: REDEEM_CODE(兑换码)/TASK_REWARD(任务奖励)/DIRECT_SEND(直接发放)"`
+	Description string    `gorm:"column:description;type:varchar(255);not null;default:'';comment:活动描述"`
+	StartTime   time.Time `gorm:"column:start_time;type:datetime;not null;comment:开始时间"`
+	EndTime     time.Time `gorm:"column:end_time;type:datetime;not null;comment:结束时间"`
+	Status      string    `gorm:"column:status;type:enum('DRAFT','ACTIVE','PAUSED','ENDED');not null;default:'DRAFT';index:idx_app_id_status;index:idx_status;comment:状态: DRAFT(草稿)/ACTIVE(进行中)/PAUSED(已暂停)/ENDED(已结束)"`
+	Budget      int64     `gorm:"column:budget;type:bigint(20);not null;default:0;comment:折扣预算(分)：活动下所有优惠券的折扣金额合计上限（0表示不限），用尽后自动暂停"`
+	BudgetUsed  int64     `gorm:"column:budget_used;type:bigint(20);not null;default:0;comment:已使用的折扣金额(分)"`
+	Currency    string    `gorm:"column:currency;type:enum('CNY','USD','EUR');not null;default:'CNY';comment:折扣预算的货币单位: CNY(人民币)/USD(美元)/EUR(欧元)"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (Campaign) TableName() string {
+	return "campaign"
+}
+
+// RedeemCode 兑换码表（兑换后发放优惠券、积分或订阅时长，不用于订单抵扣）
+type RedeemCode struct {
+	Code             string     `gorm:"column:code;primaryKey;type:varchar(50);comment:兑换码（唯一标识）"`
+	AppID            string     `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_status;index:idx_app_id_batch_id;comment:应用ID"`
+	BatchID          string     `gorm:"column:batch_id;type:varchar(32);not null;index:idx_app_id_batch_id;comment:批次ID"`
+	CampaignID       string     `gorm:"column:campaign_id;type:varchar(32);not null;default:'';comment:所属活动ID：非空时只有活动进行中才可兑换"`
+	RewardType       string     `gorm:"column:reward_type;type:enum('COUPON','POINTS','SUBSCRIPTION');not null;comment:兑换内容: COUPON(优惠券)/POINTS(积分)/SUBSCRIPTION(订阅时长)"`
+	CouponCode       string     `gorm:"column:coupon_code;type:varchar(50);not null;default:'';comment:COUPON：发放到用户券包的优惠码"`
+	Points           int64      `gorm:"column:points;type:bigint(20);not null;default:0;comment:POINTS：积分数"`
+	SubscriptionPlan string     `gorm:"column:subscription_plan;type:varchar(64);not null;default:'';comment:SUBSCRIPTION：订阅套餐"`
+	SubscriptionDays int32      `gorm:"column:subscription_days;type:int(11);not null;default:0;comment:SUBSCRIPTION：订阅天数"`
+	Status           string     `gorm:"column:status;type:enum('ACTIVE','REDEEMED','EXPIRED','REVOKED');not null;default:'ACTIVE';index:idx_app_id_status;comment:状态: ACTIVE(可用)/REDEEMED(已兑换)/EXPIRED(已过期)/REVOKED(已作废)"`
+	ExpiresAt        time.Time  `gorm:"column:expires_at;type:datetime;not null;comment:过期时间"`
+	RedeemedBy       string     `gorm:"column:redeemed_by;type:varchar(36);not null;default:'';comment:兑换用户ID"`
+	RedeemedAt       *time.Time `gorm:"column:redeemed_at;type:datetime;comment:兑换时间"`
+	CreatedAt        time.Time  `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (RedeemCode) TableName() string {
+	return "redeem_code"
+}
+
+// RedeemCodeRedemption 兑换记录表（每个兑换码最多一条）
+type RedeemCodeRedemption struct {
+	RedemptionID     string     `gorm:"column:redemption_id;primaryKey;type:varchar(32);comment:兑换记录ID（唯一标识）"`
+	Code             string     `gorm:"column:code;type:varchar(50);not null;uniqueIndex:uk_code;comment:兑换码"`
+	AppID            string     `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_user_id;index:idx_app_id_grant_status;comment:应用ID"`
+	UserID           string     `gorm:"column:user_id;type:varchar(36);not null;index:idx_app_id_user_id;comment:兑换用户ID"`
+	RewardType       string     `gorm:"column:reward_type;type:enum('COUPON','POINTS','SUBSCRIPTION');not null;comment:兑换内容: COUPON(优惠券)/POINTS(积分)/SUBSCRIPTION(订阅时长)"`
+	CouponCode       string     `gorm:"column:coupon_code;type:varchar(50);not null;default:'';comment:COUPON：发放的优惠码"`
+	UserCouponID     string     `gorm:"column:user_coupon_id;type:varchar(32);not null;default:'';comment:COUPON：发放到券包的用户优惠券ID"`
+	Points           int64      `gorm:"column:points;type:bigint(20);not null;default:0;comment:POINTS：积分数"`
+	SubscriptionPlan string     `gorm:"column:subscription_plan;type:varchar(64);not null;default:'';comment:SUBSCRIPTION：订阅套餐"`
+	SubscriptionDays int32      `gorm:"column:subscription_days;type:int(11);not null;default:0;comment:SUBSCRIPTION：订阅天数"`
+	GrantStatus      string     `gorm:"column:grant_status;type:enum('PENDING','GRANTED','FAILED');not null;default:'GRANTED';index:idx_app_id_grant_status;comment:权益发放状态: PENDING(待发放)/GRANTED(已发放)/FAILED(发放失败)"`
+	GrantError       string     `gorm:"column:grant_error;type:varchar(1024);not null;default:'';comment:最近一次发放失败的原因"`
+	GrantAttempts    int32      `gorm:"column:grant_attempts;type:int(11);not null;default:0;comment:积分、订阅时长的发放尝试次数"`
+	GrantedAt        *time.Time `gorm:"column:granted_at;type:datetime;comment:权益发放时间"`
+	RedeemedAt       time.Time  `gorm:"column:redeemed_at;type:datetime;not null;comment:兑换时间"`
+}
+
+// TableName 指定表名
+func (RedeemCodeRedemption) TableName() string {
+	return "redeem_code_redemption"
+}
+
+// Reward 奖励模板表（生成、校验、发放配置以 JSON 存储）
+type Reward struct {
+	RewardID          string    `gorm:"column:reward_id;primaryKey;type:varchar(32);comment:奖励ID（唯一标识）"`
+	AppID             string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_status;comment:应用ID"`
+	Name              string    `gorm:"column:name;type:varchar(64);not null;comment:奖励名称"`
+	Description       string    `gorm:"column:description;type:varchar(255);not null;default:'';comment:奖励描述"`
+	RewardType        string    `gorm:"column:reward_type;type:enum('COUPON','POINTS','SUBSCRIPTION');not null;comment:奖励类型: COUPON(优惠券)/POINTS(积分)/SUBSCRIPTION(订阅时长)"`
+	Content           string    `gorm:"column:content;type:text;comment:奖励内容（JSON）"`
+	GeneratorConfig   string    `gorm:"column:generator_config;type:text;comment:生成配置（JSON：优惠码前缀、发放总量）"`
+	ValidatorConfig   string    `gorm:"column:validator_config;type:text;comment:校验配置（JSON：可发放时间、受众、每用户次数）"`
+	DistributorConfig string    `gorm:"column:distributor_config;type:text;comment:发放配置（JSON：AUTO/MANUAL）"`
+	ValidDays         int32     `gorm:"column:valid_days;type:int(11);not null;default:0;comment:发放后有效天数（0表示不过期，COUPON 必填）"`
+	Version           int32     `gorm:"column:version;type:int(11);not null;default:1;comment:版本号（每次修改加一）"`
+	Status            string    `gorm:"column:status;type:enum('ACTIVE','PAUSED','ENDED');not null;default:'ACTIVE';index:idx_app_id_status;comment:状态: ACTIVE(激活)/PAUSED(已暂停)/ENDED(已结束)"`
+	CreatedAt         time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt         time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (Reward) TableName() string {
+	return "reward"
+}
+
+// RewardGrant 奖励发放记录表（名称字段为发放时的快照）
+type RewardGrant struct {
+	GrantID         string     `gorm:"column:grant_id;primaryKey;type:varchar(32);comment:发放记录ID（唯一标识）"`
+	AppID           string     `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_user_id;comment:应用ID"`
+	RewardID        string     `gorm:"column:reward_id;type:varchar(32);not null;uniqueIndex:uk_reward_id_request_id;index:idx_reward_id_user_id;comment:奖励ID"`
+	RewardName      string     `gorm:"column:reward_name;type:varchar(64);not null;comment:奖励名称（快照）"`
+	RewardType      string     `gorm:"column:reward_type;type:enum('COUPON','POINTS','SUBSCRIPTION');not null;comment:奖励类型（快照）"`
+	RewardVersion   int32      `gorm:"column:reward_version;type:int(11);not null;comment:发放时的奖励版本"`
+	ContentSnapshot string     `gorm:"column:content_snapshot;type:text;comment:发放时的奖励内容（JSON 快照）"`
+	CampaignID      string     `gorm:"column:campaign_id;type:varchar(32);not null;default:'';comment:活动ID"`
+	CampaignName    string     `gorm:"column:campaign_name;type:varchar(64);not null;default:'';comment:活动名称（快照）"`
+	TaskID          string     `gorm:"column:task_id;type:varchar(32);not null;default:'';comment:任务ID"`
+	TaskName        string     `gorm:"column:task_name;type:varchar(64);not null;default:'';comment:任务名称（快照）"`
+	UserID          string     `gorm:"column:user_id;type:varchar(36);not null;index:idx_app_id_user_id;index:idx_reward_id_user_id;comment:用户ID"`
+	RequestID       *string    `gorm:"column:request_id;type:varchar(128);uniqueIndex:uk_reward_id_request_id;comment:调用方幂等键（为空表示不去重）"`
+	Status          string     `gorm:"column:status;type:enum('PENDING','GENERATED','DISTRIBUTED','USED','EXPIRED');not null;default:'PENDING';comment:状态: PENDING(待处理)/GENERATED(已生成)/DISTRIBUTED(已发放)/USED(已使用)/EXPIRED(已过期)"`
+	CouponCode      string     `gorm:"column:coupon_code;type:varchar(50);not null;default:'';index:idx_coupon_code;comment:COUPON：生成的用户专属优惠码"`
+	ExpireTime      *time.Time `gorm:"column:expire_time;type:datetime;comment:过期时间（为空表示不过期）"`
+	GeneratedAt     *time.Time `gorm:"column:generated_at;type:datetime;comment:生成时间"`
+	DistributedAt   *time.Time `gorm:"column:distributed_at;type:datetime;comment:发放时间"`
+	UsedAt          *time.Time `gorm:"column:used_at;type:datetime;comment:使用时间"`
+	CreatedAt       time.Time  `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (RewardGrant) TableName() string {
+	return "reward_grant"
+}
+
+// Task 任务表（触发配置和完成条件以 JSON 存储，触发事件类型单独成列便于按事件查询）
+type Task struct {
+	TaskID          string    `gorm:"column:task_id;primaryKey;type:varchar(32);comment:任务ID（唯一标识）"`
+	AppID           string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_event_type_status;comment:应用ID"`
+	CampaignID      string    `gorm:"column:campaign_id;type:varchar(32);not null;default:'';index:idx_campaign_id;comment:活动ID（为空表示不属于活动）"`
+	Name            string    `gorm:"column:name;type:varchar(64);not null;comment:任务名称"`
+	Description     string    `gorm:"column:description;type:varchar(255);not null;default:'';comment:任务描述"`
+	TaskType        string    `gorm:"column:task_type;type:enum('INVITE','PURCHASE','SHARE','SIGN_IN');not null;comment:任务类型: INVITE(邀请好友)/PURCHASE(购买商品)/SHARE(分享内容)/SIGN_IN(签到打卡)"`
+	EventType       string    `gorm:"column:event_type;type:enum('USER_REGISTER','ORDER_PAID','USER_SIGN_IN');not null;index:idx_app_id_event_type_status;comment:触发事件类型"`
+	TriggerConfig   string    `gorm:"column:trigger_config;type:text;comment:触发配置（JSON：获得进度的用户属性）"`
+	ConditionConfig string    `gorm:"column:condition_config;type:text;comment:完成条件（JSON：金额、币种、事件属性、完成次数）"`
+	RewardID        string    `gorm:"column:reward_id;type:varchar(32);not null;index:idx_reward_id;comment:完成后发放的奖励ID"`
+	Repeatable      bool      `gorm:"column:repeatable;type:tinyint(1);not null;default:0;comment:是否可重复完成"`
+	Status          string    `gorm:"column:status;type:enum('ACTIVE','PAUSED','ENDED');not null;default:'ACTIVE';index:idx_app_id_event_type_status;comment:状态: ACTIVE(激活)/PAUSED(已暂停)/ENDED(已结束)"`
+	CreatedAt       time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt       time.Time `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (Task) TableName() string {
+	return "task"
+}
+
+// TaskProgress 用户任务进度表（每个任务每个用户一行）
+type TaskProgress struct {
+	TaskID      string     `gorm:"column:task_id;primaryKey;type:varchar(32);comment:任务ID"`
+	UserID      string     `gorm:"column:user_id;primaryKey;type:varchar(36);index:idx_app_id_user_id;comment:用户ID"`
+	AppID       string     `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_user_id;comment:应用ID"`
+	Progress    int32      `gorm:"column:progress;type:int(11);not null;default:0;comment:当前已计入的事件次数"`
+	Target      int32      `gorm:"column:target;type:int(11);not null;default:1;comment:完成所需次数"`
+	Completions int32      `gorm:"column:completions;type:int(11);not null;default:0;comment:已完成次数"`
+	Status      string     `gorm:"column:status;type:enum('IN_PROGRESS','COMPLETED');not null;default:'IN_PROGRESS';comment:状态: IN_PROGRESS(进行中)/COMPLETED(已完成)"`
+	LastEventID string     `gorm:"column:last_event_id;type:varchar(64);not null;default:'';comment:最近计入的事件ID"`
+	CompletedAt *time.Time `gorm:"column:completed_at;type:datetime;comment:最近完成时间"`
+	CreatedAt   time.Time  `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (TaskProgress) TableName() string {
+	return "task_progress"
+}
+
+// TaskEventLog 任务事件记录表（每个任务每个事件ID一行，用于事件去重和记录任务完成）
+type TaskEventLog struct {
+	TaskID    string    `gorm:"column:task_id;primaryKey;type:varchar(32);comment:任务ID"`
+	EventID   string    `gorm:"column:event_id;primaryKey;type:varchar(64);comment:事件ID"`
+	AppID     string    `gorm:"column:app_id;type:varchar(64);not null;index:idx_app_id_user_id;comment:应用ID"`
+	UserID    string    `gorm:"column:user_id;type:varchar(36);not null;index:idx_app_id_user_id;comment:获得进度的用户ID"`
+	Completed bool      `gorm:"column:completed;type:tinyint(1);not null;default:0;comment:该事件是否完成了任务"`
+	GrantID   string    `gorm:"column:grant_id;type:varchar(32);not null;default:'';comment:完成任务后发放的奖励记录ID"`
+	CreatedAt time.Time `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+}
+
+// TableName 指定表名
+func (TaskEventLog) TableName() string {
+	return "task_event_log"
+}
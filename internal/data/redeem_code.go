@@ -0,0 +1,315 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const redeemCodeInsertBatchSize = 500 // 批量写入兑换码时每条 INSERT 的行数
+
+// redeemCodeRepo 实现 biz.RedeemCodeRepo 接口
+type redeemCodeRepo struct {
+	data        *Data
+	userCoupons *userCouponRepo // 兑换优惠券时复用用户优惠券的模型转换
+	log         *log.Helper
+}
+
+// NewRedeemCodeRepo 创建兑换码 Repository
+func NewRedeemCodeRepo(data *Data, logger log.Logger) biz.RedeemCodeRepo {
+	return &redeemCodeRepo{
+		data:        data,
+		userCoupons: &userCouponRepo{data: data},
+		log:         log.NewHelper(log.With(logger, "module", "data/redeem_code")),
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *redeemCodeRepo) toBizModel(m *model.RedeemCode) *biz.RedeemCode {
+	c := &biz.RedeemCode{
+		Code:             m.Code,
+		AppID:            m.AppID,
+		BatchID:          m.BatchID,
+		CampaignID:       m.CampaignID,
+		RewardType:       m.RewardType,
+		CouponCode:       m.CouponCode,
+		Points:           m.Points,
+		SubscriptionPlan: m.SubscriptionPlan,
+		SubscriptionDays: m.SubscriptionDays,
+		Status:           m.Status,
+		ExpiresAt:        m.ExpiresAt,
+		RedeemedBy:       m.RedeemedBy,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+	if m.RedeemedAt != nil {
+		c.RedeemedAt = *m.RedeemedAt
+	}
+	return c
+}
+
+// toDataModel 将业务模型转换为数据模型
+func (r *redeemCodeRepo) toDataModel(b *biz.RedeemCode) *model.RedeemCode {
+	m := &model.RedeemCode{
+		Code:             b.Code,
+		AppID:            b.AppID,
+		BatchID:          b.BatchID,
+		CampaignID:       b.CampaignID,
+		RewardType:       b.RewardType,
+		CouponCode:       b.CouponCode,
+		Points:           b.Points,
+		SubscriptionPlan: b.SubscriptionPlan,
+		SubscriptionDays: b.SubscriptionDays,
+		Status:           b.Status,
+		ExpiresAt:        b.ExpiresAt,
+		RedeemedBy:       b.RedeemedBy,
+		CreatedAt:        b.CreatedAt,
+		UpdatedAt:        b.UpdatedAt,
+	}
+	if !b.RedeemedAt.IsZero() {
+		m.RedeemedAt = timePtr(b.RedeemedAt)
+	}
+	return m
+}
+
+// toRedemptionBizModel 将兑换记录数据模型转换为业务模型
+func (r *redeemCodeRepo) toRedemptionBizModel(m *model.RedeemCodeRedemption) *biz.RedeemCodeRedemption {
+	b := &biz.RedeemCodeRedemption{
+		RedemptionID:     m.RedemptionID,
+		Code:             m.Code,
+		AppID:            m.AppID,
+		UserID:           m.UserID,
+		RewardType:       m.RewardType,
+		CouponCode:       m.CouponCode,
+		UserCouponID:     m.UserCouponID,
+		Points:           m.Points,
+		SubscriptionPlan: m.SubscriptionPlan,
+		SubscriptionDays: m.SubscriptionDays,
+		GrantStatus:      m.GrantStatus,
+		GrantError:       m.GrantError,
+		GrantAttempts:    m.GrantAttempts,
+		RedeemedAt:       m.RedeemedAt,
+	}
+	if m.GrantedAt != nil {
+		b.GrantedAt = *m.GrantedAt
+	}
+	return b
+}
+
+// toRedemptionModel 将兑换记录业务模型转换为数据模型
+func (r *redeemCodeRepo) toRedemptionModel(b *biz.RedeemCodeRedemption) *model.RedeemCodeRedemption {
+	m := &model.RedeemCodeRedemption{
+		RedemptionID:     b.RedemptionID,
+		Code:             b.Code,
+		AppID:            b.AppID,
+		UserID:           b.UserID,
+		RewardType:       b.RewardType,
+		CouponCode:       b.CouponCode,
+		UserCouponID:     b.UserCouponID,
+		Points:           b.Points,
+		SubscriptionPlan: b.SubscriptionPlan,
+		SubscriptionDays: b.SubscriptionDays,
+		GrantStatus:      b.GrantStatus,
+		GrantError:       b.GrantError,
+		GrantAttempts:    b.GrantAttempts,
+		RedeemedAt:       b.RedeemedAt,
+	}
+	if !b.GrantedAt.IsZero() {
+		m.GrantedAt = timePtr(b.GrantedAt)
+	}
+	return m
+}
+
+// SaveBatch 在一个事务内批量创建兑换码（任一兑换码冲突时整批失败）
+func (r *redeemCodeRepo) SaveBatch(ctx context.Context, codes []*biz.RedeemCode) error {
+	models := make([]*model.RedeemCode, 0, len(codes))
+	for _, c := range codes {
+		models = append(models, r.toDataModel(c))
+	}
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(models, redeemCodeInsertBatchSize).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to create redeem codes: %v", err)
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeRedeemCodeCreateFailed, "zh-CN")
+	}
+	return nil
+}
+
+// FindByCode 查找兑换码（限定在应用内）
+func (r *redeemCodeRepo) FindByCode(ctx context.Context, appID, code string) (*biz.RedeemCode, error) {
+	var m model.RedeemCode
+	if err := r.data.db.WithContext(ctx).
+		Where("code = ? AND app_id = ?", code, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeRedeemCodeNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find redeem code: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// List 列出应用内的兑换码（按创建时间倒序），ACTIVE/EXPIRED 按过期时间区分未标记的过期兑换码
+func (r *redeemCodeRepo) List(ctx context.Context, filter *biz.RedeemCodeFilter, page, pageSize int) ([]*biz.RedeemCode, int64, error) {
+	var (
+		models []model.RedeemCode
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.RedeemCode{}).Where("app_id = ?", filter.AppID)
+	if filter.BatchID != "" {
+		query = query.Where("batch_id = ?", filter.BatchID)
+	}
+	if filter.RedeemedBy != "" {
+		query = query.Where("redeemed_by = ?", filter.RedeemedBy)
+	}
+	switch filter.Status {
+	case "":
+	case constants.RedeemCodeStatusActive:
+		query = query.Where("status = ? AND expires_at > ?", constants.RedeemCodeStatusActive, filter.Now)
+	case constants.RedeemCodeStatusExpired:
+		query = query.Where("status = ? OR (status = ? AND expires_at <= ?)",
+			constants.RedeemCodeStatusExpired, constants.RedeemCodeStatusActive, filter.Now)
+	default:
+		query = query.Where("status = ?", filter.Status)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count redeem codes: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("created_at DESC").Order("code").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list redeem codes: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.RedeemCode, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// Redeem 在一个事务内标记兑换码已兑换、发放用户优惠券并写入兑换记录
+// 条件更新保证并发兑换同一个兑换码时只有一个成功
+func (r *redeemCodeRepo) Redeem(ctx context.Context, redemption *biz.RedeemCodeRedemption, userCoupon *biz.UserCoupon) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.RedeemCode{}).
+			Where("code = ? AND app_id = ? AND status = ? AND expires_at > ?",
+				redemption.Code, redemption.AppID, constants.RedeemCodeStatusActive, redemption.RedeemedAt).
+			Updates(map[string]interface{}{
+				"status":      constants.RedeemCodeStatusRedeemed,
+				"redeemed_by": redemption.UserID,
+				"redeemed_at": redemption.RedeemedAt,
+				"updated_at":  redemption.RedeemedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeRedeemCodeAlreadyRedeemed, "zh-CN")
+		}
+
+		if userCoupon != nil {
+			if err := createUserCoupon(tx, r.userCoupons.toDataModel(userCoupon), false); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(r.toRedemptionModel(redemption)).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to redeem code: %v", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateStatus 条件更新兑换码状态（当前状态为 from 才修改），并发修改时只有一个成功
+func (r *redeemCodeRepo) UpdateStatus(ctx context.Context, appID, code, from, to string, now time.Time) (bool, error) {
+	result := r.data.db.WithContext(ctx).Model(&model.RedeemCode{}).
+		Where("code = ? AND app_id = ? AND status = ?", code, appID, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		r.log.Errorf("failed to update redeem code status: %v", result.Error)
+		return false, pkgErrors.WrapErrorWithLang(ctx, result.Error, pkgErrors.ErrCodeInternalError)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// FindRedemption 查找兑换码的兑换记录（限定在应用内）
+func (r *redeemCodeRepo) FindRedemption(ctx context.Context, appID, code string) (*biz.RedeemCodeRedemption, error) {
+	var m model.RedeemCodeRedemption
+	if err := r.data.db.WithContext(ctx).
+		Where("code = ? AND app_id = ?", code, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeRedeemCodeRedemptionNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find redeem code redemption: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toRedemptionBizModel(&m), nil
+}
+
+// ListRedemptions 列出应用内的兑换记录（按兑换时间倒序）
+func (r *redeemCodeRepo) ListRedemptions(ctx context.Context, filter *biz.RedemptionFilter, page, pageSize int) ([]*biz.RedeemCodeRedemption, int64, error) {
+	var (
+		models []model.RedeemCodeRedemption
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.RedeemCodeRedemption{}).Where("app_id = ?", filter.AppID)
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.GrantStatus != "" {
+		query = query.Where("grant_status = ?", filter.GrantStatus)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count redeem code redemptions: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("redeemed_at DESC").Order("redemption_id").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list redeem code redemptions: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.RedeemCodeRedemption, 0, len(models))
+	for i := range models {
+		result = append(result, r.toRedemptionBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// UpdateGrant 记录权益发放结果：条件更新（未发放成功的记录才修改），并发重试时已成功的结果不会被失败覆盖
+func (r *redeemCodeRepo) UpdateGrant(ctx context.Context, redemption *biz.RedeemCodeRedemption) (bool, error) {
+	updates := map[string]interface{}{
+		"grant_status":   redemption.GrantStatus,
+		"grant_error":    redemption.GrantError,
+		"grant_attempts": gorm.Expr("grant_attempts + 1"),
+	}
+	if !redemption.GrantedAt.IsZero() {
+		updates["granted_at"] = redemption.GrantedAt
+	}
+	result := r.data.db.WithContext(ctx).Model(&model.RedeemCodeRedemption{}).
+		Where("redemption_id = ? AND grant_status <> ?", redemption.RedemptionID, constants.RedeemGrantStatusGranted).
+		Updates(updates)
+	if result.Error != nil {
+		r.log.Errorf("failed to update redeem code grant: %v", result.Error)
+		return false, pkgErrors.WrapErrorWithLang(ctx, result.Error, pkgErrors.ErrCodeInternalError)
+	}
+	return result.RowsAffected > 0, nil
+}
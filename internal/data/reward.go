@@ -0,0 +1,545 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// rewardRepo 实现 biz.RewardRepo 接口
+type rewardRepo struct {
+	data    *Data
+	coupons *couponRepo // 生成奖励优惠券时复用优惠券的模型转换
+	log     *log.Helper
+}
+
+// NewRewardRepo 创建奖励 Repository
+func NewRewardRepo(data *Data, logger log.Logger) biz.RewardRepo {
+	return &rewardRepo{
+		data:    data,
+		coupons: &couponRepo{data: data},
+		log:     log.NewHelper(log.With(logger, "module", "data/reward")),
+	}
+}
+
+// rewardContentJSON 奖励内容的存储格式（奖励的 content 和发放记录的 content_snapshot 共用）
+type rewardContentJSON struct {
+	DiscountType     string `json:"discountType,omitempty"`
+	DiscountValue    int64  `json:"discountValue,omitempty"`
+	Currency         string `json:"currency,omitempty"`
+	MinAmount        int64  `json:"minAmount,omitempty"`
+	Points           int64  `json:"points,omitempty"`
+	SubscriptionPlan string `json:"subscriptionPlan,omitempty"`
+	SubscriptionDays int32  `json:"subscriptionDays,omitempty"`
+}
+
+// rewardGeneratorJSON 生成配置的存储格式
+type rewardGeneratorJSON struct {
+	CodePrefix string `json:"codePrefix,omitempty"`
+	TotalCount int64  `json:"totalCount,omitempty"`
+}
+
+// rewardValidatorJSON 校验配置的存储格式（时间为 Unix 秒，0 表示不限）
+type rewardValidatorJSON struct {
+	StartTime    int64  `json:"startTime,omitempty"`
+	EndTime      int64  `json:"endTime,omitempty"`
+	AudienceID   string `json:"audienceId,omitempty"`
+	PerUserLimit int32  `json:"perUserLimit,omitempty"`
+}
+
+// rewardDistributorJSON 发放配置的存储格式
+type rewardDistributorJSON struct {
+	Type string `json:"type"`
+}
+
+// toRewardContentJSON 序列化奖励内容（只含字符串和整数，序列化不会失败）
+func toRewardContentJSON(c biz.RewardContent) string {
+	data, _ := json.Marshal(rewardContentJSON(c))
+	return string(data)
+}
+
+// unmarshalRewardConfig 解析 JSON 配置，为空或格式错误时保持零值
+func (r *rewardRepo) unmarshalRewardConfig(id, column, value string, v interface{}) {
+	if value == "" {
+		return
+	}
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		r.log.Warnf("failed to unmarshal reward %s: id=%s, err=%v", column, id, err)
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *rewardRepo) toBizModel(m *model.Reward) *biz.Reward {
+	var (
+		content     rewardContentJSON
+		generator   rewardGeneratorJSON
+		validator   rewardValidatorJSON
+		distributor rewardDistributorJSON
+	)
+	r.unmarshalRewardConfig(m.RewardID, "content", m.Content, &content)
+	r.unmarshalRewardConfig(m.RewardID, "generator_config", m.GeneratorConfig, &generator)
+	r.unmarshalRewardConfig(m.RewardID, "validator_config", m.ValidatorConfig, &validator)
+	r.unmarshalRewardConfig(m.RewardID, "distributor_config", m.DistributorConfig, &distributor)
+	if distributor.Type == "" {
+		distributor.Type = constants.DistributorTypeAuto
+	}
+
+	b := &biz.Reward{
+		RewardID:    m.RewardID,
+		AppID:       m.AppID,
+		Name:        m.Name,
+		Description: m.Description,
+		RewardType:  m.RewardType,
+		Content:     biz.RewardContent(content),
+		Generator:   biz.RewardGeneratorConfig(generator),
+		Validator: biz.RewardValidatorConfig{
+			AudienceID:   validator.AudienceID,
+			PerUserLimit: validator.PerUserLimit,
+		},
+		Distributor: biz.RewardDistributorConfig{Type: distributor.Type},
+		ValidDays:   m.ValidDays,
+		Version:     m.Version,
+		Status:      m.Status,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if validator.StartTime > 0 {
+		b.Validator.StartTime = time.Unix(validator.StartTime, 0)
+	}
+	if validator.EndTime > 0 {
+		b.Validator.EndTime = time.Unix(validator.EndTime, 0)
+	}
+	return b
+}
+
+// toDataModel 将业务模型转换为数据模型
+func (r *rewardRepo) toDataModel(b *biz.Reward) *model.Reward {
+	validator := rewardValidatorJSON{
+		AudienceID:   b.Validator.AudienceID,
+		PerUserLimit: b.Validator.PerUserLimit,
+	}
+	if !b.Validator.StartTime.IsZero() {
+		validator.StartTime = b.Validator.StartTime.Unix()
+	}
+	if !b.Validator.EndTime.IsZero() {
+		validator.EndTime = b.Validator.EndTime.Unix()
+	}
+	// 配置只含字符串和整数，序列化不会失败
+	generatorData, _ := json.Marshal(rewardGeneratorJSON(b.Generator))
+	validatorData, _ := json.Marshal(validator)
+	distributorData, _ := json.Marshal(rewardDistributorJSON{Type: b.Distributor.Type})
+	return &model.Reward{
+		RewardID:          b.RewardID,
+		AppID:             b.AppID,
+		Name:              b.Name,
+		Description:       b.Description,
+		RewardType:        b.RewardType,
+		Content:           toRewardContentJSON(b.Content),
+		GeneratorConfig:   string(generatorData),
+		ValidatorConfig:   string(validatorData),
+		DistributorConfig: string(distributorData),
+		ValidDays:         b.ValidDays,
+		Version:           b.Version,
+		Status:            b.Status,
+		CreatedAt:         b.CreatedAt,
+		UpdatedAt:         b.UpdatedAt,
+	}
+}
+
+// toBizGrant 将发放记录数据模型转换为业务模型
+func (r *rewardRepo) toBizGrant(m *model.RewardGrant) *biz.RewardGrant {
+	var content rewardContentJSON
+	r.unmarshalRewardConfig(m.GrantID, "content_snapshot", m.ContentSnapshot, &content)
+	g := &biz.RewardGrant{
+		GrantID:         m.GrantID,
+		AppID:           m.AppID,
+		RewardID:        m.RewardID,
+		RewardName:      m.RewardName,
+		RewardType:      m.RewardType,
+		RewardVersion:   m.RewardVersion,
+		ContentSnapshot: biz.RewardContent(content),
+		CampaignID:      m.CampaignID,
+		CampaignName:    m.CampaignName,
+		TaskID:          m.TaskID,
+		TaskName:        m.TaskName,
+		UserID:          m.UserID,
+		Status:          m.Status,
+		CouponCode:      m.CouponCode,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+	if m.RequestID != nil {
+		g.RequestID = *m.RequestID
+	}
+	for _, t := range []struct {
+		src *time.Time
+		dst *time.Time
+	}{
+		{m.ExpireTime, &g.ExpireTime},
+		{m.GeneratedAt, &g.GeneratedAt},
+		{m.DistributedAt, &g.DistributedAt},
+		{m.UsedAt, &g.UsedAt},
+	} {
+		if t.src != nil {
+			*t.dst = *t.src
+		}
+	}
+	return g
+}
+
+// toDataGrant 将发放记录业务模型转换为数据模型
+func (r *rewardRepo) toDataGrant(g *biz.RewardGrant) *model.RewardGrant {
+	m := &model.RewardGrant{
+		GrantID:         g.GrantID,
+		AppID:           g.AppID,
+		RewardID:        g.RewardID,
+		RewardName:      g.RewardName,
+		RewardType:      g.RewardType,
+		RewardVersion:   g.RewardVersion,
+		ContentSnapshot: toRewardContentJSON(g.ContentSnapshot),
+		CampaignID:      g.CampaignID,
+		CampaignName:    g.CampaignName,
+		TaskID:          g.TaskID,
+		TaskName:        g.TaskName,
+		UserID:          g.UserID,
+		Status:          g.Status,
+		CouponCode:      g.CouponCode,
+		ExpireTime:      nullableTime(g.ExpireTime),
+		GeneratedAt:     nullableTime(g.GeneratedAt),
+		DistributedAt:   nullableTime(g.DistributedAt),
+		UsedAt:          nullableTime(g.UsedAt),
+		CreatedAt:       g.CreatedAt,
+		UpdatedAt:       g.UpdatedAt,
+	}
+	if g.RequestID != "" {
+		m.RequestID = &g.RequestID
+	}
+	return m
+}
+
+// nullableTime 零值时间返回 nil（用于可空的时间列）
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return timePtr(t)
+}
+
+// Save 创建奖励
+func (r *rewardRepo) Save(ctx context.Context, b *biz.Reward) error {
+	if err := r.data.db.WithContext(ctx).Create(r.toDataModel(b)).Error; err != nil {
+		r.log.Errorf("failed to create reward: %v", err)
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardCreateFailed, "zh-CN")
+	}
+	return nil
+}
+
+// Update 乐观锁更新奖励（版本号为 b.Version-1 时才写入）
+func (r *rewardRepo) Update(ctx context.Context, b *biz.Reward) error {
+	m := r.toDataModel(b)
+	result := r.data.db.WithContext(ctx).Model(&model.Reward{}).
+		Where("reward_id = ? AND app_id = ? AND version = ?", m.RewardID, m.AppID, m.Version-1).
+		Updates(map[string]interface{}{
+			"name":               m.Name,
+			"description":        m.Description,
+			"reward_type":        m.RewardType,
+			"content":            m.Content,
+			"generator_config":   m.GeneratorConfig,
+			"validator_config":   m.ValidatorConfig,
+			"distributor_config": m.DistributorConfig,
+			"valid_days":         m.ValidDays,
+			"version":            m.Version,
+			"status":             m.Status,
+			"updated_at":         m.UpdatedAt,
+		})
+	if result.Error != nil {
+		r.log.Errorf("failed to update reward: %v", result.Error)
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardUpdateFailed, "zh-CN")
+	}
+	if result.RowsAffected == 0 {
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardVersionConflict, "zh-CN")
+	}
+	return nil
+}
+
+// FindByID 查找奖励（限定在应用内）
+func (r *rewardRepo) FindByID(ctx context.Context, appID, rewardID string) (*biz.Reward, error) {
+	var m model.Reward
+	if err := r.data.db.WithContext(ctx).
+		Where("reward_id = ? AND app_id = ?", rewardID, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeRewardNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find reward: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// List 列出应用内的奖励（按创建时间倒序）
+func (r *rewardRepo) List(ctx context.Context, filter *biz.RewardFilter, page, pageSize int) ([]*biz.Reward, int64, error) {
+	var (
+		models []model.Reward
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.Reward{}).Where("app_id = ?", filter.AppID)
+	if filter.RewardType != "" {
+		query = query.Where("reward_type = ?", filter.RewardType)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count rewards: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("created_at DESC").Order("reward_id").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list rewards: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.Reward, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// Delete 删除奖励（物理删除），仍有未完成的发放记录或被任务引用时拒绝
+func (r *rewardRepo) Delete(ctx context.Context, appID, rewardID string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tasks int64
+		if err := tx.Model(&model.Task{}).
+			Where("reward_id = ? AND app_id = ?", rewardID, appID).
+			Count(&tasks).Error; err != nil {
+			return err
+		}
+		if tasks > 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardInUse, "zh-CN")
+		}
+
+		var unfinished int64
+		if err := tx.Model(&model.RewardGrant{}).
+			Where("reward_id = ? AND status IN ?", rewardID,
+				[]string{constants.RewardGrantStatusPending, constants.RewardGrantStatusGenerated}).
+			Count(&unfinished).Error; err != nil {
+			return err
+		}
+		if unfinished > 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardInUse, "zh-CN")
+		}
+
+		result := tx.Where("reward_id = ? AND app_id = ?", rewardID, appID).Delete(&model.Reward{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardNotFound, "zh-CN")
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("failed to delete reward: %v", err)
+		return err
+	}
+	return nil
+}
+
+// CreateGrant 在事务内锁定奖励行（同一奖励的发放串行执行），检查发放总量和每用户次数后写入发放记录
+func (r *rewardRepo) CreateGrant(ctx context.Context, g *biz.RewardGrant, totalCount int64, perUserLimit int32) (*biz.RewardGrant, bool, error) {
+	var (
+		result  *biz.RewardGrant
+		created bool
+	)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var reward model.Reward
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("reward_id").
+			Where("reward_id = ? AND app_id = ?", g.RewardID, g.AppID).
+			Take(&reward).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardNotFound, "zh-CN")
+			}
+			return err
+		}
+
+		if g.RequestID != "" {
+			var existing model.RewardGrant
+			err := tx.Where("reward_id = ? AND request_id = ?", g.RewardID, g.RequestID).Take(&existing).Error
+			if err == nil {
+				result = r.toBizGrant(&existing)
+				return nil
+			}
+			if err != gorm.ErrRecordNotFound {
+				return err
+			}
+		}
+
+		if totalCount > 0 {
+			var issued int64
+			if err := tx.Model(&model.RewardGrant{}).Where("reward_id = ?", g.RewardID).Count(&issued).Error; err != nil {
+				return err
+			}
+			if issued >= totalCount {
+				return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardStockExhausted, "zh-CN")
+			}
+		}
+		if perUserLimit > 0 {
+			var issued int64
+			if err := tx.Model(&model.RewardGrant{}).
+				Where("reward_id = ? AND user_id = ?", g.RewardID, g.UserID).Count(&issued).Error; err != nil {
+				return err
+			}
+			if issued >= int64(perUserLimit) {
+				return pkgErrors.NewBizError(marketingErrors.ErrCodeRewardUserLimitReached, "zh-CN")
+			}
+		}
+
+		if err := tx.Create(r.toDataGrant(g)).Error; err != nil {
+			return err
+		}
+		result, created = g, true
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("failed to create reward grant: %v", err)
+		return nil, false, err
+	}
+	return result, created, nil
+}
+
+// FindGrant 查找发放记录（限定在应用内）
+func (r *rewardRepo) FindGrant(ctx context.Context, appID, grantID string) (*biz.RewardGrant, error) {
+	var m model.RewardGrant
+	if err := r.data.db.WithContext(ctx).
+		Where("grant_id = ? AND app_id = ?", grantID, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeRewardGrantNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find reward grant: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizGrant(&m), nil
+}
+
+// ListGrants 列出应用内的发放记录（按创建时间倒序），EXPIRED 按过期时间包含未标记的过期记录
+func (r *rewardRepo) ListGrants(ctx context.Context, filter *biz.RewardGrantFilter, page, pageSize int) ([]*biz.RewardGrant, int64, error) {
+	var (
+		models []model.RewardGrant
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.RewardGrant{}).Where("app_id = ?", filter.AppID)
+	if filter.RewardID != "" {
+		query = query.Where("reward_id = ?", filter.RewardID)
+	}
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.CampaignID != "" {
+		query = query.Where("campaign_id = ?", filter.CampaignID)
+	}
+	if filter.TaskID != "" {
+		query = query.Where("task_id = ?", filter.TaskID)
+	}
+	switch filter.Status {
+	case "":
+	case constants.RewardGrantStatusExpired:
+		query = query.Where("status = ? OR (status <> ? AND expire_time <= ?)",
+			constants.RewardGrantStatusExpired, constants.RewardGrantStatusUsed, filter.Now)
+	case constants.RewardGrantStatusUsed:
+		query = query.Where("status = ?", filter.Status)
+	default:
+		query = query.Where("status = ? AND (expire_time IS NULL OR expire_time > ?)", filter.Status, filter.Now)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count reward grants: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("created_at DESC").Order("grant_id").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list reward grants: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.RewardGrant, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizGrant(&models[i]))
+	}
+	return result, total, nil
+}
+
+// UpdateGrantStatus 条件更新发放记录状态（当前状态为 from 才修改），并发修改时只有一个成功
+func (r *rewardRepo) UpdateGrantStatus(ctx context.Context, g *biz.RewardGrant, from string) (bool, error) {
+	result := r.data.db.WithContext(ctx).Model(&model.RewardGrant{}).
+		Where("grant_id = ? AND app_id = ? AND status = ?", g.GrantID, g.AppID, from).
+		Updates(map[string]interface{}{
+			"status":         g.Status,
+			"coupon_code":    g.CouponCode,
+			"generated_at":   nullableTime(g.GeneratedAt),
+			"distributed_at": nullableTime(g.DistributedAt),
+			"used_at":        nullableTime(g.UsedAt),
+			"updated_at":     g.UpdatedAt,
+		})
+	if result.Error != nil {
+		r.log.Errorf("failed to update reward grant status: %v", result.Error)
+		return false, pkgErrors.WrapErrorWithLang(ctx, result.Error, pkgErrors.ErrCodeInternalError)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GenerateGrant 在一个事务内把 PENDING 发放记录改为 GENERATED 并创建生成的优惠券（coupon 非空时）
+// 先条件更新发放记录（锁定该行），状态已被并发修改时回滚，不会留下孤立的优惠券
+func (r *rewardRepo) GenerateGrant(ctx context.Context, g *biz.RewardGrant, coupon *biz.Coupon) (bool, error) {
+	var ok bool
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.RewardGrant{}).
+			Where("grant_id = ? AND app_id = ? AND status = ?", g.GrantID, g.AppID, constants.RewardGrantStatusPending).
+			Updates(map[string]interface{}{
+				"status":       g.Status,
+				"coupon_code":  g.CouponCode,
+				"generated_at": nullableTime(g.GeneratedAt),
+				"updated_at":   g.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if coupon != nil {
+			if err := tx.Create(r.coupons.toDataModel(coupon)).Error; err != nil {
+				return err
+			}
+		}
+		ok = true
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("failed to generate reward grant: grant_id=%s, err=%v", g.GrantID, err)
+		return false, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return ok, nil
+}
+
+// useRewardGrant 在使用优惠券的事务内把该优惠码对应的已发放奖励标记为已使用（奖励生成的优惠券只能使用一次）
+func useRewardGrant(tx *gorm.DB, code string, now time.Time) error {
+	return tx.Model(&model.RewardGrant{}).
+		Where("coupon_code = ? AND status = ?", code, constants.RewardGrantStatusDistributed).
+		Updates(map[string]interface{}{
+			"status":     constants.RewardGrantStatusUsed,
+			"used_at":    now,
+			"updated_at": now,
+		}).Error
+}
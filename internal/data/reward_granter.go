@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+
+	"marketing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// logRewardGranter 日志实现的权益发放：本服务没有积分和订阅数据，以结构化日志输出发放记录，由下游按日志入账
+// 接入积分服务或订阅服务时替换 NewRewardGranter 的实现即可（以 ref 做幂等）
+type logRewardGranter struct {
+	log *log.Helper
+}
+
+// NewRewardGranter 创建权益发放
+func NewRewardGranter(logger log.Logger) biz.RewardGranter {
+	return &logRewardGranter{
+		log: log.NewHelper(log.With(logger, "module", "data/reward_granter")),
+	}
+}
+
+// GrantPoints 输出积分发放日志
+func (g *logRewardGranter) GrantPoints(ctx context.Context, appID, userID string, points int64, ref string) error {
+	g.log.WithContext(ctx).Infow(
+		"event", "reward_granted",
+		"reward_type", "POINTS",
+		"app_id", appID,
+		"user_id", userID,
+		"points", points,
+		"ref", ref,
+	)
+	return nil
+}
+
+// GrantSubscription 输出订阅时长发放日志
+func (g *logRewardGranter) GrantSubscription(ctx context.Context, appID, userID, plan string, days int32, ref string) error {
+	g.log.WithContext(ctx).Infow(
+		"event", "reward_granted",
+		"reward_type", "SUBSCRIPTION",
+		"app_id", appID,
+		"user_id", userID,
+		"plan", plan,
+		"days", days,
+		"ref", ref,
+	)
+	return nil
+}
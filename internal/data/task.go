@@ -0,0 +1,409 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// taskRepo 实现 biz.TaskRepo 接口
+type taskRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTaskRepo 创建任务 Repository
+func NewTaskRepo(data *Data, logger log.Logger) biz.TaskRepo {
+	return &taskRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/task")),
+	}
+}
+
+// taskTriggerJSON 触发配置的存储格式（事件类型单独存储在 event_type 列）
+type taskTriggerJSON struct {
+	UserAttribute string `json:"userAttribute,omitempty"`
+}
+
+// taskConditionJSON 完成条件的存储格式
+type taskConditionJSON struct {
+	MinAmount   int64             `json:"minAmount,omitempty"`
+	Currency    string            `json:"currency,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	TargetCount int32             `json:"targetCount"`
+}
+
+// unmarshalTaskConfig 解析 JSON 配置，为空或格式错误时保持零值
+func (r *taskRepo) unmarshalTaskConfig(id, column, value string, v interface{}) {
+	if value == "" {
+		return
+	}
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		r.log.Warnf("failed to unmarshal task %s: id=%s, err=%v", column, id, err)
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *taskRepo) toBizModel(m *model.Task) *biz.Task {
+	var (
+		trigger   taskTriggerJSON
+		condition taskConditionJSON
+	)
+	r.unmarshalTaskConfig(m.TaskID, "trigger_config", m.TriggerConfig, &trigger)
+	r.unmarshalTaskConfig(m.TaskID, "condition_config", m.ConditionConfig, &condition)
+	if condition.TargetCount <= 0 {
+		condition.TargetCount = 1
+	}
+	return &biz.Task{
+		TaskID:      m.TaskID,
+		AppID:       m.AppID,
+		CampaignID:  m.CampaignID,
+		Name:        m.Name,
+		Description: m.Description,
+		TaskType:    m.TaskType,
+		Trigger: biz.TaskTriggerConfig{
+			EventType:     m.EventType,
+			UserAttribute: trigger.UserAttribute,
+		},
+		Condition:  biz.TaskConditionConfig(condition),
+		RewardID:   m.RewardID,
+		Repeatable: m.Repeatable,
+		Status:     m.Status,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+// toDataModel 将业务模型转换为数据模型（配置只含字符串和整数，序列化不会失败）
+func (r *taskRepo) toDataModel(b *biz.Task) *model.Task {
+	trigger, _ := json.Marshal(taskTriggerJSON{UserAttribute: b.Trigger.UserAttribute})
+	condition, _ := json.Marshal(taskConditionJSON(b.Condition))
+	return &model.Task{
+		TaskID:          b.TaskID,
+		AppID:           b.AppID,
+		CampaignID:      b.CampaignID,
+		Name:            b.Name,
+		Description:     b.Description,
+		TaskType:        b.TaskType,
+		EventType:       b.Trigger.EventType,
+		TriggerConfig:   string(trigger),
+		ConditionConfig: string(condition),
+		RewardID:        b.RewardID,
+		Repeatable:      b.Repeatable,
+		Status:          b.Status,
+		CreatedAt:       b.CreatedAt,
+		UpdatedAt:       b.UpdatedAt,
+	}
+}
+
+// toBizProgress 将进度数据模型转换为业务模型
+func (r *taskRepo) toBizProgress(m *model.TaskProgress) *biz.TaskProgress {
+	p := &biz.TaskProgress{
+		TaskID:      m.TaskID,
+		AppID:       m.AppID,
+		UserID:      m.UserID,
+		Progress:    m.Progress,
+		Target:      m.Target,
+		Completions: m.Completions,
+		Status:      m.Status,
+		LastEventID: m.LastEventID,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.CompletedAt != nil {
+		p.CompletedAt = *m.CompletedAt
+	}
+	return p
+}
+
+// toBizEventRecord 将事件记录数据模型转换为业务模型
+func (r *taskRepo) toBizEventRecord(m *model.TaskEventLog) *biz.TaskEventRecord {
+	return &biz.TaskEventRecord{
+		TaskID:    m.TaskID,
+		EventID:   m.EventID,
+		AppID:     m.AppID,
+		UserID:    m.UserID,
+		Completed: m.Completed,
+		GrantID:   m.GrantID,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// Save 创建任务
+func (r *taskRepo) Save(ctx context.Context, t *biz.Task) error {
+	if err := r.data.db.WithContext(ctx).Create(r.toDataModel(t)).Error; err != nil {
+		r.log.Errorf("failed to create task: %v", err)
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeTaskCreateFailed, "zh-CN")
+	}
+	return nil
+}
+
+// Update 更新任务配置和状态
+func (r *taskRepo) Update(ctx context.Context, t *biz.Task) error {
+	m := r.toDataModel(t)
+	result := r.data.db.WithContext(ctx).Model(&model.Task{}).
+		Where("task_id = ? AND app_id = ?", m.TaskID, m.AppID).
+		Updates(map[string]interface{}{
+			"name":             m.Name,
+			"description":      m.Description,
+			"task_type":        m.TaskType,
+			"event_type":       m.EventType,
+			"trigger_config":   m.TriggerConfig,
+			"condition_config": m.ConditionConfig,
+			"reward_id":        m.RewardID,
+			"repeatable":       m.Repeatable,
+			"status":           m.Status,
+			"updated_at":       m.UpdatedAt,
+		})
+	if result.Error != nil {
+		r.log.Errorf("failed to update task: %v", result.Error)
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeTaskUpdateFailed, "zh-CN")
+	}
+	if result.RowsAffected == 0 {
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeTaskNotFound, "zh-CN")
+	}
+	return nil
+}
+
+// FindByID 查找任务（限定在应用内）
+func (r *taskRepo) FindByID(ctx context.Context, appID, taskID string) (*biz.Task, error) {
+	var m model.Task
+	if err := r.data.db.WithContext(ctx).
+		Where("task_id = ? AND app_id = ?", taskID, appID).
+		First(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeTaskNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find task: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// List 列出应用内的任务（按创建时间倒序）
+func (r *taskRepo) List(ctx context.Context, filter *biz.TaskFilter, page, pageSize int) ([]*biz.Task, int64, error) {
+	var (
+		models []model.Task
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.Task{}).Where("app_id = ?", filter.AppID)
+	if filter.CampaignID != "" {
+		query = query.Where("campaign_id = ?", filter.CampaignID)
+	}
+	if filter.TaskType != "" {
+		query = query.Where("task_type = ?", filter.TaskType)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count tasks: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("created_at DESC").Order("task_id").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list tasks: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.Task, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// Delete 删除任务（物理删除），同时删除用户进度和事件记录（已发放的奖励记录保留任务快照）
+func (r *taskRepo) Delete(ctx context.Context, appID, taskID string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("task_id = ? AND app_id = ?", taskID, appID).Delete(&model.Task{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeTaskNotFound, "zh-CN")
+		}
+		if err := tx.Where("task_id = ?", taskID).Delete(&model.TaskProgress{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("task_id = ?", taskID).Delete(&model.TaskEventLog{}).Error
+	})
+	if err != nil {
+		r.log.Errorf("failed to delete task: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListActive 列出应用内由该事件类型触发的 ACTIVE 任务
+func (r *taskRepo) ListActive(ctx context.Context, appID, eventType string) ([]*biz.Task, error) {
+	var models []model.Task
+	if err := r.data.db.WithContext(ctx).
+		Where("app_id = ? AND event_type = ? AND status = ?", appID, eventType, constants.TaskStatusActive).
+		Order("created_at").Order("task_id").
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list active tasks: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.Task, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, nil
+}
+
+// RecordEvent 在事务内锁定用户进度行（同一用户同一任务的事件串行计入），按事件ID去重后计入进度
+// 达到完成次数时完成次数加一：可重复完成的任务进度归零，否则状态改为 COMPLETED
+func (r *taskRepo) RecordEvent(ctx context.Context, t *biz.Task, rec *biz.TaskEventRecord) (*biz.TaskProgress, *biz.TaskEventRecord, bool, error) {
+	var (
+		progress  *biz.TaskProgress
+		record    *biz.TaskEventRecord
+		duplicate bool
+	)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.TaskProgress{
+			TaskID:    rec.TaskID,
+			UserID:    rec.UserID,
+			AppID:     rec.AppID,
+			Target:    t.Condition.TargetCount,
+			Status:    constants.TaskProgressStatusInProgress,
+			CreatedAt: rec.CreatedAt,
+			UpdatedAt: rec.CreatedAt,
+		}).Error; err != nil {
+			return err
+		}
+		var p model.TaskProgress
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("task_id = ? AND user_id = ?", rec.TaskID, rec.UserID).
+			Take(&p).Error; err != nil {
+			return err
+		}
+
+		var existing model.TaskEventLog
+		err := tx.Where("task_id = ? AND event_id = ?", rec.TaskID, rec.EventID).Take(&existing).Error
+		if err == nil {
+			progress, record, duplicate = r.toBizProgress(&p), r.toBizEventRecord(&existing), true
+			return nil
+		}
+		if err != gorm.ErrRecordNotFound {
+			return err
+		}
+		if !advanceTaskProgress(&p, t, rec) {
+			progress = r.toBizProgress(&p)
+			return nil
+		}
+		if err := tx.Model(&model.TaskProgress{}).
+			Where("task_id = ? AND user_id = ?", p.TaskID, p.UserID).
+			Updates(map[string]interface{}{
+				"progress":      p.Progress,
+				"target":        p.Target,
+				"completions":   p.Completions,
+				"status":        p.Status,
+				"last_event_id": p.LastEventID,
+				"completed_at":  p.CompletedAt,
+				"updated_at":    p.UpdatedAt,
+			}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&model.TaskEventLog{
+			TaskID:    rec.TaskID,
+			EventID:   rec.EventID,
+			AppID:     rec.AppID,
+			UserID:    rec.UserID,
+			Completed: rec.Completed,
+			CreatedAt: rec.CreatedAt,
+		}).Error; err != nil {
+			return err
+		}
+		progress, record = r.toBizProgress(&p), rec
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("failed to record task event: %v", err)
+		return nil, nil, false, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return progress, record, duplicate, nil
+}
+
+// advanceTaskProgress 把一次事件计入进度行（只修改内存中的 p 和 rec.Completed），
+// 不可重复完成的任务已完成时不计入并返回 false
+func advanceTaskProgress(p *model.TaskProgress, t *biz.Task, rec *biz.TaskEventRecord) bool {
+	if p.Status == constants.TaskProgressStatusCompleted {
+		if !t.Repeatable {
+			return false
+		}
+		// 任务改为可重复完成后重新开始计入
+		p.Progress = 0
+		p.Status = constants.TaskProgressStatusInProgress
+	}
+
+	p.Progress++
+	p.Target = t.Condition.TargetCount
+	p.LastEventID = rec.EventID
+	p.UpdatedAt = rec.CreatedAt
+	if p.Progress >= p.Target {
+		rec.Completed = true
+		p.Completions++
+		p.CompletedAt = timePtr(rec.CreatedAt)
+		if t.Repeatable {
+			p.Progress = 0
+		} else {
+			p.Status = constants.TaskProgressStatusCompleted
+		}
+	}
+	return true
+}
+
+// SetEventGrant 关联事件记录发放的奖励
+func (r *taskRepo) SetEventGrant(ctx context.Context, taskID, eventID, grantID string) error {
+	if err := r.data.db.WithContext(ctx).Model(&model.TaskEventLog{}).
+		Where("task_id = ? AND event_id = ?", taskID, eventID).
+		Update("grant_id", grantID).Error; err != nil {
+		r.log.Errorf("failed to set task event grant: %v", err)
+		return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return nil
+}
+
+// ListProgress 列出应用内的用户任务进度（按更新时间倒序）
+func (r *taskRepo) ListProgress(ctx context.Context, filter *biz.TaskProgressFilter, page, pageSize int) ([]*biz.TaskProgress, int64, error) {
+	var (
+		models []model.TaskProgress
+		total  int64
+	)
+	query := r.data.db.WithContext(ctx).Model(&model.TaskProgress{}).Where("app_id = ?", filter.AppID)
+	if filter.TaskID != "" {
+		query = query.Where("task_id = ?", filter.TaskID)
+	}
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count task progress: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	if err := query.Order("updated_at DESC").Order("task_id").Order("user_id").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list task progress: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.TaskProgress, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizProgress(&models[i]))
+	}
+	return result, total, nil
+}
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+)
+
+func TestAdvanceTaskProgress(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name            string
+		progress        model.TaskProgress
+		target          int32
+		repeatable      bool
+		wantCounted     bool
+		wantCompleted   bool
+		wantProgress    int32
+		wantCompletions int32
+		wantStatus      string
+	}{
+		{
+			name:         "计入一次未完成",
+			progress:     model.TaskProgress{Progress: 0, Target: 3, Status: constants.TaskProgressStatusInProgress},
+			target:       3,
+			wantCounted:  true,
+			wantProgress: 1,
+			wantStatus:   constants.TaskProgressStatusInProgress,
+		},
+		{
+			name:            "达到目标完成",
+			progress:        model.TaskProgress{Progress: 2, Target: 3, Status: constants.TaskProgressStatusInProgress},
+			target:          3,
+			wantCounted:     true,
+			wantCompleted:   true,
+			wantProgress:    3,
+			wantCompletions: 1,
+			wantStatus:      constants.TaskProgressStatusCompleted,
+		},
+		{
+			name:            "可重复完成的任务完成后进度归零",
+			progress:        model.TaskProgress{Progress: 1, Target: 2, Completions: 4, Status: constants.TaskProgressStatusInProgress},
+			target:          2,
+			repeatable:      true,
+			wantCounted:     true,
+			wantCompleted:   true,
+			wantProgress:    0,
+			wantCompletions: 5,
+			wantStatus:      constants.TaskProgressStatusInProgress,
+		},
+		{
+			name:            "不可重复完成的任务已完成时不计入",
+			progress:        model.TaskProgress{Progress: 1, Target: 1, Completions: 1, Status: constants.TaskProgressStatusCompleted, CompletedAt: &earlier},
+			target:          1,
+			wantCounted:     false,
+			wantProgress:    1,
+			wantCompletions: 1,
+			wantStatus:      constants.TaskProgressStatusCompleted,
+		},
+		{
+			name:            "任务改为可重复完成后重新开始计入",
+			progress:        model.TaskProgress{Progress: 3, Target: 3, Completions: 1, Status: constants.TaskProgressStatusCompleted, CompletedAt: &earlier},
+			target:          3,
+			repeatable:      true,
+			wantCounted:     true,
+			wantProgress:    1,
+			wantCompletions: 1,
+			wantStatus:      constants.TaskProgressStatusInProgress,
+		},
+		{
+			name:            "目标次数调低后下一次事件即完成",
+			progress:        model.TaskProgress{Progress: 2, Target: 5, Status: constants.TaskProgressStatusInProgress},
+			target:          2,
+			wantCounted:     true,
+			wantCompleted:   true,
+			wantProgress:    3,
+			wantCompletions: 1,
+			wantStatus:      constants.TaskProgressStatusCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.progress
+			task := &biz.Task{Repeatable: tt.repeatable, Condition: biz.TaskConditionConfig{TargetCount: tt.target}}
+			rec := &biz.TaskEventRecord{EventID: "evt-1", CreatedAt: now}
+
+			counted := advanceTaskProgress(&p, task, rec)
+			if counted != tt.wantCounted {
+				t.Fatalf("counted = %v, want %v", counted, tt.wantCounted)
+			}
+			if rec.Completed != tt.wantCompleted {
+				t.Errorf("rec.Completed = %v, want %v", rec.Completed, tt.wantCompleted)
+			}
+			if p.Progress != tt.wantProgress || p.Completions != tt.wantCompletions || p.Status != tt.wantStatus {
+				t.Errorf("progress = (%d, %d, %s), want (%d, %d, %s)",
+					p.Progress, p.Completions, p.Status, tt.wantProgress, tt.wantCompletions, tt.wantStatus)
+			}
+			if !tt.wantCounted {
+				if p.LastEventID != "" || !p.CompletedAt.Equal(earlier) {
+					t.Errorf("ignored event must not modify the progress row")
+				}
+				return
+			}
+			if p.Target != tt.target || p.LastEventID != "evt-1" || !p.UpdatedAt.Equal(now) {
+				t.Errorf("target/lastEventID/updatedAt = (%d, %s, %v), want (%d, evt-1, %v)", p.Target, p.LastEventID, p.UpdatedAt, tt.target, now)
+			}
+			if tt.wantCompleted && (p.CompletedAt == nil || !p.CompletedAt.Equal(now)) {
+				t.Errorf("CompletedAt = %v, want %v", p.CompletedAt, now)
+			}
+		})
+	}
+}
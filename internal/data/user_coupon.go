@@ -0,0 +1,240 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/data/model"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userCouponRepo 实现 biz.UserCouponRepo 接口
+type userCouponRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUserCouponRepo 创建用户优惠券 Repository
+func NewUserCouponRepo(data *Data, logger log.Logger) biz.UserCouponRepo {
+	return &userCouponRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/user_coupon")),
+	}
+}
+
+// toBizModel 将数据模型转换为业务模型
+func (r *userCouponRepo) toBizModel(m *model.UserCoupon) *biz.UserCoupon {
+	u := &biz.UserCoupon{
+		UserCouponID:   m.UserCouponID,
+		AppID:          m.AppID,
+		CouponCode:     m.CouponCode,
+		UserID:         m.UserID,
+		Status:         m.Status,
+		ValidFrom:      m.ValidFrom,
+		ValidUntil:     m.ValidUntil,
+		IssuedAt:       m.IssuedAt,
+		PaymentOrderID: m.PaymentOrderID,
+	}
+	if m.UsedAt != nil {
+		u.UsedAt = *m.UsedAt
+	}
+	return u
+}
+
+// toDataModel 将业务模型转换为数据模型
+func (r *userCouponRepo) toDataModel(b *biz.UserCoupon) *model.UserCoupon {
+	m := &model.UserCoupon{
+		UserCouponID:   b.UserCouponID,
+		AppID:          b.AppID,
+		CouponCode:     b.CouponCode,
+		UserID:         b.UserID,
+		Status:         b.Status,
+		ValidFrom:      b.ValidFrom,
+		ValidUntil:     b.ValidUntil,
+		IssuedAt:       b.IssuedAt,
+		PaymentOrderID: b.PaymentOrderID,
+	}
+	if !b.UsedAt.IsZero() {
+		m.UsedAt = timePtr(b.UsedAt)
+	}
+	return m
+}
+
+// Issue 发放优惠券实例（锁定优惠券行，避免并发发放时同一用户持有多个可用实例）
+func (r *userCouponRepo) Issue(ctx context.Context, u *biz.UserCoupon) error {
+	if err := r.create(ctx, u, false); err != nil {
+		r.log.Errorf("failed to issue user coupon: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Claim 用户领取优惠券实例（锁定优惠券行，保证领取总量和用户领取次数在并发下不超限）
+func (r *userCouponRepo) Claim(ctx context.Context, u *biz.UserCoupon) error {
+	if err := r.create(ctx, u, true); err != nil {
+		r.log.Errorf("failed to claim user coupon: %v", err)
+		return err
+	}
+	return nil
+}
+
+// create 在事务内锁定优惠券、检查限制、写入实例并增加已领取数量
+func (r *userCouponRepo) create(ctx context.Context, u *biz.UserCoupon, claim bool) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createUserCoupon(tx, r.toDataModel(u), claim)
+	})
+}
+
+// createUserCoupon 在事务内锁定优惠券、检查限制、写入实例并增加已领取数量（领取、发放和兑换码兑换共用）
+// claim 为 true 时检查领取总量和用户领取次数，否则只检查用户是否已持有未过期的可用实例
+func createUserCoupon(tx *gorm.DB, m *model.UserCoupon, claim bool) error {
+	var coupon model.Coupon
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("coupon_code", "claim_limit", "claim_stock", "claimed_count").
+		Where("coupon_code = ?", m.CouponCode).
+		Take(&coupon).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return pkgErrors.NewBizError(pkgErrors.ErrCodeNotFound, "zh-CN")
+		}
+		return err
+	}
+
+	query := tx.Model(&model.UserCoupon{}).
+		Where("coupon_code = ? AND user_id = ? AND app_id = ?", m.CouponCode, m.UserID, m.AppID)
+	if claim {
+		if coupon.ClaimStock > 0 && coupon.ClaimedCount >= coupon.ClaimStock {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponClaimStockExhausted, "zh-CN")
+		}
+		if coupon.ClaimLimit > 0 {
+			var claimed int64
+			if err := query.Count(&claimed).Error; err != nil {
+				return err
+			}
+			if claimed >= int64(coupon.ClaimLimit) {
+				return pkgErrors.NewBizError(marketingErrors.ErrCodeCouponClaimLimitReached, "zh-CN")
+			}
+		}
+	} else {
+		var held int64
+		if err := query.Where("status = ? AND valid_until > ?", constants.UserCouponStatusAvailable, m.IssuedAt).
+			Count(&held).Error; err != nil {
+			return err
+		}
+		if held > 0 {
+			return pkgErrors.NewBizError(marketingErrors.ErrCodeUserCouponAlreadyIssued, "zh-CN")
+		}
+	}
+
+	if err := tx.Create(m).Error; err != nil {
+		return err
+	}
+	return tx.Model(&model.Coupon{}).
+		Where("coupon_code = ?", m.CouponCode).
+		Update("claimed_count", gorm.Expr("claimed_count + 1")).Error
+}
+
+// FindByID 查找应用内的用户优惠券
+func (r *userCouponRepo) FindByID(ctx context.Context, appID, userCouponID string) (*biz.UserCoupon, error) {
+	var m model.UserCoupon
+	if err := r.data.db.WithContext(ctx).
+		Where("user_coupon_id = ? AND app_id = ?", userCouponID, appID).
+		Take(&m).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, pkgErrors.NewBizError(marketingErrors.ErrCodeUserCouponNotFound, "zh-CN")
+		}
+		r.log.Errorf("failed to find user coupon: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	return r.toBizModel(&m), nil
+}
+
+// List 列出用户优惠券（分页）：可用的按过期时间升序，已使用的按使用时间倒序，其他按发放时间倒序
+func (r *userCouponRepo) List(ctx context.Context, filter *biz.UserCouponFilter, page, pageSize int) ([]*biz.UserCoupon, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&model.UserCoupon{}).
+		Where("app_id = ? AND user_id = ?", filter.AppID, filter.UserID)
+	order := "issued_at DESC"
+	switch filter.Status {
+	case constants.UserCouponStatusAvailable:
+		query = query.Where("status = ? AND valid_until > ?", constants.UserCouponStatusAvailable, filter.Now)
+		order = "valid_until ASC"
+	case constants.UserCouponStatusUsed:
+		query = query.Where("status = ?", constants.UserCouponStatusUsed)
+		order = "used_at DESC"
+	case constants.UserCouponStatusExpired:
+		query = query.Where("status = ? AND valid_until <= ?", constants.UserCouponStatusAvailable, filter.Now)
+		order = "valid_until DESC"
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("failed to count user coupons: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	var models []model.UserCoupon
+	offset := (page - 1) * pageSize
+	if err := query.Order(order).Order("user_coupon_id").Offset(offset).Limit(pageSize).Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list user coupons: %v", err)
+		return nil, 0, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+
+	result := make([]*biz.UserCoupon, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, total, nil
+}
+
+// ListAvailable 列出用户持有的某优惠券的未使用实例（含已过期），按过期时间升序
+func (r *userCouponRepo) ListAvailable(ctx context.Context, appID, code, userID string) ([]*biz.UserCoupon, error) {
+	var models []model.UserCoupon
+	if err := r.data.db.WithContext(ctx).
+		Where("coupon_code = ? AND user_id = ? AND app_id = ? AND status = ?", code, userID, appID, constants.UserCouponStatusAvailable).
+		Order("valid_until ASC").
+		Find(&models).Error; err != nil {
+		r.log.Errorf("failed to list available user coupons: %v", err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeInternalError)
+	}
+	result := make([]*biz.UserCoupon, 0, len(models))
+	for i := range models {
+		result = append(result, r.toBizModel(&models[i]))
+	}
+	return result, nil
+}
+
+// consumeUserCoupon 在使用优惠券的事务内核销用户最早过期的可用实例
+// required 为 true 时（相对有效期优惠券）没有可用实例返回错误，否则直接跳过
+func consumeUserCoupon(tx *gorm.DB, appID, code, userID, paymentOrderID string, now time.Time, required bool) error {
+	if userID == "" && !required {
+		return nil
+	}
+	var instance model.UserCoupon
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("coupon_code = ? AND user_id = ? AND app_id = ? AND status = ? AND valid_from <= ? AND valid_until > ?",
+			code, userID, appID, constants.UserCouponStatusAvailable, now, now).
+		Order("valid_until ASC").
+		Take(&instance).Error
+	if err == gorm.ErrRecordNotFound {
+		if !required {
+			return nil
+		}
+		return pkgErrors.NewBizError(marketingErrors.ErrCodeUserCouponNotAvailable, "zh-CN")
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Model(&model.UserCoupon{}).
+		Where("user_coupon_id = ?", instance.UserCouponID).
+		Updates(map[string]interface{}{
+			"status":           constants.UserCouponStatusUsed,
+			"used_at":          now,
+			"payment_order_id": paymentOrderID,
+		}).Error
+}
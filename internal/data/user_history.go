@@ -0,0 +1,22 @@
+package data
+
+import (
+	"context"
+
+	"marketing-service/internal/biz"
+)
+
+// localUserHistoryProvider 本地用户历史实现：本服务没有订单数据，始终返回没有订单，
+// 首单判断只依据 coupon_usage 中的使用记录（由 CouponUseCase 和 UseCoupon 事务检查）
+// 接入订单服务时替换 NewUserHistoryProvider 的实现即可
+type localUserHistoryProvider struct{}
+
+// NewUserHistoryProvider 创建用户历史查询
+func NewUserHistoryProvider() biz.UserHistoryProvider {
+	return &localUserHistoryProvider{}
+}
+
+// HasOrdered 用户在应用内是否有过订单（本地实现没有订单数据）
+func (p *localUserHistoryProvider) HasOrdered(ctx context.Context, appID, userID, excludePaymentOrderID string) (bool, error) {
+	return false, nil
+}
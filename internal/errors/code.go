@@ -25,7 +25,11 @@ func init() {
 //   05: 受众模块
 //   06: 通知模块
 //   07: 分发器模块
-//   08-99: 预留扩展
+//   08: 导出模块
+//   09: 批量操作模块（导入、批量更新/删除）
+//   10: 优惠券模板模块
+//   11: 用户优惠券模块
+//   12-99: 预留扩展
 
 // 活动模块错误码 (120100-120199)
 const (
@@ -37,6 +41,14 @@ const (
 	ErrCodeCampaignUpdateFailed = 120103
 	// ErrCodeCampaignDeleteFailed 活动删除失败
 	ErrCodeCampaignDeleteFailed = 120104
+	// ErrCodeCampaignInUse 活动仍有关联的优惠券或任务
+	ErrCodeCampaignInUse = 120105
+	// ErrCodeCampaignStatusInvalid 活动当前状态不允许该操作
+	ErrCodeCampaignStatusInvalid = 120106
+	// ErrCodeCampaignNotRunning 活动不在进行中
+	ErrCodeCampaignNotRunning = 120107
+	// ErrCodeCampaignBudgetExhausted 活动折扣预算已用尽
+	ErrCodeCampaignBudgetExhausted = 120108
 )
 
 // 兑换码模块错误码 (120200-120299)
@@ -51,6 +63,12 @@ const (
 	ErrCodeRedeemCodeRedeemFailed = 120204
 	// ErrCodeRedeemCodeAssignFailed 兑换码分配失败
 	ErrCodeRedeemCodeAssignFailed = 120205
+	// ErrCodeRedeemCodeRevoked 兑换码已作废
+	ErrCodeRedeemCodeRevoked = 120206
+	// ErrCodeRedeemCodeExpired 兑换码已过期
+	ErrCodeRedeemCodeExpired = 120207
+	// ErrCodeRedeemCodeRedemptionNotFound 兑换记录不存在
+	ErrCodeRedeemCodeRedemptionNotFound = 120208
 )
 
 // 奖励模块错误码 (120300-120399)
@@ -63,6 +81,26 @@ const (
 	ErrCodeRewardUpdateFailed = 120303
 	// ErrCodeRewardDeleteFailed 奖励删除失败
 	ErrCodeRewardDeleteFailed = 120304
+	// ErrCodeRewardNotActive 奖励当前不可发放（未激活或不在可发放时间内）
+	ErrCodeRewardNotActive = 120305
+	// ErrCodeRewardNotEligible 用户不满足奖励的受众条件
+	ErrCodeRewardNotEligible = 120306
+	// ErrCodeRewardStockExhausted 奖励发放总量已用尽
+	ErrCodeRewardStockExhausted = 120307
+	// ErrCodeRewardUserLimitReached 用户获得奖励次数已达上限
+	ErrCodeRewardUserLimitReached = 120308
+	// ErrCodeRewardVersionConflict 奖励已被修改（版本冲突）
+	ErrCodeRewardVersionConflict = 120309
+	// ErrCodeRewardInUse 奖励仍有未完成的发放记录或被任务引用
+	ErrCodeRewardInUse = 120310
+	// ErrCodeRewardGrantNotFound 奖励发放记录不存在
+	ErrCodeRewardGrantNotFound = 120311
+	// ErrCodeRewardGrantStatusInvalid 奖励发放记录当前状态不允许该操作
+	ErrCodeRewardGrantStatusInvalid = 120312
+	// ErrCodeRewardGenerateFailed 奖励内容生成失败
+	ErrCodeRewardGenerateFailed = 120313
+	// ErrCodeRewardDistributeFailed 奖励发放失败
+	ErrCodeRewardDistributeFailed = 120314
 )
 
 // 任务模块错误码 (120400-120499)
@@ -89,6 +127,12 @@ const (
 	ErrCodeAudienceUpdateFailed = 120503
 	// ErrCodeAudienceDeleteFailed 受众删除失败
 	ErrCodeAudienceDeleteFailed = 120504
+	// ErrCodeAudienceInvalidRule 受众规则无效
+	ErrCodeAudienceInvalidRule = 120505
+	// ErrCodeAudienceInUse 受众仍被优惠券引用
+	ErrCodeAudienceInUse = 120506
+	// ErrCodeAudienceNotList 受众不是名单类型
+	ErrCodeAudienceNotList = 120507
 )
 
 // 通知模块错误码 (120600-120699)
@@ -109,3 +153,58 @@ const (
 	ErrCodeWebhookRequestFailed = 120703
 )
 
+// 导出模块错误码 (120800-120899)
+const (
+	// ErrCodeExportTooLarge 导出数据量过大，请使用后台导出任务
+	ErrCodeExportTooLarge = 120801
+	// ErrCodeExportJobNotFound 导出任务不存在
+	ErrCodeExportJobNotFound = 120802
+	// ErrCodeExportJobNotReady 导出任务未完成
+	ErrCodeExportJobNotReady = 120803
+	// ErrCodeExportFailed 导出失败
+	ErrCodeExportFailed = 120804
+)
+
+// 批量操作模块错误码 (120900-120999)
+const (
+	// ErrCodeImportInvalidFile 导入文件格式错误（无法解析或缺少必填列）
+	ErrCodeImportInvalidFile = 120901
+	// ErrCodeImportTooManyRows 导入行数超过上限
+	ErrCodeImportTooManyRows = 120902
+	// ErrCodeBatchTooManyCoupons 批量操作的优惠券数量超过上限
+	ErrCodeBatchTooManyCoupons = 120903
+)
+
+// 优惠券模板模块错误码 (121000-121099)
+const (
+	// ErrCodeCouponTemplateNotFound 优惠券模板不存在
+	ErrCodeCouponTemplateNotFound = 121001
+	// ErrCodeCouponTemplateNameExists 优惠券模板名称已存在
+	ErrCodeCouponTemplateNameExists = 121002
+)
+
+// 用户优惠券模块错误码 (121100-121199)
+const (
+	// ErrCodeUserCouponNotFound 用户优惠券不存在
+	ErrCodeUserCouponNotFound = 121101
+	// ErrCodeUserCouponNotAvailable 用户没有可用的优惠券（未发放、已使用或已过期）
+	ErrCodeUserCouponNotAvailable = 121102
+	// ErrCodeUserCouponAlreadyIssued 用户已持有该优惠券的可用实例
+	ErrCodeUserCouponAlreadyIssued = 121103
+	// ErrCodeCouponClaimLimitReached 用户领取次数已达上限
+	ErrCodeCouponClaimLimitReached = 121104
+	// ErrCodeCouponClaimStockExhausted 优惠券已被领完
+	ErrCodeCouponClaimStockExhausted = 121105
+	// ErrCodeCouponUserMismatch 优惠券绑定了其他用户
+	ErrCodeCouponUserMismatch = 121106
+	// ErrCodeCouponNotNewCustomer 新客优惠券：用户已使用过优惠券或已有订单
+	ErrCodeCouponNotNewCustomer = 121107
+	// ErrCodeCouponOutsideSchedule 不在优惠券的可用时段内
+	ErrCodeCouponOutsideSchedule = 121108
+	// ErrCodeCouponLimitExceeded 超出优惠券的频次限制
+	ErrCodeCouponLimitExceeded = 121109
+	// ErrCodeCouponBudgetExhausted 优惠券或所属活动的剩余折扣预算不足
+	ErrCodeCouponBudgetExhausted = 121110
+	// ErrCodeCouponPaceLimited 优惠券当前时间片的投放额度已用完
+	ErrCodeCouponPaceLimited = 121111
+)
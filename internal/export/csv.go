@@ -0,0 +1,57 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// utf8BOM 让 Excel 正确识别 UTF-8 编码的中文
+const utf8BOM = "\xEF\xBB\xBF"
+
+// csvWriter CSV 表格写入器
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+// WriteRow 写入一行（对可能被 Excel 当作公式的单元格做转义，防止 CSV 注入）
+func (c *csvWriter) WriteRow(cells []string) error {
+	row := make([]string, len(cells))
+	for i, cell := range cells {
+		row[i] = escapeFormula(cell)
+	}
+	if err := c.w.Write(row); err != nil {
+		return err
+	}
+	// 每行刷新一次，保证 HTTP 分块输出及时到达客户端
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// Close 刷新缓冲区
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula 以 = + - @ 等开头且不是数字的单元格前加单引号
+func escapeFormula(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
@@ -0,0 +1,38 @@
+package export
+
+import "strings"
+
+const (
+	langZhCN = "zh-CN"
+	langEnUS = "en-US"
+)
+
+// couponHeaders 优惠券导出列标题
+var couponHeaders = map[string][]string{
+	langZhCN: {"优惠码", "应用ID", "折扣类型", "折扣值", "货币单位", "生效时间", "过期时间", "最大使用次数", "已使用次数", "最低消费金额(分)", "状态", "创建时间", "更新时间"},
+	langEnUS: {"Coupon Code", "App ID", "Discount Type", "Discount Value", "Currency", "Valid From", "Valid Until", "Max Uses", "Used Count", "Min Amount (minor unit)", "Status", "Created At", "Updated At"},
+}
+
+// couponUsageHeaders 优惠券使用记录导出列标题
+var couponUsageHeaders = map[string][]string{
+	langZhCN: {"使用记录ID", "优惠码", "应用ID", "用户ID", "支付订单ID", "支付流水号", "原价(分)", "折扣金额(分)", "实付金额(分)", "货币单位", "使用时间"},
+	langEnUS: {"Usage ID", "Coupon Code", "App ID", "User ID", "Payment Order ID", "Payment ID", "Original Amount (minor unit)", "Discount Amount (minor unit)", "Final Amount (minor unit)", "Currency", "Used At"},
+}
+
+// CouponHeaders 按语言返回优惠券导出列标题（不支持的语言使用中文）
+func CouponHeaders(lang string) []string {
+	return couponHeaders[normalizeLang(lang)]
+}
+
+// CouponUsageHeaders 按语言返回使用记录导出列标题（不支持的语言使用中文）
+func CouponUsageHeaders(lang string) []string {
+	return couponUsageHeaders[normalizeLang(lang)]
+}
+
+// normalizeLang 将 i18n 中间件解析出的语言（如 en、en-US、en_GB）归一到支持的语言
+func normalizeLang(lang string) string {
+	if strings.HasPrefix(strings.ToLower(lang), "en") {
+		return langEnUS
+	}
+	return langZhCN
+}
@@ -0,0 +1,46 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"marketing-service/internal/constants"
+)
+
+// Writer 表格写入器（逐行写入，支持流式输出）
+type Writer interface {
+	// WriteRow 写入一行
+	WriteRow(cells []string) error
+	// Close 写入文件尾并刷新缓冲区（不关闭底层 io.Writer）
+	Close() error
+}
+
+// NewWriter 按导出格式创建表格写入器
+func NewWriter(w io.Writer, format string) (Writer, error) {
+	switch format {
+	case constants.ExportFormatCSV:
+		return newCSVWriter(w)
+	case constants.ExportFormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// IsValidFormat 检查导出格式是否支持
+func IsValidFormat(format string) bool {
+	return format == constants.ExportFormatCSV || format == constants.ExportFormatXLSX
+}
+
+// ContentType 导出格式对应的 HTTP Content-Type
+func ContentType(format string) string {
+	if format == constants.ExportFormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// FileName 导出文件名，如 coupons_20260102150405.csv
+func FileName(resource, format, timestamp string) string {
+	return fmt.Sprintf("%s_%s.%s", resource, timestamp, format)
+}
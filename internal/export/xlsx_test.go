@@ -0,0 +1,114 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"marketing-service/internal/constants"
+)
+
+// xlsxSheet 解析工作表用的最小结构
+type xlsxSheet struct {
+	Rows []struct {
+		Cells []struct {
+			Type string `xml:"t,attr"`
+			Text string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func TestXLSXWriter(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]string
+	}{
+		{name: "只有标题行", rows: [][]string{{"优惠码", "折扣值"}}},
+		{name: "没有数据行", rows: nil},
+		{
+			name: "需要转义和保留空白的单元格",
+			rows: [][]string{
+				{"Coupon Code", "Note"},
+				{"A&B<C>", `"quoted" 'single'`},
+				{"  leading and trailing  ", "line1\nline2"},
+				{"=SUM(A1:A2)", ""},
+				{"中文", "emoji 🎉"},
+			},
+		},
+		{name: "行长度不同", rows: [][]string{{"a"}, {"a", "b", "c"}, {}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, constants.ExportFormatXLSX)
+			if err != nil {
+				t.Fatalf("NewWriter: %v", err)
+			}
+			for _, row := range tt.rows {
+				if err := w.WriteRow(row); err != nil {
+					t.Fatalf("WriteRow: %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				t.Fatalf("output is not a zip archive: %v", err)
+			}
+			parts := make(map[string]*zip.File)
+			for _, f := range zr.File {
+				parts[f.Name] = f
+			}
+			for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+				f, ok := parts[name]
+				if !ok {
+					t.Fatalf("missing part %s", name)
+				}
+				if err := xml.Unmarshal(readZipFile(t, f), new(struct{})); err != nil {
+					t.Errorf("part %s is not well-formed XML: %v", name, err)
+				}
+			}
+
+			var sheet xlsxSheet
+			if err := xml.Unmarshal(readZipFile(t, parts["xl/worksheets/sheet1.xml"]), &sheet); err != nil {
+				t.Fatalf("unmarshal sheet: %v", err)
+			}
+			if len(sheet.Rows) != len(tt.rows) {
+				t.Fatalf("got %d rows, want %d", len(sheet.Rows), len(tt.rows))
+			}
+			for i, row := range tt.rows {
+				cells := sheet.Rows[i].Cells
+				if len(cells) != len(row) {
+					t.Fatalf("row %d: got %d cells, want %d", i, len(cells), len(row))
+				}
+				for j, want := range row {
+					if cells[j].Type != "inlineStr" {
+						t.Errorf("row %d cell %d: type = %q, want inlineStr", i, j, cells[j].Type)
+					}
+					if cells[j].Text != want {
+						t.Errorf("row %d cell %d = %q, want %q", i, j, cells[j].Text, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func readZipFile(t *testing.T, f *zip.File) []byte {
+	t.Helper()
+	rc, err := f.Open()
+	if err != nil {
+		t.Fatalf("open %s: %v", f.Name, err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("read %s: %v", f.Name, err)
+	}
+	return data
+}
@@ -26,6 +26,7 @@ type MarketingMetrics struct {
 	RedeemCodeGeneratedTotal prometheus.Counter
 	RedeemCodeRedeemedTotal   prometheus.Counter
 	RedeemCodeExpiredTotal    prometheus.Counter
+	RedeemCodeGrantFailedTotal prometheus.Counter
 
 	// 库存相关指标
 	InventoryReservedTotal   prometheus.Counter
@@ -89,6 +90,10 @@ func NewMarketingMetrics() *MarketingMetrics {
 			Name: "marketing_redeem_code_expired_total",
 			Help: "Total number of redeem codes expired",
 		}),
+		RedeemCodeGrantFailedTotal: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "marketing_redeem_code_grant_failed_total",
+			Help: "Total number of failed points or subscription grants for redeemed codes",
+		}),
 		InventoryReservedTotal: promauto.NewCounter(prometheus.CounterOpts{
 			Name: "marketing_inventory_reserved_total",
 			Help: "Total number of inventory reservations",
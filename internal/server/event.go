@@ -0,0 +1,322 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultEventStream        = "marketing:events"
+	defaultEventGroup         = "marketing-service"
+	defaultEventBatchSize     = 32
+	defaultEventBlock         = 5 * time.Second
+	defaultEventMaxDeliveries = 5
+	defaultEventClaimIdle     = time.Minute
+	eventRetryInterval        = time.Second      // 读取失败时的重试间隔
+	eventHandleTimeout        = 10 * time.Second // 单个事件的处理超时
+)
+
+// EventConsumer 任务事件消费者：以消费者组从 Redis Stream 读取业务事件交给任务引擎处理
+// 处理成功或事件格式错误时 ACK；处理失败的事件留在 pending 列表中，空闲超过 claimIdle 后由任一消费者（包括已下线实例留下的）接管重试，
+// 投递次数超过 maxDeliveries 后转入死信 Stream 并 ACK（任务引擎按事件ID幂等）
+// 未启用时 Start 直接返回
+type EventConsumer struct {
+	rdb           *redis.Client
+	tasks         *biz.TaskUseCase
+	log           *log.Helper
+	enabled       bool
+	stream        string
+	group         string
+	consumer      string
+	batchSize     int64
+	block         time.Duration
+	maxDeliveries int64
+	deadLetter    string
+	claimIdle     time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewEventConsumer 创建任务事件消费者
+func NewEventConsumer(c *conf.Data, rdb *redis.Client, tasks *biz.TaskUseCase, logger log.Logger) *EventConsumer {
+	ec := &EventConsumer{
+		rdb:           rdb,
+		tasks:         tasks,
+		log:           log.NewHelper(log.With(logger, "module", "server/event")),
+		stream:        defaultEventStream,
+		group:         defaultEventGroup,
+		batchSize:     defaultEventBatchSize,
+		block:         defaultEventBlock,
+		maxDeliveries: defaultEventMaxDeliveries,
+		claimIdle:     defaultEventClaimIdle,
+	}
+	ec.consumer, _ = os.Hostname()
+	if s := c.GetEventStream(); s != nil {
+		ec.enabled = s.Enabled
+		if s.Stream != "" {
+			ec.stream = s.Stream
+		}
+		if s.Group != "" {
+			ec.group = s.Group
+		}
+		if s.Consumer != "" {
+			ec.consumer = s.Consumer
+		}
+		if s.BatchSize > 0 {
+			ec.batchSize = int64(s.BatchSize)
+		}
+		if s.Block != nil && s.Block.AsDuration() > 0 {
+			ec.block = s.Block.AsDuration()
+		}
+		if s.MaxDeliveries > 0 {
+			ec.maxDeliveries = int64(s.MaxDeliveries)
+		}
+		ec.deadLetter = s.DeadLetterStream
+		if s.ClaimIdle != nil && s.ClaimIdle.AsDuration() > 0 {
+			ec.claimIdle = s.ClaimIdle.AsDuration()
+		}
+	}
+	if ec.consumer == "" {
+		ec.consumer = defaultEventGroup
+	}
+	if ec.deadLetter == "" {
+		ec.deadLetter = ec.stream + ":dead"
+	}
+	ec.ctx, ec.cancel = context.WithCancel(context.Background())
+	return ec
+}
+
+// Start 创建消费者组（已存在时忽略）并开始消费，直到 Stop 被调用
+func (c *EventConsumer) Start(ctx context.Context) error {
+	if !c.enabled {
+		return nil
+	}
+	// 先登记再访问 Redis，保证 Stop 会等待消费循环退出
+	c.wg.Add(1)
+	defer c.wg.Done()
+
+	err := c.rdb.XGroupCreateMkStream(ctx, c.stream, c.group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create event consumer group: %w", err)
+	}
+	c.log.Infof("event consumer started: stream=%s, group=%s, consumer=%s", c.stream, c.group, c.consumer)
+
+	c.run()
+	return nil
+}
+
+// Stop 停止消费，等待正在处理的事件完成
+func (c *EventConsumer) Stop(ctx context.Context) error {
+	c.cancel()
+	c.wg.Wait()
+	return nil
+}
+
+// run 消费循环：每隔 claimIdle 接管一次空闲的 pending 事件重试，其余时间读取新事件（ID 为 >）
+// 处理失败的事件不会阻塞新事件的读取
+func (c *EventConsumer) run() {
+	var lastClaim time.Time
+	for c.ctx.Err() == nil {
+		if time.Since(lastClaim) >= c.claimIdle {
+			c.reclaim()
+			lastClaim = time.Now()
+		}
+
+		streams, err := c.rdb.XReadGroup(c.ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.consumer,
+			Streams:  []string{c.stream, ">"},
+			Count:    c.batchSize,
+			Block:    c.block,
+		}).Result()
+		if err != nil && err != redis.Nil {
+			if c.ctx.Err() != nil {
+				return
+			}
+			c.log.Errorf("failed to read events: %v", err)
+			c.wait()
+			continue
+		}
+		for _, s := range streams {
+			for _, msg := range s.Messages {
+				c.handle(msg)
+			}
+		}
+	}
+}
+
+// reclaim 以 XAUTOCLAIM 接管消费者组内空闲超过 claimIdle 的 pending 事件（包括本消费者处理失败的和已下线消费者留下的）并重新处理，
+// 投递次数超过 maxDeliveries 的事件转入死信 Stream
+func (c *EventConsumer) reclaim() {
+	start := "0-0"
+	for c.ctx.Err() == nil {
+		messages, next, err := c.rdb.XAutoClaim(c.ctx, &redis.XAutoClaimArgs{
+			Stream:   c.stream,
+			Group:    c.group,
+			Consumer: c.consumer,
+			MinIdle:  c.claimIdle,
+			Start:    start,
+			Count:    c.batchSize,
+		}).Result()
+		if err != nil {
+			if c.ctx.Err() == nil {
+				c.log.Errorf("failed to claim pending events: %v", err)
+			}
+			return
+		}
+		for _, msg := range messages {
+			deliveries, err := c.deliveries(msg.ID)
+			if err != nil {
+				c.log.Warnf("failed to get event deliveries: id=%s, err=%v", msg.ID, err)
+				continue
+			}
+			if deliveries > c.maxDeliveries {
+				c.deadLetterEvent(msg, deliveries)
+				continue
+			}
+			c.handle(msg)
+		}
+		if next == "0-0" || next == "" {
+			return
+		}
+		start = next
+	}
+}
+
+// deliveries 查询本消费者持有的 pending 事件的投递次数（接管时已计入本次投递）
+func (c *EventConsumer) deliveries(id string) (int64, error) {
+	pending, err := c.rdb.XPendingExt(c.ctx, &redis.XPendingExtArgs{
+		Stream:   c.stream,
+		Group:    c.group,
+		Start:    id,
+		End:      id,
+		Count:    1,
+		Consumer: c.consumer,
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+	return pending[0].RetryCount, nil
+}
+
+// deadLetterEvent 将多次处理失败的事件连同原消息ID和投递次数写入死信 Stream 并 ACK（在同一事务内完成，失败时留待下次接管）
+func (c *EventConsumer) deadLetterEvent(msg redis.XMessage, deliveries int64) {
+	values := make(map[string]interface{}, len(msg.Values)+2)
+	for k, v := range msg.Values {
+		values[k] = v
+	}
+	values["source_id"] = msg.ID
+	values["deliveries"] = deliveries
+
+	ctx := context.Background()
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, &redis.XAddArgs{Stream: c.deadLetter, Values: values})
+		pipe.XAck(ctx, c.stream, c.group, msg.ID)
+		return nil
+	})
+	if err != nil {
+		c.log.Errorf("failed to dead-letter event: id=%s, err=%v", msg.ID, err)
+		return
+	}
+	c.log.Warnf("event moved to dead letter stream: id=%s, deliveries=%d, stream=%s", msg.ID, deliveries, c.deadLetter)
+}
+
+// handle 处理单个事件：成功或格式错误时 ACK，处理失败或完成任务但奖励未发放时留在 pending 列表中等待接管重试
+// （重试时进度按事件ID去重，奖励按相同的幂等键重新发放）
+func (c *EventConsumer) handle(msg redis.XMessage) {
+	event, err := parseStreamEvent(msg)
+	if err != nil {
+		c.log.Warnf("dropping invalid event: id=%s, err=%v", msg.ID, err)
+		c.ack(msg.ID)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventHandleTimeout)
+	defer cancel()
+	results, err := c.tasks.Trigger(ctx, event)
+	if err != nil {
+		c.log.Errorf("failed to handle event: id=%s, event_id=%s, err=%v", msg.ID, event.EventID, err)
+		return
+	}
+	if hasUngrantedReward(results) {
+		c.log.Warnf("task reward not granted, leaving event pending: id=%s, event_id=%s", msg.ID, event.EventID)
+		return
+	}
+	c.ack(msg.ID)
+}
+
+// hasUngrantedReward 是否有任务被该事件完成但奖励未发放
+func hasUngrantedReward(results []*biz.TaskTriggerResult) bool {
+	for _, r := range results {
+		if r.Completed && r.GrantID == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ack 确认事件（失败时事件留在 pending 列表中，接管重试后按事件ID去重）
+func (c *EventConsumer) ack(id string) {
+	if err := c.rdb.XAck(context.Background(), c.stream, c.group, id).Err(); err != nil {
+		c.log.Warnf("failed to ack event: id=%s, err=%v", id, err)
+	}
+}
+
+// wait 等待重试间隔，Stop 时立即返回
+func (c *EventConsumer) wait() {
+	select {
+	case <-c.ctx.Done():
+	case <-time.After(eventRetryInterval):
+	}
+}
+
+// parseStreamEvent 解析 Stream 消息：字段为 event_id（为空时使用消息ID）、app_id、event_type、user_id、amount、currency、attributes（JSON 对象）
+func parseStreamEvent(msg redis.XMessage) (*biz.TaskEvent, error) {
+	field := func(name string) string {
+		v, _ := msg.Values[name].(string)
+		return v
+	}
+	e := &biz.TaskEvent{
+		EventID:   field("event_id"),
+		AppID:     field("app_id"),
+		EventType: field("event_type"),
+		UserID:    field("user_id"),
+		Currency:  field("currency"),
+	}
+	if e.EventID == "" {
+		e.EventID = msg.ID
+	}
+	if e.AppID == "" || !e.Valid() {
+		return nil, fmt.Errorf("app_id, a supported event_type, user_id of at most 36 characters and event_id of at most 64 characters are required")
+	}
+	if v := field("amount"); v != "" {
+		amount, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount: %w", err)
+		}
+		e.Amount = amount
+	}
+	if v := field("attributes"); v != "" {
+		if err := json.Unmarshal([]byte(v), &e.Attributes); err != nil {
+			return nil, fmt.Errorf("invalid attributes: %w", err)
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestParseStreamEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         redis.XMessage
+		wantErr     bool
+		wantEventID string
+		wantAmount  int64
+		wantAttrs   map[string]string
+	}{
+		{
+			name: "完整的订单支付事件",
+			msg: redis.XMessage{ID: "1700000000000-0", Values: map[string]interface{}{
+				"event_id": "order-1", "app_id": "app", "event_type": constants.EventTypeOrderPaid,
+				"user_id": "u1", "amount": "1990", "currency": "CNY", "attributes": `{"inviter":"u2"}`,
+			}},
+			wantEventID: "order-1",
+			wantAmount:  1990,
+			wantAttrs:   map[string]string{"inviter": "u2"},
+		},
+		{
+			name: "缺少 event_id 时使用消息ID",
+			msg: redis.XMessage{ID: "1700000000000-1", Values: map[string]interface{}{
+				"app_id": "app", "event_type": constants.EventTypeUserSignIn, "user_id": "u1",
+			}},
+			wantEventID: "1700000000000-1",
+		},
+		{
+			name: "缺少 app_id",
+			msg: redis.XMessage{ID: "1-0", Values: map[string]interface{}{
+				"event_type": constants.EventTypeUserRegister, "user_id": "u1",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "不支持的事件类型",
+			msg: redis.XMessage{ID: "1-0", Values: map[string]interface{}{
+				"app_id": "app", "event_type": "UNKNOWN", "user_id": "u1",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "用户ID超过 36 个字符",
+			msg: redis.XMessage{ID: "1-0", Values: map[string]interface{}{
+				"app_id": "app", "event_type": constants.EventTypeUserRegister, "user_id": strings.Repeat("u", 37),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "事件ID超过 64 个字符",
+			msg: redis.XMessage{ID: "1-0", Values: map[string]interface{}{
+				"event_id": strings.Repeat("e", 65), "app_id": "app", "event_type": constants.EventTypeUserRegister, "user_id": "u1",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "金额不是整数",
+			msg: redis.XMessage{ID: "1-0", Values: map[string]interface{}{
+				"app_id": "app", "event_type": constants.EventTypeOrderPaid, "user_id": "u1", "amount": "19.9",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "属性不是 JSON 对象",
+			msg: redis.XMessage{ID: "1-0", Values: map[string]interface{}{
+				"app_id": "app", "event_type": constants.EventTypeUserRegister, "user_id": "u1", "attributes": "inviter=u2",
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := parseStreamEvent(tt.msg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got event %+v", e)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e.EventID != tt.wantEventID {
+				t.Errorf("EventID = %q, want %q", e.EventID, tt.wantEventID)
+			}
+			if e.Amount != tt.wantAmount {
+				t.Errorf("Amount = %d, want %d", e.Amount, tt.wantAmount)
+			}
+			if len(e.Attributes) != len(tt.wantAttrs) {
+				t.Fatalf("Attributes = %v, want %v", e.Attributes, tt.wantAttrs)
+			}
+			for k, v := range tt.wantAttrs {
+				if e.Attributes[k] != v {
+					t.Errorf("Attributes[%q] = %q, want %q", k, e.Attributes[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestHasUngrantedReward(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []*biz.TaskTriggerResult
+		want    bool
+	}{
+		{name: "没有匹配的任务", results: nil, want: false},
+		{name: "未完成任务", results: []*biz.TaskTriggerResult{{TaskID: "t1", Progress: 1}}, want: false},
+		{name: "完成任务且奖励已发放", results: []*biz.TaskTriggerResult{{TaskID: "t1", Completed: true, GrantID: "g1"}}, want: false},
+		{name: "完成任务但奖励发放失败", results: []*biz.TaskTriggerResult{{TaskID: "t1", Completed: true, RewardError: "timeout"}}, want: true},
+		{
+			name: "多个任务中有一个奖励未发放",
+			results: []*biz.TaskTriggerResult{
+				{TaskID: "t1", Completed: true, GrantID: "g1"},
+				{TaskID: "t2", Completed: true, Duplicate: true},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasUngrantedReward(tt.results); got != tt.want {
+				t.Errorf("hasUngrantedReward = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -59,6 +59,15 @@ func NewHTTPServer(s *conf.Server, marketing *service.MarketingService, logger l
 
 	v1.RegisterMarketingHTTPServer(srv, marketing)
 
+	// 注册批量导入文件上传端点（multipart/form-data）
+	srv.Route("/").POST("/marketing/v1/imports/coupons", marketing.UploadCouponImport)
+	srv.Route("/").POST("/marketing/v1/audiences/{audienceId}/members/upload", marketing.UploadAudienceMemberFile)
+
+	// 注册导出下载端点（流式响应，不经过统一响应编码器）
+	srv.Route("/").GET("/marketing/v1/exports/coupons", marketing.ExportCoupons)
+	srv.Route("/").GET("/marketing/v1/exports/coupon-usages", marketing.ExportCouponUsages)
+	srv.Route("/").GET("/marketing/v1/export-jobs/{jobId}/download", marketing.DownloadExportJob)
+
 	// 注册 Prometheus metrics 端点
 	srv.Route("/").GET("/metrics", func(ctx kratoshttp.Context) error {
 		promhttp.Handler().ServeHTTP(ctx.Response(), ctx.Request())
@@ -6,5 +6,6 @@ import "github.com/google/wire"
 var ProviderSet = wire.NewSet(
 	NewHTTPServer,
 	NewGRPCServer,
+	NewEventConsumer,
 )
 
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxAudienceFileSize 受众名单文件大小上限（与 UploadAudienceMembersRequest.content 的校验规则一致）
+const maxAudienceFileSize = 4 << 20
+
+// CreateAudience 创建受众
+func (s *MarketingService) CreateAudience(ctx context.Context, req *v1.CreateAudienceRequest) (*v1.AudienceReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	audience, err := s.auc.Create(ctx, &biz.Audience{
+		AppID:       appID,
+		Name:        req.Name,
+		Description: req.Description,
+		Type:        req.Type,
+		Rule:        toBizAudienceRule(req.Rule),
+		Status:      req.Status,
+	})
+	if err != nil {
+		s.log.Errorf("failed to create audience: %v", err)
+		return nil, err
+	}
+
+	return &v1.AudienceReply{Audience: s.toProtoAudience(audience)}, nil
+}
+
+// GetAudience 获取受众
+func (s *MarketingService) GetAudience(ctx context.Context, req *v1.GetAudienceRequest) (*v1.AudienceReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	audience, err := s.auc.Get(ctx, appID, req.AudienceId)
+	if err != nil {
+		s.log.Errorf("failed to get audience: %v", err)
+		return nil, err
+	}
+
+	return &v1.AudienceReply{Audience: s.toProtoAudience(audience)}, nil
+}
+
+// ListAudiences 列出受众
+func (s *MarketingService) ListAudiences(ctx context.Context, req *v1.ListAudiencesRequest) (*v1.ListAudiencesReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	audiences, total, err := s.auc.List(ctx, appID, req.Type, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list audiences: %v", err)
+		return nil, err
+	}
+
+	protoAudiences := make([]*v1.Audience, 0, len(audiences))
+	for _, a := range audiences {
+		protoAudiences = append(protoAudiences, s.toProtoAudience(a))
+	}
+
+	return &v1.ListAudiencesReply{
+		Audiences: protoAudiences,
+		Total:     int32(total),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	}, nil
+}
+
+// UpdateAudience 更新受众
+func (s *MarketingService) UpdateAudience(ctx context.Context, req *v1.UpdateAudienceRequest) (*v1.AudienceReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	audience, err := s.auc.Get(ctx, appID, req.AudienceId)
+	if err != nil {
+		s.log.Errorf("failed to get audience: %v", err)
+		return nil, err
+	}
+
+	// 更新字段
+	if req.Name != "" {
+		audience.Name = req.Name
+	}
+	if req.Description != nil {
+		audience.Description = *req.Description
+	}
+	if req.Rule != nil {
+		audience.Rule = toBizAudienceRule(req.Rule)
+	}
+	if req.Status != "" {
+		audience.Status = req.Status
+	}
+
+	result, err := s.auc.Update(ctx, audience)
+	if err != nil {
+		s.log.Errorf("failed to update audience: %v", err)
+		return nil, err
+	}
+
+	return &v1.AudienceReply{Audience: s.toProtoAudience(result)}, nil
+}
+
+// DeleteAudience 删除受众
+func (s *MarketingService) DeleteAudience(ctx context.Context, req *v1.DeleteAudienceRequest) (*emptypb.Empty, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	if err := s.auc.Delete(ctx, appID, req.AudienceId); err != nil {
+		s.log.Errorf("failed to delete audience: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UploadAudienceMembers 上传 LIST 类型受众的名单
+func (s *MarketingService) UploadAudienceMembers(ctx context.Context, req *v1.UploadAudienceMembersRequest) (*v1.UploadAudienceMembersReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	result, err := s.auc.UploadMembers(ctx, &biz.AudienceMemberUpload{
+		AppID:      appID,
+		AudienceID: req.AudienceId,
+		Mode:       req.Mode,
+		Content:    strings.NewReader(req.Content),
+	})
+	if err != nil {
+		s.log.Errorf("failed to upload audience members: %v", err)
+		return nil, err
+	}
+
+	return &v1.UploadAudienceMembersReply{
+		Total:        result.Total,
+		Invalid:      result.Invalid,
+		InvalidLines: result.InvalidLines,
+		MemberCount:  result.MemberCount,
+	}, nil
+}
+
+// UploadAudienceMemberFile 以 multipart/form-data 上传受众名单（POST /marketing/v1/audiences/{audienceId}/members/upload）
+// 表单字段: file（名单文件）、mode，处理逻辑和响应同 UploadAudienceMembers
+func (s *MarketingService) UploadAudienceMemberFile(ctx kratoshttp.Context) error {
+	r := ctx.Request()
+	r.Body = http.MaxBytesReader(ctx.Response(), r.Body, maxAudienceFileSize+64<<10)
+	if err := r.ParseMultipartForm(maxAudienceFileSize); err != nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, maxAudienceFileSize+1))
+	if err != nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	in := v1.UploadAudienceMembersRequest{
+		AudienceId: ctx.Vars().Get("audienceId"),
+		Content:    string(content),
+		Mode:       r.FormValue("mode"),
+	}
+
+	// 使用 UploadAudienceMembers 的 operation，与 JSON 接口走相同的中间件和校验规则
+	kratoshttp.SetOperation(ctx, v1.OperationMarketingUploadAudienceMembers)
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		return s.UploadAudienceMembers(c, req.(*v1.UploadAudienceMembersRequest))
+	})
+	out, err := h(ctx, &in)
+	if err != nil {
+		return err
+	}
+	return ctx.Result(http.StatusOK, out)
+}
+
+// CheckAudience 检查用户是否属于受众
+func (s *MarketingService) CheckAudience(ctx context.Context, req *v1.CheckAudienceRequest) (*v1.CheckAudienceReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	matched, err := s.auc.Check(ctx, appID, req.AudienceId, req.UserId, req.UserAttributes)
+	if err != nil {
+		s.log.Errorf("failed to check audience: %v", err)
+		return nil, err
+	}
+
+	return &v1.CheckAudienceReply{Matched: matched}, nil
+}
+
+// toBizAudienceRule 转换为业务受众规则
+func toBizAudienceRule(r *v1.AudienceRule) *biz.AudienceRule {
+	if r == nil {
+		return nil
+	}
+	rule := &biz.AudienceRule{Match: r.Match}
+	for _, c := range r.Conditions {
+		rule.Conditions = append(rule.Conditions, &biz.AudienceCondition{
+			Attribute: c.Attribute,
+			Operator:  c.Operator,
+			Values:    c.Values,
+		})
+	}
+	return rule
+}
+
+// toProtoAudience 转换为 Proto 受众
+func (s *MarketingService) toProtoAudience(a *biz.Audience) *v1.Audience {
+	pa := &v1.Audience{
+		AudienceId:  a.AudienceID,
+		Name:        a.Name,
+		Description: a.Description,
+		Type:        a.Type,
+		Status:      a.Status,
+		MemberCount: a.MemberCount,
+		CreatedAt:   a.CreatedAt.Unix(),
+		UpdatedAt:   a.UpdatedAt.Unix(),
+	}
+	if a.Rule != nil {
+		pa.Rule = &v1.AudienceRule{Match: a.Rule.Match}
+		for _, c := range a.Rule.Conditions {
+			pa.Rule.Conditions = append(pa.Rule.Conditions, &v1.AudienceCondition{
+				Attribute: c.Attribute,
+				Operator:  c.Operator,
+				Values:    c.Values,
+			})
+		}
+	}
+	return pa
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// BatchUpdateCouponStatus 批量修改优惠券状态
+func (s *MarketingService) BatchUpdateCouponStatus(ctx context.Context, req *v1.BatchUpdateCouponStatusRequest) (*v1.BatchCouponsReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	result, err := s.cuc.BatchUpdateStatus(ctx, toCouponBatchOp(appID, req.CouponCodes, req.Filter, req.Operator, req.Reason, req.Status))
+	if err != nil {
+		s.log.Errorf("failed to batch update coupon status: %v", err)
+		return nil, err
+	}
+	return toProtoBatchReply(result), nil
+}
+
+// BatchDeleteCoupons 批量删除优惠券
+func (s *MarketingService) BatchDeleteCoupons(ctx context.Context, req *v1.BatchDeleteCouponsRequest) (*v1.BatchCouponsReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	result, err := s.cuc.BatchDelete(ctx, toCouponBatchOp(appID, req.CouponCodes, req.Filter, req.Operator, req.Reason, ""))
+	if err != nil {
+		s.log.Errorf("failed to batch delete coupons: %v", err)
+		return nil, err
+	}
+	return toProtoBatchReply(result), nil
+}
+
+// toCouponBatchOp 转换为批量操作请求
+func toCouponBatchOp(appID string, codes []string, filter *v1.ListCouponsRequest, operator, reason, status string) *biz.CouponBatchOp {
+	op := &biz.CouponBatchOp{
+		AppID:    appID,
+		Codes:    codes,
+		Status:   status,
+		Operator: operator,
+		Reason:   reason,
+	}
+	if filter != nil {
+		op.Filter = toCouponFilter(appID, filter)
+	}
+	return op
+}
+
+// toProtoBatchReply 转换为批量操作响应
+func toProtoBatchReply(result *biz.CouponBatchResult) *v1.BatchCouponsReply {
+	items := make([]*v1.BatchCouponItemResult, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &v1.BatchCouponItemResult{
+			CouponCode: item.CouponCode,
+			Result:     item.Result,
+			OldStatus:  item.OldStatus,
+			Message:    item.Message,
+		})
+	}
+	return &v1.BatchCouponsReply{
+		BatchId:  result.BatchID,
+		Matched:  result.Matched,
+		Affected: result.Affected,
+		Failed:   result.Failed,
+		Items:    items,
+	}
+}
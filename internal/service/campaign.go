@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateCampaign 创建活动
+func (s *MarketingService) CreateCampaign(ctx context.Context, req *v1.CreateCampaignRequest) (*v1.CampaignReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	campaign, err := s.cmuc.Create(ctx, &biz.Campaign{
+		AppID:       appID,
+		Name:        req.Name,
+		Type:        req.Type,
+		Description: req.Description,
+		StartTime:   time.Unix(req.StartTime, 0),
+		EndTime:     time.Unix(req.EndTime, 0),
+		Budget:      req.Budget,
+		Currency:    req.Currency,
+	})
+	if err != nil {
+		s.log.Errorf("failed to create campaign: %v", err)
+		return nil, err
+	}
+
+	return &v1.CampaignReply{Campaign: s.toProtoCampaign(campaign)}, nil
+}
+
+// GetCampaign 获取活动
+func (s *MarketingService) GetCampaign(ctx context.Context, req *v1.GetCampaignRequest) (*v1.CampaignReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	campaign, err := s.cmuc.Get(ctx, appID, req.CampaignId)
+	if err != nil {
+		s.log.Errorf("failed to get campaign: %v", err)
+		return nil, err
+	}
+
+	return &v1.CampaignReply{Campaign: s.toProtoCampaign(campaign)}, nil
+}
+
+// ListCampaigns 列出活动
+func (s *MarketingService) ListCampaigns(ctx context.Context, req *v1.ListCampaignsRequest) (*v1.ListCampaignsReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	campaigns, total, err := s.cmuc.List(ctx, &biz.CampaignFilter{
+		AppID:  appID,
+		Status: req.Status,
+		Type:   req.Type,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list campaigns: %v", err)
+		return nil, err
+	}
+
+	protoCampaigns := make([]*v1.Campaign, 0, len(campaigns))
+	for _, c := range campaigns {
+		protoCampaigns = append(protoCampaigns, s.toProtoCampaign(c))
+	}
+
+	return &v1.ListCampaignsReply{
+		Campaigns: protoCampaigns,
+		Total:     int32(total),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	}, nil
+}
+
+// UpdateCampaign 更新活动
+func (s *MarketingService) UpdateCampaign(ctx context.Context, req *v1.UpdateCampaignRequest) (*v1.CampaignReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	campaign, err := s.cmuc.Get(ctx, appID, req.CampaignId)
+	if err != nil {
+		s.log.Errorf("failed to get campaign: %v", err)
+		return nil, err
+	}
+
+	// 更新字段
+	if req.Name != "" {
+		campaign.Name = req.Name
+	}
+	if req.Type != "" {
+		campaign.Type = req.Type
+	}
+	if req.Description != nil {
+		campaign.Description = *req.Description
+	}
+	if req.StartTime > 0 {
+		campaign.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		campaign.EndTime = time.Unix(req.EndTime, 0)
+	}
+	if req.Budget != nil {
+		campaign.Budget = *req.Budget
+	}
+
+	result, err := s.cmuc.Update(ctx, campaign)
+	if err != nil {
+		s.log.Errorf("failed to update campaign: %v", err)
+		return nil, err
+	}
+
+	return &v1.CampaignReply{Campaign: s.toProtoCampaign(result)}, nil
+}
+
+// DeleteCampaign 删除活动
+func (s *MarketingService) DeleteCampaign(ctx context.Context, req *v1.DeleteCampaignRequest) (*emptypb.Empty, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	if err := s.cmuc.Delete(ctx, appID, req.CampaignId); err != nil {
+		s.log.Errorf("failed to delete campaign: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PublishCampaign 发布活动
+func (s *MarketingService) PublishCampaign(ctx context.Context, req *v1.PublishCampaignRequest) (*v1.CampaignReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	campaign, err := s.cmuc.Publish(ctx, appID, req.CampaignId)
+	if err != nil {
+		s.log.Errorf("failed to publish campaign: %v", err)
+		return nil, err
+	}
+
+	return &v1.CampaignReply{Campaign: s.toProtoCampaign(campaign)}, nil
+}
+
+// PauseCampaign 暂停活动
+func (s *MarketingService) PauseCampaign(ctx context.Context, req *v1.PauseCampaignRequest) (*v1.CampaignReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	campaign, err := s.cmuc.Pause(ctx, appID, req.CampaignId)
+	if err != nil {
+		s.log.Errorf("failed to pause campaign: %v", err)
+		return nil, err
+	}
+
+	return &v1.CampaignReply{Campaign: s.toProtoCampaign(campaign)}, nil
+}
+
+// EndCampaign 结束活动
+func (s *MarketingService) EndCampaign(ctx context.Context, req *v1.EndCampaignRequest) (*v1.CampaignReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	campaign, err := s.cmuc.End(ctx, appID, req.CampaignId)
+	if err != nil {
+		s.log.Errorf("failed to end campaign: %v", err)
+		return nil, err
+	}
+
+	return &v1.CampaignReply{Campaign: s.toProtoCampaign(campaign)}, nil
+}
+
+// toProtoCampaign 转换为 Proto 活动
+func (s *MarketingService) toProtoCampaign(c *biz.Campaign) *v1.Campaign {
+	return &v1.Campaign{
+		CampaignId:  c.CampaignID,
+		Name:        c.Name,
+		Type:        c.Type,
+		Description: c.Description,
+		StartTime:   c.StartTime.Unix(),
+		EndTime:     c.EndTime.Unix(),
+		Status:      c.Status,
+		CreatedAt:   c.CreatedAt.Unix(),
+		UpdatedAt:   c.UpdatedAt.Unix(),
+		Budget:      c.Budget,
+		BudgetUsed:  c.BudgetUsed,
+		Currency:    c.Currency,
+	}
+}
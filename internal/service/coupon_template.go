@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CloneCoupon 以新优惠码复制已有优惠券
+func (s *MarketingService) CloneCoupon(ctx context.Context, req *v1.CloneCouponRequest) (*v1.CreateCouponReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	coupon, err := s.cuc.Clone(ctx, appID, req.CouponCode, req.NewCouponCode, unixToTime(req.ValidFrom), req.KeepBoundUser)
+	if err != nil {
+		s.log.Errorf("failed to clone coupon: %v", err)
+		return nil, err
+	}
+
+	return &v1.CreateCouponReply{
+		Coupon: s.toProtoCoupon(coupon),
+	}, nil
+}
+
+// CreateCouponTemplate 创建优惠券模板
+func (s *MarketingService) CreateCouponTemplate(ctx context.Context, req *v1.CreateCouponTemplateRequest) (*v1.CouponTemplateReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	template, err := s.tuc.Create(ctx, &biz.CouponTemplate{
+		AppID:         appID,
+		Name:          req.Name,
+		Description:   req.Description,
+		DiscountType:  req.DiscountType,
+		DiscountValue: req.DiscountValue,
+		Currency:      req.Currency,
+		MaxUses:       req.MaxUses,
+		MinAmount:     req.MinAmount,
+		ValidDays:     req.ValidDays,
+	})
+	if err != nil {
+		s.log.Errorf("failed to create coupon template: %v", err)
+		return nil, err
+	}
+
+	return &v1.CouponTemplateReply{Template: s.toProtoCouponTemplate(template)}, nil
+}
+
+// GetCouponTemplate 获取优惠券模板
+func (s *MarketingService) GetCouponTemplate(ctx context.Context, req *v1.GetCouponTemplateRequest) (*v1.CouponTemplateReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	template, err := s.tuc.Get(ctx, appID, req.TemplateId)
+	if err != nil {
+		s.log.Errorf("failed to get coupon template: %v", err)
+		return nil, err
+	}
+
+	return &v1.CouponTemplateReply{Template: s.toProtoCouponTemplate(template)}, nil
+}
+
+// ListCouponTemplates 列出优惠券模板
+func (s *MarketingService) ListCouponTemplates(ctx context.Context, req *v1.ListCouponTemplatesRequest) (*v1.ListCouponTemplatesReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	templates, total, err := s.tuc.List(ctx, appID, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list coupon templates: %v", err)
+		return nil, err
+	}
+
+	protoTemplates := make([]*v1.CouponTemplate, 0, len(templates))
+	for _, t := range templates {
+		protoTemplates = append(protoTemplates, s.toProtoCouponTemplate(t))
+	}
+
+	return &v1.ListCouponTemplatesReply{
+		Templates: protoTemplates,
+		Total:     int32(total),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	}, nil
+}
+
+// UpdateCouponTemplate 更新优惠券模板
+func (s *MarketingService) UpdateCouponTemplate(ctx context.Context, req *v1.UpdateCouponTemplateRequest) (*v1.CouponTemplateReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	template, err := s.tuc.Get(ctx, appID, req.TemplateId)
+	if err != nil {
+		s.log.Errorf("failed to get coupon template: %v", err)
+		return nil, err
+	}
+
+	// 更新字段
+	if req.Name != "" {
+		template.Name = req.Name
+	}
+	if req.Description != nil {
+		template.Description = *req.Description
+	}
+	if req.DiscountType != "" {
+		template.DiscountType = req.DiscountType
+	}
+	if req.DiscountValue > 0 {
+		template.DiscountValue = req.DiscountValue
+	}
+	if req.Currency != "" {
+		template.Currency = req.Currency
+	}
+	if req.MaxUses > 0 {
+		template.MaxUses = req.MaxUses
+	}
+	if req.MinAmount != nil {
+		template.MinAmount = *req.MinAmount
+	}
+	if req.ValidDays > 0 {
+		template.ValidDays = req.ValidDays
+	}
+
+	result, err := s.tuc.Update(ctx, template)
+	if err != nil {
+		s.log.Errorf("failed to update coupon template: %v", err)
+		return nil, err
+	}
+
+	return &v1.CouponTemplateReply{Template: s.toProtoCouponTemplate(result)}, nil
+}
+
+// DeleteCouponTemplate 删除优惠券模板
+func (s *MarketingService) DeleteCouponTemplate(ctx context.Context, req *v1.DeleteCouponTemplateRequest) (*emptypb.Empty, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	if err := s.tuc.Delete(ctx, appID, req.TemplateId); err != nil {
+		s.log.Errorf("failed to delete coupon template: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CreateCouponFromTemplate 按模板创建优惠券
+func (s *MarketingService) CreateCouponFromTemplate(ctx context.Context, req *v1.CreateCouponFromTemplateRequest) (*v1.CreateCouponReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	coupon, err := s.tuc.CreateCoupon(ctx, appID, req.TemplateId, req.CouponCode, unixToTime(req.ValidFrom))
+	if err != nil {
+		s.log.Errorf("failed to create coupon from template: %v", err)
+		return nil, err
+	}
+
+	return &v1.CreateCouponReply{
+		Coupon: s.toProtoCoupon(coupon),
+	}, nil
+}
+
+// toProtoCouponTemplate 转换为 Proto 优惠券模板
+func (s *MarketingService) toProtoCouponTemplate(t *biz.CouponTemplate) *v1.CouponTemplate {
+	return &v1.CouponTemplate{
+		TemplateId:    t.TemplateID,
+		Name:          t.Name,
+		Description:   t.Description,
+		DiscountType:  t.DiscountType,
+		DiscountValue: t.DiscountValue,
+		Currency:      t.Currency,
+		MaxUses:       t.MaxUses,
+		MinAmount:     t.MinAmount,
+		ValidDays:     t.ValidDays,
+		CreatedAt:     t.CreatedAt.Unix(),
+		UpdatedAt:     t.UpdatedAt.Unix(),
+	}
+}
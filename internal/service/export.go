@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+	"marketing-service/internal/constants"
+	"marketing-service/internal/export"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	"github.com/gaoyong06/go-pkg/middleware/i18n"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// 流式导出和下载接口不经过 proto 生成的路由，使用自定义 operation 以便中间件匹配
+const (
+	OperationExportCoupons      = "/api.marketing_service.v1.Marketing/ExportCoupons"
+	OperationExportCouponUsages = "/api.marketing_service.v1.Marketing/ExportCouponUsages"
+	OperationDownloadExportJob  = "/api.marketing_service.v1.Marketing/DownloadExportJob"
+)
+
+// CreateExportJob 创建后台导出任务
+func (s *MarketingService) CreateExportJob(ctx context.Context, req *v1.CreateExportJobRequest) (*v1.CreateExportJobReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	job := &biz.ExportJob{
+		AppID:    appID,
+		Resource: req.Resource,
+		Format:   req.Format,
+		Language: i18n.Language(ctx),
+	}
+	switch req.Resource {
+	case constants.ExportResourceCoupons:
+		filter := req.CouponFilter
+		if filter == nil {
+			filter = &v1.ListCouponsRequest{}
+		}
+		job.CouponFilter = toCouponFilter(appID, filter)
+	case constants.ExportResourceCouponUsages:
+		job.UsageFilter = toCouponUsageFilter(appID, req.UsageFilter)
+	}
+
+	created, err := s.euc.CreateJob(ctx, job)
+	if err != nil {
+		s.log.Errorf("failed to create export job: %v", err)
+		return nil, err
+	}
+
+	return &v1.CreateExportJobReply{Job: s.toProtoExportJob(created)}, nil
+}
+
+// GetExportJob 获取后台导出任务状态
+func (s *MarketingService) GetExportJob(ctx context.Context, req *v1.GetExportJobRequest) (*v1.GetExportJobReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	job, err := s.euc.GetJob(ctx, appID, req.JobId)
+	if err != nil {
+		s.log.Errorf("failed to get export job: %v", err)
+		return nil, err
+	}
+
+	return &v1.GetExportJobReply{Job: s.toProtoExportJob(job)}, nil
+}
+
+// ExportCoupons 流式导出优惠券（GET /marketing/v1/exports/coupons，查询参数同 ListCoupons，另加 format=csv|xlsx）
+func (s *MarketingService) ExportCoupons(ctx kratoshttp.Context) error {
+	var in v1.ListCouponsRequest
+	if err := ctx.BindQuery(&in); err != nil {
+		return err
+	}
+	format := exportFormatFromQuery(ctx)
+
+	kratoshttp.SetOperation(ctx, OperationExportCoupons)
+	var w *attachmentWriter
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		appID := app_id.GetAppIDFromContext(c)
+		if appID == "" {
+			return nil, pkgErrors.NewBizErrorWithLang(c, pkgErrors.ErrCodeInvalidArgument)
+		}
+		filter := toCouponFilter(appID, req.(*v1.ListCouponsRequest))
+		w = newAttachmentWriter(ctx.Response(), format, export.FileName(constants.ExportResourceCoupons, format, time.Now().UTC().Format("20060102150405")))
+		return nil, s.euc.ExportCoupons(c, filter, format, i18n.Language(c), w)
+	})
+	_, err := h(ctx, &in)
+	return s.finishStream(w, "coupons", err)
+}
+
+// ExportCouponUsages 流式导出使用记录（GET /marketing/v1/exports/coupon-usages，查询参数见 ExportCouponUsagesFilter，另加 format=csv|xlsx）
+func (s *MarketingService) ExportCouponUsages(ctx kratoshttp.Context) error {
+	var in v1.ExportCouponUsagesFilter
+	if err := ctx.BindQuery(&in); err != nil {
+		return err
+	}
+	format := exportFormatFromQuery(ctx)
+
+	kratoshttp.SetOperation(ctx, OperationExportCouponUsages)
+	var w *attachmentWriter
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		appID := app_id.GetAppIDFromContext(c)
+		if appID == "" {
+			return nil, pkgErrors.NewBizErrorWithLang(c, pkgErrors.ErrCodeInvalidArgument)
+		}
+		filter := toCouponUsageFilter(appID, req.(*v1.ExportCouponUsagesFilter))
+		w = newAttachmentWriter(ctx.Response(), format, export.FileName(constants.ExportResourceCouponUsages, format, time.Now().UTC().Format("20060102150405")))
+		return nil, s.euc.ExportUsages(c, filter, format, i18n.Language(c), w)
+	})
+	_, err := h(ctx, &in)
+	return s.finishStream(w, "coupon usages", err)
+}
+
+// DownloadExportJob 下载已完成的后台导出文件（GET /marketing/v1/export-jobs/{jobId}/download）
+func (s *MarketingService) DownloadExportJob(ctx kratoshttp.Context) error {
+	in := v1.GetExportJobRequest{JobId: ctx.Vars().Get("jobId")}
+
+	kratoshttp.SetOperation(ctx, OperationDownloadExportJob)
+	var w *attachmentWriter
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		appID := app_id.GetAppIDFromContext(c)
+		if appID == "" {
+			return nil, pkgErrors.NewBizErrorWithLang(c, pkgErrors.ErrCodeInvalidArgument)
+		}
+		job, rc, err := s.euc.OpenJobFile(c, appID, req.(*v1.GetExportJobRequest).JobId)
+		if err != nil {
+			s.log.Errorf("failed to open export job file: %v", err)
+			return nil, err
+		}
+		defer rc.Close()
+
+		w = newAttachmentWriter(ctx.Response(), job.Format, job.FileName)
+		_, err = io.Copy(w, rc)
+		return nil, err
+	})
+	_, err := h(ctx, &in)
+	return s.finishStream(w, "export job file", err)
+}
+
+// finishStream 处理流式输出的错误（在中间件链之外调用，避免中断被 recovery 中间件捕获）：
+// 尚未写出数据时交给错误编码器返回统一错误响应；已开始写出时响应头已发送，
+// 以 http.ErrAbortHandler 中断连接，客户端收到不完整的分块传输而不是看起来正常的截断文件
+func (s *MarketingService) finishStream(w *attachmentWriter, what string, err error) error {
+	if err == nil {
+		return nil
+	}
+	s.log.Errorf("failed to export %s: %v", what, err)
+	if w != nil && w.started {
+		panic(http.ErrAbortHandler)
+	}
+	return err
+}
+
+// exportFormatFromQuery 读取导出格式参数，默认 csv
+func exportFormatFromQuery(ctx kratoshttp.Context) string {
+	format := ctx.Query().Get("format")
+	if format == "" {
+		return constants.ExportFormatCSV
+	}
+	return format
+}
+
+// toCouponUsageFilter 将导出筛选条件转换为使用记录筛选条件
+func toCouponUsageFilter(appID string, req *v1.ExportCouponUsagesFilter) *biz.CouponUsageFilter {
+	return &biz.CouponUsageFilter{
+		AppID:          appID,
+		CouponCode:     req.GetCouponCode(),
+		UserID:         req.GetUserId(),
+		PaymentOrderID: req.GetPaymentOrderId(),
+		PaymentID:      req.GetPaymentId(),
+		UsedFrom:       unixToTime(req.GetUsedFrom()),
+		UsedTo:         unixToTime(req.GetUsedTo()),
+	}
+}
+
+// toProtoExportJob 转换为 Proto 导出任务
+func (s *MarketingService) toProtoExportJob(job *biz.ExportJob) *v1.ExportJob {
+	pj := &v1.ExportJob{
+		JobId:     job.JobID,
+		Resource:  job.Resource,
+		Format:    job.Format,
+		Status:    job.Status,
+		Rows:      job.Rows,
+		Error:     job.Error,
+		FileName:  job.FileName,
+		CreatedAt: job.CreatedAt.Unix(),
+	}
+	if !job.FinishedAt.IsZero() {
+		pj.FinishedAt = job.FinishedAt.Unix()
+	}
+	if job.Status == constants.ExportJobStatusSucceeded {
+		pj.DownloadUrl = fmt.Sprintf("/marketing/v1/export-jobs/%s/download", job.JobID)
+	}
+	return pj
+}
+
+// attachmentWriter 在首次写入时才发送下载响应头，写入前出错仍可返回 JSON 错误响应
+type attachmentWriter struct {
+	rw       http.ResponseWriter
+	format   string
+	fileName string
+	started  bool
+}
+
+func newAttachmentWriter(rw http.ResponseWriter, format, fileName string) *attachmentWriter {
+	return &attachmentWriter{rw: rw, format: format, fileName: fileName}
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.rw.Header().Set("Content-Type", export.ContentType(w.format))
+		w.rw.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.fileName))
+		w.rw.WriteHeader(http.StatusOK)
+	}
+	return w.rw.Write(p)
+}
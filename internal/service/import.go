@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// maxImportFileSize 导入文件大小上限（与 ImportCouponsRequest.content 的校验规则一致）
+const maxImportFileSize = 2 << 20
+
+// ImportCoupons 从 CSV 批量导入优惠券
+func (s *MarketingService) ImportCoupons(ctx context.Context, req *v1.ImportCouponsRequest) (*v1.ImportCouponsReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	result, err := s.cuc.Import(ctx, &biz.CouponImport{
+		AppID:   appID,
+		Mode:    req.Mode,
+		DryRun:  req.DryRun,
+		Content: strings.NewReader(req.Content),
+	})
+	if err != nil {
+		s.log.Errorf("failed to import coupons: %v", err)
+		return nil, err
+	}
+
+	rows := make([]*v1.ImportCouponRowResult, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		pr := &v1.ImportCouponRowResult{
+			Line:       row.Line,
+			CouponCode: row.CouponCode,
+			Status:     row.Status,
+			Message:    row.Message,
+		}
+		if row.Coupon != nil {
+			pr.Coupon = s.toProtoCoupon(row.Coupon)
+		}
+		rows = append(rows, pr)
+	}
+
+	return &v1.ImportCouponsReply{
+		Total:     result.Total,
+		Valid:     result.Valid,
+		Created:   result.Created,
+		Failed:    result.Failed,
+		Committed: result.Committed,
+		DryRun:    req.DryRun,
+		Rows:      rows,
+	}, nil
+}
+
+// UploadCouponImport 以 multipart/form-data 上传 CSV 批量导入优惠券（POST /marketing/v1/imports/coupons）
+// 表单字段: file（CSV 文件）、mode、dryRun，处理逻辑和响应同 ImportCoupons
+func (s *MarketingService) UploadCouponImport(ctx kratoshttp.Context) error {
+	r := ctx.Request()
+	r.Body = http.MaxBytesReader(ctx.Response(), r.Body, maxImportFileSize+64<<10)
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, maxImportFileSize+1))
+	if err != nil {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+	dryRun, _ := strconv.ParseBool(r.FormValue("dryRun"))
+
+	in := v1.ImportCouponsRequest{
+		Content: string(content),
+		Mode:    r.FormValue("mode"),
+		DryRun:  dryRun,
+	}
+
+	// 使用 ImportCoupons 的 operation，与 JSON 接口走相同的中间件和校验规则
+	kratoshttp.SetOperation(ctx, v1.OperationMarketingImportCoupons)
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		return s.ImportCoupons(c, req.(*v1.ImportCouponsRequest))
+	})
+	out, err := h(ctx, &in)
+	if err != nil {
+		return err
+	}
+	return ctx.Result(http.StatusOK, out)
+}
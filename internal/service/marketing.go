@@ -17,18 +17,39 @@ import (
 type MarketingService struct {
 	v1.UnimplementedMarketingServer
 
-	cuc *biz.CouponUseCase
-	log *log.Helper
+	cuc  *biz.CouponUseCase
+	euc  *biz.ExportUseCase
+	tuc  *biz.CouponTemplateUseCase
+	auc  *biz.AudienceUseCase
+	cmuc *biz.CampaignUseCase
+	rcuc *biz.RedeemCodeUseCase
+	rwuc *biz.RewardUseCase
+	tkuc *biz.TaskUseCase
+	log  *log.Helper
 }
 
 // NewMarketingService 创建营销服务（极简重构版）
 func NewMarketingService(
 	cuc *biz.CouponUseCase,
+	euc *biz.ExportUseCase,
+	tuc *biz.CouponTemplateUseCase,
+	auc *biz.AudienceUseCase,
+	cmuc *biz.CampaignUseCase,
+	rcuc *biz.RedeemCodeUseCase,
+	rwuc *biz.RewardUseCase,
+	tkuc *biz.TaskUseCase,
 	logger log.Logger,
 ) *MarketingService {
 	return &MarketingService{
-		cuc: cuc,
-		log: log.NewHelper(log.With(logger, "module", "service/marketing")),
+		cuc:  cuc,
+		euc:  euc,
+		tuc:  tuc,
+		auc:  auc,
+		cmuc: cmuc,
+		rcuc: rcuc,
+		rwuc: rwuc,
+		tkuc: tkuc,
+		log:  log.NewHelper(log.With(logger, "module", "service/marketing")),
 	}
 }
 
@@ -43,15 +64,30 @@ func (s *MarketingService) CreateCoupon(ctx context.Context, req *v1.CreateCoupo
 	}
 
 	coupon := &biz.Coupon{
-		CouponCode:    req.CouponCode,
-		AppID:         appID,
-		DiscountType:  req.DiscountType,
-		DiscountValue: req.DiscountValue,
-		Currency:      req.Currency, // 货币单位，如果为空则 biz 层会设置默认值 CNY
-		ValidFrom:     time.Unix(req.ValidFrom, 0),
-		ValidUntil:    time.Unix(req.ValidUntil, 0),
-		MaxUses:       req.MaxUses,
-		MinAmount:     req.MinAmount,
+		CouponCode:       req.CouponCode,
+		AppID:            appID,
+		DiscountType:     req.DiscountType,
+		DiscountValue:    req.DiscountValue,
+		Currency:         req.Currency, // 货币单位，如果为空则 biz 层会设置默认值 CNY
+		ValidFrom:        time.Unix(req.ValidFrom, 0),
+		ValidUntil:       time.Unix(req.ValidUntil, 0),
+		MaxUses:          req.MaxUses,
+		MinAmount:        req.MinAmount,
+		ValidDays:        req.ValidDays,
+		ClaimLimit:       req.ClaimLimit,
+		ClaimStock:       req.ClaimStock,
+		BoundUserID:      req.BoundUserId,
+		AudienceID:       req.AudienceId,
+		Eligibility:      req.Eligibility,
+		Timezone:         req.Timezone,
+		Schedule:         toBizCouponSchedule(req.Schedule),
+		UserDailyLimit:   req.UserDailyLimit,
+		UserWeeklyLimit:  req.UserWeeklyLimit,
+		UserMonthlyLimit: req.UserMonthlyLimit,
+		DailyLimit:       req.DailyLimit,
+		CampaignID:       req.CampaignId,
+		Budget:           req.Budget,
+		Pacing:           toBizCouponPacing(req.Pacing),
 	}
 
 	result, err := s.cuc.Create(ctx, coupon)
@@ -98,7 +134,7 @@ func (s *MarketingService) ListCoupons(ctx context.Context, req *v1.ListCouponsR
 		pageSize = 20
 	}
 
-	coupons, total, err := s.cuc.List(ctx, appID, req.Status, page, pageSize)
+	coupons, total, err := s.cuc.List(ctx, toCouponFilter(appID, req), page, pageSize)
 	if err != nil {
 		s.log.Errorf("failed to list coupons: %v", err)
 		return nil, err
@@ -153,6 +189,51 @@ func (s *MarketingService) UpdateCoupon(ctx context.Context, req *v1.UpdateCoupo
 	if req.Status != "" {
 		coupon.Status = req.Status
 	}
+	if req.ValidDays != nil {
+		coupon.ValidDays = *req.ValidDays
+	}
+	if req.ClaimLimit != nil {
+		coupon.ClaimLimit = *req.ClaimLimit
+	}
+	if req.ClaimStock != nil {
+		coupon.ClaimStock = *req.ClaimStock
+	}
+	if req.BoundUserId != nil {
+		coupon.BoundUserID = *req.BoundUserId
+	}
+	if req.AudienceId != nil {
+		coupon.AudienceID = *req.AudienceId
+	}
+	if req.Eligibility != nil {
+		coupon.Eligibility = *req.Eligibility
+	}
+	if req.Timezone != nil {
+		coupon.Timezone = *req.Timezone
+	}
+	if req.Schedule != nil {
+		coupon.Schedule = toBizCouponSchedule(req.Schedule)
+	}
+	if req.UserDailyLimit != nil {
+		coupon.UserDailyLimit = *req.UserDailyLimit
+	}
+	if req.UserWeeklyLimit != nil {
+		coupon.UserWeeklyLimit = *req.UserWeeklyLimit
+	}
+	if req.UserMonthlyLimit != nil {
+		coupon.UserMonthlyLimit = *req.UserMonthlyLimit
+	}
+	if req.DailyLimit != nil {
+		coupon.DailyLimit = *req.DailyLimit
+	}
+	if req.CampaignId != nil {
+		coupon.CampaignID = *req.CampaignId
+	}
+	if req.Budget != nil {
+		coupon.Budget = *req.Budget
+	}
+	if req.Pacing != nil {
+		coupon.Pacing = toBizCouponPacing(req.Pacing)
+	}
 
 	result, err := s.cuc.Update(ctx, coupon)
 	if err != nil {
@@ -185,31 +266,47 @@ func (s *MarketingService) ValidateCoupon(ctx context.Context, req *v1.ValidateC
 		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
 	}
 
-	coupon, discountAmount, err := s.cuc.Validate(ctx, req.CouponCode, appID, req.Amount)
+	result, err := s.cuc.Validate(ctx, req.CouponCode, appID, req.UserId, req.Amount, req.UserAttributes)
 	if err != nil {
 		s.log.Errorf("failed to validate coupon: %v", err)
 		return nil, err
 	}
 
-	if coupon == nil {
-		return &v1.ValidateCouponReply{
+	if !result.Valid() {
+		reply := &v1.ValidateCouponReply{
 			Valid:   false,
 			Message: "优惠券无效或不可用",
-		}, nil
-	}
-
-	finalAmount := req.Amount - discountAmount
+			Reason:  result.Reason,
+			Quotas:  toProtoCouponQuotas(result.Quotas),
+		}
+		if !result.NextAvailableAt.IsZero() {
+			reply.NextAvailableAt = result.NextAvailableAt.Unix()
+		}
+		if result.BudgetRemaining >= 0 {
+			reply.BudgetRemaining = &result.BudgetRemaining
+		}
+		return reply, nil
+	}
+
+	finalAmount := req.Amount - result.DiscountAmount
 	if finalAmount < 0 {
 		finalAmount = 0
 	}
 
-	return &v1.ValidateCouponReply{
+	reply := &v1.ValidateCouponReply{
 		Valid:          true,
 		Message:        "优惠券有效",
-		DiscountAmount: discountAmount,
+		DiscountAmount: result.DiscountAmount,
 		FinalAmount:    finalAmount,
-		Coupon:         s.toProtoCoupon(coupon),
-	}, nil
+		Coupon:         s.toProtoCoupon(result.Coupon),
+		Reason:         result.Reason,
+		Quotas:         toProtoCouponQuotas(result.Quotas),
+		BudgetClamped:  result.BudgetClamped,
+	}
+	if result.BudgetRemaining >= 0 {
+		reply.BudgetRemaining = &result.BudgetRemaining
+	}
+	return reply, nil
 }
 
 // UseCoupon 使用优惠券（供 Payment Service 调用）
@@ -237,19 +334,27 @@ func (s *MarketingService) UseCoupon(ctx context.Context, req *v1.UseCouponReque
 
 // GetCouponStats 获取优惠券统计
 func (s *MarketingService) GetCouponStats(ctx context.Context, req *v1.GetCouponStatsRequest) (*v1.GetCouponStatsReply, error) {
-	stats, err := s.cuc.GetStats(ctx, req.CouponCode)
+	stats, err := s.cuc.GetStats(ctx, req.CouponCode, req.ExactUniqueUsers)
 	if err != nil {
 		s.log.Errorf("failed to get coupon stats: %v", err)
 		return nil, err
 	}
 
 	return &v1.GetCouponStatsReply{
-		CouponCode:     stats.CouponCode,
-		TotalUses:      stats.TotalUses,
-		TotalOrders:    stats.TotalOrders,
-		TotalRevenue:   stats.TotalRevenue,
-		TotalDiscount:  stats.TotalDiscount,
-		ConversionRate: float32(stats.ConversionRate),
+		CouponCode:         stats.CouponCode,
+		TotalUses:          stats.TotalUses,
+		TotalOrders:        stats.TotalOrders,
+		TotalRevenue:       stats.TotalRevenue,
+		TotalDiscount:      stats.TotalDiscount,
+		ConversionRate:     float32(stats.ConversionRate),
+		ValidationAttempts: stats.ValidationAttempts,
+		ValidAttempts:      stats.ValidAttempts,
+		ValidRate:          stats.ValidRate,
+		RedemptionRate:     stats.RedemptionRate,
+		QuotaUtilization:   stats.QuotaUtilization,
+		AmountsByCurrency:  s.toProtoCurrencyAmounts(stats.AmountsByCurrency),
+		UniqueUsers:        stats.UniqueUsers,
+		UniqueUsersExact:   stats.UniqueUsersExact,
 	}, nil
 }
 
@@ -291,7 +396,14 @@ func (s *MarketingService) GetCouponsSummaryStats(ctx context.Context, req *v1.G
 		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
 	}
 
-	stats, err := s.cuc.GetSummaryStats(ctx, appID)
+	stats, err := s.cuc.GetSummaryStats(ctx, &biz.SummaryStatsQuery{
+		AppID:            appID,
+		TopN:             int(req.TopN),
+		RankBy:           req.RankBy,
+		From:             unixToTime(req.From),
+		To:               unixToTime(req.To),
+		ExactUniqueUsers: req.ExactUniqueUsers,
+	})
 	if err != nil {
 		s.log.Errorf("failed to get coupons summary stats: %v", err)
 		return nil, err
@@ -300,24 +412,183 @@ func (s *MarketingService) GetCouponsSummaryStats(ctx context.Context, req *v1.G
 	protoTopCoupons := make([]*v1.CouponStats, 0, len(stats.TopCoupons))
 	for _, cs := range stats.TopCoupons {
 		protoTopCoupons = append(protoTopCoupons, &v1.CouponStats{
-			CouponCode:     cs.CouponCode,
-			TotalUses:      cs.TotalUses,
-			TotalOrders:    cs.TotalOrders,
-			TotalRevenue:   cs.TotalRevenue,
-			TotalDiscount:  cs.TotalDiscount,
-			ConversionRate: cs.ConversionRate,
+			CouponCode:         cs.CouponCode,
+			TotalUses:          cs.TotalUses,
+			TotalOrders:        cs.TotalOrders,
+			TotalRevenue:       cs.TotalRevenue,
+			TotalDiscount:      cs.TotalDiscount,
+			ConversionRate:     cs.ConversionRate,
+			ValidationAttempts: cs.ValidationAttempts,
+			ValidAttempts:      cs.ValidAttempts,
+			ValidRate:          cs.ValidRate,
+			RedemptionRate:     cs.RedemptionRate,
+			QuotaUtilization:   cs.QuotaUtilization,
+			AmountsByCurrency:  s.toProtoCurrencyAmounts(cs.AmountsByCurrency),
+			DistinctUsers:      cs.DistinctUsers,
 		})
 	}
 
 	return &v1.GetCouponsSummaryStatsReply{
-		TotalCoupons:          stats.TotalCoupons,
-		ActiveCoupons:         stats.ActiveCoupons,
-		TotalUses:             stats.TotalUses,
-		TotalOrders:           stats.TotalOrders,
-		TotalRevenue:          stats.TotalRevenue,
-		TotalDiscount:         stats.TotalDiscount,
-		AverageConversionRate: stats.AverageConversionRate,
-		TopCoupons:            protoTopCoupons,
+		TotalCoupons:            stats.TotalCoupons,
+		ActiveCoupons:           stats.ActiveCoupons,
+		TotalUses:               stats.TotalUses,
+		TotalOrders:             stats.TotalOrders,
+		TotalRevenue:            stats.TotalRevenue,
+		TotalDiscount:           stats.TotalDiscount,
+		AverageConversionRate:   stats.AverageConversionRate,
+		TopCoupons:              protoTopCoupons,
+		TotalValidationAttempts: stats.TotalAttempts,
+		TotalValidAttempts:      stats.TotalValidAttempts,
+		ConversionRate:          stats.ConversionRate,
+		ValidRate:               stats.ValidRate,
+		RedemptionRate:          stats.RedemptionRate,
+		AmountsByCurrency:       s.toProtoCurrencyAmounts(stats.AmountsByCurrency),
+		UniqueUsers:             stats.UniqueUsers,
+		UniqueUsersExact:        stats.UniqueUsersExact,
+	}, nil
+}
+
+// unixToTime 将 timestamp 转换为 time.Time（0 表示未设置，返回零值）
+func unixToTime(ts int64) time.Time {
+	if ts <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
+
+// GetCouponUsageTimeSeries 获取优惠券使用时间序列统计
+func (s *MarketingService) GetCouponUsageTimeSeries(ctx context.Context, req *v1.GetCouponUsageTimeSeriesRequest) (*v1.GetCouponUsageTimeSeriesReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	points, err := s.cuc.GetUsageTimeSeries(ctx, appID, req.CouponCode, time.Unix(req.From, 0), time.Unix(req.To, 0), req.Granularity, timezone)
+	if err != nil {
+		s.log.Errorf("failed to get coupon usage time series: %v", err)
+		return nil, err
+	}
+
+	protoPoints := make([]*v1.CouponUsageTimeSeriesPoint, 0, len(points))
+	for _, p := range points {
+		protoPoints = append(protoPoints, &v1.CouponUsageTimeSeriesPoint{
+			BucketStart:    p.BucketStart.Unix(),
+			Uses:           p.Uses,
+			DistinctOrders: p.DistinctOrders,
+			DistinctUsers:  p.DistinctUsers,
+			Revenue:        p.Revenue,
+			Discount:       p.Discount,
+		})
+	}
+
+	return &v1.GetCouponUsageTimeSeriesReply{
+		Granularity: req.Granularity,
+		Timezone:    timezone,
+		Points:      protoPoints,
+	}, nil
+}
+
+// RebuildCouponStats 从使用记录重算每日统计汇总
+func (s *MarketingService) RebuildCouponStats(ctx context.Context, req *v1.RebuildCouponStatsRequest) (*v1.RebuildCouponStatsReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	rows, err := s.cuc.RebuildStats(ctx, &biz.RebuildStatsQuery{
+		AppID:      appID,
+		CouponCode: req.CouponCode,
+		From:       unixToTime(req.From),
+		To:         unixToTime(req.To),
+	})
+	if err != nil {
+		s.log.Errorf("failed to rebuild coupon stats: %v", err)
+		return nil, err
+	}
+
+	return &v1.RebuildCouponStatsReply{
+		Rows: int32(rows),
+	}, nil
+}
+
+// ListUsagesByUser 按用户列出优惠券使用记录
+func (s *MarketingService) ListUsagesByUser(ctx context.Context, req *v1.ListUsagesByUserRequest) (*v1.ListCouponUsagesReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	usages, total, err := s.cuc.ListUsagesByUser(ctx, appID, req.UserId, unixToTime(req.UsedFrom), unixToTime(req.UsedTo), page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list coupon usages by user: %v", err)
+		return nil, err
+	}
+
+	protoUsages := make([]*v1.CouponUsage, 0, len(usages))
+	for _, u := range usages {
+		protoUsages = append(protoUsages, s.toProtoCouponUsage(u))
+	}
+
+	return &v1.ListCouponUsagesReply{
+		Usages:   protoUsages,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// GetUsageByPaymentOrder 按支付订单查询优惠券使用记录
+func (s *MarketingService) GetUsageByPaymentOrder(ctx context.Context, req *v1.GetUsageByPaymentOrderRequest) (*v1.GetCouponUsageReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	usage, err := s.cuc.GetUsageByPaymentOrder(ctx, appID, req.PaymentOrderId, unixToTime(req.UsedFrom), unixToTime(req.UsedTo))
+	if err != nil {
+		s.log.Errorf("failed to get coupon usage by payment order: %v", err)
+		return nil, err
+	}
+
+	return &v1.GetCouponUsageReply{
+		Usage: s.toProtoCouponUsage(usage),
+	}, nil
+}
+
+// GetUsageByPaymentId 按支付流水号查询优惠券使用记录
+func (s *MarketingService) GetUsageByPaymentId(ctx context.Context, req *v1.GetUsageByPaymentIdRequest) (*v1.GetCouponUsageReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	usage, err := s.cuc.GetUsageByPaymentID(ctx, appID, req.PaymentId, unixToTime(req.UsedFrom), unixToTime(req.UsedTo))
+	if err != nil {
+		s.log.Errorf("failed to get coupon usage by payment id: %v", err)
+		return nil, err
+	}
+
+	return &v1.GetCouponUsageReply{
+		Usage: s.toProtoCouponUsage(usage),
 	}, nil
 }
 
@@ -337,20 +608,103 @@ func (s *MarketingService) toProtoCoupon(c *biz.Coupon) *v1.Coupon {
 		updatedAt = c.UpdatedAt.Unix()
 	}
 	return &v1.Coupon{
-		CouponCode:    c.CouponCode,
-		AppId:         c.AppID,
-		DiscountType:  c.DiscountType,
-		DiscountValue: c.DiscountValue,
-		Currency:      c.Currency,
-		ValidFrom:     validFrom,
-		ValidUntil:    validUntil,
-		MaxUses:       c.MaxUses,
-		UsedCount:     c.UsedCount,
-		MinAmount:     c.MinAmount,
-		Status:        c.Status,
-		CreatedAt:     createdAt,
-		UpdatedAt:     updatedAt,
+		CouponCode:       c.CouponCode,
+		AppId:            c.AppID,
+		DiscountType:     c.DiscountType,
+		DiscountValue:    c.DiscountValue,
+		Currency:         c.Currency,
+		ValidFrom:        validFrom,
+		ValidUntil:       validUntil,
+		MaxUses:          c.MaxUses,
+		UsedCount:        c.UsedCount,
+		MinAmount:        c.MinAmount,
+		Status:           c.Status,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+		ValidDays:        c.ValidDays,
+		ClaimLimit:       c.ClaimLimit,
+		ClaimStock:       c.ClaimStock,
+		ClaimedCount:     c.ClaimedCount,
+		BoundUserId:      c.BoundUserID,
+		AudienceId:       c.AudienceID,
+		Eligibility:      c.Eligibility,
+		Timezone:         c.Timezone,
+		Schedule:         toProtoCouponSchedule(c.Schedule),
+		UserDailyLimit:   c.UserDailyLimit,
+		UserWeeklyLimit:  c.UserWeeklyLimit,
+		UserMonthlyLimit: c.UserMonthlyLimit,
+		DailyLimit:       c.DailyLimit,
+		CampaignId:       c.CampaignID,
+		Budget:           c.Budget,
+		BudgetUsed:       c.BudgetUsed,
+		Pacing:           toProtoCouponPacing(c.Pacing),
+	}
+}
+
+// toProtoCouponQuotas 转换为 Proto 剩余额度
+func toProtoCouponQuotas(quotas []*biz.CouponQuota) []*v1.CouponQuota {
+	result := make([]*v1.CouponQuota, 0, len(quotas))
+	for _, q := range quotas {
+		pq := &v1.CouponQuota{
+			Scope:     q.Scope,
+			Limit:     q.Limit,
+			Used:      q.Used,
+			Remaining: q.Remaining,
+		}
+		if !q.ResetAt.IsZero() {
+			pq.ResetAt = q.ResetAt.Unix()
+		}
+		result = append(result, pq)
+	}
+	return result
+}
+
+// toBizCouponSchedule 转换为业务可用时段
+func toBizCouponSchedule(s *v1.CouponSchedule) *biz.CouponSchedule {
+	if s == nil {
+		return nil
+	}
+	schedule := &biz.CouponSchedule{BlackoutDates: s.BlackoutDates}
+	for _, w := range s.Windows {
+		schedule.Windows = append(schedule.Windows, &biz.CouponTimeWindow{
+			Weekdays:  w.Weekdays,
+			StartTime: w.StartTime,
+			EndTime:   w.EndTime,
+		})
 	}
+	return schedule
+}
+
+// toProtoCouponSchedule 转换为 Proto 可用时段
+func toProtoCouponSchedule(s *biz.CouponSchedule) *v1.CouponSchedule {
+	if s.IsEmpty() {
+		return nil
+	}
+	schedule := &v1.CouponSchedule{BlackoutDates: s.BlackoutDates}
+	for _, w := range s.Windows {
+		schedule.Windows = append(schedule.Windows, &v1.CouponTimeWindow{
+			Weekdays:  w.Weekdays,
+			StartTime: w.StartTime,
+			EndTime:   w.EndTime,
+		})
+	}
+	return schedule
+}
+
+// toBizCouponPacing 转换为业务投放节奏（interval 为空表示不限）
+func toBizCouponPacing(p *v1.CouponPacing) *biz.CouponPacing {
+	if p == nil || p.Interval == "" {
+		return nil
+	}
+	return &biz.CouponPacing{Interval: p.Interval, Curve: p.Curve}
+}
+
+// toProtoCouponPacing 转换为 Proto 投放节奏
+func toProtoCouponPacing(p *biz.CouponPacing) *v1.CouponPacing {
+	if p == nil {
+		return nil
+	}
+	return &v1.CouponPacing{Interval: p.Interval, Curve: p.Curve}
 }
 
 // toProtoCouponUsage 转换为 Proto CouponUsage
@@ -362,6 +716,7 @@ func (s *MarketingService) toProtoCouponUsage(u *biz.CouponUsage) *v1.CouponUsag
 	return &v1.CouponUsage{
 		CouponUsageId:  u.CouponUsageID,
 		CouponCode:     u.CouponCode,
+		AppId:          u.AppID,
 		UserId:         u.UserID,
 		PaymentOrderId: u.PaymentOrderID,
 		PaymentId:      u.PaymentID,
@@ -369,5 +724,41 @@ func (s *MarketingService) toProtoCouponUsage(u *biz.CouponUsage) *v1.CouponUsag
 		DiscountAmount: u.DiscountAmount,
 		FinalAmount:    u.FinalAmount,
 		UsedAt:         usedAt,
+		Currency:       u.Currency,
+	}
+}
+
+// toCouponFilter 将列表请求转换为优惠券筛选条件（列表和导出共用）
+func toCouponFilter(appID string, req *v1.ListCouponsRequest) *biz.CouponFilter {
+	return &biz.CouponFilter{
+		AppID:              appID,
+		Status:             req.GetStatus(),
+		CodePrefix:         req.GetCodePrefix(),
+		CodeContains:       req.GetCodeContains(),
+		DiscountType:       req.GetDiscountType(),
+		Currency:           req.GetCurrency(),
+		ActiveFrom:         unixToTime(req.GetActiveFrom()),
+		ActiveUntil:        unixToTime(req.GetActiveUntil()),
+		ExpiringWithinDays: req.GetExpiringWithinDays(),
+		Exhausted:          req.Exhausted,
+		CreatedFrom:        unixToTime(req.GetCreatedFrom()),
+		CreatedTo:          unixToTime(req.GetCreatedTo()),
+		SortBy:             req.GetSortBy(),
+		SortOrder:          req.GetSortOrder(),
+		CampaignID:         req.GetCampaignId(),
+	}
+}
+
+// toProtoCurrencyAmounts 转换按币种汇总的金额
+func (s *MarketingService) toProtoCurrencyAmounts(amounts []*biz.CurrencyAmount) []*v1.CurrencyAmount {
+	result := make([]*v1.CurrencyAmount, 0, len(amounts))
+	for _, a := range amounts {
+		result = append(result, &v1.CurrencyAmount{
+			Currency:      a.Currency,
+			TotalUses:     a.TotalUses,
+			TotalRevenue:  a.TotalRevenue,
+			TotalDiscount: a.TotalDiscount,
+		})
 	}
+	return result
 }
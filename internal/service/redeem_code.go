@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// CreateRedeemCodes 批量生成兑换码
+func (s *MarketingService) CreateRedeemCodes(ctx context.Context, req *v1.CreateRedeemCodesRequest) (*v1.CreateRedeemCodesReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	codes, err := s.rcuc.CreateBatch(ctx, &biz.RedeemCode{
+		AppID:            appID,
+		CampaignID:       req.CampaignId,
+		RewardType:       req.RewardType,
+		CouponCode:       req.CouponCode,
+		Points:           req.Points,
+		SubscriptionPlan: req.SubscriptionPlan,
+		SubscriptionDays: req.SubscriptionDays,
+		ExpiresAt:        time.Unix(req.ExpiresAt, 0),
+	}, int(req.Count), req.Prefix, int(req.Length))
+	if err != nil {
+		s.log.Errorf("failed to create redeem codes: %v", err)
+		return nil, err
+	}
+
+	reply := &v1.CreateRedeemCodesReply{Codes: make([]string, 0, len(codes))}
+	for _, c := range codes {
+		reply.BatchId = c.BatchID
+		reply.Codes = append(reply.Codes, c.Code)
+	}
+	return reply, nil
+}
+
+// GetRedeemCode 获取兑换码
+func (s *MarketingService) GetRedeemCode(ctx context.Context, req *v1.GetRedeemCodeRequest) (*v1.RedeemCodeReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	code, err := s.rcuc.Get(ctx, appID, req.Code)
+	if err != nil {
+		s.log.Errorf("failed to get redeem code: %v", err)
+		return nil, err
+	}
+
+	return &v1.RedeemCodeReply{RedeemCode: s.toProtoRedeemCode(code, time.Now())}, nil
+}
+
+// ListRedeemCodes 列出兑换码
+func (s *MarketingService) ListRedeemCodes(ctx context.Context, req *v1.ListRedeemCodesRequest) (*v1.ListRedeemCodesReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	now := time.Now()
+	codes, total, err := s.rcuc.List(ctx, &biz.RedeemCodeFilter{
+		AppID:      appID,
+		BatchID:    req.BatchId,
+		Status:     req.Status,
+		RedeemedBy: req.RedeemedBy,
+		Now:        now,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list redeem codes: %v", err)
+		return nil, err
+	}
+
+	protoCodes := make([]*v1.RedeemCode, 0, len(codes))
+	for _, c := range codes {
+		protoCodes = append(protoCodes, s.toProtoRedeemCode(c, now))
+	}
+
+	return &v1.ListRedeemCodesReply{
+		RedeemCodes: protoCodes,
+		Total:       int32(total),
+		Page:        int32(page),
+		PageSize:    int32(pageSize),
+	}, nil
+}
+
+// RedeemCode 用户兑换兑换码
+func (s *MarketingService) RedeemCode(ctx context.Context, req *v1.RedeemCodeRequest) (*v1.RedeemCodeRedemptionReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	redemption, err := s.rcuc.Redeem(ctx, appID, req.Code, req.UserId)
+	if err != nil {
+		s.log.Errorf("failed to redeem code: %v", err)
+		return nil, err
+	}
+
+	return &v1.RedeemCodeRedemptionReply{Redemption: s.toProtoRedemption(redemption)}, nil
+}
+
+// RevokeRedeemCode 作废兑换码
+func (s *MarketingService) RevokeRedeemCode(ctx context.Context, req *v1.RevokeRedeemCodeRequest) (*v1.RedeemCodeReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	code, err := s.rcuc.Revoke(ctx, appID, req.Code)
+	if err != nil {
+		s.log.Errorf("failed to revoke redeem code: %v", err)
+		return nil, err
+	}
+
+	return &v1.RedeemCodeReply{RedeemCode: s.toProtoRedeemCode(code, time.Now())}, nil
+}
+
+// ListRedeemCodeRedemptions 列出兑换记录
+func (s *MarketingService) ListRedeemCodeRedemptions(ctx context.Context, req *v1.ListRedeemCodeRedemptionsRequest) (*v1.ListRedeemCodeRedemptionsReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	redemptions, total, err := s.rcuc.ListRedemptions(ctx, &biz.RedemptionFilter{
+		AppID:       appID,
+		UserID:      req.UserId,
+		GrantStatus: req.GrantStatus,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list redeem code redemptions: %v", err)
+		return nil, err
+	}
+
+	protoRedemptions := make([]*v1.RedeemCodeRedemption, 0, len(redemptions))
+	for _, r := range redemptions {
+		protoRedemptions = append(protoRedemptions, s.toProtoRedemption(r))
+	}
+
+	return &v1.ListRedeemCodeRedemptionsReply{
+		Redemptions: protoRedemptions,
+		Total:       int32(total),
+		Page:        int32(page),
+		PageSize:    int32(pageSize),
+	}, nil
+}
+
+// RetryRedeemCodeGrant 重试兑换权益发放
+func (s *MarketingService) RetryRedeemCodeGrant(ctx context.Context, req *v1.RetryRedeemCodeGrantRequest) (*v1.RedeemCodeRedemptionReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	redemption, err := s.rcuc.RetryGrant(ctx, appID, req.Code)
+	if err != nil {
+		s.log.Errorf("failed to retry redeem code grant: %v", err)
+		return nil, err
+	}
+
+	return &v1.RedeemCodeRedemptionReply{Redemption: s.toProtoRedemption(redemption)}, nil
+}
+
+// toProtoRedemption 转换为 Proto 兑换记录
+func (s *MarketingService) toProtoRedemption(r *biz.RedeemCodeRedemption) *v1.RedeemCodeRedemption {
+	p := &v1.RedeemCodeRedemption{
+		RedemptionId:     r.RedemptionID,
+		Code:             r.Code,
+		UserId:           r.UserID,
+		RewardType:       r.RewardType,
+		CouponCode:       r.CouponCode,
+		UserCouponId:     r.UserCouponID,
+		Points:           r.Points,
+		SubscriptionPlan: r.SubscriptionPlan,
+		SubscriptionDays: r.SubscriptionDays,
+		RedeemedAt:       r.RedeemedAt.Unix(),
+		GrantStatus:      r.GrantStatus,
+		GrantError:       r.GrantError,
+		GrantAttempts:    r.GrantAttempts,
+	}
+	if !r.GrantedAt.IsZero() {
+		p.GrantedAt = r.GrantedAt.Unix()
+	}
+	return p
+}
+
+// toProtoRedeemCode 转换为 Proto 兑换码（状态为 now 时的展示状态）
+func (s *MarketingService) toProtoRedeemCode(c *biz.RedeemCode, now time.Time) *v1.RedeemCode {
+	p := &v1.RedeemCode{
+		Code:             c.Code,
+		BatchId:          c.BatchID,
+		CampaignId:       c.CampaignID,
+		RewardType:       c.RewardType,
+		CouponCode:       c.CouponCode,
+		Points:           c.Points,
+		SubscriptionPlan: c.SubscriptionPlan,
+		SubscriptionDays: c.SubscriptionDays,
+		Status:           c.DisplayStatus(now),
+		ExpiresAt:        c.ExpiresAt.Unix(),
+		RedeemedBy:       c.RedeemedBy,
+		CreatedAt:        c.CreatedAt.Unix(),
+		UpdatedAt:        c.UpdatedAt.Unix(),
+	}
+	if !c.RedeemedAt.IsZero() {
+		p.RedeemedAt = c.RedeemedAt.Unix()
+	}
+	return p
+}
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+	marketingErrors "marketing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateReward 创建奖励模板
+func (s *MarketingService) CreateReward(ctx context.Context, req *v1.CreateRewardRequest) (*v1.RewardReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	reward := &biz.Reward{
+		AppID:       appID,
+		Name:        req.Name,
+		Description: req.Description,
+		RewardType:  req.RewardType,
+		Content:     s.fromProtoRewardContent(req.Content),
+		Generator:   s.fromProtoRewardGenerator(req.GeneratorConfig),
+		Validator:   s.fromProtoRewardValidator(req.ValidatorConfig),
+		Distributor: s.fromProtoRewardDistributor(req.DistributorConfig),
+		ValidDays:   req.ValidDays,
+	}
+
+	result, err := s.rwuc.Create(ctx, reward)
+	if err != nil {
+		s.log.Errorf("failed to create reward: %v", err)
+		return nil, err
+	}
+
+	return &v1.RewardReply{Reward: s.toProtoReward(result)}, nil
+}
+
+// GetReward 获取奖励模板
+func (s *MarketingService) GetReward(ctx context.Context, req *v1.GetRewardRequest) (*v1.RewardReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	reward, err := s.rwuc.Get(ctx, appID, req.RewardId)
+	if err != nil {
+		s.log.Errorf("failed to get reward: %v", err)
+		return nil, err
+	}
+
+	return &v1.RewardReply{Reward: s.toProtoReward(reward)}, nil
+}
+
+// ListRewards 列出奖励模板
+func (s *MarketingService) ListRewards(ctx context.Context, req *v1.ListRewardsRequest) (*v1.ListRewardsReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	rewards, total, err := s.rwuc.List(ctx, &biz.RewardFilter{
+		AppID:      appID,
+		RewardType: req.RewardType,
+		Status:     req.Status,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list rewards: %v", err)
+		return nil, err
+	}
+
+	protoRewards := make([]*v1.Reward, 0, len(rewards))
+	for _, r := range rewards {
+		protoRewards = append(protoRewards, s.toProtoReward(r))
+	}
+
+	return &v1.ListRewardsReply{
+		Rewards:  protoRewards,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// UpdateReward 更新奖励模板
+func (s *MarketingService) UpdateReward(ctx context.Context, req *v1.UpdateRewardRequest) (*v1.RewardReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	reward, err := s.rwuc.Get(ctx, appID, req.RewardId)
+	if err != nil {
+		s.log.Errorf("failed to get reward: %v", err)
+		return nil, err
+	}
+	// 调用方读取后奖励已被修改
+	if req.Version > 0 && req.Version != reward.Version {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, marketingErrors.ErrCodeRewardVersionConflict)
+	}
+
+	// 更新字段
+	if req.Name != "" {
+		reward.Name = req.Name
+	}
+	if req.Description != nil {
+		reward.Description = *req.Description
+	}
+	if req.RewardType != "" {
+		reward.RewardType = req.RewardType
+	}
+	if req.Content != nil {
+		reward.Content = s.fromProtoRewardContent(req.Content)
+	}
+	if req.GeneratorConfig != nil {
+		reward.Generator = s.fromProtoRewardGenerator(req.GeneratorConfig)
+	}
+	if req.ValidatorConfig != nil {
+		reward.Validator = s.fromProtoRewardValidator(req.ValidatorConfig)
+	}
+	if req.DistributorConfig != nil {
+		reward.Distributor = s.fromProtoRewardDistributor(req.DistributorConfig)
+	}
+	if req.ValidDays != nil {
+		reward.ValidDays = *req.ValidDays
+	}
+	if req.Status != "" {
+		reward.Status = req.Status
+	}
+
+	result, err := s.rwuc.Update(ctx, reward)
+	if err != nil {
+		s.log.Errorf("failed to update reward: %v", err)
+		return nil, err
+	}
+
+	return &v1.RewardReply{Reward: s.toProtoReward(result)}, nil
+}
+
+// DeleteReward 删除奖励模板
+func (s *MarketingService) DeleteReward(ctx context.Context, req *v1.DeleteRewardRequest) (*emptypb.Empty, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	if err := s.rwuc.Delete(ctx, appID, req.RewardId); err != nil {
+		s.log.Errorf("failed to delete reward: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// IssueReward 发放奖励
+func (s *MarketingService) IssueReward(ctx context.Context, req *v1.IssueRewardRequest) (*v1.RewardGrantReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	grant, err := s.rwuc.Issue(ctx, &biz.RewardIssue{
+		AppID:      appID,
+		RewardID:   req.RewardId,
+		UserID:     req.UserId,
+		CampaignID: req.CampaignId,
+		RequestID:  req.RequestId,
+		Attrs:      req.UserAttributes,
+	})
+	if err != nil {
+		s.log.Errorf("failed to issue reward: %v", err)
+		return nil, err
+	}
+
+	return &v1.RewardGrantReply{Grant: s.toProtoRewardGrant(grant, time.Now())}, nil
+}
+
+// GetRewardGrant 获取奖励发放记录
+func (s *MarketingService) GetRewardGrant(ctx context.Context, req *v1.GetRewardGrantRequest) (*v1.RewardGrantReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	grant, err := s.rwuc.GetGrant(ctx, appID, req.GrantId)
+	if err != nil {
+		s.log.Errorf("failed to get reward grant: %v", err)
+		return nil, err
+	}
+
+	return &v1.RewardGrantReply{Grant: s.toProtoRewardGrant(grant, time.Now())}, nil
+}
+
+// ListRewardGrants 列出奖励发放记录
+func (s *MarketingService) ListRewardGrants(ctx context.Context, req *v1.ListRewardGrantsRequest) (*v1.ListRewardGrantsReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	now := time.Now()
+	grants, total, err := s.rwuc.ListGrants(ctx, &biz.RewardGrantFilter{
+		AppID:      appID,
+		RewardID:   req.RewardId,
+		UserID:     req.UserId,
+		CampaignID: req.CampaignId,
+		TaskID:     req.TaskId,
+		Status:     req.Status,
+		Now:        now,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list reward grants: %v", err)
+		return nil, err
+	}
+
+	protoGrants := make([]*v1.RewardGrant, 0, len(grants))
+	for _, g := range grants {
+		protoGrants = append(protoGrants, s.toProtoRewardGrant(g, now))
+	}
+
+	return &v1.ListRewardGrantsReply{
+		Grants:   protoGrants,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// DistributeRewardGrant 确认发放
+func (s *MarketingService) DistributeRewardGrant(ctx context.Context, req *v1.DistributeRewardGrantRequest) (*v1.RewardGrantReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	grant, err := s.rwuc.Distribute(ctx, appID, req.GrantId)
+	if err != nil {
+		s.log.Errorf("failed to distribute reward grant: %v", err)
+		return nil, err
+	}
+
+	return &v1.RewardGrantReply{Grant: s.toProtoRewardGrant(grant, time.Now())}, nil
+}
+
+// UseRewardGrant 标记奖励已使用
+func (s *MarketingService) UseRewardGrant(ctx context.Context, req *v1.UseRewardGrantRequest) (*v1.RewardGrantReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	grant, err := s.rwuc.Use(ctx, appID, req.GrantId)
+	if err != nil {
+		s.log.Errorf("failed to use reward grant: %v", err)
+		return nil, err
+	}
+
+	return &v1.RewardGrantReply{Grant: s.toProtoRewardGrant(grant, time.Now())}, nil
+}
+
+// fromProtoRewardContent 转换 Proto 奖励内容
+func (s *MarketingService) fromProtoRewardContent(p *v1.RewardContent) biz.RewardContent {
+	if p == nil {
+		return biz.RewardContent{}
+	}
+	return biz.RewardContent{
+		DiscountType:     p.DiscountType,
+		DiscountValue:    p.DiscountValue,
+		Currency:         p.Currency,
+		MinAmount:        p.MinAmount,
+		Points:           p.Points,
+		SubscriptionPlan: p.SubscriptionPlan,
+		SubscriptionDays: p.SubscriptionDays,
+	}
+}
+
+// fromProtoRewardGenerator 转换 Proto 生成配置
+func (s *MarketingService) fromProtoRewardGenerator(p *v1.RewardGeneratorConfig) biz.RewardGeneratorConfig {
+	if p == nil {
+		return biz.RewardGeneratorConfig{}
+	}
+	return biz.RewardGeneratorConfig{
+		CodePrefix: p.CodePrefix,
+		TotalCount: p.TotalCount,
+	}
+}
+
+// fromProtoRewardValidator 转换 Proto 校验配置
+func (s *MarketingService) fromProtoRewardValidator(p *v1.RewardValidatorConfig) biz.RewardValidatorConfig {
+	if p == nil {
+		return biz.RewardValidatorConfig{}
+	}
+	c := biz.RewardValidatorConfig{
+		AudienceID:   p.AudienceId,
+		PerUserLimit: p.PerUserLimit,
+	}
+	if p.StartTime > 0 {
+		c.StartTime = time.Unix(p.StartTime, 0)
+	}
+	if p.EndTime > 0 {
+		c.EndTime = time.Unix(p.EndTime, 0)
+	}
+	return c
+}
+
+// fromProtoRewardDistributor 转换 Proto 发放配置
+func (s *MarketingService) fromProtoRewardDistributor(p *v1.RewardDistributorConfig) biz.RewardDistributorConfig {
+	if p == nil {
+		return biz.RewardDistributorConfig{}
+	}
+	return biz.RewardDistributorConfig{Type: p.Type}
+}
+
+// toProtoRewardContent 转换为 Proto 奖励内容
+func (s *MarketingService) toProtoRewardContent(c biz.RewardContent) *v1.RewardContent {
+	return &v1.RewardContent{
+		DiscountType:     c.DiscountType,
+		DiscountValue:    c.DiscountValue,
+		Currency:         c.Currency,
+		MinAmount:        c.MinAmount,
+		Points:           c.Points,
+		SubscriptionPlan: c.SubscriptionPlan,
+		SubscriptionDays: c.SubscriptionDays,
+	}
+}
+
+// toProtoReward 转换为 Proto 奖励模板
+func (s *MarketingService) toProtoReward(r *biz.Reward) *v1.Reward {
+	validator := &v1.RewardValidatorConfig{
+		AudienceId:   r.Validator.AudienceID,
+		PerUserLimit: r.Validator.PerUserLimit,
+	}
+	if !r.Validator.StartTime.IsZero() {
+		validator.StartTime = r.Validator.StartTime.Unix()
+	}
+	if !r.Validator.EndTime.IsZero() {
+		validator.EndTime = r.Validator.EndTime.Unix()
+	}
+	return &v1.Reward{
+		RewardId:    r.RewardID,
+		Name:        r.Name,
+		Description: r.Description,
+		RewardType:  r.RewardType,
+		Content:     s.toProtoRewardContent(r.Content),
+		GeneratorConfig: &v1.RewardGeneratorConfig{
+			CodePrefix: r.Generator.CodePrefix,
+			TotalCount: r.Generator.TotalCount,
+		},
+		ValidatorConfig:   validator,
+		DistributorConfig: &v1.RewardDistributorConfig{Type: r.Distributor.Type},
+		ValidDays:         r.ValidDays,
+		Version:           r.Version,
+		Status:            r.Status,
+		CreatedAt:         r.CreatedAt.Unix(),
+		UpdatedAt:         r.UpdatedAt.Unix(),
+	}
+}
+
+// toProtoRewardGrant 转换为 Proto 奖励发放记录（状态为 now 时的展示状态）
+func (s *MarketingService) toProtoRewardGrant(g *biz.RewardGrant, now time.Time) *v1.RewardGrant {
+	p := &v1.RewardGrant{
+		GrantId:         g.GrantID,
+		RewardId:        g.RewardID,
+		RewardName:      g.RewardName,
+		RewardType:      g.RewardType,
+		RewardVersion:   g.RewardVersion,
+		ContentSnapshot: s.toProtoRewardContent(g.ContentSnapshot),
+		CampaignId:      g.CampaignID,
+		CampaignName:    g.CampaignName,
+		TaskId:          g.TaskID,
+		TaskName:        g.TaskName,
+		UserId:          g.UserID,
+		RequestId:       g.RequestID,
+		Status:          g.DisplayStatus(now),
+		CouponCode:      g.CouponCode,
+		CreatedAt:       g.CreatedAt.Unix(),
+		UpdatedAt:       g.UpdatedAt.Unix(),
+	}
+	if !g.ExpireTime.IsZero() {
+		p.ExpireTime = g.ExpireTime.Unix()
+	}
+	if !g.GeneratedAt.IsZero() {
+		p.GeneratedAt = g.GeneratedAt.Unix()
+	}
+	if !g.DistributedAt.IsZero() {
+		p.DistributedAt = g.DistributedAt.Unix()
+	}
+	if !g.UsedAt.IsZero() {
+		p.UsedAt = g.UsedAt.Unix()
+	}
+	return p
+}
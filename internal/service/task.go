@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateTask 创建任务
+func (s *MarketingService) CreateTask(ctx context.Context, req *v1.CreateTaskRequest) (*v1.TaskReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	task := &biz.Task{
+		AppID:       appID,
+		CampaignID:  req.CampaignId,
+		Name:        req.Name,
+		Description: req.Description,
+		TaskType:    req.TaskType,
+		Trigger:     s.fromProtoTaskTrigger(req.TriggerConfig),
+		Condition:   s.fromProtoTaskCondition(req.ConditionConfig),
+		RewardID:    req.RewardId,
+		Repeatable:  req.Repeatable,
+		Status:      req.Status,
+	}
+
+	result, err := s.tkuc.Create(ctx, task)
+	if err != nil {
+		s.log.Errorf("failed to create task: %v", err)
+		return nil, err
+	}
+
+	return &v1.TaskReply{Task: s.toProtoTask(result)}, nil
+}
+
+// GetTask 获取任务
+func (s *MarketingService) GetTask(ctx context.Context, req *v1.GetTaskRequest) (*v1.TaskReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	task, err := s.tkuc.Get(ctx, appID, req.TaskId)
+	if err != nil {
+		s.log.Errorf("failed to get task: %v", err)
+		return nil, err
+	}
+
+	return &v1.TaskReply{Task: s.toProtoTask(task)}, nil
+}
+
+// ListTasks 列出任务
+func (s *MarketingService) ListTasks(ctx context.Context, req *v1.ListTasksRequest) (*v1.ListTasksReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	tasks, total, err := s.tkuc.List(ctx, &biz.TaskFilter{
+		AppID:      appID,
+		CampaignID: req.CampaignId,
+		TaskType:   req.TaskType,
+		Status:     req.Status,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list tasks: %v", err)
+		return nil, err
+	}
+
+	protoTasks := make([]*v1.Task, 0, len(tasks))
+	for _, t := range tasks {
+		protoTasks = append(protoTasks, s.toProtoTask(t))
+	}
+
+	return &v1.ListTasksReply{
+		Tasks:    protoTasks,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// UpdateTask 更新任务
+func (s *MarketingService) UpdateTask(ctx context.Context, req *v1.UpdateTaskRequest) (*v1.TaskReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	task, err := s.tkuc.Get(ctx, appID, req.TaskId)
+	if err != nil {
+		s.log.Errorf("failed to get task: %v", err)
+		return nil, err
+	}
+
+	// 更新字段
+	if req.Name != "" {
+		task.Name = req.Name
+	}
+	if req.Description != nil {
+		task.Description = *req.Description
+	}
+	if req.TaskType != "" {
+		task.TaskType = req.TaskType
+	}
+	if req.TriggerConfig != nil {
+		task.Trigger = s.fromProtoTaskTrigger(req.TriggerConfig)
+	}
+	if req.ConditionConfig != nil {
+		task.Condition = s.fromProtoTaskCondition(req.ConditionConfig)
+	}
+	if req.RewardId != "" {
+		task.RewardID = req.RewardId
+	}
+	if req.Repeatable != nil {
+		task.Repeatable = *req.Repeatable
+	}
+	if req.Status != "" {
+		task.Status = req.Status
+	}
+
+	result, err := s.tkuc.Update(ctx, task)
+	if err != nil {
+		s.log.Errorf("failed to update task: %v", err)
+		return nil, err
+	}
+
+	return &v1.TaskReply{Task: s.toProtoTask(result)}, nil
+}
+
+// DeleteTask 删除任务
+func (s *MarketingService) DeleteTask(ctx context.Context, req *v1.DeleteTaskRequest) (*emptypb.Empty, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	if err := s.tkuc.Delete(ctx, appID, req.TaskId); err != nil {
+		s.log.Errorf("failed to delete task: %v", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListTaskProgress 列出用户任务进度
+func (s *MarketingService) ListTaskProgress(ctx context.Context, req *v1.ListTaskProgressRequest) (*v1.ListTaskProgressReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	progress, total, err := s.tkuc.ListProgress(ctx, &biz.TaskProgressFilter{
+		AppID:  appID,
+		TaskID: req.TaskId,
+		UserID: req.UserId,
+		Status: req.Status,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list task progress: %v", err)
+		return nil, err
+	}
+
+	protoProgress := make([]*v1.TaskProgress, 0, len(progress))
+	for _, p := range progress {
+		item := &v1.TaskProgress{
+			TaskId:      p.TaskID,
+			UserId:      p.UserID,
+			Progress:    p.Progress,
+			Target:      p.Target,
+			Completions: p.Completions,
+			Status:      p.Status,
+			LastEventId: p.LastEventID,
+			CreatedAt:   p.CreatedAt.Unix(),
+			UpdatedAt:   p.UpdatedAt.Unix(),
+		}
+		if !p.CompletedAt.IsZero() {
+			item.CompletedAt = p.CompletedAt.Unix()
+		}
+		protoProgress = append(protoProgress, item)
+	}
+
+	return &v1.ListTaskProgressReply{
+		Progress: protoProgress,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// TriggerEvent 上报业务事件
+func (s *MarketingService) TriggerEvent(ctx context.Context, req *v1.TriggerEventRequest) (*v1.TriggerEventReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	results, err := s.tkuc.Trigger(ctx, &biz.TaskEvent{
+		EventID:    req.EventId,
+		AppID:      appID,
+		EventType:  req.EventType,
+		UserID:     req.UserId,
+		Amount:     req.Amount,
+		Currency:   req.Currency,
+		Attributes: req.Attributes,
+	})
+	if err != nil {
+		s.log.Errorf("failed to trigger event: %v", err)
+		return nil, err
+	}
+
+	reply := &v1.TriggerEventReply{Results: make([]*v1.TaskTriggerResult, 0, len(results))}
+	for _, r := range results {
+		reply.Results = append(reply.Results, &v1.TaskTriggerResult{
+			TaskId:      r.TaskID,
+			TaskName:    r.TaskName,
+			UserId:      r.UserID,
+			Progress:    r.Progress,
+			Target:      r.Target,
+			Completions: r.Completions,
+			Completed:   r.Completed,
+			Duplicate:   r.Duplicate,
+			Ignored:     r.Ignored,
+			GrantId:     r.GrantID,
+			RewardError: r.RewardError,
+		})
+	}
+	return reply, nil
+}
+
+// fromProtoTaskTrigger 转换 Proto 触发配置
+func (s *MarketingService) fromProtoTaskTrigger(p *v1.TaskTriggerConfig) biz.TaskTriggerConfig {
+	if p == nil {
+		return biz.TaskTriggerConfig{}
+	}
+	return biz.TaskTriggerConfig{
+		EventType:     p.EventType,
+		UserAttribute: p.UserAttribute,
+	}
+}
+
+// fromProtoTaskCondition 转换 Proto 完成条件
+func (s *MarketingService) fromProtoTaskCondition(p *v1.TaskConditionConfig) biz.TaskConditionConfig {
+	if p == nil {
+		return biz.TaskConditionConfig{}
+	}
+	return biz.TaskConditionConfig{
+		MinAmount:   p.MinAmount,
+		Currency:    p.Currency,
+		Attributes:  p.Attributes,
+		TargetCount: p.TargetCount,
+	}
+}
+
+// toProtoTask 转换为 Proto 任务
+func (s *MarketingService) toProtoTask(t *biz.Task) *v1.Task {
+	return &v1.Task{
+		TaskId:      t.TaskID,
+		CampaignId:  t.CampaignID,
+		Name:        t.Name,
+		Description: t.Description,
+		TaskType:    t.TaskType,
+		TriggerConfig: &v1.TaskTriggerConfig{
+			EventType:     t.Trigger.EventType,
+			UserAttribute: t.Trigger.UserAttribute,
+		},
+		ConditionConfig: &v1.TaskConditionConfig{
+			MinAmount:   t.Condition.MinAmount,
+			Currency:    t.Condition.Currency,
+			Attributes:  t.Condition.Attributes,
+			TargetCount: t.Condition.TargetCount,
+		},
+		RewardId:   t.RewardID,
+		Repeatable: t.Repeatable,
+		Status:     t.Status,
+		CreatedAt:  t.CreatedAt.Unix(),
+		UpdatedAt:  t.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "marketing-service/api/marketing_service/v1"
+	"marketing-service/internal/biz"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
+)
+
+// IssueCoupon 将优惠券发放给用户
+func (s *MarketingService) IssueCoupon(ctx context.Context, req *v1.IssueCouponRequest) (*v1.UserCouponReply, error) {
+	// 获取 appId（只从 Context，由中间件从 Header 提取）
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	userCoupon, err := s.cuc.Issue(ctx, appID, req.CouponCode, req.UserId)
+	if err != nil {
+		s.log.Errorf("failed to issue coupon: %v", err)
+		return nil, err
+	}
+
+	return &v1.UserCouponReply{
+		UserCoupon: s.toProtoUserCoupon(userCoupon, time.Now()),
+	}, nil
+}
+
+// ClaimCoupon 用户领取优惠券到券包
+func (s *MarketingService) ClaimCoupon(ctx context.Context, req *v1.ClaimCouponRequest) (*v1.UserCouponReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	userCoupon, err := s.cuc.Claim(ctx, appID, req.CouponCode, req.UserId)
+	if err != nil {
+		s.log.Errorf("failed to claim coupon: %v", err)
+		return nil, err
+	}
+
+	return &v1.UserCouponReply{
+		UserCoupon: s.toProtoUserCoupon(userCoupon, time.Now()),
+	}, nil
+}
+
+// ListUserCoupons 列出用户券包
+func (s *MarketingService) ListUserCoupons(ctx context.Context, req *v1.ListUserCouponsRequest) (*v1.ListUserCouponsReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	now := time.Now()
+	userCoupons, total, err := s.cuc.ListUserCoupons(ctx, &biz.UserCouponFilter{
+		AppID:  appID,
+		UserID: req.UserId,
+		Status: req.Status,
+		Now:    now,
+	}, page, pageSize)
+	if err != nil {
+		s.log.Errorf("failed to list user coupons: %v", err)
+		return nil, err
+	}
+
+	protoUserCoupons := make([]*v1.UserCoupon, 0, len(userCoupons))
+	for _, u := range userCoupons {
+		protoUserCoupons = append(protoUserCoupons, s.toProtoUserCoupon(u, now))
+	}
+
+	return &v1.ListUserCouponsReply{
+		UserCoupons: protoUserCoupons,
+		Total:       int32(total),
+		Page:        int32(page),
+		PageSize:    int32(pageSize),
+	}, nil
+}
+
+// GetUserCoupon 获取用户优惠券
+func (s *MarketingService) GetUserCoupon(ctx context.Context, req *v1.GetUserCouponRequest) (*v1.UserCouponReply, error) {
+	appID := app_id.GetAppIDFromContext(ctx)
+	if appID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeInvalidArgument)
+	}
+
+	userCoupon, err := s.cuc.GetUserCoupon(ctx, appID, req.UserCouponId)
+	if err != nil {
+		s.log.Errorf("failed to get user coupon: %v", err)
+		return nil, err
+	}
+
+	return &v1.UserCouponReply{
+		UserCoupon: s.toProtoUserCoupon(userCoupon, time.Now()),
+	}, nil
+}
+
+// toProtoUserCoupon 转换为 Proto 用户优惠券（status 为 now 时刻的展示状态）
+func (s *MarketingService) toProtoUserCoupon(u *biz.UserCoupon, now time.Time) *v1.UserCoupon {
+	pu := &v1.UserCoupon{
+		UserCouponId:   u.UserCouponID,
+		CouponCode:     u.CouponCode,
+		UserId:         u.UserID,
+		Status:         u.DisplayStatus(now),
+		ValidFrom:      u.ValidFrom.Unix(),
+		ValidUntil:     u.ValidUntil.Unix(),
+		IssuedAt:       u.IssuedAt.Unix(),
+		PaymentOrderId: u.PaymentOrderID,
+	}
+	if !u.UsedAt.IsZero() {
+		pu.UsedAt = u.UsedAt.Unix()
+	}
+	if u.Coupon != nil {
+		pu.Coupon = s.toProtoCoupon(u.Coupon)
+	}
+	return pu
+}